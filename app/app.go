@@ -1,8 +1,13 @@
 package app
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/asset"
@@ -10,12 +15,15 @@ import (
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/leaderboard"
 	"github.com/lixenwraith/vi-fighter/manifest"
 	"github.com/lixenwraith/vi-fighter/mode"
 	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/render"
 	"github.com/lixenwraith/vi-fighter/service"
 	"github.com/lixenwraith/vi-fighter/system"
+	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
 // App owns the wired runtime: services, world, renderer, input, and scheduler
@@ -36,6 +44,35 @@ type App struct {
 	scheduler      *engine.ClockScheduler
 	frameReady     chan struct{}
 	gameUpdateDone <-chan struct{}
+
+	// Input recording target, closed on Close
+	recordFile *os.File
+
+	// Desync detection trace and its file, closed on Close; nil when off
+	checksums    *engine.ChecksumTrace
+	checksumFile *os.File
+
+	// Last flushed frame, saved on Close; nil when no screenshot was requested
+	capture *render.CaptureTerminal
+
+	// Asciinema recording of every flush and its file, closed on Close; nil when off
+	cast     *render.CastTerminal
+	castFile *os.File
+
+	// Online leaderboard and the running hash of recorded input; nil when off
+	leaderboard *leaderboard.Client
+	inputHash   *lockedHash
+	submits     sync.WaitGroup // In-flight game-over submissions
+
+	// Demo bot for the untouched start screen; nil when disabled
+	attract *attract
+
+	// Random stream state the session starts from, from a replay header or the seed
+	randState vmath.RandState
+
+	// Persisted preferences as loaded; saved on Close when changed in-game
+	settingsPath string
+	settings     Settings
 }
 
 // New wires the runtime, releasing anything already started on failure
@@ -68,7 +105,10 @@ func (a *App) init() error {
 	_ = a.hub.Register(termSvc)
 	_ = a.hub.Register(netSvc)
 	_ = a.hub.Register(service.NewAudioService(a.cfg.AudioMuted, a.cfg.AudioBackend))
-	_ = a.hub.Register(service.NewContentService(a.cfg.ContentPath))
+	contentSvc := service.NewContentService(a.cfg.ContentPath, a.cfg.PracticePath)
+	_ = a.hub.Register(contentSvc)
+	announceLevel, _ := parameter.ParseAnnounceLevel(a.cfg.AnnounceLevel) // validated in New
+	_ = a.hub.Register(service.NewAnnounceService(a.cfg.AnnouncePath, announceLevel))
 
 	// 2. World creation
 	// Services take no world argument, so placement relative to InitAll is free
@@ -87,11 +127,43 @@ func (a *App) init() error {
 	a.term = a.termSvc.Terminal()
 	core.SetCrashTerminal(a.term)
 	a.term.SetMouseMode(defaultMouseMode)
+	if err := a.wireLeaderboard(); err != nil {
+		return err
+	}
+	if err := a.wireInput(); err != nil {
+		return err
+	}
 	width, height := a.term.Size()
 
 	// 6. GameContext initializes the remaining world resources
 	a.ctx = engine.NewGameContext(a.world, width, height)
 	a.world.Resources.Config.ColorMode = a.term.ColorMode()
+	if err := a.world.Resources.Rand.Restore(a.randState); err != nil {
+		return fmt.Errorf("input replay %s: %w", a.cfg.ReplayPath, err)
+	}
+	contentSvc.Reseed(a.world.Resources.Rand.DeriveSeed(parameter.ContentSeedName))
+
+	// Player preferences; the CLI color-blind flag overrides the saved palette for this session
+	// a.settings keeps the file values so saveSettings can tell the override from in-game changes
+	a.settingsPath = ResolveSettings()
+	settings, err := LoadSettings(a.settingsPath)
+	if err != nil {
+		return fmt.Errorf("settings %s: %w", a.settingsPath, err)
+	}
+	a.settings = settings
+	if a.cfg.ColorBlind != "" {
+		settings.ColorBlind = a.cfg.ColorBlind // validated in New
+	}
+	a.applySettings(settings)
+	if a.cfg.ThemePath != "" {
+		theme, err := visual.LoadSequenceTheme(a.cfg.ThemePath)
+		if err != nil {
+			return fmt.Errorf("theme %s: %w", a.cfg.ThemePath, err)
+		}
+		lut, _ := theme.Palette() // anchors validated by LoadSequenceTheme
+		visual.ApplySequenceTheme(lut)
+	}
+	a.world.Resources.Config.Practice = a.cfg.PracticePath != ""
 
 	// TODO: wire event handling in network system
 
@@ -104,7 +176,12 @@ func (a *App) init() error {
 	}
 
 	// 8. Renderers; Register sorts by priority, manifest order breaks ties
-	a.orchestrator = render.NewRenderOrchestrator(a.term, a.ctx.Width, a.ctx.Height)
+	renderTerm, err := a.wireOutput()
+	if err != nil {
+		return err
+	}
+	a.orchestrator = render.NewRenderOrchestrator(renderTerm, a.ctx.Width, a.ctx.Height)
+	a.orchestrator.SetFlushHook(a.ctx.InputMeter.Flushed)
 	for _, reg := range manifest.BuildRenderers(a.ctx) {
 		a.orchestrator.Register(reg.Renderer, reg.Priority)
 	}
@@ -127,6 +204,9 @@ func (a *App) init() error {
 		a.frameReady,
 	)
 	a.ctx.ResetChan = resetChan
+	if err := a.wireChecksums(); err != nil {
+		return err
+	}
 
 	// 11. FSM
 	if err := a.loadFSM(); err != nil {
@@ -137,6 +217,9 @@ func (a *App) init() error {
 	// MetaSystem is event-only and deliberately absent from the manifest
 	metaSystem := system.NewMetaSystem(a.ctx)
 	a.scheduler.RegisterEventHandler(metaSystem.(event.Handler))
+	if a.leaderboard != nil {
+		a.scheduler.RegisterEventHandler(&scoreSubmitter{a: a})
+	}
 	for _, sys := range a.world.Systems() {
 		if h, ok := sys.(event.Handler); ok {
 			a.scheduler.RegisterEventHandler(h)
@@ -152,7 +235,180 @@ func (a *App) Close() {
 	if a.scheduler != nil {
 		a.scheduler.Stop()
 	}
+	a.saveSettings()
 	a.hub.StopAll()
+	a.submits.Wait()
+	if a.recordFile != nil {
+		a.recordFile.Close()
+	}
+	a.closeChecksums()
+	a.saveScreenshot()
+	a.closeCast()
+}
+
+// wireOutput wraps the terminal the renderer flushes to with the screenshot capture and
+// cast recorder from the startup config
+func (a *App) wireOutput() (terminal.Terminal, error) {
+	term := a.term
+	if a.cfg.ScreenshotPath != "" {
+		a.capture = render.NewCaptureTerminal(term)
+		term = a.capture
+	}
+	if a.cfg.CastPath != "" {
+		f, err := os.Create(a.cfg.CastPath)
+		if err != nil {
+			return nil, fmt.Errorf("cast record: %w", err)
+		}
+		a.castFile = f
+		if a.cast, err = render.NewCastTerminal(term, f); err != nil {
+			return nil, fmt.Errorf("cast record: %w", err)
+		}
+		term = a.cast
+	}
+	return term, nil
+}
+
+// closeCast flushes the cast recording; runs after the terminal is restored
+func (a *App) closeCast() {
+	if a.castFile == nil {
+		return
+	}
+	if a.cast != nil {
+		if err := a.cast.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "cast record: %v\n", err)
+		}
+	}
+	a.castFile.Close()
+}
+
+// saveScreenshot writes the last frame drawn; runs after the terminal is restored
+func (a *App) saveScreenshot() {
+	if a.capture == nil {
+		return
+	}
+	if err := a.capture.Screenshot().Save(a.cfg.ScreenshotPath); err != nil {
+		fmt.Fprintf(os.Stderr, "screenshot: %v\n", err)
+	}
+}
+
+// wireChecksums feeds per-tick world checksums to a recorder, or to a verifier when replaying
+// Replayed input keeps its recorded wall-clock timing, so a key landing one tick off shows
+// as a divergence at that key; anything earlier is a simulation desync
+func (a *App) wireChecksums() error {
+	if a.cfg.ChecksumPath == "" {
+		return nil
+	}
+
+	if a.cfg.ReplayPath == "" {
+		f, err := os.Create(a.cfg.ChecksumPath)
+		if err != nil {
+			return fmt.Errorf("checksum record: %w", err)
+		}
+		a.checksumFile = f
+		a.checksums = engine.NewChecksumRecorder(f)
+	} else {
+		f, err := os.Open(a.cfg.ChecksumPath)
+		if err != nil {
+			return fmt.Errorf("checksum verify: %w", err)
+		}
+		trace, err := engine.NewChecksumVerifier(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("checksum verify %s: %w", a.cfg.ChecksumPath, err)
+		}
+		trace.OnDiverge = func(tick int, want, got uint64) {
+			a.ctx.SetStatusMessage(fmt.Sprintf("desync at tick %d: %016x != %016x", tick, got, want), 0, true)
+		}
+		a.checksums = trace
+	}
+
+	a.scheduler.SetChecksumTrace(a.checksums)
+	return nil
+}
+
+// closeChecksums flushes a recording or reports the verification outcome
+// Runs after the terminal is restored so the report reaches the shell
+func (a *App) closeChecksums() {
+	if a.checksums == nil {
+		return
+	}
+	if a.checksumFile != nil {
+		if err := a.checksums.Flush(); err != nil {
+			fmt.Fprintf(os.Stderr, "checksum record: %v\n", err)
+		}
+		a.checksumFile.Close()
+		return
+	}
+	if tick := a.checksums.Diverged(); tick >= 0 {
+		fmt.Fprintf(os.Stderr, "checksum: first divergent tick %d of %d\n", tick, a.checksums.Ticks())
+	} else {
+		fmt.Fprintf(os.Stderr, "checksum: %d ticks in sync\n", a.checksums.Ticks())
+	}
+}
+
+// RecordingMeta is the header line of input recordings, read back by replay tools
+type RecordingMeta struct {
+	Rand *vmath.RandState `json:"rand,omitempty"`
+}
+
+// wireInput installs the input recording and replay middleware from the startup config
+// and resolves the random stream state: a replay's header wins over the configured seed
+func (a *App) wireInput() error {
+	a.randState = vmath.RandState{Seed: a.cfg.Seed}
+	if a.cfg.Seed == 0 {
+		a.randState.Seed = uint64(time.Now().UnixNano())
+	}
+
+	var replay input.Middleware
+	if a.cfg.ReplayPath != "" {
+		data, err := os.ReadFile(a.cfg.ReplayPath)
+		if err != nil {
+			return fmt.Errorf("input replay: %w", err)
+		}
+		var meta RecordingMeta
+		if _, err := input.ReadRecordingMeta(bytes.NewReader(data), &meta); err != nil {
+			return fmt.Errorf("input replay %s: %w", a.cfg.ReplayPath, err)
+		}
+		if meta.Rand != nil {
+			a.randState = *meta.Rand
+		}
+		if replay, err = input.Replay(bytes.NewReader(data), 1.0); err != nil {
+			return fmt.Errorf("input replay %s: %w", a.cfg.ReplayPath, err)
+		}
+	}
+
+	// The demo bot injects outermost so recordings hold only player input
+	var mws []input.Middleware
+	if idle, ok := a.cfg.attractIdle(); ok && replay == nil {
+		a.attract = newAttract(idle, a.cfg.SmokeTest > 0, a.randState.Seed)
+		mws = append(mws, a.attract.middleware()...)
+	}
+	// Leaderboard scores carry a hash of the recording, kept even when no file is written
+	var recorders []io.Writer
+	if a.cfg.RecordPath != "" {
+		f, err := os.Create(a.cfg.RecordPath)
+		if err != nil {
+			return fmt.Errorf("input record: %w", err)
+		}
+		a.recordFile = f
+		recorders = append(recorders, f)
+	}
+	if a.leaderboard != nil {
+		a.inputHash = &lockedHash{h: sha256.New()}
+		recorders = append(recorders, a.inputHash)
+	}
+	if len(recorders) > 0 {
+		rec := io.MultiWriter(recorders...)
+		if err := input.WriteRecordingMeta(rec, RecordingMeta{Rand: &a.randState}); err != nil {
+			return fmt.Errorf("input record: %w", err)
+		}
+		mws = append(mws, input.Record(rec))
+	}
+	if replay != nil {
+		mws = append(mws, replay)
+	}
+	a.termSvc.Use(mws...)
+	return nil
 }
 
 // loadKeymap merges an external key table over the defaults
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/bot"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// errSmokeNoProgress reports a smoke run in which the bot never cleared a character
+var errSmokeNoProgress = errors.New("smoke test: demo bot cleared no characters")
+
+// attract is the demo bot that plays while the game sits untouched after startup
+// A greedy agent's keys are injected ahead of the terminal, so the input machine,
+// router, and systems see them exactly as typed input; the first player key ends the demo
+type attract struct {
+	idle     time.Duration
+	locked   bool // Smoke runs: player input other than quit is dropped and never ends the demo
+	injector *input.Injector
+	pilot    *bot.Pilot
+
+	// Written by the poll goroutine filter, read by the main loop
+	touched atomic.Bool // Any player input; disarms the idle trigger
+	wake    atomic.Bool // Player input during the demo, swallowed
+	active  atomic.Bool
+
+	// Main-loop exclusive
+	armedAt   time.Time
+	startedAt time.Time
+}
+
+func newAttract(idle time.Duration, locked bool, seed uint64) *attract {
+	return &attract{
+		idle:     idle,
+		locked:   locked,
+		injector: input.NewInjector(parameter.AttractInjectBuffer),
+		pilot:    bot.NewPilot(bot.NewGreedy(bot.Human, seed), parameter.BotRetryDelay),
+	}
+}
+
+// middleware returns the injector and the player input observer beneath it
+// The observer sits inside the injector so it never sees the bot's own keys
+func (at *attract) middleware() []input.Middleware {
+	return []input.Middleware{at.injector.Middleware(), input.Filter(at.observe)}
+}
+
+// observe marks player input and swallows the key that wakes the game from the demo
+func (at *attract) observe(ev terminal.Event) bool {
+	if ev.Type != terminal.EventKey && ev.Type != terminal.EventMouse {
+		return true
+	}
+	if at.locked {
+		return ev.Key == terminal.KeyCtrlC || ev.Key == terminal.KeyCtrlQ
+	}
+	at.touched.Store(true)
+	if at.active.Load() {
+		at.wake.Store(true)
+		return false
+	}
+	return true
+}
+
+// attractTick starts, paces, and ends the demo; called once per frame from the main loop
+func (a *App) attractTick(now time.Time) {
+	at := a.attract
+	if at == nil {
+		return
+	}
+
+	if at.wake.CompareAndSwap(true, false) {
+		a.stopAttract()
+		return
+	}
+
+	if !at.active.Load() {
+		if at.touched.Load() || now.Sub(at.armedAt) < at.idle {
+			return
+		}
+		at.active.Store(true)
+		at.startedAt = now
+		at.pilot.Reset(0)
+	}
+
+	// The router clears the status line on every intent, including the bot's
+	if a.ctx.GetStatusMessage() != parameter.AttractBanner {
+		a.ctx.SetStatusMessage(parameter.AttractBanner, 0, true)
+	}
+
+	ev, ok := at.pilot.Next(now.Sub(at.startedAt), func(st *bot.State) {
+		a.world.RunSafe(func() { bot.Snapshot(a.ctx, st) })
+	})
+	if ok {
+		at.injector.Inject(ev) // a full buffer drops the key like a missed keystroke
+	}
+}
+
+// stopAttract drops the bot's pending keys, returns to Normal mode, and starts a fresh game
+func (a *App) stopAttract() {
+	at := a.attract
+	at.active.Store(false)
+	at.injector.Drain()
+	at.injector.Inject(bot.Escape())
+
+	a.ctx.PushEvent(event.EventGameReset, nil)
+	a.ctx.MacroClearFlag.Store(true)
+	a.ctx.ClearStatusMessage()
+}
+
+// smokeResult reports whether a smoke run made progress; nil outside smoke runs
+func (a *App) smokeResult() error {
+	if a.cfg.SmokeTest <= 0 {
+		return nil
+	}
+	if a.world.Resources.Status.Ints.Get("typing.correct").Load() == 0 {
+		return errSmokeNoProgress
+	}
+	return nil
+}
@@ -2,8 +2,15 @@ package app
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 )
 
 // Config is the resolved startup configuration
@@ -23,6 +30,9 @@ type Config struct {
 	// ContentPath is a file path or glob for typing content; "" = default discovery
 	ContentPath string
 
+	// PracticePath is a user file whose lines are revealed in order; "" = normal spawning
+	PracticePath string
+
 	// GameScript is a game.toml path or a map directory; "" = config discovery
 	GameScript string
 
@@ -31,6 +41,63 @@ type Config struct {
 
 	// KeymapPath is a keymap TOML path; "" = keymap discovery
 	KeymapPath string
+
+	// RecordPath writes all terminal input to a file for ReplayPath; "" = off
+	RecordPath string
+
+	// ReplayPath plays recorded input before handing over to the keyboard; "" = off
+	ReplayPath string
+
+	// AnnouncePath receives plain-text announcements for screen readers, a file or named pipe; "" = off
+	AnnouncePath string
+
+	// AnnounceLevel names the announcement verbosity (see parameter.AnnounceLevelNames); "" = normal
+	AnnounceLevel string
+
+	// ChecksumPath records per-tick world checksums, or verifies against them when
+	// replaying, reporting the first divergent tick; "" = off
+	ChecksumPath string
+
+	// ScreenshotPath saves the last frame on exit as PNG or SVG, by extension; "" = off
+	ScreenshotPath string
+
+	// CastPath records every frame drawn as an asciinema v2 cast; "" = off
+	CastPath string
+
+	// LeaderboardURL submits the final score on exit and shows the top scores; "" = off
+	LeaderboardURL string
+
+	// LeaderboardName is the player name on the leaderboard; "" = the OS user name
+	LeaderboardName string
+
+	// LeaderboardKey signs submitted scores; required with LeaderboardURL
+	LeaderboardKey string
+
+	// ColorBlind names the initial CVD palette (see visual.CVDModeNames); "" = off
+	ColorBlind string
+
+	// ThemePath is a sequence theme file replacing the default glyph palette; "" = stock
+	ThemePath string
+
+	// Seed fixes the random streams for a reproducible session; 0 = time-based
+	// A replay's recorded stream state takes precedence
+	Seed uint64
+
+	// AttractIdle is the untouched time at startup before the demo bot plays; 0 = off
+	// Ignored while replaying
+	AttractIdle time.Duration
+
+	// SmokeTest starts the demo bot at once and quits after this long, failing
+	// when no character was cleared; 0 = off
+	SmokeTest time.Duration
+}
+
+// attractIdle resolves the demo trigger delay; a smoke test starts the bot at once
+func (c Config) attractIdle() (time.Duration, bool) {
+	if c.SmokeTest > 0 {
+		return 0, true
+	}
+	return c.AttractIdle, c.AttractIdle > 0
 }
 
 // Validate reports configuration conflicts
@@ -38,5 +105,35 @@ func (c Config) Validate() error {
 	if c.ForceDefault && c.GameScript != "" {
 		return errors.New("game script and forced default are mutually exclusive")
 	}
+	if c.RecordPath != "" && c.RecordPath == c.ReplayPath {
+		return errors.New("record and replay paths must differ")
+	}
+	if c.ChecksumPath != "" && (c.ChecksumPath == c.RecordPath || c.ChecksumPath == c.ReplayPath) {
+		return errors.New("checksum path must differ from the record and replay paths")
+	}
+	if c.CastPath != "" && (c.CastPath == c.RecordPath || c.CastPath == c.ReplayPath || c.CastPath == c.ChecksumPath) {
+		return errors.New("cast path must differ from the record, replay, and checksum paths")
+	}
+	if ext := strings.ToLower(filepath.Ext(c.ScreenshotPath)); c.ScreenshotPath != "" && ext != ".png" && ext != ".svg" {
+		return errors.New("screenshot path must end in .png or .svg")
+	}
+	if c.LeaderboardURL != "" && c.LeaderboardKey == "" {
+		return fmt.Errorf("leaderboard needs a signing key in $%s", parameter.LeaderboardKeyEnv)
+	}
+	if utf8.RuneCountInString(c.LeaderboardName) > parameter.LeaderboardNameMax {
+		return fmt.Errorf("leaderboard name longer than %d characters", parameter.LeaderboardNameMax)
+	}
+	if c.AttractIdle < 0 || c.SmokeTest < 0 {
+		return errors.New("attract and smoke durations must not be negative")
+	}
+	if c.SmokeTest > 0 && c.ReplayPath != "" {
+		return errors.New("smoke test and replay are mutually exclusive")
+	}
+	if _, ok := parameter.ParseAnnounceLevel(c.AnnounceLevel); !ok {
+		return fmt.Errorf("unknown announce level %q", c.AnnounceLevel)
+	}
+	if _, ok := visual.ParseCVDMode(c.ColorBlind); !ok {
+		return fmt.Errorf("unknown color-blind mode %q", c.ColorBlind)
+	}
 	return nil
 }
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/leaderboard"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// wireLeaderboard creates the leaderboard client from the startup config
+// Replays and smoke runs never submit, so they get no client
+func (a *App) wireLeaderboard() error {
+	if a.cfg.LeaderboardURL == "" || a.cfg.ReplayPath != "" || a.cfg.SmokeTest > 0 {
+		return nil
+	}
+
+	// Without a user config dir scores that fail to submit are lost
+	var queuePath string
+	if base, err := os.UserConfigDir(); err == nil {
+		queuePath = filepath.Join(base, parameter.AppConfigDirName, parameter.LeaderboardQueueFile)
+	}
+	client, err := leaderboard.NewClient(a.cfg.LeaderboardURL, []byte(a.cfg.LeaderboardKey), queuePath)
+	if err != nil {
+		return err
+	}
+	a.leaderboard = client
+	return nil
+}
+
+// scoreSubmitter sends the final score when a run ends and reports back to the game-over
+// overlay; requests run off the game loop and Close waits for them to finish
+type scoreSubmitter struct {
+	a *App
+}
+
+// EventTypes implements event.Handler
+func (h *scoreSubmitter) EventTypes() []event.EventType {
+	return []event.EventType{event.EventGameOver}
+}
+
+// HandleEvent implements event.Handler
+// A session the player never touched, with only the demo bot playing, is not submitted
+func (h *scoreSubmitter) HandleEvent(ev event.GameEvent) {
+	p, ok := ev.Payload.(*event.GameOverPayload)
+	if !ok {
+		return
+	}
+	a := h.a
+	if a.attract != nil && !a.attract.touched.Load() {
+		return
+	}
+
+	score := leaderboard.Score{
+		Player:     a.playerName(),
+		Seed:       a.randState.Seed,
+		Score:      p.Score,
+		ReplayHash: hex.EncodeToString(a.inputHash.Sum()),
+		Time:       time.Now().UTC(),
+	}
+	a.ctx.PushEvent(event.EventMetaLeaderboardResult, &event.MetaLeaderboardResultPayload{
+		Status: "submitting as " + score.Player + "...",
+	})
+
+	a.submits.Add(1)
+	core.Go(func() {
+		defer a.submits.Done()
+		a.ctx.PushEvent(event.EventMetaLeaderboardResult, a.submitScore(score))
+	})
+}
+
+// submitScore sends score, then fetches the top scores with score marked
+func (a *App) submitScore(score leaderboard.Score) *event.MetaLeaderboardResultPayload {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*parameter.LeaderboardTimeout)
+	defer cancel()
+	switch err := a.leaderboard.Submit(ctx, score); {
+	case errors.Is(err, leaderboard.ErrQueued):
+		return &event.MetaLeaderboardResultPayload{Status: "offline, score queued"}
+	case err != nil:
+		return &event.MetaLeaderboardResultPayload{Status: fmt.Sprintf("not submitted: %v", err)}
+	}
+
+	top, err := a.leaderboard.Top(ctx, parameter.LeaderboardTopCount)
+	if err != nil {
+		return &event.MetaLeaderboardResultPayload{Status: fmt.Sprintf("submitted; top scores: %v", err)}
+	}
+	result := &event.MetaLeaderboardResultPayload{
+		Status: "submitted as " + score.Player,
+		Top:    make([]event.LeaderboardRow, len(top)),
+	}
+	for i, s := range top {
+		result.Top[i] = event.LeaderboardRow{
+			Player: s.Player,
+			Score:  s.Score,
+			Own:    s.Player == score.Player && s.Score == score.Score && s.Time.Equal(score.Time),
+		}
+	}
+	return result
+}
+
+// lockedHash guards the input hash, written by the input goroutine and read on game over
+type lockedHash struct {
+	mu sync.Mutex
+	h  hash.Hash
+}
+
+func (l *lockedHash) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.h.Write(p)
+}
+
+// Sum returns the hash of the input so far
+func (l *lockedHash) Sum() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.h.Sum(nil)
+}
+
+// playerName resolves the leaderboard name, falling back to the OS user name
+func (a *App) playerName() string {
+	if a.cfg.LeaderboardName != "" {
+		return a.cfg.LeaderboardName
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "player"
+}
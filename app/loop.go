@@ -39,17 +39,32 @@ func (a *App) Loop() error {
 	a.frameReady <- struct{}{}
 	a.scheduler.Start()
 
-	frameTicker := time.NewTicker(parameter.FrameUpdateInterval)
-	defer frameTicker.Stop()
+	// Frame pacing: the timer wakes the select, Wait absorbs timer slack and
+	// resyncs after a stalled frame instead of queueing catch-up frames
+	frameLoop := engine.NewLoop(0, parameter.FrameUpdateInterval)
+	frameTimer := time.NewTimer(frameLoop.Until())
+	defer frameTimer.Stop()
 
 	eventChan := a.termSvc.Events()
 	lastMouseMode := defaultMouseMode
 
+	var smokeDeadline time.Time
+	if a.attract != nil {
+		a.attract.armedAt = time.Now()
+		if a.cfg.SmokeTest > 0 {
+			smokeDeadline = a.attract.armedAt.Add(a.cfg.SmokeTest)
+		}
+	}
+
 	for {
 		select {
 		case ev := <-eventChan:
+			if ev.Type == terminal.EventKey {
+				a.ctx.InputMeter.Keystroke(ev.At)
+			}
+
 			// Dumb pipe: key event → machine → intent → router
-			if intent := a.inputMachine.Process(ev); intent != nil {
+			if intent := a.inputMachine.Process(ev.Event); intent != nil {
 				if !a.handleIntent(intent) {
 					return nil // player quit
 				}
@@ -70,10 +85,15 @@ func (a *App) Loop() error {
 				a.orchestrator.Resize(a.ctx.Width, a.ctx.Height)
 			}
 
-		case <-frameTicker.C:
+		case <-frameTimer.C:
+			frameLoop.Wait()
 			if !a.frame() {
 				return nil
 			}
+			if !smokeDeadline.IsZero() && time.Now().After(smokeDeadline) {
+				return a.smokeResult()
+			}
+			frameTimer.Reset(frameLoop.Until())
 		}
 	}
 }
@@ -107,6 +127,7 @@ func (a *App) frame() bool {
 	a.ctx.IncrementFrameNumber()
 
 	a.router.ProcessMouseTick()
+	a.attractTick(time.Now())
 
 	macroIntents := a.router.ProcessMacroTick()
 	for _, intent := range macroIntents {
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/lixenwraith/toml"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+)
+
+// Settings is the persisted player preference file
+// Written on exit when changed in-game via :set or :cvd
+type Settings struct {
+	ColorBlind string                `toml:"color_blind"`
+	CRT        bool                  `toml:"crt"`
+	Dither     string                `toml:"dither"`
+	NoAmbience bool                  `toml:"no_ambience"`
+	Trail      string                `toml:"trail"`
+	Blocks     bool                  `toml:"blocks"`
+	Spawn      string                `toml:"spawn"`
+	Minimap    bool                  `toml:"minimap"`
+	HUD        bool                  `toml:"hud"`
+	Preview    bool                  `toml:"preview"`
+	Field      string                `toml:"field"`
+	Assist     engine.AssistSettings `toml:"assist"`
+	Popups     engine.PopupSettings  `toml:"popups"`
+}
+
+// DefaultSettings returns the preferences of a fresh install
+func DefaultSettings() Settings {
+	return Settings{
+		ColorBlind: visual.CVDOff.String(),
+		Dither:     visual.DitherOff.String(),
+		Trail:      visual.TrailOff.String(),
+		Spawn:      parameter.SpawnPatternRandom.String(),
+		Field:      engine.FormatFieldSize(0, 0),
+		Assist:     engine.DefaultAssistSettings(),
+		Popups:     engine.DefaultPopupSettings(),
+	}
+}
+
+// ResolveSettings returns the settings path under the user config dir
+// "" when no user config dir is available (settings are then session-only)
+func ResolveSettings() string {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, parameter.AppConfigDirName, parameter.SettingsConfigFile)
+}
+
+// LoadSettings reads the settings file over defaults; a missing file yields defaults
+func LoadSettings(path string) (Settings, error) {
+	s := DefaultSettings()
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return s, nil
+		}
+		return s, err
+	}
+	if err := toml.Unmarshal(data, &s); err != nil {
+		return DefaultSettings(), err
+	}
+
+	s.Assist.Clamp()
+	s.Popups.Clamp()
+	if _, ok := visual.ParseCVDMode(s.ColorBlind); !ok {
+		s.ColorBlind = visual.CVDOff.String()
+	}
+	if _, ok := visual.ParseDitherMode(s.Dither); !ok {
+		s.Dither = visual.DitherOff.String()
+	}
+	if _, ok := visual.ParseTrailStyle(s.Trail); !ok {
+		s.Trail = visual.TrailOff.String()
+	}
+	if _, ok := parameter.ParseSpawnPattern(s.Spawn); !ok {
+		s.Spawn = parameter.SpawnPatternRandom.String()
+	}
+	if _, _, ok := engine.ParseFieldSize(s.Field); !ok {
+		s.Field = engine.FormatFieldSize(0, 0)
+	}
+	return s, nil
+}
+
+// SaveSettings writes the settings file, creating the config directory
+// Written to a sibling temp file and renamed so a crash never truncates it
+func SaveSettings(path string, s Settings) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := toml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// applySettings pushes persisted preferences into the world and clock
+func (a *App) applySettings(s Settings) {
+	config := a.world.Resources.Config
+	config.Accessibility, _ = visual.ParseCVDMode(s.ColorBlind) // validated by LoadSettings
+	config.CRT = s.CRT
+	config.Dither, _ = visual.ParseDitherMode(s.Dither) // validated by LoadSettings
+	config.NoAmbience = s.NoAmbience
+	config.Trail, _ = visual.ParseTrailStyle(s.Trail) // validated by LoadSettings
+	config.SpawnBlocks = s.Blocks
+	config.SpawnPattern, _ = parameter.ParseSpawnPattern(s.Spawn) // validated by LoadSettings
+	config.Minimap = s.Minimap
+	config.InputHUD = s.HUD
+	config.Preview = s.Preview
+	config.FieldWidth, config.FieldHeight, _ = engine.ParseFieldSize(s.Field) // validated by LoadSettings
+	config.Assist = s.Assist
+	config.Popups = s.Popups
+	a.ctx.PausableClock.SetRate(s.Assist.SpeedPercent)
+
+	if config.FieldWidth > 0 {
+		width, height, crop := config.BaseMapSize()
+		a.world.SetupLevel(width, height, false, crop)
+	}
+}
+
+// currentSettings snapshots in-game preferences for persistence
+func (a *App) currentSettings() Settings {
+	config := a.world.Resources.Config
+	return Settings{
+		ColorBlind: config.Accessibility.String(),
+		CRT:        config.CRT,
+		Dither:     config.Dither.String(),
+		NoAmbience: config.NoAmbience,
+		Trail:      config.Trail.String(),
+		Blocks:     config.SpawnBlocks,
+		Spawn:      config.SpawnPattern.String(),
+		Minimap:    config.Minimap,
+		HUD:        config.InputHUD,
+		Preview:    config.Preview,
+		Field:      engine.FormatFieldSize(config.FieldWidth, config.FieldHeight),
+		Assist:     config.Assist,
+		Popups:     config.Popups,
+	}
+}
+
+// saveSettings persists preferences changed during the session
+// Failures are silent: preferences are a convenience, never a reason to fail exit
+func (a *App) saveSettings() {
+	if a.settingsPath == "" || a.ctx == nil {
+		return
+	}
+	cur := a.currentSettings()
+
+	// The -cvd flag is a one-off override: keep the saved palette unless it was changed in-game
+	if a.cfg.ColorBlind != "" {
+		if flag, _ := visual.ParseCVDMode(a.cfg.ColorBlind); cur.ColorBlind == flag.String() {
+			cur.ColorBlind = a.settings.ColorBlind
+		}
+	}
+
+	if cur != a.settings {
+		_ = SaveSettings(a.settingsPath, cur)
+	}
+}
@@ -0,0 +1,20 @@
+package asset
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed bestiary/*.sprite
+var bestiaryFS embed.FS
+
+// DefaultBestiary is the embedded sprite bestiary filesystem, one .sprite file per set
+var DefaultBestiary fs.FS
+
+func init() {
+	sub, err := fs.Sub(bestiaryFS, "bestiary")
+	if err != nil {
+		panic("asset: embedded bestiary missing")
+	}
+	DefaultBestiary = sub
+}
@@ -584,4 +584,40 @@ var SplashFontFallback = [12]uint16{
 	0x0000, 0xFFF0, 0xFFF0, 0xC030,
 	0xC030, 0xC030, 0xC030, 0xC030,
 	0xC030, 0xFFF0, 0xFFF0, 0x0000,
-}
\ No newline at end of file
+}
+// SplashGlyphWidth is the bitmap width and the default advance of a splash glyph
+const SplashGlyphWidth = 12
+
+// GlyphMetrics is a splash glyph's horizontal placement relative to the pen
+// Bearing offsets bitmap column 0 from the pen, negative to trim left padding
+// Advance moves the pen to the next glyph; zero keeps the monospaced SplashGlyphWidth
+type GlyphMetrics struct {
+	Bearing int8
+	Advance int8
+}
+
+// SplashFontMetrics holds proportional spacing indexed like SplashFont
+// Entries left zero keep the glyph monospaced; the font editor exports this table
+var SplashFontMetrics = [95]GlyphMetrics{}
+
+// SplashGlyph returns the bitmap and resolved metrics for r, the fallback box outside ASCII 32-126
+func SplashGlyph(r rune) (*[12]uint16, GlyphMetrics) {
+	if r < 32 || r > 126 {
+		return &SplashFontFallback, GlyphMetrics{Advance: SplashGlyphWidth}
+	}
+	m := SplashFontMetrics[r-32]
+	if m.Advance == 0 {
+		m.Advance = SplashGlyphWidth
+	}
+	return &SplashFont[r-32], m
+}
+
+// SplashTextWidth returns the pen advance of text laid out with SplashGlyph metrics
+func SplashTextWidth(text []rune) int {
+	w := 0
+	for _, r := range text {
+		_, m := SplashGlyph(r)
+		w += int(m.Advance)
+	}
+	return w
+}
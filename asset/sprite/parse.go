@@ -0,0 +1,314 @@
+package sprite
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/asset"
+)
+
+// Text format, one directive per line, "//" starts a comment:
+//
+//	sprite NAME               begins a template, name runs to end of line
+//	size W H                  cell dimensions
+//	ticks N                   base ticks per frame change
+//	fg #rrggbb                appends a foreground palette color
+//	bg #rrggbb                appends a background palette color
+//	aura #rrggbb R HZ [ROT FOCUS]
+//	border #rrggbb ROT WIDTH
+//	frame                     begins a frame, followed by H row lines
+//	|art|fg|bg|attr|          fixed-width row, each field exactly W bytes
+//
+// Row fields are sliced by width, so '|' may appear inside art
+// Short rows are padded with spaces, which is the skip value for every layer
+// Comment lines directly above "sprite" become Template.Doc; trailing comments
+// on fg/bg and frame lines become palette notes and frame labels
+
+// layerCount is the number of fixed-width fields in a row line
+const layerCount = 4
+
+// Load reads and validates all templates from a file in fsys
+func Load(fsys fs.FS, name string) ([]Template, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	templates, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return templates, nil
+}
+
+// LoadOrDefault reads templates from path, or from the embedded bestiary file name
+// when path is empty
+func LoadOrDefault(path, name string) ([]Template, error) {
+	if path != "" {
+		return LoadFile(path)
+	}
+	return Load(asset.DefaultBestiary, name)
+}
+
+// LoadFile reads and validates all templates from a file on disk
+func LoadFile(path string) ([]Template, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	templates, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return templates, nil
+}
+
+// parser tracks the template and frame under construction
+type parser struct {
+	templates []Template
+	names     map[string]bool
+	cur       *Template
+	doc       []string // comment block pending for the next sprite
+	rows      int      // rows still expected for the current frame
+}
+
+// Parse reads templates in the text format and validates each one
+func Parse(r io.Reader) ([]Template, error) {
+	p := &parser{names: make(map[string]bool)}
+	sc := bufio.NewScanner(r)
+	line := 0
+	for sc.Scan() {
+		line++
+		if err := p.line(sc.Text()); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := p.finish(); err != nil {
+		return nil, err
+	}
+	return p.templates, nil
+}
+
+func (p *parser) line(raw string) error {
+	text := strings.TrimRight(raw, "\r")
+
+	if p.rows > 0 {
+		return p.row(text)
+	}
+
+	note := ""
+	if i := strings.Index(text, "//"); i >= 0 {
+		note = strings.TrimSpace(text[i+2:])
+		text = text[:i]
+	}
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		if strings.HasPrefix(strings.TrimSpace(raw), "//") {
+			p.doc = append(p.doc, note)
+		} else {
+			p.doc = nil
+		}
+		return nil
+	}
+	doc := p.doc
+	p.doc = nil
+
+	if fields[0] == "sprite" {
+		if err := p.finish(); err != nil {
+			return err
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), "sprite"))
+		if name == "" {
+			return fmt.Errorf("sprite: missing name")
+		}
+		if p.names[name] {
+			return fmt.Errorf("sprite %q: duplicate name", name)
+		}
+		p.names[name] = true
+		p.templates = append(p.templates, Template{Name: name, Doc: doc})
+		p.cur = &p.templates[len(p.templates)-1]
+		return nil
+	}
+
+	if p.cur == nil {
+		return fmt.Errorf("%s: outside sprite", fields[0])
+	}
+	t := p.cur
+	args := fields[1:]
+
+	switch fields[0] {
+	case "size":
+		if len(args) != 2 {
+			return fmt.Errorf("size: want W H")
+		}
+		w, err1 := strconv.Atoi(args[0])
+		h, err2 := strconv.Atoi(args[1])
+		if err1 != nil || err2 != nil || w <= 0 || h <= 0 {
+			return fmt.Errorf("size: invalid %q", strings.Join(args, " "))
+		}
+		if len(t.Frames) > 0 {
+			return fmt.Errorf("size: must precede frames")
+		}
+		t.Width, t.Height = w, h
+
+	case "ticks":
+		if len(args) != 1 {
+			return fmt.Errorf("ticks: want N")
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("ticks: %w", err)
+		}
+		t.TicksPerFrame = n
+
+	case "fg", "bg":
+		if len(args) != 1 {
+			return fmt.Errorf("%s: want #rrggbb", fields[0])
+		}
+		c, err := color.ParseHex(args[0])
+		if err != nil {
+			return fmt.Errorf("%s: %w", fields[0], err)
+		}
+		if fields[0] == "fg" {
+			t.FgPalette = append(t.FgPalette, c)
+			t.FgNotes = appendNote(t.FgNotes, len(t.FgPalette)-1, note)
+		} else {
+			t.BgPalette = append(t.BgPalette, c)
+			t.BgNotes = appendNote(t.BgNotes, len(t.BgPalette)-1, note)
+		}
+
+	case "aura":
+		if len(args) != 3 && len(args) != 5 {
+			return fmt.Errorf("aura: want #rrggbb RADIUS HZ [ROT FOCUS]")
+		}
+		c, err := color.ParseHex(args[0])
+		if err != nil {
+			return fmt.Errorf("aura: %w", err)
+		}
+		nums, err := parseFloats(args[1:])
+		if err != nil {
+			return fmt.Errorf("aura: %w", err)
+		}
+		t.AuraColor, t.AuraRadius, t.AuraPulseFreq = c, nums[0], nums[1]
+		if len(nums) == 4 {
+			t.AuraRotSpeed, t.AuraFocusWidth = nums[2], nums[3]
+		}
+
+	case "border":
+		if len(args) != 3 {
+			return fmt.Errorf("border: want #rrggbb ROT WIDTH")
+		}
+		c, err := color.ParseHex(args[0])
+		if err != nil {
+			return fmt.Errorf("border: %w", err)
+		}
+		rot, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return fmt.Errorf("border: %w", err)
+		}
+		width, err := strconv.Atoi(args[2])
+		if err != nil {
+			return fmt.Errorf("border: %w", err)
+		}
+		t.BorderHighlight, t.BorderRotSpeed, t.BorderWidth = c, rot, width
+
+	case "frame":
+		if t.Width <= 0 || t.Height <= 0 {
+			return fmt.Errorf("frame: size must be set first")
+		}
+		t.Frames = append(t.Frames, Frame{Label: note})
+		p.rows = t.Height
+
+	default:
+		return fmt.Errorf("unknown directive %q", fields[0])
+	}
+	return nil
+}
+
+// row splits a fixed-width row line into its layers
+func (p *parser) row(text string) error {
+	t := p.cur
+	w := t.Width
+	if len(text) == 0 || text[0] != '|' {
+		return fmt.Errorf("sprite %q: expected row, %d more in frame %d", t.Name, p.rows, len(t.Frames)-1)
+	}
+
+	var layers [layerCount]string
+	pos := 1
+	for i := range layers {
+		end := min(pos+w, len(text))
+		field := ""
+		if pos < len(text) {
+			field = text[pos:end]
+		}
+		layers[i] = field + strings.Repeat(" ", w-len(field))
+		pos += w
+		if pos < len(text) && text[pos] != '|' {
+			return fmt.Errorf("sprite %q: row field %d wider than %d", t.Name, i, w)
+		}
+		pos++
+	}
+	if pos < len(text) {
+		if rest := strings.TrimSpace(text[pos:]); rest != "" && !strings.HasPrefix(rest, "//") {
+			return fmt.Errorf("sprite %q: trailing data after row", t.Name)
+		}
+	}
+
+	f := &t.Frames[len(t.Frames)-1]
+	f.Art = append(f.Art, layers[0])
+	f.Fg = append(f.Fg, layers[1])
+	f.Bg = append(f.Bg, layers[2])
+	f.Attr = append(f.Attr, layers[3])
+	p.rows--
+	return nil
+}
+
+// finish validates the template under construction
+func (p *parser) finish() error {
+	if p.rows > 0 {
+		return fmt.Errorf("sprite %q: frame truncated, %d rows missing", p.cur.Name, p.rows)
+	}
+	if p.cur == nil {
+		return nil
+	}
+	if err := p.cur.Validate(); err != nil {
+		return err
+	}
+	p.cur = nil
+	return nil
+}
+
+// appendNote stores a palette note at idx, leaving notes nil when none are set
+func appendNote(notes []string, idx int, note string) []string {
+	if note == "" {
+		return notes
+	}
+	for len(notes) < idx {
+		notes = append(notes, "")
+	}
+	return append(notes, note)
+}
+
+func parseFloats(args []string) ([]float64, error) {
+	nums := make([]float64, len(args))
+	for i, a := range args {
+		v, err := strconv.ParseFloat(a, 64)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = v
+	}
+	return nums, nil
+}
@@ -0,0 +1,150 @@
+package sprite
+
+import (
+	"fmt"
+
+	"github.com/lixenwraith/color"
+)
+
+// MaxPalette is the number of colors addressable by a single palette index digit
+const MaxPalette = 16
+
+// Layer attribute codes used in Frame.Attr
+const (
+	AttrNone = ' '
+	AttrBold = 'B'
+	AttrDim  = 'D'
+)
+
+// Frame holds per-cell visual data for one animation frame
+// Every row is exactly Width bytes, every layer exactly Height rows
+// Palette index encoding: '0'-'9','a'-'f' → 0-15; ' ' → skip (keep existing)
+type Frame struct {
+	Art  []string // character grid
+	Fg   []string // fg palette index per byte position
+	Bg   []string // bg palette index per byte position
+	Attr []string // 'B'=bold, 'D'=dim, ' '=none
+
+	Label string // optional note, kept across load/save
+}
+
+// Template defines a species with per-cell palette-driven visuals
+// Optional effect fields are zero when the sprite does not use them
+type Template struct {
+	Name          string
+	Width, Height int
+	FgPalette     []color.RGB
+	BgPalette     []color.RGB
+
+	// Notes kept across load/save: comment block above the sprite, per-color names
+	Doc     []string
+	FgNotes []string // parallel to FgPalette, may be shorter
+	BgNotes []string // parallel to BgPalette, may be shorter
+
+	// Radial aura
+	AuraColor      color.RGB
+	AuraRadius     float64
+	AuraPulseFreq  float64 // Hz
+	AuraRotSpeed   float64 // Hz, 0 = static omnidirectional
+	AuraFocusWidth float64 // 0.1 = tight beam, 1.0 = gentle spread
+
+	// Border rotation
+	BorderRotSpeed  float64 // Hz, 0 = off
+	BorderHighlight color.RGB
+	BorderWidth     int // highlight width in perimeter cells
+
+	TicksPerFrame int // base ticks per frame change
+	Frames        []Frame
+}
+
+// PaletteIndex decodes a palette index byte, returns -1 for skip or invalid
+func PaletteIndex(b byte) int {
+	if b >= '0' && b <= '9' {
+		return int(b - '0')
+	}
+	if b >= 'a' && b <= 'f' {
+		return int(b-'a') + 10
+	}
+	return -1
+}
+
+// EncodeIndex renders a palette index as its layer byte, ' ' when out of range
+func EncodeIndex(idx int) byte {
+	if idx < 0 || idx >= MaxPalette {
+		return ' '
+	}
+	if idx < 10 {
+		return byte('0' + idx)
+	}
+	return byte('a' + idx - 10)
+}
+
+// Validate checks dimensions, palette index bounds, and attribute codes
+func (t *Template) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("sprite: missing name")
+	}
+	if t.Width <= 0 || t.Height <= 0 {
+		return fmt.Errorf("sprite %q: invalid size %dx%d", t.Name, t.Width, t.Height)
+	}
+	if len(t.FgPalette) > MaxPalette || len(t.BgPalette) > MaxPalette {
+		return fmt.Errorf("sprite %q: palette exceeds %d colors", t.Name, MaxPalette)
+	}
+	if t.TicksPerFrame <= 0 {
+		return fmt.Errorf("sprite %q: ticks per frame must be positive", t.Name)
+	}
+	if len(t.Frames) == 0 {
+		return fmt.Errorf("sprite %q: no frames", t.Name)
+	}
+
+	for i := range t.Frames {
+		f := &t.Frames[i]
+		if err := t.checkLayer(i, "art", f.Art, true, func(b byte) bool { return b >= 0x20 && b < 0x7f }); err != nil {
+			return err
+		}
+		if err := t.checkLayer(i, "fg", f.Fg, false, paletteCheck(len(t.FgPalette))); err != nil {
+			return err
+		}
+		if err := t.checkLayer(i, "bg", f.Bg, false, paletteCheck(len(t.BgPalette))); err != nil {
+			return err
+		}
+		if err := t.checkLayer(i, "attr", f.Attr, false, func(b byte) bool {
+			return b == AttrNone || b == AttrBold || b == AttrDim
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paletteCheck accepts skip or an index within a palette of size n
+func paletteCheck(n int) func(byte) bool {
+	return func(b byte) bool {
+		if b == ' ' {
+			return true
+		}
+		idx := PaletteIndex(b)
+		return idx >= 0 && idx < n
+	}
+}
+
+// checkLayer validates one layer of a frame; optional layers may be absent
+func (t *Template) checkLayer(frame int, layer string, rows []string, required bool, valid func(byte) bool) error {
+	if len(rows) == 0 && !required {
+		return nil
+	}
+	if len(rows) != t.Height {
+		return fmt.Errorf("sprite %q frame %d: %s has %d rows, want %d", t.Name, frame, layer, len(rows), t.Height)
+	}
+	for y, row := range rows {
+		if len(row) != t.Width {
+			return fmt.Errorf("sprite %q frame %d: %s row %d is %d wide, want %d", t.Name, frame, layer, y, len(row), t.Width)
+		}
+		for x := 0; x < len(row); x++ {
+			if !valid(row[x]) {
+				return fmt.Errorf("sprite %q frame %d: %s row %d col %d: invalid %q", t.Name, frame, layer, y, x, row[x])
+			}
+		}
+	}
+	return nil
+}
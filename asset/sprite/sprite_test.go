@@ -0,0 +1,63 @@
+package sprite_test
+
+import (
+	"bytes"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lixenwraith/vi-fighter/asset"
+	"github.com/lixenwraith/vi-fighter/asset/sprite"
+)
+
+func TestBestiary_LoadAndRoundTrip(t *testing.T) {
+	names, err := fs.Glob(asset.DefaultBestiary, "*.sprite")
+	if err != nil || len(names) == 0 {
+		t.Fatalf("no embedded bestiary files: %v", err)
+	}
+
+	for _, name := range names {
+		templates, err := sprite.Load(asset.DefaultBestiary, name)
+		if err != nil {
+			t.Fatalf("load %s: %v", name, err)
+		}
+
+		// Saved files must match the checked-in data byte for byte so editor
+		// saves produce minimal diffs
+		var buf bytes.Buffer
+		if err := sprite.Write(&buf, templates); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		want, err := fs.ReadFile(asset.DefaultBestiary, name)
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%s: round trip differs from file", name)
+		}
+
+		again, err := sprite.Parse(&buf)
+		if err != nil {
+			t.Fatalf("reparse %s: %v", name, err)
+		}
+		if !reflect.DeepEqual(templates, again) {
+			t.Errorf("%s: round trip mismatch", name)
+		}
+	}
+}
+
+func TestParse_Rejects(t *testing.T) {
+	cases := map[string]string{
+		"palette bound": "sprite A\nsize 2 1\nticks 1\nfg #fff\nframe\n|ab|01|  |  |\n",
+		"wide row":      "sprite A\nsize 2 1\nticks 1\nframe\n|abc|  |  |  |\n",
+		"short frame":   "sprite A\nsize 2 2\nticks 1\nframe\n|ab|  |  |  |\n",
+		"bad attr":      "sprite A\nsize 2 1\nticks 1\nframe\n|ab|  |  |XX|\n",
+		"duplicate":     "sprite A\nsize 1 1\nticks 1\nframe\n|a| | | |\nsprite A\n",
+	}
+	for name, src := range cases {
+		if _, err := sprite.Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package sprite
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Write encodes templates in the text format read by Parse
+// Templates are validated first so the output always loads back
+func Write(w io.Writer, templates []Template) error {
+	for i := range templates {
+		if err := templates[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for i := range templates {
+		if i > 0 {
+			bw.WriteByte('\n')
+		}
+		writeTemplate(bw, &templates[i])
+	}
+	return bw.Flush()
+}
+
+func writeTemplate(bw *bufio.Writer, t *Template) {
+	for _, line := range t.Doc {
+		if line == "" {
+			bw.WriteString("//\n")
+		} else {
+			fmt.Fprintf(bw, "// %s\n", line)
+		}
+	}
+	fmt.Fprintf(bw, "sprite %s\n", t.Name)
+	fmt.Fprintf(bw, "size %d %d\n", t.Width, t.Height)
+	fmt.Fprintf(bw, "ticks %d\n", t.TicksPerFrame)
+	for i, c := range t.FgPalette {
+		fmt.Fprintf(bw, "fg %s%s\n", c.Hex(), noteSuffix(t.FgNotes, i))
+	}
+	for i, c := range t.BgPalette {
+		fmt.Fprintf(bw, "bg %s%s\n", c.Hex(), noteSuffix(t.BgNotes, i))
+	}
+	if t.AuraRadius != 0 {
+		fmt.Fprintf(bw, "aura %s %s %s", t.AuraColor.Hex(), formatFloat(t.AuraRadius), formatFloat(t.AuraPulseFreq))
+		if t.AuraRotSpeed != 0 || t.AuraFocusWidth != 0 {
+			fmt.Fprintf(bw, " %s %s", formatFloat(t.AuraRotSpeed), formatFloat(t.AuraFocusWidth))
+		}
+		bw.WriteByte('\n')
+	}
+	if t.BorderRotSpeed != 0 {
+		fmt.Fprintf(bw, "border %s %s %d\n", t.BorderHighlight.Hex(), formatFloat(t.BorderRotSpeed), t.BorderWidth)
+	}
+
+	blank := strings.Repeat(" ", t.Width)
+	layer := func(rows []string, y int) string {
+		if y < len(rows) {
+			return rows[y]
+		}
+		return blank
+	}
+	for i := range t.Frames {
+		f := &t.Frames[i]
+		if f.Label != "" {
+			fmt.Fprintf(bw, "frame // %s\n", f.Label)
+		} else {
+			bw.WriteString("frame\n")
+		}
+		for y := range t.Height {
+			fmt.Fprintf(bw, "|%s|%s|%s|%s|\n", layer(f.Art, y), layer(f.Fg, y), layer(f.Bg, y), layer(f.Attr, y))
+		}
+	}
+}
+
+// noteSuffix renders the trailing comment for palette entry i
+func noteSuffix(notes []string, i int) string {
+	if i < len(notes) && notes[i] != "" {
+		return " // " + notes[i]
+	}
+	return ""
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
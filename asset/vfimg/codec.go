@@ -0,0 +1,194 @@
+package vfimg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lixenwraith/color"
+)
+
+// Encode writes img in the current format version
+func Encode(w io.Writer, img *Image) error {
+	if err := img.Validate(); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "%s\n", Magic)
+	fmt.Fprintf(bw, "v:%d\n", Version)
+	fmt.Fprintf(bw, "w:%d\n", img.Width)
+	fmt.Fprintf(bw, "h:%d\n", img.Height)
+	fmt.Fprintf(bw, "m:%d\n", img.RenderMode)
+	fmt.Fprintf(bw, "ax:%d\n", img.AnchorX)
+	fmt.Fprintf(bw, "ay:%d\n", img.AnchorY)
+
+	// Sorted so re-encoding an unchanged image is byte-identical
+	keys := make([]string, 0, len(img.Meta))
+	for k := range img.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(bw, "%s:%s\n", k, img.Meta[k])
+	}
+	fmt.Fprintf(bw, "\n")
+
+	cellBuf := make([]byte, cellBytes)
+	for _, cell := range img.Cells {
+		binary.LittleEndian.PutUint32(cellBuf[0:4], uint32(cell.Rune))
+		cellBuf[4] = cell.TrueFg.R
+		cellBuf[5] = cell.TrueFg.G
+		cellBuf[6] = cell.TrueFg.B
+		cellBuf[7] = cell.TrueBg.R
+		cellBuf[8] = cell.TrueBg.G
+		cellBuf[9] = cell.TrueBg.B
+		cellBuf[10] = cell.Palette256Fg
+		cellBuf[11] = cell.Palette256Bg
+		var flags uint8
+		if cell.Transparent {
+			flags |= cellFlagTransparent
+		}
+		cellBuf[12] = flags
+
+		if _, err := bw.Write(cellBuf); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Decode reads an image, files without a version line are version 1
+func Decode(r io.Reader) (*Image, error) {
+	br := bufio.NewReader(r)
+
+	line, err := readHeaderLine(br)
+	if err != nil {
+		return nil, fmt.Errorf("read magic: %w", err)
+	}
+	if line != Magic {
+		return nil, fmt.Errorf("invalid magic: %q", line)
+	}
+
+	img := &Image{}
+	for {
+		line, err = readHeaderLine(br)
+		if err != nil {
+			return nil, fmt.Errorf("read header: %w", err)
+		}
+		if line == "" {
+			break
+		}
+
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "v":
+			v, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version: %q", val)
+			}
+			if v > Version {
+				return nil, fmt.Errorf("unsupported version %d, newest supported is %d", v, Version)
+			}
+		case "w":
+			img.Width, _ = strconv.Atoi(val)
+		case "h":
+			img.Height, _ = strconv.Atoi(val)
+		case "m":
+			m, _ := strconv.Atoi(val)
+			img.RenderMode = RenderMode(m)
+		case "ax":
+			img.AnchorX, _ = strconv.Atoi(val)
+		case "ay":
+			img.AnchorY, _ = strconv.Atoi(val)
+		default:
+			if img.Meta == nil {
+				img.Meta = make(map[string]string)
+			}
+			img.Meta[key] = val
+		}
+	}
+
+	if img.Width <= 0 || img.Height <= 0 {
+		return nil, fmt.Errorf("invalid dimensions: %dx%d", img.Width, img.Height)
+	}
+
+	cellCount := img.Width * img.Height
+	cells := make([]Cell, cellCount)
+	cellBuf := make([]byte, cellBytes)
+
+	for i := range cellCount {
+		if _, err := io.ReadFull(br, cellBuf); err != nil {
+			return nil, fmt.Errorf("read cell %d: %w", i, err)
+		}
+		cells[i] = Cell{
+			Rune:         rune(binary.LittleEndian.Uint32(cellBuf[0:4])),
+			TrueFg:       color.RGB{R: cellBuf[4], G: cellBuf[5], B: cellBuf[6]},
+			TrueBg:       color.RGB{R: cellBuf[7], G: cellBuf[8], B: cellBuf[9]},
+			Palette256Fg: cellBuf[10],
+			Palette256Bg: cellBuf[11],
+			Transparent:  cellBuf[12]&cellFlagTransparent != 0,
+		}
+	}
+
+	img.Cells = cells
+	return img, nil
+}
+
+func readHeaderLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Load reads a named image from a filesystem, for embedded assets
+func Load(fsys fs.FS, name string) (*Image, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, err := Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return img, nil
+}
+
+// LoadFile reads an image from disk
+func LoadFile(path string) (*Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Decode(f)
+}
+
+// SaveFile writes an image to disk
+func SaveFile(path string, img *Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,38 @@
+package vfimg
+
+import (
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// Draw composites img with its top-left cell at x,y, skipping transparent and off-buffer cells
+// alpha below 1 blends over existing content in TrueColor; 256-color cells always replace
+// since palette indices cannot be blended
+func Draw(buf *render.RenderBuffer, img *Image, x, y int, colorMode terminal.ColorMode, alpha float64) {
+	if img == nil || alpha <= 0 {
+		return
+	}
+	mode := render.BlendReplace
+	if alpha < 1 && colorMode != terminal.ColorMode256 {
+		mode = render.BlendAlpha
+	}
+
+	for cy := range img.Height {
+		for cx := range img.Width {
+			src := &img.Cells[cy*img.Width+cx]
+			if src.Transparent {
+				continue
+			}
+			cell := src.Terminal(colorMode)
+			buf.Set(x+cx, y+cy, cell.Rune, cell.Fg, cell.Bg, mode, alpha, cell.Attrs)
+		}
+	}
+}
+
+// DrawAnchored draws img so its anchor cell lands on x,y
+func DrawAnchored(buf *render.RenderBuffer, img *Image, x, y int, colorMode terminal.ColorMode, alpha float64) {
+	if img == nil {
+		return
+	}
+	Draw(buf, img, x-img.AnchorX, y-img.AnchorY, colorMode, alpha)
+}
@@ -0,0 +1,115 @@
+// Package vfimg reads and writes .vfimg, a pre-converted terminal image that
+// carries both TrueColor and 256-color representations of every cell
+//
+// A file is a text header followed by binary cell data:
+//
+//	VFIMG
+//	v:1          format version, absent in files written before versioning
+//	w:<cells>    width
+//	h:<cells>    height
+//	m:<mode>     render mode, 0 background-only, 1 quadrant
+//	ax:<cells>   anchor X, the image's logical origin relative to its top-left
+//	ay:<cells>   anchor Y
+//	<key>:<val>  any other key, kept in Image.Meta
+//	             blank line ends the header
+//
+// Cells follow row-major, 13 bytes each: rune (uint32 LE), TrueColor fg RGB,
+// TrueColor bg RGB, 256-color fg index, 256-color bg index, flags
+package vfimg
+
+import (
+	"fmt"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+)
+
+// Format constants
+const (
+	Magic   = "VFIMG"
+	Version = 1 // Highest version this package reads and the version it writes
+
+	cellBytes                 = 13 // rune(4) + trueFg(3) + trueBg(3) + pal256Fg(1) + pal256Bg(1) + flags(1)
+	cellFlagTransparent uint8 = 1 << 0
+)
+
+// RenderMode records how source pixels were mapped to cells
+type RenderMode uint8
+
+const (
+	ModeBackgroundOnly RenderMode = iota // One pixel per cell as background color
+	ModeQuadrant                         // 2×2 pixels per cell as quadrant glyph with fg/bg
+)
+
+// String returns human-readable mode name
+func (m RenderMode) String() string {
+	switch m {
+	case ModeBackgroundOnly:
+		return "Background"
+	case ModeQuadrant:
+		return "Quadrant"
+	default:
+		return "Unknown"
+	}
+}
+
+// Image holds both TrueColor and 256-color representations
+type Image struct {
+	Width      int
+	Height     int
+	RenderMode RenderMode
+	AnchorX    int // Logical origin relative to top-left, spawn and DrawAnchored place this cell
+	AnchorY    int
+	Meta       map[string]string // Unrecognized header keys, kept across decode/encode
+	Cells      []Cell
+}
+
+// Cell stores both color mode representations for one cell
+type Cell struct {
+	Rune         rune
+	TrueFg       color.RGB
+	TrueBg       color.RGB
+	Palette256Fg uint8
+	Palette256Bg uint8
+	Transparent  bool
+}
+
+// Validate checks dimensions against the cell count
+func (img *Image) Validate() error {
+	if img.Width <= 0 || img.Height <= 0 {
+		return fmt.Errorf("invalid dimensions: %dx%d", img.Width, img.Height)
+	}
+	if len(img.Cells) != img.Width*img.Height {
+		return fmt.Errorf("cell count %d does not match %dx%d", len(img.Cells), img.Width, img.Height)
+	}
+	return nil
+}
+
+// TerminalCells extracts single-mode cells, transparent cells are zero
+func (img *Image) TerminalCells(colorMode terminal.ColorMode) []terminal.Cell {
+	cells := make([]terminal.Cell, len(img.Cells))
+	for i := range img.Cells {
+		if !img.Cells[i].Transparent {
+			cells[i] = img.Cells[i].Terminal(colorMode)
+		}
+	}
+	return cells
+}
+
+// Terminal returns the cell in the given color mode
+// 256-color cells carry palette indices in the R channel with the 256 attrs set
+func (c *Cell) Terminal(colorMode terminal.ColorMode) terminal.Cell {
+	if colorMode == terminal.ColorMode256 {
+		return terminal.Cell{
+			Rune:  c.Rune,
+			Fg:    color.RGB{R: c.Palette256Fg},
+			Bg:    color.RGB{R: c.Palette256Bg},
+			Attrs: terminal.AttrFg256 | terminal.AttrBg256,
+		}
+	}
+	return terminal.Cell{
+		Rune: c.Rune,
+		Fg:   c.TrueFg,
+		Bg:   c.TrueBg,
+	}
+}
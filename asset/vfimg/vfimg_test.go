@@ -0,0 +1,74 @@
+package vfimg_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	img := &vfimg.Image{
+		Width:      2,
+		Height:     1,
+		RenderMode: vfimg.ModeQuadrant,
+		AnchorX:    1,
+		AnchorY:    -3,
+		Meta:       map[string]string{"src": "title.png"},
+		Cells: []vfimg.Cell{
+			{Rune: '▚', TrueFg: color.RGB{R: 10, G: 20, B: 30}, TrueBg: color.RGB{R: 200}, Palette256Fg: 17, Palette256Bg: 196},
+			{Transparent: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := vfimg.Encode(&buf, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	encoded := append([]byte(nil), buf.Bytes()...)
+
+	got, err := vfimg.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !reflect.DeepEqual(img, got) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", got, img)
+	}
+
+	var again bytes.Buffer
+	if err := vfimg.Encode(&again, got); err != nil {
+		t.Fatalf("re-encode: %v", err)
+	}
+	if !bytes.Equal(encoded, again.Bytes()) {
+		t.Error("re-encoding is not byte-identical")
+	}
+}
+
+func TestDecode_Legacy(t *testing.T) {
+	// Files written before versioning have no v: line
+	src := "VFIMG\nw:1\nh:1\nm:0\nax:0\nay:0\n\n" + string(make([]byte, 13))
+	img, err := vfimg.Decode(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if img.Width != 1 || img.Height != 1 || len(img.Cells) != 1 || img.Meta != nil {
+		t.Errorf("unexpected image: %+v", img)
+	}
+}
+
+func TestDecode_Rejects(t *testing.T) {
+	cases := map[string]string{
+		"magic":      "PNG\n\n",
+		"version":    "VFIMG\nv:99\nw:1\nh:1\n\n" + string(make([]byte, 13)),
+		"dimensions": "VFIMG\nw:0\nh:1\n\n",
+		"truncated":  "VFIMG\nw:2\nh:1\n\n" + string(make([]byte, 13)),
+	}
+	for name, src := range cases {
+		if _, err := vfimg.Decode(strings.NewReader(src)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Package bot drives the game with scripted agents
+// An Agent sees a State snapshot and answers with keystrokes; a Pilot paces them
+// against a clock. The attract demo injects them into the live input pipeline,
+// the Runner feeds them to headless sessions at simulation speed
+package bot
+
+import (
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+)
+
+// Glyph is one typeable character on the field
+type Glyph struct {
+	X, Y  int
+	Rune  rune
+	Type  component.GlyphType
+	Level component.GlyphLevel
+}
+
+// State is the observable game state an agent plans from
+// Buffers are reused between snapshots; agents must not retain them across calls
+type State struct {
+	Elapsed time.Duration // Game time since the session started
+	Mode    core.GameMode
+	Cursor  core.Point
+
+	Width, Height int // Map dimensions
+
+	Energy int64
+	Heat   int
+
+	Glyphs []Glyph
+
+	// Dense cell index into Glyphs, offset by one; 0 = empty
+	cells []int32
+}
+
+// At returns the glyph in a cell
+func (s *State) At(x, y int) (Glyph, bool) {
+	if x < 0 || y < 0 || x >= s.Width || y >= s.Height {
+		return Glyph{}, false
+	}
+	i := s.cells[y*s.Width+x]
+	if i == 0 {
+		return Glyph{}, false
+	}
+	return s.Glyphs[i-1], true
+}
+
+// Action is one keystroke and the pause before it
+type Action struct {
+	Key   terminal.Event
+	Delay time.Duration
+}
+
+// Agent plans keystrokes from the observed state
+type Agent interface {
+	// Name identifies the agent in reports
+	Name() string
+
+	// Plan appends the next keystrokes to actions, called when the previous plan is spent
+	// An empty plan makes the pilot wait and observe again
+	Plan(s *State, actions []Action) []Action
+}
+
+// Snapshot fills s from the world
+// Caller holds the world lock
+func Snapshot(ctx *engine.GameContext, s *State) {
+	w := ctx.World
+	config := w.Resources.Config
+
+	s.Elapsed = time.Duration(w.Resources.Status.Ints.Get("time.game_elapsed_ms").Load()) * time.Millisecond
+	s.Mode = ctx.GetMode()
+	s.Width, s.Height = config.MapWidth, config.MapHeight
+
+	cursor := w.Resources.Player.Entity
+	if pos, ok := w.Positions.GetPosition(cursor); ok {
+		s.Cursor = core.Point{X: pos.X, Y: pos.Y}
+	}
+	s.Energy = 0
+	if energy, ok := w.Components.Energy.GetComponent(cursor); ok {
+		s.Energy = energy.Current
+	}
+	s.Heat = 0
+	if heat, ok := w.Components.Heat.GetComponent(cursor); ok {
+		s.Heat = heat.Current
+	}
+
+	n := s.Width * s.Height
+	if cap(s.cells) < n {
+		s.cells = make([]int32, n)
+	}
+	s.cells = s.cells[:n]
+	clear(s.cells)
+
+	s.Glyphs = s.Glyphs[:0]
+	w.Components.Glyph.Each(func(e core.Entity, g *component.GlyphComponent) bool {
+		pos, ok := w.Positions.GetPosition(e)
+		if !ok || pos.X < 0 || pos.Y < 0 || pos.X >= s.Width || pos.Y >= s.Height {
+			return true
+		}
+		s.Glyphs = append(s.Glyphs, Glyph{X: pos.X, Y: pos.Y, Rune: g.Rune, Type: g.Type, Level: g.Level})
+		s.cells[pos.Y*s.Width+pos.X] = int32(len(s.Glyphs))
+		return true
+	})
+}
+
+// Key returns a printable key event
+func Key(r rune) terminal.Event {
+	return terminal.Event{Type: terminal.EventKey, Key: terminal.KeyRune, Rune: r}
+}
+
+// Escape returns the escape key event
+func Escape() terminal.Event {
+	return terminal.Event{Type: terminal.EventKey, Key: terminal.KeyEscape}
+}
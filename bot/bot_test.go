@@ -0,0 +1,208 @@
+package bot
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+)
+
+// fieldState builds a state with glyphs placed on a small field
+func fieldState(cursor core.Point, glyphs ...Glyph) *State {
+	s := &State{Cursor: cursor, Width: 20, Height: 10}
+	s.cells = make([]int32, s.Width*s.Height)
+	for _, g := range glyphs {
+		s.Glyphs = append(s.Glyphs, g)
+		s.cells[g.Y*s.Width+g.X] = int32(len(s.Glyphs))
+	}
+	return s
+}
+
+func keys(actions []Action) string {
+	var out []rune
+	for _, a := range actions {
+		switch a.Key.Key {
+		case terminal.KeyRune:
+			out = append(out, a.Key.Rune)
+		case terminal.KeyEscape:
+			out = append(out, '⎋')
+		}
+	}
+	return string(out)
+}
+
+func TestGreedyPlan(t *testing.T) {
+	exact := Profile{Name: "exact", MaxRun: 8}
+
+	tests := []struct {
+		name   string
+		cursor core.Point
+		glyphs []Glyph
+		want   string
+	}{
+		{
+			name:   "empty field",
+			cursor: core.Point{X: 5, Y: 5},
+			want:   "",
+		},
+		{
+			name:   "run from its head",
+			cursor: core.Point{X: 2, Y: 1},
+			glyphs: []Glyph{
+				{X: 6, Y: 4, Rune: 'a'},
+				{X: 7, Y: 4, Rune: 'b', Type: component.GlyphBlue},
+				{X: 8, Y: 4, Rune: 'c'},
+			},
+			want: "3j4liabc⎋",
+		},
+		{
+			name:   "red is skipped and ends a run",
+			cursor: core.Point{X: 0, Y: 0},
+			glyphs: []Glyph{
+				{X: 1, Y: 0, Rune: 'r', Type: component.GlyphRed},
+				{X: 3, Y: 2, Rune: 'x'},
+				{X: 4, Y: 2, Rune: 'y', Type: component.GlyphRed},
+			},
+			want: "2j3lix⎋",
+		},
+		{
+			name:   "backward single steps",
+			cursor: core.Point{X: 5, Y: 5},
+			glyphs: []Glyph{{X: 4, Y: 4, Rune: 'q'}},
+			want:   "khiq⎋",
+		},
+		{
+			name:   "already on target",
+			cursor: core.Point{X: 5, Y: 5},
+			glyphs: []Glyph{{X: 5, Y: 5, Rune: 'z'}, {X: 6, Y: 5, Rune: 'w'}},
+			want:   "izw⎋",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGreedy(exact, 1)
+			got := keys(g.Plan(fieldState(tt.cursor, tt.glyphs...), nil))
+			if got != tt.want {
+				t.Errorf("plan = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// scripted replays fixed plans, then plans nothing
+type scripted struct {
+	plans [][]Action
+}
+
+func (s *scripted) Name() string { return "scripted" }
+
+func (s *scripted) Plan(_ *State, actions []Action) []Action {
+	if len(s.plans) == 0 {
+		return actions
+	}
+	actions = append(actions, s.plans[0]...)
+	s.plans = s.plans[1:]
+	return actions
+}
+
+func TestPilotPacing(t *testing.T) {
+	agent := &scripted{plans: [][]Action{
+		{{Key: Key('a'), Delay: 100 * time.Millisecond}, {Key: Key('b'), Delay: 50 * time.Millisecond}},
+	}}
+	p := NewPilot(agent, time.Second)
+	observed := 0
+	observe := func(*State) { observed++ }
+
+	steps := []struct {
+		at   time.Duration
+		want rune // 0 = nothing due
+	}{
+		{0, 0},
+		{99 * time.Millisecond, 0},
+		{100 * time.Millisecond, 'a'},
+		{120 * time.Millisecond, 0},
+		{150 * time.Millisecond, 'b'},
+		{160 * time.Millisecond, 0},  // empty plan, retry in a second
+		{1100 * time.Millisecond, 0}, // still waiting
+		{1160 * time.Millisecond, 0}, // observed again, still empty
+	}
+	for _, st := range steps {
+		ev, ok := p.Next(st.at, observe)
+		if ok != (st.want != 0) || ev.Rune != st.want {
+			t.Fatalf("at %v: key %q (due %v), want %q", st.at, ev.Rune, ok, st.want)
+		}
+	}
+	if observed != 3 {
+		t.Errorf("observed %d times, want 3", observed)
+	}
+}
+
+func TestRunnerClearsGlyphs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("headless game")
+	}
+	r := &Runner{Duration: 20 * time.Second, Seed: 7}
+	results, err := r.Run(1, func(seed uint64) Agent { return NewGreedy(Expert, seed) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := results[0]
+	if res.Keys == 0 || res.Correct == 0 {
+		t.Fatalf("greedy agent made no progress: %+v", res)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	var results []Result
+	for _, e := range []int64{50, 10, 40, 20, 30} {
+		results = append(results, Result{Energy: e, Correct: 1})
+	}
+
+	s := Summarize(results)
+	if s.Games != 5 || s.Mean != 30 || s.Min != 10 || s.Max != 50 || s.P50 != 30 || s.P90 != 50 || s.Correct != 5 {
+		t.Errorf("summary = %+v", s)
+	}
+
+	lows, counts := Histogram(results, 2)
+	if len(lows) != 2 || lows[0] != 10 || counts[0]+counts[1] != 5 {
+		t.Errorf("histogram lows %v counts %v", lows, counts)
+	}
+}
+
+func TestRunnerDeterministic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("headless game")
+	}
+	var rec bytes.Buffer
+	recorder := engine.NewChecksumRecorder(&rec)
+	play := func(trace *engine.ChecksumTrace) Result {
+		r := &Runner{Duration: 30 * time.Second, Seed: 11, Trace: trace}
+		res, err := r.Play(r.Seed, NewGreedy(Expert, r.Seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	first := play(recorder)
+	if err := recorder.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := engine.NewChecksumVerifier(&rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := play(verifier)
+
+	if tick := verifier.Diverged(); tick >= 0 {
+		t.Fatalf("replay diverged at tick %d of %d", tick, verifier.Ticks())
+	}
+	if verifier.Ticks() != recorder.Ticks() || first.Energy != second.Energy {
+		t.Errorf("ticks %d/%d, energy %d/%d", recorder.Ticks(), verifier.Ticks(), first.Energy, second.Energy)
+	}
+}
@@ -0,0 +1,167 @@
+package bot
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Profile sets an agent's pacing and accuracy
+type Profile struct {
+	Name string
+
+	// Pause before each motion key, plus a uniform random share of the jitter
+	MotionDelay, MotionJitter time.Duration
+
+	// Pause before each typed character
+	TypeDelay, TypeJitter time.Duration
+
+	// Pause before the first key of each new target
+	ThinkDelay, ThinkJitter time.Duration
+
+	// TypoChance is the per-character probability of a wrong key before the right one
+	TypoChance float64
+
+	// MaxRun caps the characters typed per target
+	MaxRun int
+}
+
+// Reference profiles; Human paces the attract demo
+var (
+	Human = Profile{
+		Name:        "human",
+		MotionDelay: 140 * time.Millisecond, MotionJitter: 120 * time.Millisecond,
+		TypeDelay: 90 * time.Millisecond, TypeJitter: 110 * time.Millisecond,
+		ThinkDelay: 350 * time.Millisecond, ThinkJitter: 450 * time.Millisecond,
+		TypoChance: 0.04,
+		MaxRun:     12,
+	}
+	Expert = Profile{
+		Name:        "expert",
+		MotionDelay: 60 * time.Millisecond, MotionJitter: 40 * time.Millisecond,
+		TypeDelay: 45 * time.Millisecond, TypeJitter: 35 * time.Millisecond,
+		ThinkDelay: 120 * time.Millisecond, ThinkJitter: 120 * time.Millisecond,
+		TypoChance: 0.01,
+		MaxRun:     24,
+	}
+)
+
+// Profiles lists the reference profiles by name
+var Profiles = map[string]Profile{
+	Human.Name:  Human,
+	Expert.Name: Expert,
+}
+
+// Greedy moves to the nearest typeable run with counted hjkl motions, types it in
+// Insert mode, and escapes back to Normal mode; red and white glyphs are left alone
+type Greedy struct {
+	profile Profile
+	rng     *vmath.FastRand
+}
+
+// NewGreedy creates a greedy agent; seed drives its timing and typo rolls only
+func NewGreedy(profile Profile, seed uint64) *Greedy {
+	return &Greedy{profile: profile, rng: vmath.NewFastRand(seed)}
+}
+
+// Name returns the agent and profile name
+func (g *Greedy) Name() string {
+	return "greedy-" + g.profile.Name
+}
+
+// Typeable reports whether the greedy agent types glyphs of this type
+func Typeable(t component.GlyphType) bool {
+	return t == component.GlyphGreen || t == component.GlyphBlue || t == component.GlyphGold
+}
+
+// Plan scripts the keys to reach the nearest typeable run and type it
+func (g *Greedy) Plan(s *State, actions []Action) []Action {
+	found := false
+	tx, ty := 0, 0
+	bestDist := math.MaxInt
+	for _, gl := range s.Glyphs {
+		if !Typeable(gl.Type) {
+			continue
+		}
+		if d := vmath.IntAbs(gl.X-s.Cursor.X) + vmath.IntAbs(gl.Y-s.Cursor.Y); d < bestDist {
+			found, tx, ty, bestDist = true, gl.X, gl.Y, d
+		}
+	}
+	if !found {
+		return actions
+	}
+
+	// Start at the head of the run so ordered sequences type cleanly
+	for {
+		if gl, ok := s.At(tx-1, ty); !ok || !Typeable(gl.Type) {
+			break
+		}
+		tx--
+	}
+
+	p := &g.profile
+	start := len(actions)
+	think := g.jitter(p.ThinkDelay, p.ThinkJitter)
+	actions = g.motion(actions, ty-s.Cursor.Y, 'j', 'k', think)
+	actions = g.motion(actions, tx-s.Cursor.X, 'l', 'h', 0)
+
+	delay := g.jitter(p.MotionDelay, p.MotionJitter)
+	if len(actions) == start {
+		delay = think
+	}
+	actions = append(actions, Action{Key: Key('i'), Delay: delay})
+
+	for x := tx; x < tx+p.MaxRun; x++ {
+		gl, ok := s.At(x, ty)
+		if !ok || !Typeable(gl.Type) {
+			break
+		}
+		if g.rng.Float64() < p.TypoChance {
+			if typo := rune('a' + g.rng.Intn(26)); typo != gl.Rune {
+				actions = append(actions, Action{Key: Key(typo), Delay: g.typeDelay()})
+			}
+		}
+		actions = append(actions, Action{Key: Key(gl.Rune), Delay: g.typeDelay()})
+	}
+
+	return append(actions, Action{Key: Escape(), Delay: g.typeDelay()})
+}
+
+// motion appends a counted motion over delta cells, forward or back by sign
+// first is the pause before the first key when it is non-zero
+func (g *Greedy) motion(actions []Action, delta int, forward, back rune, first time.Duration) []Action {
+	if delta == 0 {
+		return actions
+	}
+	key := forward
+	if delta < 0 {
+		key, delta = back, -delta
+	}
+
+	var seq []rune
+	if delta > 1 {
+		seq = []rune(strconv.Itoa(delta))
+	}
+	seq = append(seq, key)
+
+	for _, r := range seq {
+		delay := g.jitter(g.profile.MotionDelay, g.profile.MotionJitter)
+		if first > 0 {
+			delay, first = first, 0
+		}
+		actions = append(actions, Action{Key: Key(r), Delay: delay})
+	}
+	return actions
+}
+
+func (g *Greedy) typeDelay() time.Duration {
+	return g.jitter(g.profile.TypeDelay, g.profile.TypeJitter)
+}
+
+// jitter returns base plus a uniform random share of spread
+func (g *Greedy) jitter(base, spread time.Duration) time.Duration {
+	return base + time.Duration(g.rng.Float64()*float64(spread))
+}
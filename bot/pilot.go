@@ -0,0 +1,63 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/lixenwraith/terminal"
+)
+
+// Pilot paces an agent's plans against a caller-supplied clock
+// Not safe for concurrent use
+type Pilot struct {
+	agent Agent
+	retry time.Duration
+
+	state  State
+	queue  []Action
+	nextAt time.Duration
+}
+
+// NewPilot creates a pilot for agent; retry is the wait after an empty plan
+func NewPilot(agent Agent, retry time.Duration) *Pilot {
+	return &Pilot{agent: agent, retry: retry}
+}
+
+// Agent returns the piloted agent
+func (p *Pilot) Agent() Agent {
+	return p.agent
+}
+
+// Next returns the key due at now, observing and planning when the previous plan is spent
+// observe fills the state and is only called when a new plan is needed
+// Keys are paced relative to the moment the previous one was taken
+func (p *Pilot) Next(now time.Duration, observe func(*State)) (terminal.Event, bool) {
+	if now < p.nextAt {
+		return terminal.Event{}, false
+	}
+
+	if len(p.queue) == 0 {
+		observe(&p.state)
+		p.queue = p.agent.Plan(&p.state, p.queue[:0])
+		if len(p.queue) == 0 {
+			p.nextAt = now + p.retry
+			return terminal.Event{}, false
+		}
+		p.nextAt = now + p.queue[0].Delay
+		if now < p.nextAt {
+			return terminal.Event{}, false
+		}
+	}
+
+	ev := p.queue[0].Key
+	p.queue = p.queue[1:]
+	if len(p.queue) > 0 {
+		p.nextAt = now + p.queue[0].Delay
+	}
+	return ev, true
+}
+
+// Reset drops the pending plan and restarts pacing at now
+func (p *Pilot) Reset(now time.Duration) {
+	p.queue = p.queue[:0]
+	p.nextAt = now
+}
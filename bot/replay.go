@@ -0,0 +1,103 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/manifest"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Replay plays recorded input headlessly from the recorded random state and renders the
+// screen every frame interval of game time, at most once per tick
+// Keys land on the first tick at or after their recorded offset; resize records are skipped
+// and the screen keeps the runner's size
+// Runs for Duration when set, else until parameter.ReplayTail past the last event or the
+// replay quits the game; an onFrame error stops the replay and is returned
+func (r *Runner) Replay(state vmath.RandState, events []input.TimedEvent, frame time.Duration,
+	onFrame func(at time.Duration, shot render.Screenshot) error) error {
+	s, err := r.newSession(state)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	end := r.Duration
+	if end <= 0 {
+		end = parameter.ReplayTail
+		if len(events) > 0 {
+			end += events[len(events)-1].At
+		}
+	}
+	if frame <= 0 {
+		frame = parameter.GameUpdateInterval
+	}
+
+	term := render.NewHeadlessTerminal(terminal.ColorModeTrueColor, s.ctx.Width, s.ctx.Height)
+	s.world.Resources.Config.ColorMode = term.ColorMode()
+	orchestrator := render.NewRenderOrchestrator(term, s.ctx.Width, s.ctx.Height)
+	for _, reg := range manifest.BuildRenderers(s.ctx) {
+		orchestrator.Register(reg.Renderer, reg.Priority)
+	}
+
+	next := 0
+	nextFrame := time.Duration(0)
+	for elapsed := time.Duration(0); elapsed <= end; elapsed += parameter.GameUpdateInterval {
+		for ; next < len(events) && events[next].At <= elapsed; next++ {
+			if ev := events[next].Event; ev.Type != terminal.EventResize && !s.press(ev) {
+				return nil
+			}
+		}
+		if !s.macroTick() {
+			return nil
+		}
+
+		s.now = s.now.Add(parameter.GameUpdateInterval)
+		s.scheduler.Step()
+
+		if elapsed >= nextFrame {
+			orchestrator.RenderFrame(s.renderContext(), s.world)
+			if err := onFrame(elapsed, term.Screenshot()); err != nil {
+				return err
+			}
+			nextFrame += frame
+		}
+	}
+	return nil
+}
+
+// macroTick runs the held mouse and macro playback steps the app runs each frame
+// Returns false when a macro quit the game
+func (s *session) macroTick() bool {
+	s.router.ProcessMouseTick()
+	intents := s.router.ProcessMacroTick()
+	cont := true
+	for _, intent := range intents {
+		s.world.RunSafe(func() {
+			cont = s.router.Handle(intent)
+		})
+		if !cont {
+			return false
+		}
+	}
+	if len(intents) > 0 {
+		s.scheduler.DispatchEventsImmediately()
+	}
+	return true
+}
+
+// renderContext snapshots the frame state like the app's render loop
+func (s *session) renderContext() (ctx render.RenderContext) {
+	s.world.RunSafe(func() {
+		timeRes := *s.world.Resources.Time
+		var cursorX, cursorY int
+		if pos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity); ok {
+			cursorX, cursorY = pos.X, pos.Y
+		}
+		ctx = render.NewRenderContextFromGame(s.ctx, timeRes, cursorX, cursorY)
+	})
+	return ctx
+}
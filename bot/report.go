@@ -0,0 +1,100 @@
+package bot
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Summary describes the energy distribution over a set of games
+type Summary struct {
+	Games        int
+	Mean, StdDev float64
+	Min, Max     int64
+	P10, P50     int64
+	P90          int64
+
+	// Typing totals over all games
+	Correct, Errors int64
+}
+
+// Summarize computes the energy distribution of results
+func Summarize(results []Result) Summary {
+	s := Summary{Games: len(results)}
+	if len(results) == 0 {
+		return s
+	}
+
+	energies := make([]int64, len(results))
+	var sum float64
+	for i, r := range results {
+		energies[i] = r.Energy
+		sum += float64(r.Energy)
+		s.Correct += r.Correct
+		s.Errors += r.Errors
+	}
+	slices.Sort(energies)
+
+	s.Mean = sum / float64(len(energies))
+	var sq float64
+	for _, e := range energies {
+		d := float64(e) - s.Mean
+		sq += d * d
+	}
+	s.StdDev = math.Sqrt(sq / float64(len(energies)))
+
+	s.Min, s.Max = energies[0], energies[len(energies)-1]
+	s.P10 = percentile(energies, 0.10)
+	s.P50 = percentile(energies, 0.50)
+	s.P90 = percentile(energies, 0.90)
+	return s
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []int64, p float64) int64 {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)]
+}
+
+// Histogram counts energies in bins equal-width buckets from the lowest to the highest
+// lows[i] is the inclusive lower bound of bucket i
+func Histogram(results []Result, bins int) (lows []int64, counts []int) {
+	if len(results) == 0 || bins <= 0 {
+		return nil, nil
+	}
+	lo, hi := results[0].Energy, results[0].Energy
+	for _, r := range results[1:] {
+		lo, hi = min(lo, r.Energy), max(hi, r.Energy)
+	}
+
+	width := max((hi-lo)/int64(bins)+1, 1)
+	lows = make([]int64, bins)
+	counts = make([]int, bins)
+	for i := range lows {
+		lows[i] = lo + int64(i)*width
+	}
+	for _, r := range results {
+		counts[min(int((r.Energy-lo)/width), bins-1)]++
+	}
+	return lows, counts
+}
+
+// WriteCSV writes one row per game with a header
+func WriteCSV(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"seed", "agent", "energy", "correct", "errors", "keys"})
+	for _, r := range results {
+		_ = cw.Write([]string{
+			strconv.FormatUint(r.Seed, 10),
+			r.Agent,
+			strconv.FormatInt(r.Energy, 10),
+			strconv.FormatInt(r.Correct, 10),
+			strconv.FormatInt(r.Errors, 10),
+			strconv.Itoa(r.Keys),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,248 @@
+package bot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/manifest"
+	"github.com/lixenwraith/vi-fighter/mode"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/service"
+	"github.com/lixenwraith/vi-fighter/system"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Runner plays headless games at simulation speed, for benchmarking agents and balancing
+// Each game is a fresh world on a virtual clock with no terminal, renderer, or audio;
+// agent keys go through the same input machine and router as typed input
+type Runner struct {
+	// Width and Height size the virtual terminal; 0 = parameter.BotFieldWidth/Height
+	Width, Height int
+
+	// Duration is the game time played per game; 0 = parameter.BotGameDuration
+	Duration time.Duration
+
+	// Seed seeds game i with Seed+i
+	Seed uint64
+
+	// GameConfig is a game.toml path or map directory; "" = embedded default
+	GameConfig string
+
+	// ContentPath is a file path or glob for typing content; "" = default discovery
+	ContentPath string
+
+	// Sample records the energy curve at this game-time interval; 0 = final energy only
+	Sample time.Duration
+
+	// Trace receives the world checksum of every tick, for determinism checks; nil = off
+	Trace *engine.ChecksumTrace
+
+	// Setup runs on each new world before its first tick, e.g. to override spawn settings
+	Setup func(ctx *engine.GameContext)
+}
+
+// Result is the outcome of one game
+type Result struct {
+	Seed    uint64
+	Agent   string
+	Energy  int64
+	Correct int64
+	Errors  int64
+	Keys    int
+
+	// Curve holds the energy at each Runner.Sample interval
+	Curve []int64
+}
+
+// Run plays n games in sequence, creating each game's agent from its seed
+func (r *Runner) Run(n int, newAgent func(seed uint64) Agent) ([]Result, error) {
+	results := make([]Result, 0, n)
+	for i := range n {
+		seed := r.Seed + uint64(i)
+		res, err := r.Play(seed, newAgent(seed))
+		if err != nil {
+			return results, fmt.Errorf("game %d (seed %d): %w", i, seed, err)
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// Play runs one game to its duration
+func (r *Runner) Play(seed uint64, agent Agent) (Result, error) {
+	s, err := r.newSession(vmath.RandState{Seed: seed})
+	if err != nil {
+		return Result{}, err
+	}
+	defer s.close()
+
+	duration := r.Duration
+	if duration <= 0 {
+		duration = parameter.BotGameDuration
+	}
+
+	pilot := NewPilot(agent, parameter.BotRetryDelay)
+	observe := func(st *State) {
+		s.world.RunSafe(func() { Snapshot(s.ctx, st) })
+	}
+
+	res := Result{Seed: seed, Agent: agent.Name()}
+	nextSample := r.Sample
+	for elapsed := time.Duration(0); elapsed < duration; elapsed += parameter.GameUpdateInterval {
+		if r.Sample > 0 && elapsed >= nextSample {
+			res.Curve = append(res.Curve, s.energy())
+			nextSample += r.Sample
+		}
+		for range parameter.BotMaxKeysPerTick {
+			ev, ok := pilot.Next(elapsed, observe)
+			if !ok {
+				break
+			}
+			res.Keys++
+			if !s.press(ev) {
+				return res, fmt.Errorf("agent %s quit the game", agent.Name())
+			}
+		}
+		s.now = s.now.Add(parameter.GameUpdateInterval)
+		s.scheduler.Step()
+	}
+
+	res.Energy = s.energy()
+	if r.Sample > 0 {
+		res.Curve = append(res.Curve, res.Energy)
+	}
+	res.Correct = s.world.Resources.Status.Ints.Get("typing.correct").Load()
+	res.Errors = s.world.Resources.Status.Ints.Get("typing.errors").Load()
+	return res, nil
+}
+
+// sessionEpoch starts every virtual clock at the same instant, so wall-time reads repeat
+var sessionEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// session is one headless game, wired like app.App without terminal, renderer, or audio
+type session struct {
+	hub       *service.Hub
+	world     *engine.World
+	ctx       *engine.GameContext
+	machine   *input.Machine
+	router    *mode.Router
+	scheduler *engine.ClockScheduler
+
+	// Virtual wall clock read by the pausable clock
+	now time.Time
+}
+
+func (r *Runner) newSession(state vmath.RandState) (*session, error) {
+	event.InitRegistry()
+
+	width, height := r.Width, r.Height
+	if width <= 0 || height <= 0 {
+		width, height = parameter.BotFieldWidth, parameter.BotFieldHeight
+	}
+
+	s := &session{hub: service.NewHub(), now: sessionEpoch}
+	contentSvc := service.NewContentService(r.ContentPath, "")
+	contentSvc.SetInlineRefresh(true)
+	_ = s.hub.Register(contentSvc)
+	s.world = engine.NewWorld()
+	if err := s.hub.InitAll(); err != nil {
+		return nil, err
+	}
+	s.hub.BindResources(s.world.Resources)
+
+	s.ctx = engine.NewGameContext(s.world, width, height)
+	s.ctx.PausableClock.SetTimeSource(func() time.Time { return s.now })
+	if err := s.world.Resources.Rand.Restore(state); err != nil {
+		s.close()
+		return nil, err
+	}
+	contentSvc.Reseed(s.world.Resources.Rand.DeriveSeed(parameter.ContentSeedName))
+
+	for _, sys := range manifest.BuildSystems(s.world) {
+		s.world.AddSystem(sys)
+	}
+
+	s.machine = input.NewMachine()
+	s.router = mode.NewRouter(s.ctx, s.machine)
+
+	// Step drives ticks directly; frame sync channels stay idle
+	var resetChan chan<- struct{}
+	s.scheduler, _, resetChan = engine.NewClockScheduler(
+		s.world,
+		s.ctx.PausableClock,
+		&s.ctx.IsPaused,
+		parameter.GameUpdateInterval,
+		make(chan struct{}, 1),
+	)
+	s.ctx.ResetChan = resetChan
+	if r.Trace != nil {
+		s.scheduler.SetChecksumTrace(r.Trace)
+	}
+
+	if err := r.loadFSM(s.scheduler); err != nil {
+		s.close()
+		return nil, err
+	}
+
+	metaSystem := system.NewMetaSystem(s.ctx)
+	s.scheduler.RegisterEventHandler(metaSystem.(event.Handler))
+	for _, sys := range s.world.Systems() {
+		if h, ok := sys.(event.Handler); ok {
+			s.scheduler.RegisterEventHandler(h)
+		}
+	}
+
+	if r.Setup != nil {
+		s.world.RunSafe(func() { r.Setup(s.ctx) })
+	}
+	return s, nil
+}
+
+// loadFSM loads the configured game script, falling back to the embedded default
+func (r *Runner) loadFSM(cs *engine.ClockScheduler) error {
+	if r.GameConfig == "" {
+		return cs.LoadFSMFromFS(asset.DefaultFSMConfig, asset.DefaultFSMEntry, manifest.RegisterFSMComponents)
+	}
+	path := r.GameConfig
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, parameter.GameConfigFile)
+	}
+	if err := cs.LoadFSMFromPath(path, manifest.RegisterFSMComponents); err != nil {
+		return fmt.Errorf("load FSM %s: %w", path, err)
+	}
+	return nil
+}
+
+// press feeds one key through the input machine and router and settles its events
+// Returns false when the key quit the game
+func (s *session) press(ev terminal.Event) bool {
+	cont := true
+	if intent := s.machine.Process(ev); intent != nil {
+		s.world.RunSafe(func() {
+			cont = s.router.Handle(intent)
+		})
+	}
+	s.scheduler.DispatchEventsImmediately()
+	return cont
+}
+
+// energy returns the player's current energy
+func (s *session) energy() (current int64) {
+	s.world.RunSafe(func() {
+		if energy, ok := s.world.Components.Energy.GetComponent(s.world.Resources.Player.Entity); ok {
+			current = energy.Current
+		}
+	})
+	return current
+}
+
+func (s *session) close() {
+	s.hub.StopAll()
+}
@@ -0,0 +1,112 @@
+package ascimage
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// Adjustment limits and step sizes for interactive control
+const (
+	BrightnessStep = 0.05
+	ContrastStep   = 0.1
+	GammaStep      = 0.1
+	SaturationStep = 0.1
+
+	minBrightness, maxBrightness = -1.0, 1.0
+	minContrast, maxContrast     = 0.0, 4.0
+	minGamma, maxGamma           = 0.1, 5.0
+	minSaturation, maxSaturation = 0.0, 4.0
+)
+
+// Adjust holds tonal corrections applied to source pixels before quantization
+type Adjust struct {
+	Brightness float64 // Offset added to each channel, 0 is neutral
+	Contrast   float64 // Scale around mid-gray, 1 is neutral
+	Gamma      float64 // Midtone curve, 1 is neutral, above 1 brightens
+	Saturation float64 // Chroma scale, 1 is neutral, 0 is grayscale
+}
+
+// DefaultAdjust returns the neutral adjustment
+func DefaultAdjust() Adjust {
+	return Adjust{Contrast: 1, Gamma: 1, Saturation: 1}
+}
+
+// IsNeutral returns true if the adjustment leaves pixels unchanged
+func (a Adjust) IsNeutral() bool {
+	return a == DefaultAdjust()
+}
+
+// Clamp limits all values to their valid ranges, rounded so repeated steps return to neutral exactly
+func (a Adjust) Clamp() Adjust {
+	a.Brightness = clampRound(a.Brightness, minBrightness, maxBrightness)
+	a.Contrast = clampRound(a.Contrast, minContrast, maxContrast)
+	a.Gamma = clampRound(a.Gamma, minGamma, maxGamma)
+	a.Saturation = clampRound(a.Saturation, minSaturation, maxSaturation)
+	return a
+}
+
+func clampRound(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, math.Round(v*100)/100))
+}
+
+// String returns a compact summary for status lines
+func (a Adjust) String() string {
+	return fmt.Sprintf("bri %+.2f con %.1f gam %.1f sat %.1f", a.Brightness, a.Contrast, a.Gamma, a.Saturation)
+}
+
+// Apply wraps img so every sampled pixel is adjusted, neutral adjustments return img unchanged
+// Converters sample sparsely, so per-sample adjustment avoids processing the full-resolution source
+func (a Adjust) Apply(img image.Image) image.Image {
+	if a.IsNeutral() {
+		return img
+	}
+	adj := &adjustedImage{Image: img, saturation: a.Saturation}
+	for i := range adj.lut {
+		v := float64(i) / 255
+		v = (v-0.5)*a.Contrast + 0.5 + a.Brightness
+		v = math.Max(0, math.Min(1, v))
+		v = math.Pow(v, 1/a.Gamma)
+		adj.lut[i] = uint8(v*255 + 0.5)
+	}
+	return adj
+}
+
+// adjustedImage applies a per-channel tone curve and saturation on At
+type adjustedImage struct {
+	image.Image
+	lut        [256]uint8
+	saturation float64
+}
+
+func (m *adjustedImage) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+func (m *adjustedImage) At(x, y int) color.Color {
+	c := color.NRGBAModel.Convert(m.Image.At(x, y)).(color.NRGBA)
+	if c.A == 0 {
+		return c
+	}
+	r := float64(m.lut[c.R])
+	g := float64(m.lut[c.G])
+	b := float64(m.lut[c.B])
+	if m.saturation != 1 {
+		lum := 0.299*r + 0.587*g + 0.114*b
+		r = lum + (r-lum)*m.saturation
+		g = lum + (g-lum)*m.saturation
+		b = lum + (b-lum)*m.saturation
+	}
+	return color.NRGBA{R: clampByte(r), G: clampByte(g), B: clampByte(b), A: c.A}
+}
+
+func clampByte(v float64) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return uint8(v + 0.5)
+}
@@ -6,6 +6,8 @@ import (
 
 	lcolor "github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
+	"github.com/lixenwraith/vi-fighter/render"
 )
 
 // QuadrantChars maps 4-bit patterns to Unicode quadrant characters
@@ -29,26 +31,14 @@ var QuadrantChars = [16]rune{
 	'█', // 1111 - full block
 }
 
-// RenderMode determines the rendering approach
-type RenderMode uint8
+// RenderMode determines the rendering approach, shared with the .vfimg format
+type RenderMode = vfimg.RenderMode
 
 const (
-	ModeBackgroundOnly RenderMode = iota
-	ModeQuadrant
+	ModeBackgroundOnly = vfimg.ModeBackgroundOnly
+	ModeQuadrant       = vfimg.ModeQuadrant
 )
 
-// String returns human-readable mode name
-func (m RenderMode) String() string {
-	switch m {
-	case ModeBackgroundOnly:
-		return "Background"
-	case ModeQuadrant:
-		return "Quadrant"
-	default:
-		return "Unknown"
-	}
-}
-
 // ConvertedImage holds the conversion result
 type ConvertedImage struct {
 	Cells  []terminal.Cell
@@ -57,7 +47,8 @@ type ConvertedImage struct {
 }
 
 // ConvertImage converts an image to terminal cells
-func ConvertImage(img image.Image, targetWidth int, mode RenderMode, colorMode terminal.ColorMode) *ConvertedImage {
+// metric selects the distance used for 256-color quantization
+func ConvertImage(img image.Image, targetWidth int, mode RenderMode, colorMode terminal.ColorMode, metric render.Metric) *ConvertedImage {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
@@ -79,9 +70,9 @@ func ConvertImage(img image.Image, targetWidth int, mode RenderMode, colorMode t
 
 	switch mode {
 	case ModeBackgroundOnly:
-		convertBackground(img, cells, outW, outH, colorMode)
+		convertBackground(img, cells, outW, outH, colorMode, metric)
 	case ModeQuadrant:
-		convertQuadrant(img, cells, outW, outH, colorMode)
+		convertQuadrant(img, cells, outW, outH, colorMode, metric)
 	}
 
 	return &ConvertedImage{
@@ -102,7 +93,7 @@ func CalculateOutputSize(srcW, srcH, targetWidth int) (outW, outH int) {
 	return outW, outH
 }
 
-func convertBackground(img image.Image, cells []terminal.Cell, outW, outH int, colorMode terminal.ColorMode) {
+func convertBackground(img image.Image, cells []terminal.Cell, outW, outH int, colorMode terminal.ColorMode, metric render.Metric) {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
@@ -124,7 +115,7 @@ func convertBackground(img image.Image, cells []terminal.Cell, outW, outH int, c
 			cells[idx].Rune = ' '
 
 			if colorMode == terminal.ColorMode256 {
-				palIdx := terminal.RGBTo256(rgb)
+				palIdx := render.Quantize256(rgb, metric)
 				cells[idx].Bg = lcolor.RGB{R: palIdx}
 				cells[idx].Attrs = terminal.AttrBg256
 			} else {
@@ -134,7 +125,7 @@ func convertBackground(img image.Image, cells []terminal.Cell, outW, outH int, c
 	}
 }
 
-func convertQuadrant(img image.Image, cells []terminal.Cell, outW, outH int, colorMode terminal.ColorMode) {
+func convertQuadrant(img image.Image, cells []terminal.Cell, outW, outH int, colorMode terminal.ColorMode, metric render.Metric) {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
@@ -171,8 +162,8 @@ func convertQuadrant(img image.Image, cells []terminal.Cell, outW, outH int, col
 			cells[idx].Rune = char
 
 			if colorMode == terminal.ColorMode256 {
-				fgIdx := terminal.RGBTo256(fg)
-				bgIdx := terminal.RGBTo256(bg)
+				fgIdx := render.Quantize256(fg, metric)
+				bgIdx := render.Quantize256(bg, metric)
 				cells[idx].Fg = lcolor.RGB{R: fgIdx}
 				cells[idx].Bg = lcolor.RGB{R: bgIdx}
 				cells[idx].Attrs = terminal.AttrFg256 | terminal.AttrBg256
@@ -1,55 +1,23 @@
 package ascimage
 
 import (
-	"bufio"
-	"encoding/binary"
-	"fmt"
 	"image"
 	"image/color"
-	"io"
-	"os"
-	"strconv"
-	"strings"
 
 	lcolor "github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
-)
-
-// DualModeImage holds both TrueColor and 256-color representations
-type DualModeImage struct {
-	Width      int
-	Height     int
-	RenderMode RenderMode
-	AnchorX    int
-	AnchorY    int
-	Cells      []DualCell
-}
-
-// DualCell stores both color mode representations for one cell
-type DualCell struct {
-	Rune         rune
-	TrueFg       lcolor.RGB
-	TrueBg       lcolor.RGB
-	Palette256Fg uint8
-	Palette256Bg uint8
-	Transparent  bool
-}
-
-// File format constants
-const (
-	dualMagic                 = "VFIMG"
-	cellFlagTransparent uint8 = 1 << 0
-	cellBytes                 = 13 // rune(4) + trueFg(3) + trueBg(3) + pal256Fg(1) + pal256Bg(1) + flags(1)
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
+	"github.com/lixenwraith/vi-fighter/render"
 )
 
 // ConvertImageDual converts image to both color modes in single pass
-func ConvertImageDual(img image.Image, targetWidth int, mode RenderMode) *DualModeImage {
+func ConvertImageDual(img image.Image, targetWidth int, mode RenderMode, metric render.Metric) *vfimg.Image {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
 
 	if srcW == 0 || srcH == 0 || targetWidth <= 0 {
-		return &DualModeImage{Width: 0, Height: 0, RenderMode: mode}
+		return &vfimg.Image{Width: 0, Height: 0, RenderMode: mode}
 	}
 
 	aspectRatio := float64(srcH) / float64(srcW)
@@ -61,16 +29,16 @@ func ConvertImageDual(img image.Image, targetWidth int, mode RenderMode) *DualMo
 		outH = 1
 	}
 
-	cells := make([]DualCell, outW*outH)
+	cells := make([]vfimg.Cell, outW*outH)
 
 	switch mode {
 	case ModeBackgroundOnly:
-		convertBackgroundDual(img, cells, outW, outH)
+		convertBackgroundDual(img, cells, outW, outH, metric)
 	case ModeQuadrant:
-		convertQuadrantDual(img, cells, outW, outH)
+		convertQuadrantDual(img, cells, outW, outH, metric)
 	}
 
-	return &DualModeImage{
+	return &vfimg.Image{
 		Width:      outW,
 		Height:     outH,
 		RenderMode: mode,
@@ -78,7 +46,7 @@ func ConvertImageDual(img image.Image, targetWidth int, mode RenderMode) *DualMo
 	}
 }
 
-func convertBackgroundDual(img image.Image, cells []DualCell, outW, outH int) {
+func convertBackgroundDual(img image.Image, cells []vfimg.Cell, outW, outH int, metric render.Metric) {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
@@ -106,12 +74,12 @@ func convertBackgroundDual(img image.Image, cells []DualCell, outW, outH int) {
 			rgb := colorToRGB(c)
 			cells[idx].Rune = ' '
 			cells[idx].TrueBg = rgb
-			cells[idx].Palette256Bg = terminal.RGBTo256(rgb)
+			cells[idx].Palette256Bg = render.Quantize256(rgb, metric)
 		}
 	}
 }
 
-func convertQuadrantDual(img image.Image, cells []DualCell, outW, outH int) {
+func convertQuadrantDual(img image.Image, cells []vfimg.Cell, outW, outH int, metric render.Metric) {
 	bounds := img.Bounds()
 	srcW := bounds.Dx()
 	srcH := bounds.Dy()
@@ -159,8 +127,8 @@ func convertQuadrantDual(img image.Image, cells []DualCell, outW, outH int) {
 			cells[idx].Rune = char
 			cells[idx].TrueFg = fg
 			cells[idx].TrueBg = bg
-			cells[idx].Palette256Fg = terminal.RGBTo256(fg)
-			cells[idx].Palette256Bg = terminal.RGBTo256(bg)
+			cells[idx].Palette256Fg = render.Quantize256(fg, metric)
+			cells[idx].Palette256Bg = render.Quantize256(bg, metric)
 		}
 	}
 }
@@ -170,171 +138,11 @@ func colorIsTransparent(c color.Color) bool {
 	return a == 0
 }
 
-// ToConvertedImage extracts single-mode ConvertedImage from dual representation
-func (d *DualModeImage) ToConvertedImage(colorMode terminal.ColorMode) *ConvertedImage {
-	cells := make([]terminal.Cell, len(d.Cells))
-
-	for i, dc := range d.Cells {
-		if dc.Transparent {
-			continue
-		}
-		if colorMode == terminal.ColorMode256 {
-			cells[i] = terminal.Cell{
-				Rune:  dc.Rune,
-				Fg:    lcolor.RGB{R: dc.Palette256Fg},
-				Bg:    lcolor.RGB{R: dc.Palette256Bg},
-				Attrs: terminal.AttrFg256 | terminal.AttrBg256,
-			}
-		} else {
-			cells[i] = terminal.Cell{
-				Rune: dc.Rune,
-				Fg:   dc.TrueFg,
-				Bg:   dc.TrueBg,
-			}
-		}
-	}
-
+// FromDual extracts single-mode ConvertedImage from a dual-mode image
+func FromDual(d *vfimg.Image, colorMode terminal.ColorMode) *ConvertedImage {
 	return &ConvertedImage{
-		Cells:  cells,
+		Cells:  d.TerminalCells(colorMode),
 		Width:  d.Width,
 		Height: d.Height,
 	}
 }
-
-// WriteDualMode writes dual-mode image to writer
-// Format: readable header lines terminated by blank line, followed by binary cell data
-func WriteDualMode(w io.Writer, img *DualModeImage) error {
-	bw := bufio.NewWriter(w)
-
-	fmt.Fprintf(bw, "%s\n", dualMagic)
-	fmt.Fprintf(bw, "w:%d\n", img.Width)
-	fmt.Fprintf(bw, "h:%d\n", img.Height)
-	fmt.Fprintf(bw, "m:%d\n", img.RenderMode)
-	fmt.Fprintf(bw, "ax:%d\n", img.AnchorX)
-	fmt.Fprintf(bw, "ay:%d\n", img.AnchorY)
-	fmt.Fprintf(bw, "\n")
-
-	cellBuf := make([]byte, cellBytes)
-	for _, cell := range img.Cells {
-		binary.LittleEndian.PutUint32(cellBuf[0:4], uint32(cell.Rune))
-		cellBuf[4] = cell.TrueFg.R
-		cellBuf[5] = cell.TrueFg.G
-		cellBuf[6] = cell.TrueFg.B
-		cellBuf[7] = cell.TrueBg.R
-		cellBuf[8] = cell.TrueBg.G
-		cellBuf[9] = cell.TrueBg.B
-		cellBuf[10] = cell.Palette256Fg
-		cellBuf[11] = cell.Palette256Bg
-		var flags uint8
-		if cell.Transparent {
-			flags |= cellFlagTransparent
-		}
-		cellBuf[12] = flags
-
-		if _, err := bw.Write(cellBuf); err != nil {
-			return err
-		}
-	}
-
-	return bw.Flush()
-}
-
-// ReadDualMode reads dual-mode image from reader
-func ReadDualMode(r io.Reader) (*DualModeImage, error) {
-	br := bufio.NewReader(r)
-
-	line, err := readHeaderLine(br)
-	if err != nil {
-		return nil, fmt.Errorf("read magic: %w", err)
-	}
-	if line != dualMagic {
-		return nil, fmt.Errorf("invalid magic: %q", line)
-	}
-
-	img := &DualModeImage{}
-
-	for {
-		line, err = readHeaderLine(br)
-		if err != nil {
-			return nil, fmt.Errorf("read header: %w", err)
-		}
-		if line == "" {
-			break
-		}
-
-		key, val, ok := strings.Cut(line, ":")
-		if !ok {
-			continue
-		}
-
-		switch key {
-		case "w":
-			img.Width, _ = strconv.Atoi(val)
-		case "h":
-			img.Height, _ = strconv.Atoi(val)
-		case "m":
-			m, _ := strconv.Atoi(val)
-			img.RenderMode = RenderMode(m)
-		case "ax":
-			img.AnchorX, _ = strconv.Atoi(val)
-		case "ay":
-			img.AnchorY, _ = strconv.Atoi(val)
-		}
-	}
-
-	if img.Width <= 0 || img.Height <= 0 {
-		return nil, fmt.Errorf("invalid dimensions: %dx%d", img.Width, img.Height)
-	}
-
-	cellCount := img.Width * img.Height
-	cells := make([]DualCell, cellCount)
-	cellBuf := make([]byte, cellBytes)
-
-	for i := range cellCount {
-		if _, err := io.ReadFull(br, cellBuf); err != nil {
-			return nil, fmt.Errorf("read cell %d: %w", i, err)
-		}
-		cells[i] = DualCell{
-			Rune:         rune(binary.LittleEndian.Uint32(cellBuf[0:4])),
-			TrueFg:       lcolor.RGB{R: cellBuf[4], G: cellBuf[5], B: cellBuf[6]},
-			TrueBg:       lcolor.RGB{R: cellBuf[7], G: cellBuf[8], B: cellBuf[9]},
-			Palette256Fg: cellBuf[10],
-			Palette256Bg: cellBuf[11],
-			Transparent:  cellBuf[12]&cellFlagTransparent != 0,
-		}
-	}
-
-	img.Cells = cells
-	return img, nil
-}
-
-func readHeaderLine(br *bufio.Reader) (string, error) {
-	line, err := br.ReadString('\n')
-	if err != nil {
-		return "", err
-	}
-	return strings.TrimRight(line, "\r\n"), nil
-}
-
-// SaveDualMode writes dual-mode image to file
-// Format: magic(5) + version(1) + width(2) + height(2) + mode(1) + cells(12 each)
-func SaveDualMode(path string, img *DualModeImage) error {
-	f, err := os.Create(path)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	return WriteDualMode(f, img)
-}
-
-// LoadDualMode reads dual-mode image from file
-func LoadDualMode(path string) (*DualModeImage, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
-
-	return ReadDualMode(f)
-}
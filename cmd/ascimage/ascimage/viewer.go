@@ -6,6 +6,7 @@ import (
 
 	lcolor "github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
 	"github.com/lixenwraith/vi-fighter/render"
 )
 
@@ -21,7 +22,7 @@ const (
 // Viewer manages image display with viewport and navigation
 type Viewer struct {
 	img     image.Image
-	dualImg *DualModeImage
+	dualImg *vfimg.Image
 
 	srcWidth  int
 	srcHeight int
@@ -37,6 +38,10 @@ type Viewer struct {
 	ViewportX  int
 	ViewportY  int
 	ShowStatus bool
+
+	Metric     render.Metric // 256-color quantization distance, image only
+	Adjust     Adjust        // Tonal correction applied before conversion, image only
+	ShowAdjust bool          // Adjustment HUD visibility
 }
 
 // NewViewer creates a viewer for the given image
@@ -51,11 +56,12 @@ func NewViewer(img image.Image) *Viewer {
 		ViewMode:   ViewFit,
 		ZoomLevel:  100,
 		ShowStatus: true,
+		Adjust:     DefaultAdjust(),
 	}
 }
 
 // NewViewerFromDual creates a viewer for a pre-converted .vfimg file
-func NewViewerFromDual(dual *DualModeImage) *Viewer {
+func NewViewerFromDual(dual *vfimg.Image) *Viewer {
 	return &Viewer{
 		dualImg:    dual,
 		srcWidth:   dual.Width,
@@ -65,6 +71,7 @@ func NewViewerFromDual(dual *DualModeImage) *Viewer {
 		ViewMode:   ViewFit,
 		ZoomLevel:  100,
 		ShowStatus: true,
+		Adjust:     DefaultAdjust(),
 	}
 }
 
@@ -124,7 +131,7 @@ func (v *Viewer) calculateTargetWidth(termW, termH int) int {
 func (v *Viewer) Update(termW, termH int) {
 	if v.dualImg != nil {
 		if v.converted == nil {
-			v.converted = v.dualImg.ToConvertedImage(v.ColorMode)
+			v.converted = FromDual(v.dualImg, v.ColorMode)
 			v.convWidth = v.converted.Width
 		}
 		v.clampViewport(termW, termH)
@@ -136,7 +143,7 @@ func (v *Viewer) Update(termW, termH int) {
 		return
 	}
 
-	v.converted = ConvertImage(v.img, targetW, v.RenderMode, v.ColorMode)
+	v.converted = ConvertImage(v.Adjust.Apply(v.img), targetW, v.RenderMode, v.ColorMode, v.Metric)
 	v.convWidth = targetW
 	v.clampViewport(termW, termH)
 }
@@ -144,7 +151,7 @@ func (v *Viewer) Update(termW, termH int) {
 // ForceUpdate forces reconversion
 func (v *Viewer) ForceUpdate(termW, termH int) {
 	if v.dualImg != nil {
-		v.converted = v.dualImg.ToConvertedImage(v.ColorMode)
+		v.converted = FromDual(v.dualImg, v.ColorMode)
 		v.convWidth = v.converted.Width
 		v.clampViewport(termW, termH)
 		return
@@ -257,6 +264,16 @@ func (v *Viewer) AdjustZoom(delta int) {
 	}
 }
 
+// SetAdjust replaces the tonal adjustment and shows the HUD, no-op for .vfimg
+// Caller must ForceUpdate to reconvert
+func (v *Viewer) SetAdjust(a Adjust) {
+	if v.dualImg != nil {
+		return
+	}
+	v.Adjust = a.Clamp()
+	v.ShowAdjust = true
+}
+
 // Render draws the image to the render buffer
 func (v *Viewer) Render(buf *render.RenderBuffer, termW, termH int) {
 	if v.converted == nil {
@@ -268,22 +285,44 @@ func (v *Viewer) Render(buf *render.RenderBuffer, termW, termH int) {
 		availH--
 	}
 
+	v.RenderAt(buf, 0, 0, termW, availH, 1.0)
+
+	if v.ShowAdjust && v.dualImg == nil {
+		v.renderAdjustHUD(buf, termW)
+	}
+	if v.ShowStatus {
+		v.renderStatus(buf, termW, termH)
+	}
+}
+
+// RenderAt draws the image centered in the w×h area at x0,y0 without a status bar
+// alpha below 1 blends over existing content, used for fade transitions
+func (v *Viewer) RenderAt(buf *render.RenderBuffer, x0, y0, w, h int, alpha float64) {
+	if v.converted == nil {
+		return
+	}
+
+	mode := render.BlendReplace
+	if alpha < 1.0 {
+		mode = render.BlendAlpha
+	}
+
 	offsetX := 0
 	offsetY := 0
-	if v.converted.Width < termW {
-		offsetX = (termW - v.converted.Width) / 2
+	if v.converted.Width < w {
+		offsetX = (w - v.converted.Width) / 2
 	}
-	if v.converted.Height < availH {
-		offsetY = (availH - v.converted.Height) / 2
+	if v.converted.Height < h {
+		offsetY = (h - v.converted.Height) / 2
 	}
 
-	for y := range availH {
+	for y := range h {
 		srcY := y + v.ViewportY - offsetY
 		if srcY < 0 || srcY >= v.converted.Height {
 			continue
 		}
 
-		for x := range termW {
+		for x := range w {
 			srcX := x + v.ViewportX - offsetX
 			if srcX < 0 || srcX >= v.converted.Width {
 				continue
@@ -292,13 +331,9 @@ func (v *Viewer) Render(buf *render.RenderBuffer, termW, termH int) {
 			srcIdx := srcY*v.converted.Width + srcX
 			cell := v.converted.Cells[srcIdx]
 
-			buf.Set(x, y, cell.Rune, cell.Fg, cell.Bg, render.BlendReplace, 1.0, cell.Attrs)
+			buf.Set(x0+x, y0+y, cell.Rune, cell.Fg, cell.Bg, mode, alpha, cell.Attrs)
 		}
 	}
-
-	if v.ShowStatus {
-		v.renderStatus(buf, termW, termH)
-	}
 }
 
 func (v *Viewer) renderStatus(buf *render.RenderBuffer, termW, termH int) {
@@ -348,7 +383,10 @@ func (v *Viewer) renderStatus(buf *render.RenderBuffer, termW, termH int) {
 		if v.converted != nil && (v.converted.Width > termW || v.converted.Height > termH-1) {
 			status += fmt.Sprintf("| [%d,%d] ", v.ViewportX, v.ViewportY)
 		}
-		help = " q:quit f:fit m:mode c:color ±:zoom arrows:pan"
+		if !v.Adjust.IsNeutral() {
+			status += "| adjusted "
+		}
+		help = " q:quit f:fit m:mode c:color ±:zoom 1-8:adjust a:hud arrows:pan"
 	}
 
 	x := 0
@@ -377,6 +415,52 @@ func (v *Viewer) renderStatus(buf *render.RenderBuffer, termW, termH int) {
 	}
 }
 
+// renderAdjustHUD draws current adjustment values in the top-right corner
+func (v *Viewer) renderAdjustHUD(buf *render.RenderBuffer, termW int) {
+	hudBg := lcolor.RGB{R: 40, G: 40, B: 50}
+	labelFg := lcolor.RGB{R: 140, G: 140, B: 160}
+	valueFg := lcolor.RGB{R: 200, G: 200, B: 200}
+	changedFg := lcolor.RGB{R: 255, G: 200, B: 80}
+
+	neutral := DefaultAdjust()
+	rows := []struct {
+		keys    string
+		label   string
+		value   string
+		changed bool
+	}{
+		{"1/2", "brightness", fmt.Sprintf("%+.2f", v.Adjust.Brightness), v.Adjust.Brightness != neutral.Brightness},
+		{"3/4", "contrast", fmt.Sprintf("%.1f", v.Adjust.Contrast), v.Adjust.Contrast != neutral.Contrast},
+		{"5/6", "gamma", fmt.Sprintf("%.1f", v.Adjust.Gamma), v.Adjust.Gamma != neutral.Gamma},
+		{"7/8", "saturation", fmt.Sprintf("%.1f", v.Adjust.Saturation), v.Adjust.Saturation != neutral.Saturation},
+		{"r", "reset", "", false},
+	}
+
+	const hudW = 24
+	x0 := termW - hudW - 1
+	if x0 < 0 {
+		return
+	}
+	for i, row := range rows {
+		y := i + 1
+		for x := range hudW {
+			buf.Set(x0+x, y, ' ', valueFg, hudBg, render.BlendReplace, 1.0, terminal.AttrNone)
+		}
+		line := fmt.Sprintf(" %-4s%-11s", row.keys, row.label)
+		for j, r := range line {
+			buf.SetFgOnly(x0+j, y, r, labelFg, terminal.AttrNone)
+		}
+		fg := valueFg
+		if row.changed {
+			fg = changedFg
+		}
+		vx := x0 + hudW - 1 - len(row.value)
+		for j, r := range row.value {
+			buf.SetFgOnly(vx+j, y, r, fg, terminal.AttrNone)
+		}
+	}
+}
+
 // NeedsPanning returns true if image exceeds viewport
 func (v *Viewer) NeedsPanning(termW, termH int) bool {
 	if v.converted == nil {
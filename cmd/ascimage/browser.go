@@ -0,0 +1,664 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/terminal/tui"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
+	"github.com/lixenwraith/vi-fighter/cmd/ascimage/ascimage"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/render/renderer"
+)
+
+// Transition selects how slideshow images replace each other
+type Transition uint8
+
+const (
+	TransitionFade Transition = iota // Crossfade through the background
+	TransitionCut                    // Instant switch
+)
+
+func (t Transition) String() string {
+	if t == TransitionCut {
+		return "cut"
+	}
+	return "fade"
+}
+
+func parseTransition(s string) Transition {
+	switch s {
+	case "fade":
+		return TransitionFade
+	case "cut", "none":
+		return TransitionCut
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown transition: %s, using fade\n", s)
+		return TransitionFade
+	}
+}
+
+// Browser constants
+const (
+	browserTreeMaxW  = 40
+	browserTick      = 40 * time.Millisecond
+	fadeDuration     = 600 * time.Millisecond
+	viewerCacheSize  = 16
+	minSlideInterval = time.Second
+)
+
+// Browser colors
+var (
+	browserBg       = color.RGB{R: 20, G: 20, B: 28}
+	browserFg       = color.RGB{R: 200, G: 200, B: 200}
+	browserDim      = color.RGB{R: 110, G: 110, B: 130}
+	browserDirFg    = color.RGB{R: 100, G: 180, B: 255}
+	browserCursorBg = color.RGB{R: 50, G: 50, B: 70}
+	browserStatusBg = color.RGB{R: 40, G: 40, B: 50}
+	browserErrFg    = color.RGB{R: 255, G: 100, B: 100}
+)
+
+var imageExts = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".vfimg": true}
+
+// browserEntry is a directory or image in the scanned tree
+type browserEntry struct {
+	Path     string
+	Name     string
+	IsDir    bool
+	Children []*browserEntry
+}
+
+// Browser shows a directory of images as a tree with a live preview and a slideshow
+type Browser struct {
+	root   *browserEntry
+	images []string // Every image in tree order, the slideshow sequence
+
+	nodes     []tui.TreeNode
+	tree      *tui.TreeState
+	expansion *tui.TreeExpansion
+	adapter   *renderer.TUIAdapter
+
+	RenderMode ascimage.RenderMode
+	ColorMode  terminal.ColorMode
+	Metric     render.Metric   // 256-color quantization for newly loaded images
+	Adjust     ascimage.Adjust // Applied to every newly loaded image
+
+	viewers map[string]*ascimage.Viewer
+	recent  []string // Viewer cache order, oldest first
+	errors  map[string]error
+
+	full bool // Single image fullscreen with viewer controls
+
+	// Slideshow
+	slideshow  bool
+	paused     bool
+	Interval   time.Duration
+	Transition Transition
+	slideIdx   int
+	nextAt     time.Time
+	prev       *ascimage.Viewer // Outgoing image while fading
+	fadeStart  time.Time
+}
+
+// NewBrowser scans dir for images, directories without images are pruned
+func NewBrowser(dir string) (*Browser, error) {
+	root, err := scanImages(dir)
+	if err != nil {
+		return nil, err
+	}
+	b := &Browser{
+		root:       root,
+		tree:       tui.NewTreeState(20),
+		expansion:  tui.NewTreeExpansion(),
+		adapter:    renderer.NewTUIAdapter(1, 1),
+		RenderMode: ascimage.ModeQuadrant,
+		ColorMode:  terminal.ColorModeTrueColor,
+		Adjust:     ascimage.DefaultAdjust(),
+		viewers:    make(map[string]*ascimage.Viewer),
+		errors:     make(map[string]error),
+		Interval:   5 * time.Second,
+	}
+	var collect func(e *browserEntry)
+	collect = func(e *browserEntry) {
+		for _, c := range e.Children {
+			if c.IsDir {
+				collect(c)
+			} else {
+				b.images = append(b.images, c.Path)
+			}
+		}
+	}
+	collect(root)
+	b.rebuildNodes()
+	return b, nil
+}
+
+func scanImages(dir string) (*browserEntry, error) {
+	entries := map[string]*browserEntry{dir: {Path: dir, Name: filepath.Base(dir), IsDir: true}}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		if d.IsDir() {
+			if strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			entries[path] = &browserEntry{Path: path, Name: d.Name(), IsDir: true}
+			return nil
+		}
+		if !imageExts[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		// Link the file and any parents not linked yet
+		child := &browserEntry{Path: path, Name: d.Name()}
+		for p := filepath.Dir(path); ; p = filepath.Dir(p) {
+			parent := entries[p]
+			parent.Children = append(parent.Children, child)
+			if p == dir || len(parent.Children) > 1 {
+				break
+			}
+			child = parent
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortEntries(entries[dir])
+	return entries[dir], nil
+}
+
+// sortEntries orders directories before files, each alphabetically
+func sortEntries(e *browserEntry) {
+	sort.Slice(e.Children, func(i, j int) bool {
+		a, b := e.Children[i], e.Children[j]
+		if a.IsDir != b.IsDir {
+			return a.IsDir
+		}
+		return a.Name < b.Name
+	})
+	for _, c := range e.Children {
+		if c.IsDir {
+			sortEntries(c)
+		}
+	}
+}
+
+func (b *Browser) rebuildNodes() {
+	builder := tui.NewTreeBuilder(b.expansion)
+	var add func(e *browserEntry, depth int, visible bool)
+	add = func(e *browserEntry, depth int, visible bool) {
+		for _, c := range e.Children {
+			node := tui.TreeNode{
+				Key:        c.Path,
+				Label:      c.Name,
+				Depth:      depth,
+				Expandable: c.IsDir,
+				Style:      tui.Style{Fg: browserFg},
+				Data:       c,
+			}
+			if c.IsDir {
+				node.Style.Fg = browserDirFg
+				node.Suffix = fmt.Sprintf(" (%d)", len(c.Children))
+			} else if b.errors[c.Path] != nil {
+				node.Style.Fg = browserErrFg
+			}
+			builder.Add(node, visible)
+			if c.IsDir {
+				add(c, depth+1, visible && b.expansion.IsExpanded(c.Path))
+			}
+		}
+	}
+	add(b.root, 0, true)
+	builder.MarkLastSiblings()
+	b.nodes = builder.Nodes()
+	b.tree.AdjustScroll(len(b.nodes))
+}
+
+func (b *Browser) cursorEntry() *browserEntry {
+	if b.tree.Cursor < 0 || b.tree.Cursor >= len(b.nodes) {
+		return nil
+	}
+	return b.nodes[b.tree.Cursor].Data.(*browserEntry)
+}
+
+// selectedImage is the image under the cursor, "" on a directory
+func (b *Browser) selectedImage() string {
+	if e := b.cursorEntry(); e != nil && !e.IsDir {
+		return e.Path
+	}
+	return ""
+}
+
+// viewer returns a cached viewer for path, loading it on first use
+// Load errors are remembered so the tree can flag broken files
+func (b *Browser) viewer(path string) *ascimage.Viewer {
+	if v, ok := b.viewers[path]; ok {
+		return v
+	}
+	if b.errors[path] != nil {
+		return nil
+	}
+
+	var v *ascimage.Viewer
+	if isVfimg(path) {
+		dual, err := vfimg.LoadFile(path)
+		if err != nil {
+			b.errors[path] = err
+			b.rebuildNodes()
+			return nil
+		}
+		v = ascimage.NewViewerFromDual(dual)
+	} else {
+		img, err := loadImage(path)
+		if err != nil {
+			b.errors[path] = err
+			b.rebuildNodes()
+			return nil
+		}
+		v = ascimage.NewViewer(img)
+		v.RenderMode = b.RenderMode
+		v.Metric = b.Metric
+		v.Adjust = b.Adjust
+	}
+	v.ColorMode = b.ColorMode
+
+	b.viewers[path] = v
+	b.recent = append(b.recent, path)
+	if len(b.recent) > viewerCacheSize {
+		delete(b.viewers, b.recent[0])
+		b.recent = b.recent[1:]
+	}
+	return v
+}
+
+// resetViewers drops cached conversions after a render or color mode change
+func (b *Browser) resetViewers() {
+	b.viewers = make(map[string]*ascimage.Viewer)
+	b.recent = b.recent[:0]
+	b.prev = nil
+}
+
+// --- Slideshow ---
+
+func (b *Browser) startSlideshow() {
+	if len(b.images) == 0 {
+		return
+	}
+	b.slideIdx = 0
+	if sel := b.selectedImage(); sel != "" {
+		for i, p := range b.images {
+			if p == sel {
+				b.slideIdx = i
+				break
+			}
+		}
+	}
+	b.slideshow = true
+	b.paused = false
+	b.prev = nil
+	b.nextAt = time.Now().Add(b.Interval)
+}
+
+// stopSlideshow returns to the browser with the cursor on the last shown image
+func (b *Browser) stopSlideshow() {
+	b.slideshow = false
+	b.prev = nil
+	b.revealInTree(b.images[b.slideIdx])
+}
+
+// step moves the slideshow by delta images, starting a fade from the current one
+func (b *Browser) step(delta int, now time.Time) {
+	if b.Transition == TransitionFade {
+		b.prev = b.viewer(b.images[b.slideIdx])
+		b.fadeStart = now
+	}
+	n := len(b.images)
+	b.slideIdx = ((b.slideIdx+delta)%n + n) % n
+	b.nextAt = now.Add(b.Interval)
+}
+
+// tick advances the slideshow, true when the frame needs redrawing
+func (b *Browser) tick(now time.Time) bool {
+	if !b.slideshow {
+		return false
+	}
+	redraw := false
+	if b.prev != nil {
+		if now.Sub(b.fadeStart) >= fadeDuration {
+			b.prev = nil
+		}
+		redraw = true
+	}
+	if !b.paused && !now.Before(b.nextAt) {
+		b.step(1, now)
+		redraw = true
+	}
+	return redraw
+}
+
+// revealInTree expands the parents of path and moves the cursor onto it
+func (b *Browser) revealInTree(path string) {
+	for p := filepath.Dir(path); p != b.root.Path && p != "." && p != "/"; p = filepath.Dir(p) {
+		b.expansion.Expand(p)
+	}
+	b.rebuildNodes()
+	for i, n := range b.nodes {
+		if n.Key == path {
+			b.tree.Cursor = i
+			b.tree.AdjustScroll(len(b.nodes))
+			return
+		}
+	}
+}
+
+// --- Input ---
+
+// handleKey processes a key, false to quit
+func (b *Browser) handleKey(ev terminal.Event, termW, termH int) bool {
+	if ev.Key == terminal.KeyCtrlC {
+		return false
+	}
+	switch {
+	case b.slideshow:
+		b.handleSlideshowKey(ev)
+	case b.full:
+		if ev.Key == terminal.KeyEscape || ev.Rune == 'q' || ev.Rune == 'Q' {
+			b.full = false
+			return true
+		}
+		if v := b.viewer(b.selectedImage()); v != nil {
+			v.ShowStatus = true
+			if handleKey(ev, v, termW, termH) == actionRedraw {
+				v.ForceUpdate(termW, termH)
+			}
+		}
+	default:
+		return b.handleTreeKey(ev)
+	}
+	return true
+}
+
+func (b *Browser) handleTreeKey(ev terminal.Event) bool {
+	total := len(b.nodes)
+	switch ev.Key {
+	case terminal.KeyEscape:
+		return false
+	case terminal.KeyUp:
+		b.tree.MoveCursor(-1, total)
+	case terminal.KeyDown:
+		b.tree.MoveCursor(1, total)
+	case terminal.KeyPageUp:
+		b.tree.PageUp(total)
+	case terminal.KeyPageDown:
+		b.tree.PageDown(total)
+	case terminal.KeyLeft:
+		b.collapse()
+	case terminal.KeyRight:
+		b.expand()
+	case terminal.KeyEnter:
+		if b.selectedImage() != "" {
+			b.full = true
+		} else {
+			b.expand()
+		}
+	case terminal.KeySpace:
+		b.startSlideshow()
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q', 'Q':
+			return false
+		case 'k':
+			b.tree.MoveCursor(-1, total)
+		case 'j':
+			b.tree.MoveCursor(1, total)
+		case 'g':
+			b.tree.JumpStart()
+		case 'G':
+			b.tree.JumpEnd(total)
+		case 'h':
+			b.collapse()
+		case 'l':
+			b.expand()
+		case 'p':
+			b.startSlideshow()
+		case 't':
+			b.Transition ^= 1
+		case 'm', 'M':
+			if b.RenderMode == ascimage.ModeQuadrant {
+				b.RenderMode = ascimage.ModeBackgroundOnly
+			} else {
+				b.RenderMode = ascimage.ModeQuadrant
+			}
+			b.resetViewers()
+		case 'c', 'C':
+			if b.ColorMode == terminal.ColorModeTrueColor {
+				b.ColorMode = terminal.ColorMode256
+			} else {
+				b.ColorMode = terminal.ColorModeTrueColor
+			}
+			b.resetViewers()
+		}
+	}
+	return true
+}
+
+func (b *Browser) handleSlideshowKey(ev terminal.Event) {
+	now := time.Now()
+	switch ev.Key {
+	case terminal.KeyEscape:
+		b.stopSlideshow()
+	case terminal.KeySpace:
+		b.paused = !b.paused
+		b.nextAt = now.Add(b.Interval)
+	case terminal.KeyRight:
+		b.step(1, now)
+	case terminal.KeyLeft:
+		b.step(-1, now)
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q', 'Q', 'p':
+			b.stopSlideshow()
+		case 'n', 'l':
+			b.step(1, now)
+		case 'N', 'h':
+			b.step(-1, now)
+		case '[':
+			b.Interval = max(minSlideInterval, b.Interval-time.Second)
+			b.nextAt = now.Add(b.Interval)
+		case ']':
+			b.Interval += time.Second
+			b.nextAt = now.Add(b.Interval)
+		case 't':
+			b.Transition ^= 1
+		}
+	}
+}
+
+func (b *Browser) collapse() {
+	e := b.cursorEntry()
+	if e == nil {
+		return
+	}
+	if e.IsDir && b.expansion.IsExpanded(e.Path) {
+		b.expansion.Collapse(e.Path)
+		b.rebuildNodes()
+		return
+	}
+	if parent := tui.FindParentIndex(b.nodes, b.tree.Cursor); parent >= 0 {
+		b.tree.Cursor = parent
+		b.tree.AdjustScroll(len(b.nodes))
+	}
+}
+
+func (b *Browser) expand() {
+	if e := b.cursorEntry(); e != nil && e.IsDir {
+		b.expansion.Expand(e.Path)
+		b.rebuildNodes()
+	}
+}
+
+// --- Rendering ---
+
+func (b *Browser) Draw(buf *render.RenderBuffer, w, h int) {
+	if w < 10 || h < 3 {
+		return
+	}
+	switch {
+	case b.slideshow:
+		b.drawSlideshow(buf, w, h)
+	case b.full:
+		if v := b.viewer(b.selectedImage()); v != nil {
+			v.ShowStatus = true
+			v.Update(w, h)
+			v.Render(buf, w, h)
+		}
+	default:
+		b.drawBrowser(buf, w, h)
+	}
+}
+
+func (b *Browser) drawBrowser(buf *render.RenderBuffer, w, h int) {
+	treeW := min(browserTreeMaxW, w/3)
+	bodyH := h - 1
+
+	b.adapter.Resize(treeW, bodyH)
+	b.adapter.Clear(browserBg)
+	r := b.adapter.Region()
+	r.Text(1, 0, tui.Truncate(b.root.Path, treeW-2), browserDim, browserBg, terminal.AttrBold)
+	r.VLine(treeW-1, tui.LineSingle, browserDim)
+	list := r.Sub(0, 1, treeW-1, bodyH-1)
+	b.tree.SetVisible(list.H)
+	b.tree.AdjustScroll(len(b.nodes))
+	list.Tree(b.nodes, b.tree.Cursor, b.tree.Scroll, tui.TreeOpts{
+		CursorBg:    browserCursorBg,
+		DefaultBg:   browserBg,
+		IndentWidth: 2,
+		IconWidth:   2,
+	})
+	b.adapter.FlushTo(buf, 0, 0, 0xFF)
+
+	previewX, previewW := treeW, w-treeW
+	path := b.selectedImage()
+	status := fmt.Sprintf(" %d images | %s | ", len(b.images), b.RenderMode.String())
+	if path != "" {
+		if v := b.viewer(path); v != nil {
+			v.ShowStatus = false
+			v.ViewMode = ascimage.ViewFit
+			v.Update(previewW, bodyH)
+			v.RenderAt(buf, previewX, 0, previewW, bodyH, 1.0)
+			srcW, srcH := v.ImageSize()
+			status += fmt.Sprintf("%s %dx%d", filepath.Base(path), srcW, srcH)
+		} else {
+			status += fmt.Sprintf("%s: %v", filepath.Base(path), b.errors[path])
+		}
+	}
+	b.drawStatus(buf, w, h-1, status, " Enter:view Space:slideshow m:mode c:color q:quit")
+}
+
+func (b *Browser) drawSlideshow(buf *render.RenderBuffer, w, h int) {
+	bodyH := h - 1
+	if b.prev != nil {
+		t := float64(time.Since(b.fadeStart)) / float64(fadeDuration)
+		b.prev.ShowStatus = false
+		b.prev.Update(w, bodyH)
+		b.prev.RenderAt(buf, 0, 0, w, bodyH, max(0, 1-t))
+		if v := b.viewer(b.images[b.slideIdx]); v != nil {
+			v.ShowStatus = false
+			v.Update(w, bodyH)
+			v.RenderAt(buf, 0, 0, w, bodyH, min(1, t))
+		}
+	} else if v := b.viewer(b.images[b.slideIdx]); v != nil {
+		v.ShowStatus = false
+		v.Update(w, bodyH)
+		v.RenderAt(buf, 0, 0, w, bodyH, 1.0)
+	}
+
+	state := fmt.Sprintf("every %s", b.Interval)
+	if b.paused {
+		state = "paused"
+	}
+	path := b.images[b.slideIdx]
+	status := fmt.Sprintf(" [%d/%d] %s | %s | %s", b.slideIdx+1, len(b.images), path, state, b.Transition)
+	if err := b.errors[path]; err != nil {
+		status += " | " + err.Error()
+	}
+	b.drawStatus(buf, w, h-1, status, " Space:pause n/N:next/prev [/]:interval t:transition q:back")
+}
+
+func (b *Browser) drawStatus(buf *render.RenderBuffer, w, y int, status, help string) {
+	b.adapter.Resize(w, 1)
+	b.adapter.Clear(browserStatusBg)
+	r := b.adapter.Region()
+	r.Text(0, 0, tui.Truncate(status, w), browserFg, browserStatusBg, terminal.AttrNone)
+	if tui.RuneLen(status)+tui.RuneLen(help) < w {
+		r.TextRight(0, help, browserDim, browserStatusBg, terminal.AttrNone)
+	}
+	b.adapter.FlushTo(buf, 0, y, 0xFF)
+}
+
+// runBrowser runs the directory browser until quit
+// Input is read on a goroutine so slideshow timing runs between keys
+func runBrowser(b *Browser) error {
+	term := terminal.New(b.ColorMode)
+	if err := term.Init(); err != nil {
+		return fmt.Errorf("terminal init: %w", err)
+	}
+	core.SetCrashTerminal(term)
+	defer term.Fini()
+	defer core.RecoverAndRestore()
+
+	termW, termH := term.Size()
+	buf := render.NewRenderBuffer(b.ColorMode, termW, termH)
+
+	events := make(chan terminal.Event, 16)
+	core.Go(func() {
+		for {
+			ev := term.PollEvent()
+			events <- ev
+			if ev.Type == terminal.EventClosed || ev.Type == terminal.EventError {
+				return
+			}
+		}
+	})
+
+	ticker := time.NewTicker(browserTick)
+	defer ticker.Stop()
+
+	for {
+		buf.Clear()
+		b.Draw(buf, termW, termH)
+		buf.FlushToTerminal(term)
+
+	wait:
+		for {
+			select {
+			case ev := <-events:
+				switch ev.Type {
+				case terminal.EventKey:
+					if !b.handleKey(ev, termW, termH) {
+						return nil
+					}
+				case terminal.EventResize:
+					termW, termH = ev.Width, ev.Height
+					buf.Resize(termW, termH)
+				case terminal.EventError, terminal.EventClosed:
+					return nil
+				}
+				break wait
+			case now := <-ticker.C:
+				if b.tick(now) {
+					break wait
+				}
+			}
+		}
+	}
+}
@@ -9,8 +9,10 @@ import (
 	_ "image/png"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
 	"github.com/lixenwraith/vi-fighter/cmd/ascimage/ascimage"
 	"github.com/lixenwraith/vi-fighter/render"
 )
@@ -27,10 +29,15 @@ func main() {
 		zoomLevel  int
 		anchorX    int
 		anchorY    int
+		interval   time.Duration
+		transition string
+		adjust     = ascimage.DefaultAdjust()
+		metricStr  string
 	)
 
 	flag.StringVar(&modeStr, "m", "quadrant", "Render mode: 'bg' or 'quadrant'")
 	flag.StringVar(&colorStr, "c", "auto", "Color depth: 'auto', 'true', or '256'")
+	flag.StringVar(&metricStr, "metric", "redmean", "256-color quantization distance: 'redmean' or 'ciede2000'")
 	flag.IntVar(&width, "w", 0, "Output width (file mode only, 0 = 80)")
 	flag.StringVar(&dualOutput, "dual", "", "Output dual-mode .vfimg file")
 	flag.StringVar(&output, "o", "", "Output ANSI to file ('-' for stdout), omit for interactive")
@@ -39,6 +46,12 @@ func main() {
 	flag.IntVar(&zoomLevel, "z", 100, "Initial zoom level percent (interactive only)")
 	flag.IntVar(&anchorX, "ax", 0, "Anchor X offset (dual-mode output)")
 	flag.IntVar(&anchorY, "ay", 0, "Anchor Y offset (dual-mode output)")
+	flag.Float64Var(&adjust.Brightness, "brightness", 0, "Brightness offset, -1 to 1")
+	flag.Float64Var(&adjust.Contrast, "contrast", 1, "Contrast scale, 0 to 4")
+	flag.Float64Var(&adjust.Gamma, "gamma", 1, "Gamma, 0.1 to 5 (above 1 brightens midtones)")
+	flag.Float64Var(&adjust.Saturation, "saturation", 1, "Saturation scale, 0 (grayscale) to 4")
+	flag.DurationVar(&interval, "interval", 5*time.Second, "Slideshow interval (directory only)")
+	flag.StringVar(&transition, "transition", "fade", "Slideshow transition: 'fade' or 'cut' (directory only)")
 	flag.Parse()
 
 	if flag.NArg() < 1 {
@@ -48,11 +61,15 @@ func main() {
 
 	inputPath := flag.Arg(0)
 	colorMode := parseColorMode(colorStr)
+	adjust = adjust.Clamp()
+	metric := parseMetric(metricStr)
 
-	if isVfimg(inputPath) {
+	if info, err := os.Stat(inputPath); err == nil && info.IsDir() {
+		runDirectoryInput(inputPath, modeStr, colorMode, metric, adjust, interval, transition)
+	} else if isVfimg(inputPath) {
 		runVfimgInput(inputPath, colorMode, output, noStatus)
 	} else {
-		runImageInput(inputPath, modeStr, colorMode, width, output, dualOutput,
+		runImageInput(inputPath, modeStr, colorMode, metric, adjust, width, output, dualOutput,
 			fitMode, noStatus, zoomLevel, anchorX, anchorY)
 	}
 }
@@ -61,8 +78,34 @@ func isVfimg(path string) bool {
 	return strings.HasSuffix(strings.ToLower(path), ".vfimg")
 }
 
+func runDirectoryInput(dir, modeStr string, colorMode terminal.ColorMode, metric render.Metric, adjust ascimage.Adjust, interval time.Duration, transition string) {
+	browser, err := NewBrowser(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error scanning directory: %v\n", err)
+		os.Exit(1)
+	}
+	if len(browser.images) == 0 {
+		fmt.Fprintf(os.Stderr, "No images found in %s\n", dir)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "Found: %d images in %s\n", len(browser.images), dir)
+
+	browser.RenderMode = parseRenderMode(modeStr)
+	browser.ColorMode = colorMode
+	browser.Metric = metric
+	browser.Adjust = adjust
+	browser.Interval = max(minSlideInterval, interval)
+	browser.Transition = parseTransition(transition)
+
+	if err := runBrowser(browser); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
 func runVfimgInput(path string, colorMode terminal.ColorMode, output string, noStatus bool) {
-	dual, err := ascimage.LoadDualMode(path)
+	dual, err := vfimg.LoadFile(path)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading vfimg: %v\n", err)
 		os.Exit(1)
@@ -72,7 +115,7 @@ func runVfimgInput(path string, colorMode terminal.ColorMode, output string, noS
 		path, dual.Width, dual.Height, dual.RenderMode.String())
 
 	if output != "" {
-		conv := dual.ToConvertedImage(colorMode)
+		conv := ascimage.FromDual(dual, colorMode)
 		if err := ascimage.WriteANSI(conv, output, colorMode); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
 			os.Exit(1)
@@ -90,7 +133,7 @@ func runVfimgInput(path string, colorMode terminal.ColorMode, output string, noS
 	}
 }
 
-func runImageInput(path, modeStr string, colorMode terminal.ColorMode, width int,
+func runImageInput(path, modeStr string, colorMode terminal.ColorMode, metric render.Metric, adjust ascimage.Adjust, width int,
 	output, dualOutput string, fitMode, noStatus bool, zoomLevel, anchorX, anchorY int) {
 
 	img, err := loadImage(path)
@@ -104,34 +147,41 @@ func runImageInput(path, modeStr string, colorMode terminal.ColorMode, width int
 
 	renderMode := parseRenderMode(modeStr)
 
+	if dualOutput != "" || output != "" {
+		if !adjust.IsNeutral() {
+			fmt.Fprintf(os.Stderr, "Adjust: %s\n", adjust)
+		}
+		img = adjust.Apply(img)
+	}
+
 	if dualOutput != "" {
-		runDualOutput(img, renderMode, width, dualOutput, anchorX, anchorY)
+		runDualOutput(img, renderMode, metric, width, dualOutput, anchorX, anchorY)
 	} else if output != "" {
-		runFileOutput(img, renderMode, colorMode, width, output)
+		runFileOutput(img, renderMode, colorMode, metric, width, output)
 	} else {
-		runInteractive(img, renderMode, colorMode, fitMode, noStatus, zoomLevel)
+		runInteractive(img, renderMode, colorMode, metric, adjust, fitMode, noStatus, zoomLevel)
 	}
 }
 
-func runDualOutput(img image.Image, renderMode ascimage.RenderMode, width int, output string, anchorX, anchorY int) {
+func runDualOutput(img image.Image, renderMode ascimage.RenderMode, metric render.Metric, width int, output string, anchorX, anchorY int) {
 	if width <= 0 {
 		width = 80
 	}
 
-	dual := ascimage.ConvertImageDual(img, width, renderMode)
+	dual := ascimage.ConvertImageDual(img, width, renderMode, metric)
 	dual.AnchorX = anchorX
 	dual.AnchorY = anchorY
 
 	fmt.Fprintf(os.Stderr, "Dual-mode output: %dx%d cells\n", dual.Width, dual.Height)
 
-	if err := ascimage.SaveDualMode(output, dual); err != nil {
+	if err := vfimg.SaveFile(output, dual); err != nil {
 		fmt.Fprintf(os.Stderr, "Error writing dual-mode output: %v\n", err)
 		os.Exit(1)
 	}
 }
 
 func printUsage() {
-	fmt.Fprintln(os.Stderr, "Usage: ascimage [options] <image|file.vfimg>")
+	fmt.Fprintln(os.Stderr, "Usage: ascimage [options] <image|file.vfimg|directory>")
 	fmt.Fprintln(os.Stderr, "\nSupported formats: PNG, JPEG, GIF (input), .vfimg (view/convert)")
 	fmt.Fprintln(os.Stderr, "\nOptions:")
 	flag.PrintDefaults()
@@ -143,6 +193,8 @@ func printUsage() {
 	fmt.Fprintln(os.Stderr, "  .vfimg input:")
 	fmt.Fprintln(os.Stderr, "    File output (-o):  convert .vfimg to ANSI sequences")
 	fmt.Fprintln(os.Stderr, "    Interactive:        view with color mode toggle (default)")
+	fmt.Fprintln(os.Stderr, "  Directory input:")
+	fmt.Fprintln(os.Stderr, "    Browser:            image tree with preview, slideshow (default)")
 	fmt.Fprintln(os.Stderr, "\nInteractive controls:")
 	fmt.Fprintln(os.Stderr, "  q, Esc, Ctrl+C    Quit")
 	fmt.Fprintln(os.Stderr, "  f                 Toggle fit/actual size (image only)")
@@ -151,6 +203,20 @@ func printUsage() {
 	fmt.Fprintln(os.Stderr, "  +/-               Zoom in/out (image only)")
 	fmt.Fprintln(os.Stderr, "  Arrow keys, hjkl  Pan viewport")
 	fmt.Fprintln(os.Stderr, "  s                 Toggle status bar")
+	fmt.Fprintln(os.Stderr, "  1/2, 3/4          Brightness, contrast down/up (image only)")
+	fmt.Fprintln(os.Stderr, "  5/6, 7/8          Gamma, saturation down/up (image only)")
+	fmt.Fprintln(os.Stderr, "  r                 Reset adjustments (image only)")
+	fmt.Fprintln(os.Stderr, "  a                 Toggle adjustment HUD (image only)")
+	fmt.Fprintln(os.Stderr, "\nBrowser controls:")
+	fmt.Fprintln(os.Stderr, "  j/k, h/l          Move, collapse/expand directory")
+	fmt.Fprintln(os.Stderr, "  Enter             View selected image fullscreen (q/Esc returns)")
+	fmt.Fprintln(os.Stderr, "  Space, p          Start slideshow from selection")
+	fmt.Fprintln(os.Stderr, "  t                 Toggle fade/cut transition")
+	fmt.Fprintln(os.Stderr, "\nSlideshow controls:")
+	fmt.Fprintln(os.Stderr, "  Space             Pause/resume")
+	fmt.Fprintln(os.Stderr, "  n/N, Left/Right   Next/previous image")
+	fmt.Fprintln(os.Stderr, "  [ ]               Shorten/lengthen interval by 1s")
+	fmt.Fprintln(os.Stderr, "  q, Esc            Back to browser")
 }
 
 func loadImage(path string) (image.Image, error) {
@@ -176,6 +242,14 @@ func parseRenderMode(s string) ascimage.RenderMode {
 	}
 }
 
+func parseMetric(s string) render.Metric {
+	m, ok := render.ParseMetric(s)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown metric: %s, using redmean\n", s)
+	}
+	return m
+}
+
 func parseColorMode(s string) terminal.ColorMode {
 	switch s {
 	case "auto":
@@ -190,12 +264,12 @@ func parseColorMode(s string) terminal.ColorMode {
 	}
 }
 
-func runFileOutput(img image.Image, renderMode ascimage.RenderMode, colorMode terminal.ColorMode, width int, output string) {
+func runFileOutput(img image.Image, renderMode ascimage.RenderMode, colorMode terminal.ColorMode, metric render.Metric, width int, output string) {
 	if width <= 0 {
 		width = 80
 	}
 
-	converted := ascimage.ConvertImage(img, width, renderMode, colorMode)
+	converted := ascimage.ConvertImage(img, width, renderMode, colorMode, metric)
 	fmt.Fprintf(os.Stderr, "Output: %dx%d cells\n", converted.Width, converted.Height)
 
 	if err := ascimage.WriteANSI(converted, output, colorMode); err != nil {
@@ -204,11 +278,13 @@ func runFileOutput(img image.Image, renderMode ascimage.RenderMode, colorMode te
 	}
 }
 
-func runInteractive(img image.Image, renderMode ascimage.RenderMode, colorMode terminal.ColorMode, fitMode, noStatus bool, zoomLevel int) {
+func runInteractive(img image.Image, renderMode ascimage.RenderMode, colorMode terminal.ColorMode, metric render.Metric, adjust ascimage.Adjust, fitMode, noStatus bool, zoomLevel int) {
 	viewer := ascimage.NewViewer(img)
 	viewer.RenderMode = renderMode
 	viewer.ColorMode = colorMode
 	viewer.ShowStatus = !noStatus
+	viewer.Metric = metric
+	viewer.Adjust = adjust
 
 	if !fitMode {
 		viewer.ViewMode = ascimage.ViewActual
@@ -302,6 +378,15 @@ func handleKey(ev terminal.Event, viewer *ascimage.Viewer, termW, termH int) key
 		case '-', '_':
 			viewer.AdjustZoom(-10)
 			return actionRedraw
+		case '1', '2', '3', '4', '5', '6', '7', '8':
+			viewer.SetAdjust(nudgeAdjust(viewer.Adjust, ev.Rune))
+			return actionRedraw
+		case 'r', 'R':
+			viewer.SetAdjust(ascimage.DefaultAdjust())
+			return actionRedraw
+		case 'a', 'A':
+			viewer.ShowAdjust = !viewer.ShowAdjust
+			return actionNone
 		case '0':
 			viewer.ZoomLevel = 100
 			viewer.ViewMode = ascimage.ViewCustom
@@ -365,6 +450,26 @@ func handleKey(ev terminal.Event, viewer *ascimage.Viewer, termW, termH int) key
 	return actionNone
 }
 
+// nudgeAdjust steps one adjustment for a digit key: 1/2 brightness, 3/4 contrast,
+// 5/6 gamma, 7/8 saturation, odd keys decrease and even keys increase
+func nudgeAdjust(a ascimage.Adjust, key rune) ascimage.Adjust {
+	sign := 1.0
+	if (key-'1')%2 == 0 {
+		sign = -1
+	}
+	switch (key - '1') / 2 {
+	case 0:
+		a.Brightness += sign * ascimage.BrightnessStep
+	case 1:
+		a.Contrast += sign * ascimage.ContrastStep
+	case 2:
+		a.Gamma += sign * ascimage.GammaStep
+	case 3:
+		a.Saturation += sign * ascimage.SaturationStep
+	}
+	return a
+}
+
 func renderFrame(viewer *ascimage.Viewer, buf *render.RenderBuffer, term terminal.Terminal, termW, termH int) {
 	buf.Clear()
 	viewer.Render(buf, termW, termH)
@@ -0,0 +1,219 @@
+// balance sweeps spawn settings against reference agents and reports the energy curves
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/bot"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+var (
+	flagInterval = flag.String("interval", strconv.Itoa(parameter.SpawnIntervalMs), "Base spawn intervals in ms, comma separated")
+	flagMaxLine  = flag.String("maxline", "0", "Line length caps, comma separated, 0 = uncapped")
+	flagRed      = flag.String("red", "0", "Red spawn ratios in [0,1], comma separated")
+	flagProfiles = flag.String("p", bot.Human.Name+","+bot.Expert.Name, "Greedy agent profiles, comma separated: "+profileNames())
+	flagGames    = flag.Int("n", 5, "Games per sweep point and profile")
+	flagDuration = flag.Duration("d", parameter.BotGameDuration, "Game time per game")
+	flagSample   = flag.Duration("sample", 10*time.Second, "Energy curve sample interval")
+	flagSeed     = flag.Uint64("seed", 1, "Seed of the first game, game i uses seed+i at every point")
+	flagGame     = flag.String("g", "", "Game config: game.toml path or map directory")
+	flagContent  = flag.String("f", "", "Content file path or glob pattern")
+	flagCSV      = flag.String("csv", "", "Write mean and percentile curves as CSV to this file")
+	flagSVG      = flag.String("svg", "", "Plot mean curves as SVG to this file")
+)
+
+// point is one combination of swept spawn settings
+type point struct {
+	tuning engine.SpawnTuning
+}
+
+func (p point) String() string {
+	return fmt.Sprintf("interval=%dms maxline=%d red=%.2f", p.tuning.IntervalMs, p.tuning.MaxLine, p.tuning.RedRatio)
+}
+
+// curve is the aggregated energy curve of one point and profile
+type curve struct {
+	point   point
+	profile string
+	at      []time.Duration
+	steps   []bot.Summary
+	final   bot.Summary
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	points, err := sweep()
+	if err != nil {
+		return err
+	}
+	var profiles []bot.Profile
+	for _, name := range strings.Split(*flagProfiles, ",") {
+		profile, ok := bot.Profiles[strings.TrimSpace(name)]
+		if !ok {
+			return fmt.Errorf("unknown profile %q, want one of %s", name, profileNames())
+		}
+		profiles = append(profiles, profile)
+	}
+	if *flagSample <= 0 || *flagDuration <= 0 {
+		return fmt.Errorf("sample and duration must be positive")
+	}
+
+	var curves []curve
+	for _, pt := range points {
+		for _, profile := range profiles {
+			runner := &bot.Runner{
+				Duration:    *flagDuration,
+				Sample:      *flagSample,
+				Seed:        *flagSeed,
+				GameConfig:  *flagGame,
+				ContentPath: *flagContent,
+				Setup: func(ctx *engine.GameContext) {
+					ctx.World.Resources.Config.Spawn = pt.tuning
+				},
+			}
+			results, err := runner.Run(*flagGames, func(seed uint64) bot.Agent {
+				return bot.NewGreedy(profile, seed)
+			})
+			if err != nil {
+				return fmt.Errorf("%v: %w", pt, err)
+			}
+
+			c := aggregate(pt, profile.Name, results)
+			curves = append(curves, c)
+			fmt.Printf("%-40s %-7s energy mean %8.0f  p10 %8d  p50 %8d  p90 %8d  errors %d\n",
+				pt, profile.Name, c.final.Mean, c.final.P10, c.final.P50, c.final.P90, c.final.Errors)
+		}
+	}
+
+	if *flagCSV != "" {
+		if err := writeFile(*flagCSV, curves, writeCSV); err != nil {
+			return err
+		}
+	}
+	if *flagSVG != "" {
+		if err := writeFile(*flagSVG, curves, writeSVG); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sweep expands the flag lists into their cartesian product
+func sweep() ([]point, error) {
+	intervals, err := parseList(*flagInterval, strconv.Atoi)
+	if err != nil {
+		return nil, fmt.Errorf("interval: %w", err)
+	}
+	maxLines, err := parseList(*flagMaxLine, strconv.Atoi)
+	if err != nil {
+		return nil, fmt.Errorf("maxline: %w", err)
+	}
+	reds, err := parseList(*flagRed, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+	if err != nil {
+		return nil, fmt.Errorf("red: %w", err)
+	}
+
+	var points []point
+	for _, interval := range intervals {
+		for _, maxLine := range maxLines {
+			for _, red := range reds {
+				if interval <= 0 || maxLine < 0 || red < 0 || red > 1 {
+					return nil, fmt.Errorf("out of range: interval=%d maxline=%d red=%v", interval, maxLine, red)
+				}
+				points = append(points, point{engine.SpawnTuning{IntervalMs: interval, MaxLine: maxLine, RedRatio: red}})
+			}
+		}
+	}
+	return points, nil
+}
+
+// aggregate summarizes the games of one point at every sample
+func aggregate(pt point, profile string, results []bot.Result) curve {
+	c := curve{point: pt, profile: profile, final: bot.Summarize(results)}
+	if len(results) == 0 {
+		return c
+	}
+	samples := len(results[0].Curve)
+	at := make([]bot.Result, len(results))
+	for i := range samples {
+		for j, r := range results {
+			at[j] = bot.Result{Energy: r.Curve[min(i, len(r.Curve)-1)]}
+		}
+		c.at = append(c.at, min(time.Duration(i+1)*(*flagSample), *flagDuration))
+		c.steps = append(c.steps, bot.Summarize(at))
+	}
+	return c
+}
+
+func writeCSV(f *os.File, curves []curve) error {
+	cw := csv.NewWriter(f)
+	_ = cw.Write([]string{"interval_ms", "max_line", "red_ratio", "agent", "t_s", "mean", "stddev", "p10", "p50", "p90"})
+	for _, c := range curves {
+		for i, s := range c.steps {
+			_ = cw.Write([]string{
+				strconv.Itoa(c.point.tuning.IntervalMs),
+				strconv.Itoa(c.point.tuning.MaxLine),
+				strconv.FormatFloat(c.point.tuning.RedRatio, 'f', -1, 64),
+				c.profile,
+				strconv.FormatFloat(c.at[i].Seconds(), 'f', -1, 64),
+				strconv.FormatFloat(s.Mean, 'f', 1, 64),
+				strconv.FormatFloat(s.StdDev, 'f', 1, 64),
+				strconv.FormatInt(s.P10, 10),
+				strconv.FormatInt(s.P50, 10),
+				strconv.FormatInt(s.P90, 10),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeFile(path string, curves []curve, write func(*os.File, []curve) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f, curves); err != nil {
+		f.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// parseList parses a comma separated list with parse
+func parseList[T any](s string, parse func(string) (T, error)) ([]T, error) {
+	var out []T
+	for _, field := range strings.Split(s, ",") {
+		v, err := parse(strings.TrimSpace(field))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func profileNames() string {
+	names := make([]string, 0, len(bot.Profiles))
+	for name := range bot.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"math"
+	"os"
+	"time"
+)
+
+// Plot geometry in SVG user units
+const (
+	plotWidth   = 900
+	plotHeight  = 520
+	plotLeft    = 70
+	plotRight   = 260 // Legend column
+	plotTop     = 20
+	plotBottom  = 40
+	plotTicks   = 5
+	plotLegendY = 18
+)
+
+// plotColors cycles per curve
+var plotColors = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+}
+
+// writeSVG plots the mean energy of every curve over game time
+func writeSVG(f *os.File, curves []curve) error {
+	var tMax time.Duration
+	lo, hi := 0.0, 0.0
+	for _, c := range curves {
+		for i, s := range c.steps {
+			tMax = max(tMax, c.at[i])
+			lo, hi = math.Min(lo, s.Mean), math.Max(hi, s.Mean)
+		}
+	}
+	if tMax == 0 {
+		tMax = time.Second
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+
+	innerW := float64(plotWidth - plotLeft - plotRight)
+	innerH := float64(plotHeight - plotTop - plotBottom)
+	px := func(t time.Duration) float64 { return plotLeft + innerW*float64(t)/float64(tMax) }
+	py := func(e float64) float64 { return plotTop + innerH*(hi-e)/(hi-lo) }
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="11">`+"\n", plotWidth, plotHeight)
+	fmt.Fprintf(w, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+
+	// Axes with evenly spaced ticks
+	fmt.Fprintf(w, `<g stroke="#888" fill="none"><rect x="%d" y="%d" width="%.0f" height="%.0f"/></g>`+"\n", plotLeft, plotTop, innerW, innerH)
+	for i := 0; i <= plotTicks; i++ {
+		t := tMax * time.Duration(i) / plotTicks
+		e := lo + (hi-lo)*float64(i)/plotTicks
+		fmt.Fprintf(w, `<text x="%.1f" y="%d" text-anchor="middle">%v</text>`+"\n", px(t), plotHeight-plotBottom+16, t.Round(time.Second))
+		fmt.Fprintf(w, `<text x="%d" y="%.1f" text-anchor="end">%.0f</text>`+"\n", plotLeft-6, py(e)+4, e)
+		fmt.Fprintf(w, `<line x1="%d" y1="%.1f" x2="%.0f" y2="%.1f" stroke="#eee"/>`+"\n", plotLeft, py(e), plotLeft+innerW, py(e))
+	}
+	fmt.Fprintf(w, `<text x="%.0f" y="%d" text-anchor="middle">game time</text>`+"\n", plotLeft+innerW/2, plotHeight-6)
+	fmt.Fprintf(w, `<text x="14" y="%.0f" transform="rotate(-90 14 %.0f)" text-anchor="middle">mean energy</text>`+"\n", plotTop+innerH/2, plotTop+innerH/2)
+
+	for n, c := range curves {
+		color := plotColors[n%len(plotColors)]
+		fmt.Fprintf(w, `<polyline fill="none" stroke="%s" stroke-width="1.5" points="`, color)
+		for i, s := range c.steps {
+			fmt.Fprintf(w, "%.1f,%.1f ", px(c.at[i]), py(s.Mean))
+		}
+		fmt.Fprintln(w, `"/>`)
+
+		ly := plotTop + n*plotLegendY
+		lx := plotWidth - plotRight + 12
+		fmt.Fprintf(w, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-width="3"/>`+"\n", lx, ly, lx+16, ly, color)
+		fmt.Fprintf(w, `<text x="%d" y="%d">%s</text>`+"\n", lx+22, ly+4, html.EscapeString(legend(c)))
+	}
+
+	fmt.Fprintln(w, `</svg>`)
+	return w.Flush()
+}
+
+// legend labels a curve compactly
+func legend(c curve) string {
+	t := c.point.tuning
+	return fmt.Sprintf("%s %dms L%d r%.2f", c.profile, t.IntervalMs, t.MaxLine, t.RedRatio)
+}
@@ -0,0 +1,84 @@
+// bot-bench plays headless games with a reference agent and reports the energy distribution
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/lixenwraith/vi-fighter/bot"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+var (
+	flagGames    = flag.Int("n", 20, "Number of games")
+	flagProfile  = flag.String("p", bot.Human.Name, "Greedy agent profile: "+profileNames())
+	flagDuration = flag.Duration("d", parameter.BotGameDuration, "Game time per game")
+	flagSeed     = flag.Uint64("seed", 1, "Seed of the first game, game i uses seed+i")
+	flagGame     = flag.String("g", "", "Game config: game.toml path or map directory")
+	flagContent  = flag.String("f", "", "Content file path or glob pattern")
+	flagCSV      = flag.String("csv", "", "Write per-game results as CSV to this file")
+	flagBins     = flag.Int("bins", 10, "Histogram buckets, 0 = none")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	profile, ok := bot.Profiles[*flagProfile]
+	if !ok {
+		return fmt.Errorf("unknown profile %q, want one of %s", *flagProfile, profileNames())
+	}
+
+	runner := &bot.Runner{
+		Duration:    *flagDuration,
+		Seed:        *flagSeed,
+		GameConfig:  *flagGame,
+		ContentPath: *flagContent,
+	}
+	results, err := runner.Run(*flagGames, func(seed uint64) bot.Agent {
+		return bot.NewGreedy(profile, seed)
+	})
+	if err != nil {
+		return err
+	}
+
+	if *flagCSV != "" {
+		f, err := os.Create(*flagCSV)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := bot.WriteCSV(f, results); err != nil {
+			return fmt.Errorf("csv %s: %w", *flagCSV, err)
+		}
+	}
+
+	s := bot.Summarize(results)
+	fmt.Printf("agent greedy-%s, %d games of %v\n", profile.Name, s.Games, *flagDuration)
+	fmt.Printf("energy  mean %.0f  stddev %.0f\n", s.Mean, s.StdDev)
+	fmt.Printf("        min %d  p10 %d  p50 %d  p90 %d  max %d\n", s.Min, s.P10, s.P50, s.P90, s.Max)
+	fmt.Printf("typing  correct %d  errors %d\n", s.Correct, s.Errors)
+
+	lows, counts := bot.Histogram(results, *flagBins)
+	for i, low := range lows {
+		fmt.Printf("%10d | %s %d\n", low, strings.Repeat("#", counts[i]), counts[i])
+	}
+	return nil
+}
+
+func profileNames() string {
+	names := make([]string, 0, len(bot.Profiles))
+	for name := range bot.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
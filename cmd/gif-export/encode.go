@@ -0,0 +1,176 @@
+package main
+
+import (
+	"image"
+	stdcolor "image/color"
+	"image/gif"
+	"io"
+	"math"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// gifHoldLast is the delay after the last frame before the animation loops, in 1/100 s
+const gifHoldLast = 200
+
+// gifExporter keeps each frame as the changed cell region with its own palette
+// image/gif only encodes whole animations, so frames stay in memory until close
+type gifExporter struct {
+	w     io.Writer
+	speed float64
+
+	anim gif.GIF
+	prev render.Screenshot
+	last time.Duration // Game time of the last kept frame
+}
+
+func newGIFExporter(w io.Writer, speed float64) *gifExporter {
+	return &gifExporter{w: w, speed: speed}
+}
+
+func (g *gifExporter) frame(at time.Duration, shot render.Screenshot) error {
+	box := image.Rect(0, 0, shot.Width, shot.Height)
+	if g.prev.Cells == nil {
+		g.anim.Config = image.Config{Width: shot.Width * render.ShotCellWidth, Height: shot.Height * render.ShotCellHeight}
+	} else {
+		var changed bool
+		if box, changed = changedRegion(g.prev, shot); !changed {
+			return nil // The previous frame's delay grows to cover this one
+		}
+		// Delays derive from absolute times so rounding never accumulates
+		g.anim.Delay[len(g.anim.Delay)-1] = g.centis(at) - g.centis(g.last)
+	}
+
+	region := shot.Crop(box.Min.X, box.Min.Y, box.Dx(), box.Dy()).Image()
+	offset := image.Pt(box.Min.X*render.ShotCellWidth, box.Min.Y*render.ShotCellHeight)
+	g.anim.Image = append(g.anim.Image, quantize(region, offset))
+	g.anim.Delay = append(g.anim.Delay, gifHoldLast)
+	g.anim.Disposal = append(g.anim.Disposal, gif.DisposalNone)
+	g.prev, g.last = shot, at
+	return nil
+}
+
+// centis converts game time to output time in 1/100 s
+func (g *gifExporter) centis(at time.Duration) int {
+	return int(math.Round(at.Seconds() / g.speed * 100))
+}
+
+func (g *gifExporter) close() error {
+	if len(g.anim.Image) == 0 {
+		return nil
+	}
+	return gif.EncodeAll(g.w, &g.anim)
+}
+
+// changedRegion returns the bounding box of cells that differ, in cells
+func changedRegion(prev, cur render.Screenshot) (image.Rectangle, bool) {
+	if prev.Width != cur.Width || prev.Height != cur.Height {
+		return image.Rect(0, 0, cur.Width, cur.Height), true
+	}
+	box := image.Rectangle{}
+	changed := false
+	for y := range cur.Height {
+		for x := range cur.Width {
+			i := y*cur.Width + x
+			if prev.Cells[i] == cur.Cells[i] {
+				continue
+			}
+			cell := image.Rect(x, y, x+1, y+1)
+			if changed {
+				box = box.Union(cell)
+			} else {
+				box, changed = cell, true
+			}
+		}
+	}
+	return box, changed
+}
+
+// quantize converts img to a paletted image placed at offset
+// Frames with up to 256 colors keep them exactly; others get a median-cut palette
+func quantize(img *image.RGBA, offset image.Point) *image.Paletted {
+	b := img.Bounds()
+	pixel := func(x, y int) uint32 {
+		p := img.PixOffset(x, y)
+		return uint32(img.Pix[p])<<16 | uint32(img.Pix[p+1])<<8 | uint32(img.Pix[p+2])
+	}
+
+	// Cells are flat color, so most pixels repeat their left neighbor and skip the map
+	index := make(map[uint32]uint8, 256)
+	var colors []color.RGB
+	exact := true
+	last := uint32(math.MaxUint32)
+	for y := b.Min.Y; y < b.Max.Y && exact; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := pixel(x, y)
+			if c == last {
+				continue
+			}
+			last = c
+			if _, ok := index[c]; ok {
+				continue
+			}
+			if len(colors) == 256 {
+				exact = false
+				break
+			}
+			index[c] = uint8(len(colors))
+			colors = append(colors, color.RGB{R: uint8(c >> 16), G: uint8(c >> 8), B: uint8(c)})
+		}
+	}
+
+	var palette *render.Palette
+	if !exact {
+		colors = render.MedianCut(img, 256)
+		palette = render.NewPalette(colors, render.MetricRedmean)
+		clear(index)
+	}
+
+	pal := make(stdcolor.Palette, len(colors))
+	for i, c := range colors {
+		pal[i] = stdcolor.RGBA{R: c.R, G: c.G, B: c.B, A: 255}
+	}
+	out := image.NewPaletted(b.Add(offset), pal)
+	last = math.MaxUint32
+	var idx uint8
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row := out.PixOffset(b.Min.X+offset.X, y+offset.Y)
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if c := pixel(x, y); c != last {
+				last = c
+				var ok bool
+				if idx, ok = index[c]; !ok {
+					// Distinct colors per frame are few, so exact search beats building a LUT
+					idx = uint8(palette.NearestExact(color.RGB{R: uint8(c >> 16), G: uint8(c >> 8), B: uint8(c)}))
+					index[c] = idx
+				}
+			}
+			out.Pix[row+x-b.Min.X] = idx
+		}
+	}
+	return out
+}
+
+// castExporter writes changed cells as asciinema output events, timed by playback speed
+type castExporter struct {
+	cast  *render.CastWriter
+	speed float64
+}
+
+func newCastExporter(w io.Writer, width, height int, speed float64) (*castExporter, error) {
+	cast, err := render.NewCastWriter(w, width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &castExporter{cast: cast, speed: speed}, nil
+}
+
+func (c *castExporter) frame(at time.Duration, shot render.Screenshot) error {
+	return c.cast.Frame(time.Duration(float64(at)/c.speed), shot)
+}
+
+func (c *castExporter) close() error {
+	return c.cast.Flush()
+}
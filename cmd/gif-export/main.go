@@ -0,0 +1,139 @@
+// gif-export plays a recorded replay headlessly and writes it as an animated GIF or an
+// asciinema v2 cast
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/app"
+	"github.com/lixenwraith/vi-fighter/bot"
+	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+var (
+	flagOut      = flag.String("o", "", "Output file: .gif or .cast")
+	flagSize     = flag.String("size", fmt.Sprintf("%dx%d", parameter.BotFieldWidth, parameter.BotFieldHeight), "Screen size the replay is played at, WxH")
+	flagFPS      = flag.Int("fps", 10, "Frames per second of game time, at most 20 (one per tick)")
+	flagSpeed    = flag.Float64("speed", 1, "Playback speed of the output")
+	flagCrop     = flag.String("crop", "", "Export only this region, x,y,w,h in cells")
+	flagDuration = flag.Duration("d", 0, "Game time to export, 0 = until the replay ends")
+	flagGame     = flag.String("g", "", "Game config: game.toml path or map directory")
+	flagContent  = flag.String("f", "", "Content file path or glob pattern")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: gif-export -o out.gif|out.cast [options] replay\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// exporter consumes the frames of a replay
+type exporter interface {
+	frame(at time.Duration, shot render.Screenshot) error
+	close() error
+}
+
+func run() error {
+	if flag.NArg() != 1 || *flagOut == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *flagFPS <= 0 || *flagSpeed <= 0 {
+		return fmt.Errorf("fps and speed must be positive")
+	}
+	ext := strings.ToLower(filepath.Ext(*flagOut))
+	if ext != ".gif" && ext != ".cast" {
+		return fmt.Errorf("output %s: extension must be .gif or .cast", *flagOut)
+	}
+
+	var width, height int
+	if _, err := fmt.Sscanf(*flagSize, "%dx%d", &width, &height); err != nil || width <= 0 || height <= 0 {
+		return fmt.Errorf("size %q: want WxH", *flagSize)
+	}
+	crop, err := parseCrop(*flagCrop, width, height)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	var meta app.RecordingMeta
+	if _, err := input.ReadRecordingMeta(bytes.NewReader(data), &meta); err != nil {
+		return fmt.Errorf("replay %s: %w", flag.Arg(0), err)
+	}
+	if meta.Rand == nil {
+		return fmt.Errorf("replay %s: no random state header, record it with a newer build", flag.Arg(0))
+	}
+	events, err := input.ReadRecording(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("replay %s: %w", flag.Arg(0), err)
+	}
+
+	f, err := os.Create(*flagOut)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var out exporter
+	if ext == ".gif" {
+		out = newGIFExporter(f, *flagSpeed)
+	} else if out, err = newCastExporter(f, crop.Dx(), crop.Dy(), *flagSpeed); err != nil {
+		return err
+	}
+
+	runner := &bot.Runner{
+		Width:       width,
+		Height:      height,
+		Duration:    *flagDuration,
+		GameConfig:  *flagGame,
+		ContentPath: *flagContent,
+	}
+	frames := 0
+	err = runner.Replay(*meta.Rand, events, time.Second/time.Duration(*flagFPS), func(at time.Duration, shot render.Screenshot) error {
+		frames++
+		return out.frame(at, shot.Crop(crop.Min.X, crop.Min.Y, crop.Dx(), crop.Dy()))
+	})
+	if err != nil {
+		return err
+	}
+	if err := out.close(); err != nil {
+		return fmt.Errorf("output %s: %w", *flagOut, err)
+	}
+	fmt.Printf("%s: %d frames, %d events\n", *flagOut, frames, len(events))
+	return f.Close()
+}
+
+// parseCrop reads "x,y,w,h", empty selects the whole screen
+func parseCrop(s string, width, height int) (image.Rectangle, error) {
+	screen := image.Rect(0, 0, width, height)
+	if s == "" {
+		return screen, nil
+	}
+	var x, y, w, h int
+	if _, err := fmt.Sscanf(s, "%d,%d,%d,%d", &x, &y, &w, &h); err != nil || w <= 0 || h <= 0 {
+		return image.Rectangle{}, fmt.Errorf("crop %q: want x,y,w,h", s)
+	}
+	crop := image.Rect(x, y, x+w, y+h)
+	if !crop.In(screen) {
+		return image.Rectangle{}, fmt.Errorf("crop %q: outside the %dx%d screen", s, width, height)
+	}
+	return crop, nil
+}
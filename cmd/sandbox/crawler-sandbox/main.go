@@ -1,40 +1,22 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"math"
+	"os"
 	"time"
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset/sprite"
+	"github.com/lixenwraith/vi-fighter/engine"
 )
 
-// Frame holds per-cell visual data for one animation frame
-// All string fields must be exactly Width bytes per row, Height rows
-// Palette index encoding: '0'-'9','a'-'f' → 0-15; ' ' → skip (keep existing)
-type Frame struct {
-	Art  []string // character grid
-	Fg   []string // fg palette index per byte position
-	Bg   []string // bg palette index per byte position
-	Attr []string // 'B'=bold, 'D'=dim, ' '=none
-}
-
-// EnemyTemplate defines a species with per-cell palette-driven visuals
-type EnemyTemplate struct {
-	Name          string
-	Width, Height int
-	FgPalette     []color.RGB
-	BgPalette     []color.RGB
-	AuraColor     color.RGB
-	AuraRadius    float64
-	AuraPulseFreq float64 // Hz
-	TicksPerFrame int     // base ticks per frame change
-	Frames        []Frame
-}
-
 // Enemy represents a placed instance
 type Enemy struct {
 	X, Y       int
-	Template   *EnemyTemplate
+	Template   *sprite.Template
 	AnimOffset int
 	Phase      float64 // aura pulse phase offset (radians)
 }
@@ -78,266 +60,24 @@ func addRGB(a, b color.RGB) color.RGB {
 	return color.RGB{R: uint8(r), G: uint8(g), B: uint8(bl)}
 }
 
-func paletteIdx(b byte) int {
-	if b >= '0' && b <= '9' {
-		return int(b - '0')
-	}
-	if b >= 'a' && b <= 'f' {
-		return int(b - 'a' + 10)
-	}
-	return -1
-}
-
 // --- Bestiary ---
 
-var bestiary = []EnemyTemplate{
-	// ================================================================
-	// INFERNAL — 6x3, 5 frames, fast flicker
-	// Fg: 0=LemonYellow 1=FlameOrange 2=BrightRed 3=White 4=Amber 5=DarkCrimson 6=Vermilion
-	// Bg: 0=DarkAmber 1=BlackRed 2=Red
-	// ================================================================
-	{
-		Name: "INFERNAL", Width: 6, Height: 3,
-		FgPalette: []color.RGB{
-			color.LemonYellow, color.FlameOrange, color.BrightRed,
-			color.White, color.Amber, color.DarkCrimson, color.Vermilion,
-		},
-		BgPalette: []color.RGB{
-			color.DarkAmber, color.BlackRed, color.Red,
-		},
-		AuraColor: color.FlameOrange, AuraRadius: 3.0, AuraPulseFreq: 1.5,
-		TicksPerFrame: 2,
-		Frames: []Frame{
-			{
-				Art:  []string{`,*##*,`, `<[@@]>`, ` /||\ `},
-				Fg:   []string{`012210`, `412234`, ` 5555 `},
-				Bg:   []string{` 0110 `, ` 1221 `, `      `},
-				Attr: []string{` BBBB `, `BBBBBB`, `      `},
-			},
-			{
-				Art:  []string{`'*##*'`, `>[@@]<`, ` \||/ `},
-				Fg:   []string{`012210`, `412234`, ` 5555 `},
-				Bg:   []string{` 0110 `, ` 1221 `, `      `},
-				Attr: []string{` BBBB `, `BBBBBB`, `      `},
-			},
-			{
-				Art:  []string{`~*##*~`, `<{@@}>`, ` |/\| `},
-				Fg:   []string{`112211`, `412234`, ` 5555 `},
-				Bg:   []string{`001100`, ` 1221 `, `      `},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `      `},
-			},
-			{
-				Art:  []string{`;*##*;`, `>{@@}<`, ` /||\ `},
-				Fg:   []string{`612216`, `412234`, ` 5555 `},
-				Bg:   []string{` 0110 `, ` 1221 `, `      `},
-				Attr: []string{` BBBB `, `BBBBBB`, `      `},
-			},
-			{
-				Art:  []string{`.*##*.`, `<[@@]>`, ` \  / `},
-				Fg:   []string{`112211`, `412234`, ` 5  5 `},
-				Bg:   []string{`001100`, ` 1221 `, `  11  `},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `      `},
-			},
-		},
-	},
-
-	// ================================================================
-	// WRAITH — 5x3, 4 frames, slow phase-shift
-	// Fg: 0=PaleLavender 1=ElectricViolet 2=DarkViolet 3=SoftLavender 4=DeepPurple
-	// Bg: 0=Obsidian 1=DeepPurple
-	// ================================================================
-	{
-		Name: "WRAITH", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.PaleLavender, color.ElectricViolet, color.DarkViolet,
-			color.SoftLavender, color.DeepPurple,
-		},
-		BgPalette: []color.RGB{
-			color.Obsidian, color.DeepPurple,
-		},
-		AuraColor: color.DeepPurple, AuraRadius: 2.5, AuraPulseFreq: 0.6,
-		TicksPerFrame: 3,
-		Frames: []Frame{
-			{
-				Art:  []string{` .@. `, `(   )`, ` |~| `},
-				Fg:   []string{` 010 `, `2   2`, ` 414 `},
-				Bg:   []string{` 010 `, `00000`, `     `},
-				Attr: []string{` DBD `, `D   D`, ` DBD `},
-			},
-			{
-				Art:  []string{` :@: `, `{   }`, ` }~{ `},
-				Fg:   []string{` 310 `, `2   2`, ` 212 `},
-				Bg:   []string{` 010 `, `00100`, `     `},
-				Attr: []string{` DBD `, `D   D`, ` DBD `},
-			},
-			{
-				Art:  []string{` '@' `, `[   ]`, ` |~| `},
-				Fg:   []string{` 310 `, `2   2`, ` 414 `},
-				Bg:   []string{` 110 `, `00000`, `     `},
-				Attr: []string{`DBBBD`, `D   D`, ` DBD `},
-			},
-			{
-				Art:  []string{` ;@; `, `<   >`, ` {~} `},
-				Fg:   []string{` 010 `, `2   2`, ` 212 `},
-				Bg:   []string{` 010 `, `01110`, `     `},
-				Attr: []string{` DBD `, `D   D`, ` DBD `},
-			},
-		},
-	},
-
-	// ================================================================
-	// ARCANE EYE — 5x3, 5 frames, deliberate blink cycle
-	// Fg: 0=CeruleanBlue 1=SteelBlue 2=White 3=BrightCyan 4=LightSkyBlue
-	//     5=CobaltBlue 6=DodgerBlue 7=SkyTeal
-	// Bg: 0=DeepNavy 1=CobaltBlue 2=DodgerBlue
-	// ================================================================
-	{
-		Name: "ARCANE EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.CeruleanBlue, color.SteelBlue, color.White,
-			color.BrightCyan, color.LightSkyBlue, color.CobaltBlue,
-			color.DodgerBlue, color.SkyTeal,
-		},
-		BgPalette: []color.RGB{
-			color.DeepNavy, color.CobaltBlue, color.DodgerBlue,
-		},
-		AuraColor: color.CobaltBlue, AuraRadius: 2.5, AuraPulseFreq: 0.8,
-		TicksPerFrame: 4,
-		Frames: []Frame{
-			// Wide open
-			{
-				Art:  []string{`/---\`, `|(O)|`, `\---/`},
-				Fg:   []string{`01110`, `53325`, `01110`},
-				Bg:   []string{`00000`, `01210`, `00000`},
-				Attr: []string{` BBB `, `BBBBB`, ` BBB `},
-			},
-			// Open
-			{
-				Art:  []string{`/---\`, `|(o)|`, `\---/`},
-				Fg:   []string{`01110`, `53425`, `01110`},
-				Bg:   []string{`00000`, `01110`, `00000`},
-				Attr: []string{` BBB `, `BBBBB`, ` BBB `},
-			},
-			// Narrow slit
-			{
-				Art:  []string{`/---\`, `|(=)|`, `\---/`},
-				Fg:   []string{`01110`, `53725`, `01110`},
-				Bg:   []string{`00000`, `01110`, `00000`},
-				Attr: []string{` BBB `, `BB BB`, ` BBB `},
-			},
-			// Open, glow surge
-			{
-				Art:  []string{`/~~~\`, `|(O)|`, `\~~~/`},
-				Fg:   []string{`06660`, `53325`, `06660`},
-				Bg:   []string{`00000`, `01210`, `00000`},
-				Attr: []string{`BBBBB`, `BBBBB`, `BBBBB`},
-			},
-			// Blink shut
-			{
-				Art:  []string{`/---\`, `|===|`, `\---/`},
-				Fg:   []string{`01110`, `51115`, `01110`},
-				Bg:   []string{`00000`, `00000`, `00000`},
-				Attr: []string{` BBB `, `     `, ` BBB `},
-			},
-		},
-	},
-
-	// ================================================================
-	// VENOM QUEEN — 6x3, 4 frames, toxic drip
-	// Fg: 0=NeonGreen 1=YellowGreen 2=BrightGreen 3=White 4=DarkGreen 5=Lime
-	// Bg: 0=BlackGreen 1=DarkGreen
-	// ================================================================
-	{
-		Name: "VENOM QUEEN", Width: 6, Height: 3,
-		FgPalette: []color.RGB{
-			color.NeonGreen, color.YellowGreen, color.BrightGreen,
-			color.White, color.DarkGreen, color.Lime,
-		},
-		BgPalette: []color.RGB{
-			color.BlackGreen, color.DarkGreen,
-		},
-		AuraColor: color.NeonGreen, AuraRadius: 2.0, AuraPulseFreq: 1.0,
-		TicksPerFrame: 3,
-		Frames: []Frame{
-			{
-				Art:  []string{`/~oo~\`, `{+##+}`, ` \,,/ `},
-				Fg:   []string{`002200`, `121121`, ` 0550 `},
-				Bg:   []string{` 0110 `, `001100`, `      `},
-				Attr: []string{`DBBBD `, `BBBBBB`, ` DDDD `},
-			},
-			{
-				Art:  []string{`\~oo~/`, `<+##+>`, `  ,,  `},
-				Fg:   []string{`002200`, `121121`, `  55  `},
-				Bg:   []string{` 0110 `, `001100`, `  00  `},
-				Attr: []string{`DBBBD `, `BBBBBB`, `  DD  `},
-			},
-			{
-				Art:  []string{`|~oo~|`, `{+##+}`, ` |,,| `},
-				Fg:   []string{`102201`, `121121`, ` 0550 `},
-				Bg:   []string{`00110 `, `001100`, `      `},
-				Attr: []string{` BBBB `, `BBBBBB`, ` DDDD `},
-			},
-			{
-				Art:  []string{`/~oo~\`, `>+##+<`, ` \,,/ `},
-				Fg:   []string{`002200`, `521125`, ` 0550 `},
-				Bg:   []string{` 0110 `, `101101`, `      `},
-				Attr: []string{`DBBBD `, `BBBBBB`, ` DDDD `},
-			},
-		},
-	},
-
-	// ================================================================
-	// IRON REAVER — 6x3, 4 frames, mechanical chop
-	// Fg: 0=Silver 1=CoolSilver 2=IronGray 3=BrightRed 4=White 5=DarkGray
-	// Bg: 0=DarkSlate 1=BlackRed 2=Gunmetal
-	// ================================================================
-	{
-		Name: "IRON REAVER", Width: 6, Height: 3,
-		FgPalette: []color.RGB{
-			color.Silver, color.CoolSilver, color.IronGray,
-			color.BrightRed, color.White, color.DarkGray,
-		},
-		BgPalette: []color.RGB{
-			color.DarkSlate, color.BlackRed, color.Gunmetal,
-		},
-		AuraColor: color.IronGray, AuraRadius: 1.5, AuraPulseFreq: 2.0,
-		TicksPerFrame: 2,
-		Frames: []Frame{
-			// Blades open
-			{
-				Art:  []string{`\-@@-/`, `|[##]|`, `/-  -\`},
-				Fg:   []string{`023320`, `213312`, `02  20`},
-				Bg:   []string{`201102`, `001100`, `20  02`},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `BB  BB`},
-			},
-			// Blades swap
-			{
-				Art:  []string{`/-@@-\`, `|{##}|`, `\-  -/`},
-				Fg:   []string{`023320`, `213312`, `02  20`},
-				Bg:   []string{`201102`, `001100`, `20  02`},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `BB  BB`},
-			},
-			// Blades straight, core pulse
-			{
-				Art:  []string{`|-@@-|`, `|[##]|`, `|-  -|`},
-				Fg:   []string{`223322`, `243342`, `22  22`},
-				Bg:   []string{`001100`, `011110`, `00  00`},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `BB  BB`},
-			},
-			// Strike pose
-			{
-				Art:  []string{`\=@@=\`, `>[##]<`, `/=  =/`},
-				Fg:   []string{`043340`, `413314`, `04  40`},
-				Bg:   []string{`201102`, `101101`, `20  02`},
-				Attr: []string{`BBBBBB`, `BBBBBB`, `BB  BB`},
-			},
-		},
-	},
-}
+var bestiary []sprite.Template
 
 var enemies []Enemy
 
+var flagSprites = flag.String("sprites", "", "Load bestiary from a .sprite file instead of the embedded default")
+
 func main() {
+	flag.Parse()
+
+	var err error
+	bestiary, err = sprite.LoadOrDefault(*flagSprites, "crawler.sprite")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	term := terminal.New()
 	if err := term.Init(); err != nil {
 		panic(err)
@@ -347,12 +87,13 @@ func main() {
 	w, h := term.Size()
 	layoutEnemies(w, h)
 
-	ticker := time.NewTicker(150 * time.Millisecond)
-	defer ticker.Stop()
-
+	// Animation ticks are posted as KeyNone events, paced by the engine loop
 	go func() {
-		for range ticker.C {
-			term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
+		loop := engine.NewLoop(150*time.Millisecond, 150*time.Millisecond)
+		for {
+			for range loop.Updates(loop.Wait()) {
+				term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
+			}
 		}
 	}()
 
@@ -542,7 +283,7 @@ func renderSprite(cells []terminal.Cell, w, h int, e *Enemy, tick int) {
 
 			// Bg: always check, even for space chars (allows invisible-fg + visible-bg)
 			if y < len(frame.Bg) && x < len(frame.Bg[y]) {
-				pi := paletteIdx(frame.Bg[y][x])
+				pi := sprite.PaletteIndex(frame.Bg[y][x])
 				if pi >= 0 && pi < len(t.BgPalette) {
 					cells[idx].Bg = t.BgPalette[pi]
 				}
@@ -556,7 +297,7 @@ func renderSprite(cells []terminal.Cell, w, h int, e *Enemy, tick int) {
 
 			// Fg
 			if y < len(frame.Fg) && x < len(frame.Fg[y]) {
-				pi := paletteIdx(frame.Fg[y][x])
+				pi := sprite.PaletteIndex(frame.Fg[y][x])
 				if pi >= 0 && pi < len(t.FgPalette) {
 					cells[idx].Fg = t.FgPalette[pi]
 				}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
 )
 
 const aspectRatio = 2.1
@@ -523,13 +524,11 @@ func main() {
 	selected := 0
 
 	inputCh := startInputReader(term)
-	lastFrame := time.Now()
+	loop := engine.NewLoop(0, 16*time.Millisecond)
 	running := true
 
 	for running {
-		frameStart := time.Now()
-		dt := frameStart.Sub(lastFrame).Seconds()
-		lastFrame = frameStart
+		dt := loop.Wait().Seconds()
 
 	drainInput:
 		for {
@@ -620,11 +619,6 @@ func main() {
 		renderHUD(cells, w, h, ember, controls, selected)
 
 		term.Flush(cells, w, h)
-
-		elapsed := time.Since(frameStart)
-		if elapsed < 16*time.Millisecond {
-			time.Sleep(16*time.Millisecond - elapsed)
-		}
 	}
 
 	term.Fini()
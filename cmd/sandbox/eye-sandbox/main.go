@@ -1,54 +1,27 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"math"
+	"os"
 	"time"
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset/sprite"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/render"
 )
 
-// Frame holds per-cell visual data for one animation frame
-// Palette index encoding: '0'-'9','a'-'f' → 0-15; ' ' → skip
-type Frame struct {
-	Art  []string
-	Fg   []string
-	Bg   []string
-	Attr []string
-}
-
 type borderCell struct{ x, y int }
 
-type EnemyTemplate struct {
-	Name          string
-	Width, Height int
-	FgPalette     []color.RGB
-	BgPalette     []color.RGB
-
-	// Radial aura
-	AuraColor      color.RGB
-	AuraRadius     float64
-	AuraPulseFreq  float64 // Hz
-	AuraRotSpeed   float64 // Hz, 0 = static omnidirectional
-	AuraFocusWidth float64 // 0.1 = tight beam, 1.0 = gentle spread
-
-	// Programmatic border rotation
-	BorderRotSpeed  float64 // Hz, 0 = off
-	BorderHighlight color.RGB
-	BorderWidth     int // highlight width in perimeter cells
-
-	TicksPerFrame int
-	Frames        []Frame
-
-	// Computed at init
-	borderPerim []borderCell
-}
-
 type Enemy struct {
 	X, Y       int
-	Template   *EnemyTemplate
+	Template   *sprite.Template
 	AnimOffset int
 	Phase      float64
+	Perim      []borderCell // border rotation path, nil when rotation is off
 }
 
 var startTime = time.Now()
@@ -86,16 +59,6 @@ func addRGB(a, b color.RGB) color.RGB {
 	return color.RGB{R: uint8(r), G: uint8(g), B: uint8(bl)}
 }
 
-func paletteIdx(b byte) int {
-	if b >= '0' && b <= '9' {
-		return int(b - '0')
-	}
-	if b >= 'a' && b <= 'f' {
-		return int(b-'a') + 10
-	}
-	return -1
-}
-
 func computePerimeter(w, h int) []borderCell {
 	cells := make([]borderCell, 0, 2*w+2*(h-2))
 	for x := range w {
@@ -115,354 +78,30 @@ func computePerimeter(w, h int) []borderCell {
 
 // --- Bestiary ---
 
-var bestiary = []EnemyTemplate{
-
-	// ================================================================
-	// VOID EYE — 5x3, slow contemplative blink
-	// Deep ocean abyss. Slow rotating aura suggests submerged current
-	// Edge: [---] solid opaque box
-	// Fg: 0=DimGray 1=SteelBlue 2=White 3=CeruleanBlue 4=NavyBlue
-	//     5=LightSkyBlue 6=CobaltBlue 7=DodgerBlue
-	// Bg: 0=DeepNavy 1=Gunmetal 2=CobaltBlue
-	// ================================================================
-	{
-		Name: "VOID EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.DimGray, color.SteelBlue, color.White,
-			color.CeruleanBlue, color.NavyBlue, color.LightSkyBlue,
-			color.CobaltBlue, color.DodgerBlue,
-		},
-		BgPalette: []color.RGB{
-			color.DeepNavy, color.Gunmetal, color.CobaltBlue,
-		},
-		AuraColor: color.CobaltBlue, AuraRadius: 2.5, AuraPulseFreq: 0.5,
-		AuraRotSpeed: 0.15, AuraFocusWidth: 0.7,
-		TicksPerFrame: 4,
-		Frames: []Frame{
-			{ // Wide open — bright pupil, deep iris bg
-				Art:  []string{"[---]", "|(O)|", "[---]"},
-				Fg:   []string{"01110", "43234", "01110"},
-				Bg:   []string{"00000", "01210", "00000"},
-				Attr: []string{" BBB ", " BBB ", " BBB "},
-			},
-			{ // Open — dimmer pupil
-				Art:  []string{"[---]", "|(o)|", "[---]"},
-				Fg:   []string{"01110", "43534", "01110"},
-				Bg:   []string{"00000", "01110", "00000"},
-				Attr: []string{" BBB ", " BBB ", " BBB "},
-			},
-			{ // Narrow slit
-				Art:  []string{"[===]", "|(=)|", "[===]"},
-				Fg:   []string{"06660", "43634", "06660"},
-				Bg:   []string{"00000", "01110", "00000"},
-				Attr: []string{" BBB ", "  B  ", " BBB "},
-			},
-			{ // Glow surge — border activates
-				Art:  []string{"[~~~]", "|(O)|", "[~~~]"},
-				Fg:   []string{"07770", "43234", "07770"},
-				Bg:   []string{"00100", "01210", "00100"},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-			{ // Shut
-				Art:  []string{"[---]", "|===|", "[---]"},
-				Fg:   []string{"01110", "46664", "01110"},
-				Bg:   []string{"00000", "00000", "00000"},
-				Attr: []string{" BBB ", "     ", " BBB "},
-			},
-		},
-	},
-
-	// ================================================================
-	// FLAME EYE — 5x3, aggressive flicker
-	// Edge: # corners, thick hot border
-	// Fg: 0=LemonYellow 1=FlameOrange 2=White 3=BrightRed 4=Amber
-	//     5=DarkCrimson 6=Vermilion 7=WarmOrange
-	// Bg: 0=BlackRed 1=DarkAmber 2=Red
-	// ================================================================
-	{
-		Name: "FLAME EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.LemonYellow, color.FlameOrange, color.White,
-			color.BrightRed, color.Amber, color.DarkCrimson,
-			color.Vermilion, color.WarmOrange,
-		},
-		BgPalette: []color.RGB{
-			color.BlackRed, color.DarkAmber, color.Red,
-		},
-		AuraColor: color.FlameOrange, AuraRadius: 2.5, AuraPulseFreq: 2.0,
-		TicksPerFrame: 2,
-		Frames: []Frame{
-			{ // Base
-				Art:  []string{"#---#", "|<@>|", "#---#"},
-				Fg:   []string{"51115", "54245", "51115"},
-				Bg:   []string{"00000", "01210", "00000"},
-				Attr: []string{"B   B", " BBB ", "B   B"},
-			},
-			{ // Flare — star pupil, border hash pattern
-				Art:  []string{"#-#-#", "|{*}|", "#-#-#"},
-				Fg:   []string{"51615", "57275", "51615"},
-				Bg:   []string{"01010", "01210", "01010"},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-			{ // Dim — small pupil
-				Art:  []string{"#---#", "|<o>|", "#---#"},
-				Fg:   []string{"51115", "54745", "51115"},
-				Bg:   []string{"00000", "01110", "00000"},
-				Attr: []string{"B   B", " BBB ", "B   B"},
-			},
-			{ // Bright — full glow
-				Art:  []string{"#===#", "|<O>|", "#===#"},
-				Fg:   []string{"50005", "54245", "50005"},
-				Bg:   []string{"01110", "01210", "01110"},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-		},
-	},
-
-	// ================================================================
-	// FROST EYE — 5x3, crystalline pulse
-	// Edge: * decorative corners, icy
-	// Fg: 0=BrightCyan 1=White 2=LightSkyBlue 3=CeruleanBlue
-	//     4=SteelBlue 5=CoolSilver 6=AliceBlue 7=PaleCyan
-	// Bg: 0=DeepNavy 1=CobaltBlue 2=SteelBlue
-	// ================================================================
-	{
-		Name: "FROST EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.BrightCyan, color.White, color.LightSkyBlue,
-			color.CeruleanBlue, color.SteelBlue, color.CoolSilver,
-			color.AliceBlue, color.PaleCyan,
-		},
-		BgPalette: []color.RGB{
-			color.DeepNavy, color.CobaltBlue, color.SteelBlue,
-		},
-		AuraColor: color.BrightCyan, AuraRadius: 2.5, AuraPulseFreq: 0.4,
-		AuraRotSpeed: 0.2, AuraFocusWidth: 0.4,
-		TicksPerFrame: 4,
-		Frames: []Frame{
-			{ // Open — diamond pupil
-				Art:  []string{"*---*", "|<O>|", "*---*"},
-				Fg:   []string{"43334", "30103", "43334"},
-				Bg:   []string{"00000", "01210", "00000"},
-				Attr: []string{"B   B", " BBB ", "B   B"},
-			},
-			{ // Crystal shift — border sparkle
-				Art:  []string{"*-+-*", "|(O)|", "*-+-*"},
-				Fg:   []string{"43134", "30103", "43134"},
-				Bg:   []string{"00100", "01210", "00100"},
-				Attr: []string{"BBBBB", " BBB ", "BBBBB"},
-			},
-			{ // Narrow — slit
-				Art:  []string{"*---*", "|{=}|", "*---*"},
-				Fg:   []string{"43334", "30534", "43334"},
-				Bg:   []string{"00000", "01110", "00000"},
-				Attr: []string{"B   B", "  B  ", "B   B"},
-			},
-			{ // Surge — full ice bloom
-				Art:  []string{"*~+~*", "|(O)|", "*~+~*"},
-				Fg:   []string{"40104", "30103", "40104"},
-				Bg:   []string{"01210", "01210", "01210"},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-		},
-	},
-
-	// ================================================================
-	// STORM EYE — 6x3, electric, programmatic rotating border
-	// Edge: + corners with rotating bg highlight
-	// Fg: 0=BrightCyan 1=CeruleanBlue 2=White 3=LemonYellow
-	//     4=SteelBlue 5=DodgerBlue 6=SkyTeal 7=LightSkyBlue
-	// Bg: 0=DeepNavy 1=CobaltBlue
-	// ================================================================
-	{
-		Name: "STORM EYE", Width: 6, Height: 3,
-		FgPalette: []color.RGB{
-			color.BrightCyan, color.CeruleanBlue, color.White,
-			color.LemonYellow, color.SteelBlue, color.DodgerBlue,
-			color.SkyTeal, color.LightSkyBlue,
-		},
-		BgPalette: []color.RGB{
-			color.DeepNavy, color.CobaltBlue,
-		},
-		AuraColor: color.BrightCyan, AuraRadius: 3.0, AuraPulseFreq: 1.2,
-		AuraRotSpeed: 0.8, AuraFocusWidth: 0.5,
-		BorderRotSpeed: 1.0, BorderHighlight: color.BrightCyan, BorderWidth: 3,
-		TicksPerFrame: 4,
-		Frames: []Frame{
-			{ // Wide open
-				Art:  []string{"+~~~~+", "|(OO)|", "+~~~~+"},
-				Fg:   []string{"400004", "412214", "400004"},
-				Bg:   []string{"000000", "011110", "000000"},
-				Attr: []string{"BBBBBB", " BBBB ", "BBBBBB"},
-			},
-			{ // Narrow
-				Art:  []string{"+~~~~+", "|(==)|", "+~~~~+"},
-				Fg:   []string{"400004", "416614", "400004"},
-				Bg:   []string{"000000", "011110", "000000"},
-				Attr: []string{"BBBBBB", " B  B ", "BBBBBB"},
-			},
-			{ // Surge — interior flash
-				Art:  []string{"+~~~~+", "|{OO}|", "+~~~~+"},
-				Fg:   []string{"430034", "432234", "430034"},
-				Bg:   []string{"001100", "011110", "001100"},
-				Attr: []string{"BBBBBB", "BBBBBB", "BBBBBB"},
-			},
-		},
-	},
-
-	// ================================================================
-	// BLOOD EYE — 5x3, veined, pulsing
-	// Edge: > < pointed sides
-	// Fg: 0=DarkCrimson 1=BrightRed 2=White 3=Vermilion
-	//     4=Coral 5=Red 6=Salmon 7=LightCoral
-	// Bg: 0=BlackRed 1=DarkCrimson 2=Red
-	// ================================================================
-	{
-		Name: "BLOOD EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.DarkCrimson, color.BrightRed, color.White,
-			color.Vermilion, color.Coral, color.Red,
-			color.Salmon, color.LightCoral,
-		},
-		BgPalette: []color.RGB{
-			color.BlackRed, color.DarkCrimson, color.Red,
-		},
-		AuraColor: color.DarkCrimson, AuraRadius: 2.0, AuraPulseFreq: 1.2,
-		TicksPerFrame: 3,
-		Frames: []Frame{
-			{ // Base — steady gaze
-				Art:  []string{">---<", "|(X)|", ">---<"},
-				Fg:   []string{"31113", "05250", "31113"},
-				Bg:   []string{"00000", "01210", "00000"},
-				Attr: []string{"B   B", " BBB ", "B   B"},
-			},
-			{ // Vein pulse — border throbs
-				Art:  []string{">===<", "|(X)|", ">===<"},
-				Fg:   []string{"35553", "05250", "35553"},
-				Bg:   []string{"01110", "01210", "01110"},
-				Attr: []string{"BBBBB", " BBB ", "BBBBB"},
-			},
-			{ // Slit — angry narrow
-				Art:  []string{">---<", "|-X-|", ">---<"},
-				Fg:   []string{"31113", "05250", "31113"},
-				Bg:   []string{"00000", "01110", "00000"},
-				Attr: []string{"B   B", "  B  ", "B   B"},
-			},
-			{ // Full dilate — threat display
-				Art:  []string{">-#-<", "|(O)|", ">-#-<"},
-				Fg:   []string{"31513", "04240", "31513"},
-				Bg:   []string{"00100", "01210", "00100"},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-		},
-	},
-
-	// ================================================================
-	// GOLDEN EYE — 6x3, warm amber, programmatic rotating border
-	// Edge: | sides, = top/bottom. Slow warm rotation
-	// Fg: 0=Gold 1=Amber 2=White 3=LemonYellow 4=DarkGold
-	//     5=PaleGold 6=Buttercream 7=WarmOrange
-	// Bg: 0=DarkAmber 1=Amber 2=Gold
-	// ================================================================
-	{
-		Name: "GOLDEN EYE", Width: 6, Height: 3,
-		FgPalette: []color.RGB{
-			color.Gold, color.Amber, color.White,
-			color.LemonYellow, color.DarkGold, color.PaleGold,
-			color.Buttercream, color.WarmOrange,
-		},
-		BgPalette: []color.RGB{
-			color.DarkAmber, color.Amber, color.Gold,
-		},
-		AuraColor: color.Amber, AuraRadius: 2.5, AuraPulseFreq: 0.6,
-		AuraRotSpeed: -0.3, AuraFocusWidth: 0.6,
-		BorderRotSpeed: 0.4, BorderHighlight: color.Gold, BorderWidth: 3,
-		TicksPerFrame: 5,
-		Frames: []Frame{
-			{ // Regal open
-				Art:  []string{"|====|", "|(OO)|", "|====|"},
-				Fg:   []string{"400004", "412214", "400004"},
-				Bg:   []string{"000000", "011110", "000000"},
-				Attr: []string{"BBBBBB", " BBBB ", "BBBBBB"},
-			},
-			{ // Shimmer — center hash
-				Art:  []string{"|=##=|", "|{OO}|", "|=##=|"},
-				Fg:   []string{"403304", "712217", "403304"},
-				Bg:   []string{"001100", "011110", "001100"},
-				Attr: []string{"BBBBBB", "BBBBBB", "BBBBBB"},
-			},
-			{ // Narrow
-				Art:  []string{"|====|", "|(==)|", "|====|"},
-				Fg:   []string{"400004", "415514", "400004"},
-				Bg:   []string{"000000", "011110", "000000"},
-				Attr: []string{"BBBBBB", " B  B ", "BBBBBB"},
-			},
-			{ // Crown — full glow
-				Art:  []string{"|~##~|", "|(OO)|", "|~##~|"},
-				Fg:   []string{"433334", "412214", "433334"},
-				Bg:   []string{"012210", "012210", "012210"},
-				Attr: []string{"BBBBBB", "BBBBBB", "BBBBBB"},
-			},
-		},
-	},
-
-	// ================================================================
-	// ABYSS EYE — 5x3, dimensional rift, transparent corners
-	// Edge: . ' corners (NO bg), - | sides (with bg)
-	// Corner cells show aura through, creating bleed effect
-	// Fg: 0=PaleLavender 1=ElectricViolet 2=White 3=SoftLavender
-	//     4=DarkViolet 5=MutedPurple 6=DeepPurple 7=Orchid
-	// Bg: 0=Obsidian 1=DeepPurple
-	// ================================================================
-	{
-		Name: "ABYSS EYE", Width: 5, Height: 3,
-		FgPalette: []color.RGB{
-			color.PaleLavender, color.ElectricViolet, color.White,
-			color.SoftLavender, color.DarkViolet, color.MutedPurple,
-			color.DeepPurple, color.Orchid,
-		},
-		BgPalette: []color.RGB{
-			color.Obsidian, color.DeepPurple,
-		},
-		AuraColor: color.DeepPurple, AuraRadius: 3.0, AuraPulseFreq: 0.5,
-		AuraRotSpeed: 0.25, AuraFocusWidth: 0.3,
-		TicksPerFrame: 4,
-		Frames: []Frame{
-			{ // Open — rift visible
-				Art:  []string{".---.", "|(O)|", "'---'"},
-				Fg:   []string{"64446", "41214", "64446"},
-				Bg:   []string{" 000 ", "01110", " 000 "},
-				Attr: []string{" BBB ", " BBB ", " BBB "},
-			},
-			{ // Shift — bracket iris
-				Art:  []string{".---.", "|{O}|", "'---'"},
-				Fg:   []string{"64446", "47274", "64446"},
-				Bg:   []string{" 000 ", "01110", " 000 "},
-				Attr: []string{" BBB ", " BBB ", " BBB "},
-			},
-			{ // Phase — border flickers dim
-				Art:  []string{".~~~.", "|[O]|", "'~~~'"},
-				Fg:   []string{"65556", "41214", "65556"},
-				Bg:   []string{" 111 ", "01110", " 111 "},
-				Attr: []string{"DBBBD", " BBB ", "DBBBD"},
-			},
-			{ // Rift surge — core flares
-				Art:  []string{".~~~.", "|(O)|", "'~~~'"},
-				Fg:   []string{"61116", "41214", "61116"},
-				Bg:   []string{" 111 ", "01110", " 111 "},
-				Attr: []string{"BBBBB", "BBBBB", "BBBBB"},
-			},
-		},
-	},
-}
+var bestiary []sprite.Template
 
 var enemies []Enemy
 
+var flagSprites = flag.String("sprites", "", "Load bestiary from a .sprite file instead of the embedded default")
+var flagShot = flag.String("shot", "", "Save the last frame on exit as a .png or .svg screenshot")
+var flagCast = flag.String("cast", "", "Record the screen to an asciinema v2 cast file")
+
+// saveShot writes the last flushed frame
+func saveShot(capture *render.CaptureTerminal, path string) {
+	if err := capture.Screenshot().Save(path); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// borderPerims holds precomputed border rotation paths, indexed as bestiary
+var borderPerims [][]borderCell
+
 func initBestiary() {
+	borderPerims = make([][]borderCell, len(bestiary))
 	for i := range bestiary {
 		t := &bestiary[i]
 		if t.BorderRotSpeed != 0 {
-			t.borderPerim = computePerimeter(t.Width, t.Height)
+			borderPerims[i] = computePerimeter(t.Width, t.Height)
 		}
 		if t.BorderWidth == 0 {
 			t.BorderWidth = 2
@@ -471,23 +110,56 @@ func initBestiary() {
 }
 
 func main() {
+	flag.Parse()
+
+	var err error
+	bestiary, err = sprite.LoadOrDefault(*flagSprites, "eye.sprite")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	initBestiary()
 
-	term := terminal.New()
-	if err := term.Init(); err != nil {
+	base := terminal.New()
+	if err := base.Init(); err != nil {
 		panic(err)
 	}
+	term := terminal.Terminal(base)
+	if *flagShot != "" {
+		capture := render.NewCaptureTerminal(base)
+		term = capture
+		defer saveShot(capture, *flagShot) // Runs after Fini so errors reach the shell
+	}
+	if *flagCast != "" {
+		f, err := os.Create(*flagCast)
+		if err != nil {
+			base.Fini()
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		cast, err := render.NewCastTerminal(term, f)
+		if err != nil {
+			base.Fini()
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		term = cast
+		defer cast.Close()
+	}
 	defer term.Fini()
 
 	w, h := term.Size()
 	layoutEnemies(w, h)
 
-	ticker := time.NewTicker(150 * time.Millisecond)
-	defer ticker.Stop()
-
+	// Animation ticks are posted as KeyNone events, paced by the engine loop
 	go func() {
-		for range ticker.C {
-			term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
+		loop := engine.NewLoop(150*time.Millisecond, 150*time.Millisecond)
+		for {
+			for range loop.Updates(loop.Wait()) {
+				term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
+			}
 		}
 	}()
 
@@ -542,6 +214,7 @@ func layoutEnemies(w, h int) {
 			Template:   t,
 			AnimOffset: i * 3,
 			Phase:      float64(i) * 1.1,
+			Perim:      borderPerims[i],
 		})
 		currX += t.Width + spacing
 	}
@@ -686,7 +359,7 @@ func renderSprite(cells []terminal.Cell, w, h int, e *Enemy, tick int) {
 
 			// Bg — applied even for space chars (allows bg-only cells)
 			if y < len(frame.Bg) && x < len(frame.Bg[y]) {
-				pi := paletteIdx(frame.Bg[y][x])
+				pi := sprite.PaletteIndex(frame.Bg[y][x])
 				if pi >= 0 && pi < len(t.BgPalette) {
 					cells[idx].Bg = t.BgPalette[pi]
 				}
@@ -700,7 +373,7 @@ func renderSprite(cells []terminal.Cell, w, h int, e *Enemy, tick int) {
 			cells[idx].Rune = ch
 
 			if y < len(frame.Fg) && x < len(frame.Fg[y]) {
-				pi := paletteIdx(frame.Fg[y][x])
+				pi := sprite.PaletteIndex(frame.Fg[y][x])
 				if pi >= 0 && pi < len(t.FgPalette) {
 					cells[idx].Fg = t.FgPalette[pi]
 				}
@@ -721,12 +394,12 @@ func renderSprite(cells []terminal.Cell, w, h int, e *Enemy, tick int) {
 // renderBorderHighlight overlays rotating highlight on perimeter cells
 func renderBorderHighlight(cells []terminal.Cell, w, h int, e *Enemy, now time.Time) {
 	t := e.Template
-	if t.BorderRotSpeed == 0 || len(t.borderPerim) == 0 {
+	if t.BorderRotSpeed == 0 || len(e.Perim) == 0 {
 		return
 	}
 
 	elapsed := now.Sub(startTime).Seconds()
-	n := float64(len(t.borderPerim))
+	n := float64(len(e.Perim))
 
 	// Current position along perimeter (fractional, wrapping)
 	pos := elapsed*math.Abs(t.BorderRotSpeed)*n + e.Phase*n/6.28
@@ -734,7 +407,7 @@ func renderBorderHighlight(cells []terminal.Cell, w, h int, e *Enemy, now time.T
 
 	bw := float64(t.BorderWidth)
 
-	for i, cell := range t.borderPerim {
+	for i, cell := range e.Perim {
 		fi := float64(i)
 
 		// Distance to primary highlight (wrapping)
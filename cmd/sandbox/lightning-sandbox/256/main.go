@@ -9,6 +9,7 @@ import (
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/render"
 )
 
@@ -84,10 +85,11 @@ func main() {
 		}
 	}()
 
-	ticker := time.NewTicker(16 * time.Millisecond)
-	defer ticker.Stop()
+	loop := engine.NewLoop(0, 16*time.Millisecond)
 
-	for now := range ticker.C {
+	for {
+		loop.Wait()
+		now := time.Now()
 		w, h = term.Size()
 		buf.Resize(w, h)
 		buf.Clear()
@@ -5,10 +5,12 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/render"
 )
 
@@ -87,6 +89,9 @@ func main() {
 		{w/2 + 8, h/2 + 6, '@'}, {w/2 + 9, h/2 + 6, '#'}, {w/2 + 10, h/2 + 6, '&'},
 	}
 
+	// CRT filter toggle, written by the input goroutine
+	var crt atomic.Bool
+
 	go func() {
 		for {
 			ev := term.PollEvent()
@@ -94,15 +99,20 @@ func main() {
 				term.Fini()
 				os.Exit(0)
 			}
+			if ev.Type == terminal.EventKey && ev.Rune == 'c' {
+				crt.Store(!crt.Load())
+			}
 		}
 	}()
 
-	ticker := time.NewTicker(16 * time.Millisecond)
-	defer ticker.Stop()
+	loop := engine.NewLoop(0, 16*time.Millisecond)
 
-	for now := range ticker.C {
+	for {
+		loop.Wait()
+		now := time.Now()
 		w, h = term.Size()
 		buf.Clear()
+		buf.SetCRT(crt.Load())
 
 		// Draw background chars
 		for _, bg := range bgChars {
@@ -191,7 +201,7 @@ func main() {
 		}
 
 		// Debug footer
-		debugStr := fmt.Sprintf("Time: %.2fs | Alpha: %.2f | Size: %dx%d | 'q' to exit", elapsed.Seconds(), alpha, w, h)
+		debugStr := fmt.Sprintf("Time: %.2fs | Alpha: %.2f | Size: %dx%d | 'c' CRT | 'q' to exit", elapsed.Seconds(), alpha, w, h)
 		drawText(buf, 2, h-1, debugStr)
 
 		buf.FlushToTerminal(term)
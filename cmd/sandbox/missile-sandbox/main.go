@@ -8,6 +8,8 @@ import (
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/physics"
 	"github.com/lixenwraith/vi-fighter/render"
 	"github.com/lixenwraith/vi-fighter/vmath"
 )
@@ -26,6 +28,9 @@ var (
 	ColorRed    = color.RGB{R: 255, G: 60, B: 60}
 )
 
+// frameStep is the fixed simulation step, also the frame cap
+const frameStep = time.Second / 60
+
 // --- Types ---
 
 type MissileType int
@@ -81,6 +86,14 @@ var (
 	screenWidth  int
 	screenHeight int
 	globalRng    = vmath.NewFastRand(uint64(time.Now().UnixNano()))
+	terrain      *Terrain
+)
+
+// Obstacle response tuning
+var (
+	seekerLookahead = vmath.FromInt(12)
+	seekerAvoid     = vmath.FromInt(400)
+	bounceKeep      = vmath.FromFloat(0.9)
 )
 
 func main() {
@@ -90,9 +103,11 @@ func main() {
 	}
 	defer term.Fini()
 	term.SetCursorVisible(false)
+	term.SetMouseMode(terminal.MouseModeClick | terminal.MouseModeDrag)
 
 	screenWidth, screenHeight = term.Size()
 	buf := render.NewRenderBuffer(terminal.ColorModeTrueColor, screenWidth, screenHeight)
+	terrain = NewTerrain(screenWidth, screenHeight-1)
 
 	missiles := make([]*Missile, 0)
 	targets := make([]core.Point, 3)
@@ -110,14 +125,26 @@ func main() {
 	}()
 
 	resizeCh := term.ResizeChan()
-	ticker := time.NewTicker(time.Second / 60)
-	defer ticker.Stop()
+	loop := engine.NewLoop(frameStep, frameStep)
+	loop.Wait()
 
 	running := true
 	for running {
 		select {
 		case ev := <-inputCh:
-			if ev.Type == terminal.EventKey {
+			switch ev.Type {
+			case terminal.EventMouse:
+				// Left paints obstacles, right erases
+				if ev.MouseAction == terminal.MouseActionPress || ev.MouseAction == terminal.MouseActionDrag {
+					switch ev.MouseBtn {
+					case terminal.MouseBtnLeft:
+						terrain.Set(ev.MouseX, ev.MouseY, true)
+					case terminal.MouseBtnRight:
+						terrain.Set(ev.MouseX, ev.MouseY, false)
+					}
+				}
+
+			case terminal.EventKey:
 				switch ev.Key {
 				case terminal.KeyEscape, terminal.KeyCtrlC:
 					running = false
@@ -132,6 +159,14 @@ func main() {
 					if ev.Rune >= '1' && ev.Rune <= '8' {
 						currentType = MissileType(ev.Rune - '1')
 					}
+					switch ev.Rune {
+					case 'g':
+						terrain.Scatter(12, func(x, y int) bool {
+							return nearAny(x, y, origin, targets)
+						})
+					case 'c':
+						terrain.Clear()
+					}
 				case terminal.KeyUp:
 					currentTargetIdx = (currentTargetIdx - 1 + len(targets)) % len(targets)
 				case terminal.KeyDown:
@@ -146,12 +181,15 @@ func main() {
 		case resize := <-resizeCh:
 			screenWidth, screenHeight = resize.Width, resize.Height
 			buf.Resize(screenWidth, screenHeight)
+			terrain.Resize(screenWidth, screenHeight-1)
 			updateTargets(targets)
 			origin = core.Point{X: 10, Y: screenHeight / 2}
 			term.Sync()
 
-		case <-ticker.C:
-			UpdateMissiles(missiles)
+		case <-time.After(loop.Until()):
+			for range loop.Updates(loop.Wait()) {
+				UpdateMissiles(missiles)
+			}
 
 			active := missiles[:0]
 			for _, m := range missiles {
@@ -162,6 +200,7 @@ func main() {
 			missiles = active
 
 			buf.Clear()
+			terrain.Render(buf)
 
 			// Draw targets
 			for i, t := range targets {
@@ -178,7 +217,7 @@ func main() {
 			buf.Set(origin.X, origin.Y, '▶', ColorGreen, ColorBg, render.BlendReplace, 1.0, terminal.AttrBold)
 
 			// Draw UI
-			uiText := fmt.Sprintf("[%s] ←/→:Type ↑/↓:Target Space:Fire Esc:Quit",
+			uiText := fmt.Sprintf("[%s] ←/→:Type ↑/↓:Target Space:Fire Mouse:Paint/Erase g:Obstacles c:Clear Esc:Quit",
 				MissileTypeName(currentType))
 			DrawString(buf, 2, screenHeight-1, uiText, color.RGB{R: 180, G: 180, B: 180})
 
@@ -206,6 +245,30 @@ func hasActiveChildren(m *Missile) bool {
 	return false
 }
 
+// blockedOrEdge treats the screen border as an obstacle so seekers steer back into play
+func blockedOrEdge(x, y int) bool {
+	if x < 0 || x >= screenWidth || y < 0 || y >= screenHeight-1 {
+		return true
+	}
+	return terrain.Blocked(x, y)
+}
+
+// nearAny reports whether (x, y) is within the clearance box of the origin or a target
+func nearAny(x, y int, origin core.Point, targets []core.Point) bool {
+	near := func(p core.Point) bool {
+		return vmath.IntAbs(x-p.X) <= 4 && vmath.IntAbs(y-p.Y) <= 2
+	}
+	if near(origin) {
+		return true
+	}
+	for _, t := range targets {
+		if near(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func updateTargets(targets []core.Point) {
 	targets[0] = core.Point{X: screenWidth - 10, Y: 5}
 	targets[1] = core.Point{X: screenWidth - 10, Y: screenHeight / 2}
@@ -281,7 +344,7 @@ func SpawnMissile(t MissileType, origin, target core.Point) *Missile {
 }
 
 func UpdateMissiles(missiles []*Missile) {
-	dt := vmath.FromFloat(1.0 / 60.0)
+	dt := vmath.FromFloat(frameStep.Seconds())
 
 	for _, m := range missiles {
 		if !m.Active {
@@ -305,13 +368,13 @@ func updateSingleMissile(m *Missile, dt int64) {
 		return
 	}
 	m.Age++
+	prevX, prevY := m.Pos.PreciseX, m.Pos.PreciseY
 
 	switch m.Type {
 	case MissileKinetic:
 		gravity := vmath.FromInt(25)
 		m.Pos.VelY += vmath.Mul(gravity, dt)
-		m.Pos.PreciseX += vmath.Mul(m.Pos.VelX, dt)
-		m.Pos.PreciseY += vmath.Mul(m.Pos.VelY, dt)
+		m.Pos.Move(dt)
 
 		// Dense smoke trail
 		if m.Age%2 == 0 {
@@ -341,8 +404,7 @@ func updateSingleMissile(m *Missile, dt int64) {
 		}
 
 	case MissileHelix:
-		m.Pos.PreciseX += vmath.Mul(m.Pos.VelX, dt)
-		m.Pos.PreciseY += vmath.Mul(m.Pos.VelY, dt)
+		m.Pos.Move(dt)
 		m.Phase += vmath.FromInt(12)
 
 		baseX, baseY := vmath.Normalize2D(m.Pos.VelX, m.Pos.VelY)
@@ -383,6 +445,10 @@ func updateSingleMissile(m *Missile, dt int64) {
 		maxSpeed := vmath.FromInt(50)
 		steerForce := vmath.FromInt(100)
 
+		// Feelers bend the path around obstacles, homing yields in proportion
+		avoid := physics.AvoidWalls(&m.Pos, seekerLookahead, seekerAvoid, dt, blockedOrEdge)
+		steerForce = vmath.Mul(steerForce, vmath.Scale-avoid)
+
 		desiredX, desiredY := vmath.Normalize2D(dx, dy)
 		desiredX = vmath.Mul(desiredX, maxSpeed)
 		desiredY = vmath.Mul(desiredY, maxSpeed)
@@ -393,8 +459,8 @@ func updateSingleMissile(m *Missile, dt int64) {
 
 		m.Pos.VelX += vmath.Mul(steerX, dt)
 		m.Pos.VelY += vmath.Mul(steerY, dt)
-		m.Pos.PreciseX += vmath.Mul(m.Pos.VelX, dt)
-		m.Pos.PreciseY += vmath.Mul(m.Pos.VelY, dt)
+		m.Pos.VelX, m.Pos.VelY = physics.CapSpeed(m.Pos.VelX, m.Pos.VelY, maxSpeed)
+		m.Pos.Move(dt)
 
 		// Engine flare
 		velX, velY := vmath.Normalize2D(m.Pos.VelX, m.Pos.VelY)
@@ -418,8 +484,7 @@ func updateSingleMissile(m *Missile, dt int64) {
 	case MissileCluster:
 		gravity := vmath.FromInt(18)
 		m.Pos.VelY += vmath.Mul(gravity, dt)
-		m.Pos.PreciseX += vmath.Mul(m.Pos.VelX, dt)
-		m.Pos.PreciseY += vmath.Mul(m.Pos.VelY, dt)
+		m.Pos.Move(dt)
 
 		if m.Age%3 == 0 {
 			m.Trail = append(m.Trail, Particle{
@@ -464,12 +529,17 @@ func updateSingleMissile(m *Missile, dt int64) {
 
 	case MissileLaser:
 		if m.Age == 1 {
-			// Draw instant beam
+			// Draw instant beam, cut short by the first obstacle
 			x1, y1 := m.Origin.X, m.Origin.Y
 			x2, y2 := m.Target.X, m.Target.Y
+			ax, ay := vmath.CenteredFromGrid(x1, y1)
+			bx, by := vmath.CenteredFromGrid(x2, y2)
+			if hit, ok := physics.SweepWalls(ax, ay, bx, by, terrain.Blocked); ok {
+				x2, y2 = hit.FreeX, hit.FreeY
+			}
 			steps := max(vmath.IntAbs(x2-x1), vmath.IntAbs(y2-y1))
 			for i := 0; i <= steps; i++ {
-				t := float64(i) / float64(steps)
+				t := float64(i) / float64(max(steps, 1))
 				px := vmath.FromFloat(float64(x1) + t*float64(x2-x1))
 				py := vmath.FromFloat(float64(y1) + t*float64(y2-y1))
 				m.Trail = append(m.Trail, Particle{
@@ -511,7 +581,7 @@ func updateSingleMissile(m *Missile, dt int64) {
 
 		// Rainbow trail
 		hue := int(m.Age) % 256
-		c := hueToRGB(hue)
+		c := render.HueRamp(hue)
 		m.Trail = append(m.Trail, Particle{
 			X: m.Pos.PreciseX, Y: m.Pos.PreciseY,
 			MaxAge: 20, Char: '~',
@@ -549,11 +619,15 @@ func updateSingleMissile(m *Missile, dt int64) {
 		})
 
 	case MissileBounce:
-		m.Pos.PreciseX += vmath.Mul(m.Pos.VelX, dt)
-		m.Pos.PreciseY += vmath.Mul(m.Pos.VelY, dt)
+		m.Pos.Move(dt)
 
-		px, py := vmath.ToInt(m.Pos.PreciseX), vmath.ToInt(m.Pos.PreciseY)
 		bounced := false
+		if hit, ok := physics.SweepWalls(prevX, prevY, m.Pos.PreciseX, m.Pos.PreciseY, terrain.Blocked); ok {
+			physics.ReflectOffWall(&m.Pos, hit, bounceKeep)
+			bounced = true
+		}
+
+		px, py := vmath.ToInt(m.Pos.PreciseX), vmath.ToInt(m.Pos.PreciseY)
 
 		if px <= 0 || px >= screenWidth-1 {
 			m.Pos.VelX = -m.Pos.VelX
@@ -567,7 +641,7 @@ func updateSingleMissile(m *Missile, dt int64) {
 		if bounced {
 			m.Bounces--
 			// Bounce spark
-			for i := range 6 {
+			for range 6 {
 				angle := float64(globalRng.Intn(628)) / 100
 				m.Trail = append(m.Trail, Particle{
 					X: m.Pos.PreciseX, Y: m.Pos.PreciseY,
@@ -593,6 +667,16 @@ func updateSingleMissile(m *Missile, dt int64) {
 		})
 	}
 
+	// Terrain impact, swept from the previous position so fast missiles cannot tunnel
+	if m.Type != MissileLaser && m.Type != MissileBounce {
+		if hit, ok := physics.SweepWalls(prevX, prevY, m.Pos.PreciseX, m.Pos.PreciseY, terrain.Blocked); ok {
+			m.Pos.PreciseX, m.Pos.PreciseY = vmath.CenteredFromGrid(hit.FreeX, hit.FreeY)
+			m.Active = false
+			spawnExplosion(m)
+			return
+		}
+	}
+
 	// Bounds and hit check
 	px, py := vmath.ToInt(m.Pos.PreciseX), vmath.ToInt(m.Pos.PreciseY)
 	if m.Type != MissileLaser && m.Type != MissileSpiral {
@@ -712,7 +796,7 @@ func renderMissileBody(buf *render.RenderBuffer, m *Missile) {
 		c = ColorCyan
 	case MissileWave:
 		char = '≋'
-		c = hueToRGB(int(m.Age) % 256)
+		c = render.HueRamp(int(m.Age) % 256)
 	case MissileSpiral:
 		char = '✺'
 		c = ColorGreen
@@ -724,27 +808,6 @@ func renderMissileBody(buf *render.RenderBuffer, m *Missile) {
 	buf.Set(screenX, screenY, char, c, ColorBg, render.BlendReplace, 1.0, terminal.AttrBold)
 }
 
-func hueToRGB(hue int) color.RGB {
-	h := float64(hue) / 256.0 * 6.0
-	x := 1.0 - math.Abs(math.Mod(h, 2)-1)
-	var r, g, b float64
-	switch int(h) {
-	case 0:
-		r, g, b = 1, x, 0
-	case 1:
-		r, g, b = x, 1, 0
-	case 2:
-		r, g, b = 0, 1, x
-	case 3:
-		r, g, b = 0, x, 1
-	case 4:
-		r, g, b = x, 0, 1
-	default:
-		r, g, b = 1, 0, x
-	}
-	return color.RGB{R: uint8(r * 255), G: uint8(g * 255), B: uint8(b * 255)}
-}
-
 func AngleToChar(rad float64) rune {
 	if rad < 0 {
 		rad += math.Pi * 2
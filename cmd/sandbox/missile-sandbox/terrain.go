@@ -0,0 +1,110 @@
+package main
+
+import (
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+var (
+	ColorWall     = color.RGB{R: 70, G: 78, B: 110}
+	ColorWallEdge = color.RGB{R: 120, G: 130, B: 170}
+)
+
+// Terrain is a per-cell obstacle bitmap over the screen, doubling as the spatial index
+// for missile queries: a cell lookup is a single slice index
+type Terrain struct {
+	width, height int
+	cells         []bool
+}
+
+func NewTerrain(width, height int) *Terrain {
+	return &Terrain{width: width, height: height, cells: make([]bool, width*height)}
+}
+
+// Resize keeps obstacles in the overlapping region
+func (t *Terrain) Resize(width, height int) {
+	cells := make([]bool, width*height)
+	for y := range min(height, t.height) {
+		copy(cells[y*width:y*width+min(width, t.width)], t.cells[y*t.width:])
+	}
+	t.width, t.height, t.cells = width, height, cells
+}
+
+// Blocked reports whether (x, y) holds an obstacle, off-screen cells are open
+func (t *Terrain) Blocked(x, y int) bool {
+	if x < 0 || x >= t.width || y < 0 || y >= t.height {
+		return false
+	}
+	return t.cells[y*t.width+x]
+}
+
+func (t *Terrain) Set(x, y int, on bool) {
+	if x < 0 || x >= t.width || y < 0 || y >= t.height {
+		return
+	}
+	t.cells[y*t.width+x] = on
+}
+
+func (t *Terrain) Clear() {
+	clear(t.cells)
+}
+
+// Block fills a w×h rectangle with its top-left at (x, y)
+func (t *Terrain) Block(x, y, w, h int) {
+	for dy := range h {
+		for dx := range w {
+			t.Set(x+dx, y+dy, true)
+		}
+	}
+}
+
+// Wall draws a gap-free line between two cells
+func (t *Terrain) Wall(x1, y1, x2, y2 int) {
+	ax, ay := vmath.CenteredFromGrid(x1, y1)
+	bx, by := vmath.CenteredFromGrid(x2, y2)
+	vmath.Traverse(ax, ay, bx, by, func(x, y int) bool {
+		t.Set(x, y, true)
+		return true
+	})
+}
+
+// Scatter adds n random blocks and walls, keeping a margin around the origin and targets clear
+func (t *Terrain) Scatter(n int, keepClear func(x, y int) bool) {
+	for range n {
+		x := 14 + globalRng.Intn(max(1, t.width-28))
+		y := 1 + globalRng.Intn(max(1, t.height-3))
+		if globalRng.Intn(2) == 0 {
+			w, h := 2+globalRng.Intn(5), 1+globalRng.Intn(3)
+			t.Block(x, y, w, h)
+		} else if globalRng.Intn(2) == 0 {
+			t.Wall(x, y, x, y+3+globalRng.Intn(6))
+		} else {
+			t.Wall(x, y, x+4+globalRng.Intn(10), y)
+		}
+	}
+	for y := range t.height {
+		for x := range t.width {
+			if keepClear(x, y) {
+				t.Set(x, y, false)
+			}
+		}
+	}
+}
+
+// Render draws obstacles, edges facing open cells are lit
+func (t *Terrain) Render(buf *render.RenderBuffer) {
+	for y := range t.height - 1 {
+		for x := range t.width {
+			if !t.cells[y*t.width+x] {
+				continue
+			}
+			c := ColorWall
+			if !t.Blocked(x-1, y) || !t.Blocked(x+1, y) || !t.Blocked(x, y-1) || !t.Blocked(x, y+1) {
+				c = ColorWallEdge
+			}
+			buf.Set(x, y, '█', c, ColorBg, render.BlendReplace, 1.0, terminal.AttrNone)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import "github.com/lixenwraith/vi-fighter/vmath"
+
+// broadPhase buckets bodies into a uniform grid over the bounds, cells at least one
+// diameter of the largest body wide, so any touching pair shares or neighbors a cell
+// Buckets are intrusive linked lists rebuilt every step; storage is reused
+type broadPhase struct {
+	cell       int64 // Q32.32 edge length
+	nx, ny, nz int
+	head       []int32 // First body per cell, -1 empty
+	next       []int32 // Next body in the same cell
+	cellOf     [][3]int
+}
+
+// build sizes the grid for the current bodies and buckets them
+func (g *broadPhase) build(parts []Part) {
+	var maxR int64
+	for i := range parts {
+		maxR = max(maxR, parts[i].Radius)
+	}
+	g.cell = max(2*maxR, vmath.Scale)
+
+	// Bounds are inclusive, the extra cell holds bodies resting exactly on the far face
+	g.nx = int((2*boundsX)/g.cell) + 1
+	g.ny = int((2*boundsY)/g.cell) + 1
+	g.nz = int((boundsZMax-boundsZMin)/g.cell) + 1
+
+	n := g.nx * g.ny * g.nz
+	if cap(g.head) < n {
+		g.head = make([]int32, n)
+	}
+	g.head = g.head[:n]
+	for i := range g.head {
+		g.head[i] = -1
+	}
+	if cap(g.next) < len(parts) {
+		g.next = make([]int32, len(parts))
+		g.cellOf = make([][3]int, len(parts))
+	}
+	g.next = g.next[:len(parts)]
+	g.cellOf = g.cellOf[:len(parts)]
+
+	for i := range parts {
+		c := [3]int{
+			g.axis(parts[i].Pos.X+boundsX, g.nx),
+			g.axis(parts[i].Pos.Y+boundsY, g.ny),
+			g.axis(parts[i].Pos.Z-boundsZMin, g.nz),
+		}
+		g.cellOf[i] = c
+		idx := g.index(c[0], c[1], c[2])
+		g.next[i] = g.head[idx]
+		g.head[idx] = int32(i)
+	}
+}
+
+// axis maps an offset from the lower bound to a clamped cell coordinate
+func (g *broadPhase) axis(offset int64, n int) int {
+	return max(0, min(n-1, int(offset/g.cell)))
+}
+
+func (g *broadPhase) index(x, y, z int) int {
+	return (z*g.ny+y)*g.nx + x
+}
+
+// pairs calls fn once for every pair of bodies in the same or adjacent cells, i < j
+func (g *broadPhase) pairs(fn func(i, j int)) {
+	for i, c := range g.cellOf {
+		for z := max(0, c[2]-1); z <= min(g.nz-1, c[2]+1); z++ {
+			for y := max(0, c[1]-1); y <= min(g.ny-1, c[1]+1); y++ {
+				for x := max(0, c[0]-1); x <= min(g.nx-1, c[0]+1); x++ {
+					for j := g.head[g.index(x, y, z)]; j >= 0; j = g.next[j] {
+						if int(j) > i {
+							fn(i, int(j))
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"math"
 	"os"
-	"sort"
+	"slices"
 	"time"
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/render/three"
 	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
@@ -28,16 +30,13 @@ type Part struct {
 	Flash    int64 // Q32.32 remaining flash seconds
 }
 
-type projected struct {
-	cx, cy, radius, depth float64
-	index                 int
-}
-
 const (
 	targetFPS    = 30
 	framePeriod  = time.Second / targetFPS
 	flashSeconds = 0.2
 	hudRows      = 2
+	maxParts     = 256
+	burstCount   = 10 // Bodies added by A
 )
 
 var (
@@ -55,22 +54,30 @@ var (
 	massMax     = vmath.FromFloat(20.0)
 	flashDur    = vmath.FromFloat(flashSeconds)
 
-	// Precomputed lighting (float64 for per-pixel shading path)
-	lightX, lightY, lightZ float64
-	halfX, halfY, halfZ    float64
+	// Spawned bodies are smaller so a hundred fit the box, mass scales with volume
+	spawnRadiusMin = vmath.FromFloat(0.8)
+	spawnRadiusMax = vmath.FromFloat(1.6)
+	spawnSpeed     = vmath.FromFloat(6.0)
 )
 
-func initLighting() {
-	lx, ly, lz := -0.35, -0.55, 0.75
-	m := math.Sqrt(lx*lx + ly*ly + lz*lz)
-	lightX, lightY, lightZ = lx/m, ly/m, lz/m
-
-	// Blinn-Phong half vector: normalize(light + view), view = (0,0,1)
-	hx, hy, hz := lightX, lightY, lightZ+1.0
-	m = math.Sqrt(hx*hx + hy*hy + hz*hz)
-	halfX, halfY, halfZ = hx/m, hy/m, hz/m
+// spawnColors is the neon palette cycled by added bodies
+var spawnColors = []color.RGB{
+	{R: 40, G: 180, B: 255},
+	{R: 255, G: 60, B: 120},
+	{R: 120, G: 255, B: 80},
+	{R: 255, G: 180, B: 40},
+	{R: 180, G: 90, B: 255},
+	{R: 40, G: 255, B: 220},
 }
 
+// Point mesh demo: a Fibonacci sphere spinning in the middle of the box
+const (
+	meshPoints = 160
+	meshRadius = 5.0
+	meshDepth  = 17.0
+	meshSpin   = 0.6 // Radians per second about Y
+)
+
 // --- Vec3 operations using vmath primitives ---
 
 func v3Sub(a, b Vec3) Vec3 {
@@ -198,220 +205,70 @@ func separateParts(a, b *Part, n Vec3, overlap int64) {
 	}
 }
 
-// --- Projection ---
-
-func projectPart(p *Part, idx, screenW, screenH int) projected {
-	z := vmath.ToFloat(p.Pos.Z)
-	x := vmath.ToFloat(p.Pos.X)
-	y := vmath.ToFloat(p.Pos.Y)
-	r := vmath.ToFloat(p.Radius)
-	f := vmath.ToFloat(focalLen)
-
-	denom := z + f
-	if denom < 0.5 {
-		denom = 0.5
-	}
-	invZ := f / denom
-
-	viewH := float64(screenH - hudRows)
-	scale := viewH * 0.13
-
-	return projected{
-		cx:     float64(screenW)/2.0 + x*invZ*scale*2.0, // 2x for terminal cell aspect 1:2
-		cy:     viewH/2.0 + y*invZ*scale,
-		radius: r * invZ * scale,
-		depth:  z,
-		index:  idx,
-	}
-}
-
 // --- Rendering ---
 
-func renderSphere(buf *render.RenderBuffer, p *Part, proj projected, isSelected bool, screenW, viewH int) {
-	if proj.radius < 0.4 {
-		return
-	}
-
-	// Expand bounds for glow
-	glowRadius := proj.radius * 1.6
-	prX := glowRadius * 2.0
-	prY := glowRadius
-
-	minX := max(0, int(proj.cx-prX-1))
-	maxX := min(screenW-1, int(proj.cx+prX+1))
-	minY := max(0, int(proj.cy-prY-1))
-	maxY := min(viewH-1, int(proj.cy+prY+1))
-
-	// Neon: boost saturation, use depth for intensity not darkness
-	zMin := vmath.ToFloat(boundsZMin)
-	zMax := vmath.ToFloat(boundsZMax)
-	depthT := (proj.depth - zMin) / (zMax - zMin)
-	depthT = math.Max(0, math.Min(1, depthT))
-	depthBright := 1.0 - depthT*0.4 // Less depth falloff
-
-	// Saturated neon base
-	baseR := math.Min(255, float64(p.Color.R)*1.3)
-	baseG := math.Min(255, float64(p.Color.G)*1.3)
-	baseB := math.Min(255, float64(p.Color.B)*1.3)
-
-	flashT := 0.0
-	if p.Flash > 0 {
-		flashT = vmath.ToFloat(p.Flash) / flashSeconds
-	}
-
-	sphereRadiusSq := 1.0
-	coreRadius := 0.7 // Inner bright core
-
-	for sy := minY; sy <= maxY; sy++ {
-		for sx := minX; sx <= maxX; sx++ {
-			// Use original sphere radius for core calculations
-			nx := (float64(sx) + 0.5 - proj.cx) / (proj.radius * 2.0)
-			ny := (float64(sy) + 0.5 - proj.cy) / proj.radius
-			distSq := nx*nx + ny*ny
-
-			// Glow extends beyond sphere
-			if distSq > 2.5 {
-				continue
-			}
-
-			var r, g, b float64
-
-			if distSq <= sphereRadiusSq {
-				// Inside sphere - neon core with hot center
-				nz := math.Sqrt(1.0 - distSq)
-
-				// Rim glow - strong colored edge
-				rim := 1.0 - nz
-				rim = rim * rim * 0.8
-
-				// Core glow - white hot center
-				coreDist := math.Sqrt(distSq) / coreRadius
-				coreGlow := 0.0
-				if coreDist < 1.0 {
-					coreGlow = (1.0 - coreDist) * 0.6
-				}
-
-				// Specular hotspot
-				spec := nx*halfX + ny*halfY + nz*halfZ
-				if spec < 0 {
-					spec = 0
-				}
-				spec = math.Pow(spec, 20.0) * 0.9
-
-				// Combine: base color + rim tint + core white + specular
-				intensity := (0.4 + rim*0.6) * depthBright
-				r = baseR*intensity + coreGlow*255 + spec*255
-				g = baseG*intensity + coreGlow*255 + spec*255
-				b = baseB*intensity + coreGlow*255 + spec*255
-
-			} else {
-				// Outer glow - exponential falloff
-				glowDist := math.Sqrt(distSq) - 1.0
-				glowFalloff := math.Exp(-glowDist*3.0) * 0.5 * depthBright
-				r = baseR * glowFalloff
-				g = baseG * glowFalloff
-				b = baseB * glowFalloff
-			}
-
-			// Frozen: cyan tint instead of grayscale
-			if p.Frozen {
-				avg := (r + g + b) / 3
-				r = avg * 0.5
-				g = avg*0.8 + 40
-				b = avg + 60
-			}
-
-			// Flash: bright white pulse
-			if flashT > 0 {
-				flash := flashT * 0.8
-				r = r*(1-flash) + 255*flash
-				g = g*(1-flash) + 255*flash
-				b = b*(1-flash) + 255*flash
-			}
-
-			// Selection: pulsing outer ring
-			if isSelected && distSq > 0.8 && distSq <= 1.2 {
-				pulse := 0.5 + 0.5*math.Sin(float64(time.Now().UnixMilli())/100.0)
-				r = math.Min(255, r+80*pulse)
-				g = math.Min(255, g+80*pulse)
-				b = math.Min(255, b+40*pulse)
-			}
-
-			c := color.RGB{R: clampF(r), G: clampF(g), B: clampF(b)}
-
-			// Alpha: solid core, fading glow
-			alpha := 1.0
-			if distSq > sphereRadiusSq {
-				alpha = math.Max(0, 1.0-((math.Sqrt(distSq)-1.0)/0.6))
-			} else {
-				edgeDist := 1.0 - math.Sqrt(distSq)
-				if edgeDist < 0.08 {
-					alpha = edgeDist / 0.08
-				}
-			}
-
-			// Use screen blend for additive glow effect
-			if distSq > sphereRadiusSq {
-				buf.Set(sx, sy, ' ', color.RGB{}, c, render.BlendScreen, alpha*0.7, terminal.AttrNone)
-			} else {
-				buf.Set(sx, sy, ' ', color.RGB{}, c, render.BlendAlpha, alpha, terminal.AttrNone)
-			}
-		}
-	}
+// newScene sizes the scene to the area above the HUD and fogs the far half of the box
+func newScene(screenW, screenH int) *three.Scene {
+	scene := three.NewScene(screenW, screenH-hudRows)
+	scene.Camera.Focal = vmath.ToFloat(focalLen)
+	scene.Fog = three.Fog{Near: vmath.ToFloat(boundsZMin), Far: vmath.ToFloat(boundsZMax), Fade: 0.4}
+	return scene
 }
 
-func renderFrame(buf *render.RenderBuffer, parts *[3]Part, selected, screenW, screenH int, paused bool) {
-	viewH := screenH - hudRows
+func renderFrame(buf *render.RenderBuffer, scene *three.Scene, mesh *three.Mesh, parts []Part, selected, screenW, screenH int, paused bool, fps float64) {
+	pulse := 0.5 + 0.5*math.Sin(float64(time.Now().UnixMilli())/100.0)
 
-	// Project all parts
-	projs := [3]projected{}
 	for i := range parts {
-		projs[i] = projectPart(&parts[i], i, screenW, screenH)
+		p := &parts[i]
+		sp := three.Sphere{
+			Center: three.Vec3{X: vmath.ToFloat(p.Pos.X), Y: vmath.ToFloat(p.Pos.Y), Z: vmath.ToFloat(p.Pos.Z)},
+			Radius: vmath.ToFloat(p.Radius),
+			Color:  p.Color,
+			Flash:  vmath.ToFloat(p.Flash) / flashSeconds,
+			Frost:  p.Frozen,
+		}
+		if i == selected {
+			sp.Ring = pulse
+		}
+		scene.Sphere(sp)
 	}
-
-	// Painter's algorithm: sort far to near
-	order := [3]int{0, 1, 2}
-	sort.Slice(order[:], func(i, j int) bool {
-		return projs[order[i]].depth > projs[order[j]].depth
-	})
-
-	for _, idx := range order {
-		renderSphere(buf, &parts[idx], projs[idx], idx == selected, screenW, viewH)
+	if mesh != nil {
+		scene.Mesh(mesh)
 	}
+	scene.Draw(buf)
 
-	renderHUD(buf, parts, selected, screenW, screenH, paused)
+	renderHUD(buf, parts, selected, screenW, screenH, paused, fps)
 }
 
-func renderHUD(buf *render.RenderBuffer, parts *[3]Part, selected, screenW, screenH int, paused bool) {
+// renderHUD shows the selected body, the body count and frame rate, and the controls
+func renderHUD(buf *render.RenderBuffer, parts []Part, selected, screenW, screenH int, paused bool, fps float64) {
 	statusY := screenH - 2
 	controlY := screenH - 1
 	dim := color.RGB{R: 100, G: 100, B: 110}
 
 	x := 1
-	for i := range parts {
-		marker := "  "
-		if i == selected {
-			marker = "> "
-		}
+	if selected < len(parts) {
+		p := &parts[selected]
 		frozen := ""
-		if parts[i].Frozen {
+		if p.Frozen {
 			frozen = " [F]"
 		}
-		s := fmt.Sprintf("%sPart%d m=%.1f%s", marker, i+1, vmath.ToFloat(parts[i].Mass), frozen)
+		s := fmt.Sprintf("> Part%d m=%.1f r=%.1f%s", selected+1, vmath.ToFloat(p.Mass), vmath.ToFloat(p.Radius), frozen)
 
-		fg := parts[i].Color
-		if parts[i].Frozen {
+		fg := p.Color
+		if p.Frozen {
 			fg = color.Lerp(fg, color.Grayscale(fg), 0.5)
 		}
 		writeStr(buf, x, statusY, s, fg)
 		x += len([]rune(s)) + 3
 	}
+	writeStr(buf, x, statusY, fmt.Sprintf("bodies %d/%d  %.0f fps", len(parts), maxParts, fps), dim)
 
 	if paused {
 		writeStr(buf, screenW-9, statusY, "[PAUSED]", color.RGB{R: 255, G: 200, B: 50})
 	}
 
-	writeStr(buf, 1, controlY, "1/2/3:sel  f:freeze  up/dn:mass  space:pause  r:reset  q:quit", dim)
+	writeStr(buf, 1, controlY, "1-9/tab:sel  f:freeze  up/dn:mass  a/A:add 1/10  x:remove  m:mesh  space:pause  r:reset  q:quit", dim)
 }
 
 func writeStr(buf *render.RenderBuffer, x, y int, s string, fg color.RGB) {
@@ -421,21 +278,9 @@ func writeStr(buf *render.RenderBuffer, x, y int, s string, fg color.RGB) {
 	}
 }
 
-func clampF(v float64) uint8 {
-	if v > 255.0 {
-		return 255
-	}
-	if v < 0.0 {
-		return 0
-	}
-	return uint8(v)
-}
-
 // --- Main ---
 
 func main() {
-	initLighting()
-
 	term := terminal.New(terminal.ColorModeTrueColor)
 	if err := term.Init(); err != nil {
 		fmt.Fprintf(os.Stderr, "terminal init: %v\n", err)
@@ -446,124 +291,124 @@ func main() {
 	w, h := term.Size()
 
 	buf := render.NewRenderBuffer(terminal.ColorModeTrueColor, w, h)
+	buf.SetDamageTracking(true) // Only rows the spheres touched last frame are cleared and finalized
+	scene := newScene(w, h)
+	mesh := &three.Mesh{
+		Points: three.FibonacciSphere(meshPoints, meshRadius),
+		Origin: three.Vec3{Z: meshDepth},
+		Pitch:  0.4,
+		Color:  color.RGB{R: 255, G: 200, B: 80},
+	}
+	showMesh := false
 
 	parts := initParts()
 	selected := 0
 	paused := false
+	rng := vmath.NewFastRand(uint64(time.Now().UnixNano()))
+	var grid broadPhase
+	fps := float64(targetFPS)
 
-	ticker := time.NewTicker(framePeriod)
-	defer ticker.Stop()
-
-	lastTick := time.Now()
+	loop := engine.NewLoop(framePeriod, framePeriod)
+	stepDt := vmath.FromFloat(framePeriod.Seconds())
 	running := true
 
 	// use channel-based input
 	inputCh := startInputReader(term)
 
 	for running {
-		select {
-		case <-ticker.C:
-			// Drain input non-blocking
-		drainInput:
-			for {
-				select {
-				case ev, ok := <-inputCh:
-					if !ok {
-						running = false
-						break drainInput
+		frameDt := loop.Wait()
+		if frameDt > 0 {
+			fps += (1/frameDt.Seconds() - fps) * 0.1
+		}
+
+		// Drain input non-blocking
+	drainInput:
+		for {
+			select {
+			case ev, ok := <-inputCh:
+				if !ok {
+					running = false
+					break drainInput
+				}
+				if ev.Type == terminal.EventResize {
+					w, h = term.Size()
+					buf.Resize(w, h)
+					scene.Resize(w, h-hudRows)
+					continue drainInput
+				}
+				switch {
+				case ev.Key == terminal.KeyRune && ev.Rune == 'q':
+					running = false
+				case ev.Key == terminal.KeyRune && ev.Rune >= '1' && ev.Rune <= '9':
+					if i := int(ev.Rune - '1'); i < len(parts) {
+						selected = i
 					}
-					if ev.Type == terminal.EventResize {
-						w, h = term.Size()
-						buf.Resize(w, h)
-						continue drainInput
+				case ev.Key == terminal.KeyTab:
+					selected = (selected + 1) % len(parts)
+				case ev.Key == terminal.KeyBacktab:
+					selected = (selected + len(parts) - 1) % len(parts)
+				case ev.Key == terminal.KeyRune && ev.Rune == 'a':
+					parts = addParts(parts, 1, rng)
+				case ev.Key == terminal.KeyRune && ev.Rune == 'A':
+					parts = addParts(parts, burstCount, rng)
+				case ev.Key == terminal.KeyRune && ev.Rune == 'x':
+					if len(parts) > 1 {
+						parts = slices.Delete(parts, selected, selected+1)
+						selected = min(selected, len(parts)-1)
 					}
-					switch {
-					case ev.Key == terminal.KeyRune && ev.Rune == 'q':
-						running = false
-					case ev.Key == terminal.KeyRune && ev.Rune == '1':
-						selected = 0
-					case ev.Key == terminal.KeyRune && ev.Rune == '2':
-						selected = 1
-					case ev.Key == terminal.KeyRune && ev.Rune == '3':
-						selected = 2
-					case ev.Key == terminal.KeyRune && ev.Rune == 'f':
-						parts[selected].Frozen = !parts[selected].Frozen
-						if parts[selected].Frozen {
-							parts[selected].Vel = Vec3{}
-						}
-					case ev.Key == terminal.KeyUp:
-						parts[selected].Mass += massStep
-						if parts[selected].Mass > massMax {
-							parts[selected].Mass = massMax
-						}
-					case ev.Key == terminal.KeyDown:
-						parts[selected].Mass -= massStep
-						if parts[selected].Mass < massMin {
-							parts[selected].Mass = massMin
-						}
-					case ev.Key == terminal.KeyRune && ev.Rune == ' ':
-						paused = !paused
-					case ev.Key == terminal.KeyRune && ev.Rune == 'r':
-						parts = initParts()
-						selected = 0
-						paused = false
-					case ev.Key == terminal.KeyEscape:
-						running = false
+				case ev.Key == terminal.KeyRune && ev.Rune == 'f':
+					parts[selected].Frozen = !parts[selected].Frozen
+					if parts[selected].Frozen {
+						parts[selected].Vel = Vec3{}
 					}
-				default:
-					break drainInput
+				case ev.Key == terminal.KeyUp:
+					parts[selected].Mass += massStep
+					if parts[selected].Mass > massMax {
+						parts[selected].Mass = massMax
+					}
+				case ev.Key == terminal.KeyDown:
+					parts[selected].Mass -= massStep
+					if parts[selected].Mass < massMin {
+						parts[selected].Mass = massMin
+					}
+				case ev.Key == terminal.KeyRune && ev.Rune == 'm':
+					showMesh = !showMesh
+				case ev.Key == terminal.KeyRune && ev.Rune == ' ':
+					paused = !paused
+				case ev.Key == terminal.KeyRune && ev.Rune == 'r':
+					parts = initParts()
+					selected = 0
+					paused = false
+				case ev.Key == terminal.KeyEscape:
+					running = false
 				}
+			default:
+				break drainInput
 			}
+		}
 
-			// Tick
-			now := time.Now()
-			dtSec := now.Sub(lastTick).Seconds()
-			lastTick = now
-			if dtSec > 0.1 {
-				dtSec = 0.1
-			}
-			dt := vmath.FromFloat(dtSec)
-
-			if !paused {
-				simulate(&parts, dt)
+		// Fixed-step physics, the loop drops backlog past MaxCatchUp
+		steps := loop.Updates(frameDt)
+		if !paused {
+			for range steps {
+				simulate(parts, &grid, stepDt)
 			}
+			mesh.Yaw += meshSpin * frameDt.Seconds()
+		}
 
-			// Render
-			buf.Clear()
-			renderFrame(buf, &parts, selected, w, h, paused)
-			buf.FlushToTerminal(term)
+		// Render
+		buf.Clear()
+		var shown *three.Mesh
+		if showMesh {
+			shown = mesh
 		}
+		renderFrame(buf, scene, shown, parts, selected, w, h, paused, fps)
+		buf.FlushToTerminal(term)
 	}
 }
 
-// func initParts() [3]Part {
-// 	return [3]Part{
-// 		{
-// 			Pos:    Vec3{vmath.FromFloat(-4.0), vmath.FromFloat(-2.0), vmath.FromFloat(10.0)},
-// 			Vel:    Vec3{vmath.FromFloat(5.0), vmath.FromFloat(2.0), vmath.FromFloat(-3.0)},
-// 			Mass:   massDefault,
-// 			Radius: partRadius,
-// 			Color:  color.RGB{R: 80, G: 160, B: 255}, // Blue
-// 		},
-// 		{
-// 			Pos:    Vec3{vmath.FromFloat(3.0), vmath.FromFloat(1.5), vmath.FromFloat(18.0)},
-// 			Vel:    Vec3{vmath.FromFloat(-3.0), vmath.FromFloat(-4.0), vmath.FromFloat(4.0)},
-// 			Mass:   massDefault,
-// 			Radius: partRadius,
-// 			Color:  color.RGB{R: 255, G: 90, B: 90}, // Red
-// 		},
-// 		{
-// 			Pos:    Vec3{vmath.FromFloat(0.0), vmath.FromFloat(0.0), vmath.FromFloat(24.0)},
-// 			Vel:    Vec3{vmath.FromFloat(2.0), vmath.FromFloat(3.5), vmath.FromFloat(-6.0)},
-// 			Mass:   massDefault,
-// 			Radius: partRadius,
-// 			Color:  color.RGB{R: 90, G: 255, B: 120}, // Green
-// 		},
-// 	}
-// }
-
-func initParts() [3]Part {
-	return [3]Part{
+func initParts() []Part {
+	return []Part{
 		{
 			Pos:    Vec3{vmath.FromFloat(-4.0), vmath.FromFloat(-2.0), vmath.FromFloat(10.0)},
 			Vel:    Vec3{vmath.FromFloat(5.0), vmath.FromFloat(2.0), vmath.FromFloat(-3.0)},
@@ -588,7 +433,35 @@ func initParts() [3]Part {
 	}
 }
 
-func simulate(parts *[3]Part, dt int64) {
+// addParts appends up to n bodies at random positions and velocities, capped at maxParts
+// Overlaps at spawn are pushed apart by the next collision pass
+func addParts(parts []Part, n int, rng *vmath.FastRand) []Part {
+	between := func(lo, hi int64) int64 {
+		return lo + vmath.Mul(hi-lo, vmath.FromFloat(rng.Float64()))
+	}
+	for range min(n, maxParts-len(parts)) {
+		r := between(spawnRadiusMin, spawnRadiusMax)
+		ratio := vmath.Div(r, partRadius)
+		parts = append(parts, Part{
+			Pos: Vec3{
+				between(-boundsX+r, boundsX-r),
+				between(-boundsY+r, boundsY-r),
+				between(boundsZMin+r, boundsZMax-r),
+			},
+			Vel: Vec3{
+				between(-spawnSpeed, spawnSpeed),
+				between(-spawnSpeed, spawnSpeed),
+				between(-spawnSpeed, spawnSpeed),
+			},
+			Mass:   max(massMin, vmath.Mul(massDefault, vmath.Mul(ratio, vmath.Mul(ratio, ratio)))),
+			Radius: r,
+			Color:  spawnColors[len(parts)%len(spawnColors)],
+		})
+	}
+	return parts
+}
+
+func simulate(parts []Part, grid *broadPhase, dt int64) {
 	// Integrate positions
 	for i := range parts {
 		if parts[i].Frozen {
@@ -607,10 +480,11 @@ func simulate(parts *[3]Part, dt int64) {
 		reflectAxis(&parts[i].Pos.Z, &parts[i].Vel.Z, boundsZMin, boundsZMax, restitution)
 	}
 
-	// Pair-wise sphere collisions
-	resolveCollision(&parts[0], &parts[1])
-	resolveCollision(&parts[0], &parts[2])
-	resolveCollision(&parts[1], &parts[2])
+	// Sphere collisions between grid neighbors
+	grid.build(parts)
+	grid.pairs(func(i, j int) {
+		resolveCollision(&parts[i], &parts[j])
+	})
 
 	// Decay flash timers
 	for i := range parts {
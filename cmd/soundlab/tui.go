@@ -17,6 +17,7 @@ import (
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/terminal/tui"
 	"github.com/lixenwraith/vi-fighter/audio"
+	"github.com/lixenwraith/vi-fighter/core"
 )
 
 type focusArea int
@@ -110,7 +111,9 @@ func runTUI(s *Session) error {
 		s.out = os.Stdout
 		return err
 	}
+	core.SetCrashTerminal(term)
 	defer term.Fini()
+	defer core.RecoverAndRestore()
 
 	// Signals become a clean loop exit rather than os.Exit: Fini must run or
 	// the terminal is left raw on the alternate screen.
@@ -119,13 +122,13 @@ func runTUI(s *Session) error {
 	defer signal.Stop(sig)
 	sigDone := make(chan struct{})
 	defer close(sigDone)
-	go func() {
+	core.Go(func() {
 		select {
 		case <-sig:
 			term.PostEvent(terminal.Event{Type: terminal.EventClosed})
 		case <-sigDone:
 		}
-	}()
+	})
 
 	// The transport readout animates without input: a ticker wakes the
 	// blocking PollEvent through the synthetic channel. KeyNone is what
@@ -133,7 +136,7 @@ func runTUI(s *Session) error {
 	// by construction.
 	stop := make(chan struct{})
 	defer close(stop)
-	go func() {
+	core.Go(func() {
 		t := time.NewTicker(tickEvery)
 		defer t.Stop()
 		for {
@@ -144,7 +147,7 @@ func runTUI(s *Session) error {
 				term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
 			}
 		}
-	}()
+	})
 
 	w, h := term.Size()
 	a := &tuiApp{
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/lixenwraith/terminal"
+)
+
+// Import rasterization settings
+const (
+	RasterSize       = 120 // Font render canvas edge in pixels, a multiple of the grid size
+	RasterPointSize  = 96  // Leaves room for ascenders and descenders within the canvas
+	DefaultThreshold = 128
+	ThresholdStep    = 16
+)
+
+// imageExts are imported as bitmaps; anything else is treated as a font file or font name
+var imageExts = map[string]bool{".png": true, ".gif": true, ".jpg": true, ".jpeg": true}
+
+// startImport opens the import path prompt
+func (e *Editor) startImport() {
+	e.importMode = true
+	e.setStatus("Import: "+e.importPath+"_", 0)
+}
+
+func (e *Editor) handleImportInput(ev terminal.Event) {
+	switch ev.Key {
+	case terminal.KeyEscape:
+		e.importMode = false
+		e.setStatus("Import cancelled", 0)
+		return
+	case terminal.KeyEnter:
+		e.importMode = false
+		e.importGlyph(e.importPath)
+		return
+	case terminal.KeyBackspace, terminal.KeyDelete:
+		if len(e.importPath) > 0 {
+			e.importPath = e.importPath[:len(e.importPath)-1]
+		}
+	case terminal.KeyRune:
+		e.importPath += string(ev.Rune)
+	}
+	e.setStatus("Import: "+e.importPath+"_", 0)
+}
+
+// importGlyph loads an image or rasterizes the current character from a font, keeps the
+// coverage so the threshold can be retuned, and replaces the current glyph
+func (e *Editor) importGlyph(path string) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		e.setStatus("Import: no path given", 2)
+		return
+	}
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+
+	var img image.Image
+	var err error
+	if imageExts[strings.ToLower(filepath.Ext(path))] {
+		img, err = loadImage(path)
+	} else {
+		img, err = rasterizeFont(path, e.current)
+	}
+	if err != nil {
+		e.setStatus(fmt.Sprintf("Import failed: %v", err), 2)
+		return
+	}
+
+	e.importCov = coverage(img)
+	e.importFor = e.current
+	e.hasImport = true
+	e.applyThreshold()
+}
+
+// adjustThreshold moves the ink threshold and rebuilds the imported glyph
+func (e *Editor) adjustThreshold(delta int) {
+	e.threshold = max(ThresholdStep, min(255-ThresholdStep, e.threshold+delta))
+	e.applyThreshold()
+}
+
+// applyThreshold sets each pixel whose ink coverage exceeds the threshold
+func (e *Editor) applyThreshold() {
+	if !e.hasImport || e.importFor != e.current {
+		e.setStatus(fmt.Sprintf("Threshold %d (no import for this glyph)", e.threshold), 0)
+		return
+	}
+
+	var g [GridRows]uint16
+	for r := range GridRows {
+		for c := range GridCols {
+			if int(e.importCov[r][c]) > e.threshold {
+				g[r] |= 1 << (15 - c)
+			}
+		}
+	}
+	e.glyphs[e.current] = g
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Imported '%c' at threshold %d", e.current, e.threshold), 1)
+}
+
+func loadImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+// rasterizeFont renders one character black on white with ImageMagick
+// font is a font file path or a system font name as listed by `magick -list font`
+// Gravity centers the line box rather than the ink, so glyphs share a baseline
+func rasterizeFont(font string, r rune) (image.Image, error) {
+	text := string(r)
+	if r == '@' || r == '\\' {
+		text = `\` + text // label: reads a file after a leading @ and consumes escapes
+	}
+	args := []string{
+		"-background", "white", "-fill", "black",
+		"-font", font, "-pointsize", fmt.Sprint(RasterPointSize),
+		"-size", fmt.Sprintf("%dx%d", RasterSize, RasterSize), "-gravity", "center",
+		"label:" + text, "png:-",
+	}
+
+	for _, tool := range []string{"magick", "convert"} {
+		var out, stderr bytes.Buffer
+		cmd := exec.Command(tool, args...)
+		cmd.Stdout = &out
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if errors.Is(err, exec.ErrNotFound) {
+				continue
+			}
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, fmt.Errorf("%s: %s", tool, msg)
+			}
+			return nil, fmt.Errorf("%s: %w", tool, err)
+		}
+		return png.Decode(&out)
+	}
+	return nil, errors.New("fonts need ImageMagick (magick or convert)")
+}
+
+// coverage area-averages the image down to the grid as ink amount per cell
+// Pixels are composited over white; an image that is mostly ink is taken as light on dark and inverted
+func coverage(img image.Image) [GridRows][GridCols]uint8 {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	var sum [GridRows][GridCols]float64
+	var count [GridRows][GridCols]int
+	total := 0.0
+	for y := range h {
+		r := y * GridRows / h
+		for x := range w {
+			c := x * GridCols / w
+			cr, cg, cb, ca := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			lum := (0.299*float64(cr) + 0.587*float64(cg) + 0.114*float64(cb) + float64(0xFFFF-ca)) / 0xFFFF
+			ink := 1 - min(lum, 1)
+			sum[r][c] += ink
+			count[r][c]++
+			total += ink
+		}
+	}
+	invert := w*h > 0 && total/float64(w*h) > 0.5
+
+	var cov [GridRows][GridCols]uint8
+	for r := range GridRows {
+		for c := range GridCols {
+			if count[r][c] == 0 {
+				continue
+			}
+			v := sum[r][c] / float64(count[r][c])
+			if invert {
+				v = 1 - v
+			}
+			cov[r][c] = uint8(v*255 + 0.5)
+		}
+	}
+	return cov
+}
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"runtime/debug"
 	"strings"
 	"time"
 
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/asset"
+	"github.com/lixenwraith/vi-fighter/core"
 )
 
 // Editor constants
@@ -38,6 +38,12 @@ var (
 	ColorError     = color.RGB{R: 200, G: 50, B: 50}
 )
 
+// Drawing tool preview colors
+var (
+	ColorPreview = color.RGB{R: 255, G: 140, B: 0}
+	ColorAnchor  = color.RGB{R: 0, G: 160, B: 255}
+)
+
 // Box drawing characters
 const (
 	BoxTopLeft     = '┌'
@@ -61,6 +67,8 @@ type Editor struct {
 	// Data
 	glyphs   map[rune][GridRows]uint16
 	original map[rune][GridRows]uint16
+	metrics  map[rune]asset.GlyphMetrics // Resolved, Advance is never zero
+	origMet  map[rune]asset.GlyphMetrics
 	current  rune
 	modified bool
 
@@ -82,6 +90,19 @@ type Editor struct {
 	// Row clipboard for row operations
 	rowClip    uint16
 	hasRowClip bool
+
+	// Drawing tool and the anchors placed so far
+	tool        Tool
+	anchors     [2]core.Point
+	anchorCount int
+
+	// Import prompt, and the last import's ink coverage kept for threshold tuning
+	importMode bool
+	importPath string
+	importCov  [GridRows][GridCols]uint8
+	importFor  rune
+	hasImport  bool
+	threshold  int
 }
 
 func main() {
@@ -90,14 +111,9 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Failed to initialize terminal: %v\n", err)
 		os.Exit(1)
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			terminal.EmergencyReset(os.Stdout)
-			fmt.Fprintf(os.Stderr, "CRASH: %v\n%s\n", r, debug.Stack())
-		} else {
-			term.Fini()
-		}
-	}()
+	core.SetCrashTerminal(term)
+	defer term.Fini()
+	defer core.RecoverAndRestore()
 
 	editor := NewEditor(term)
 	editor.Run()
@@ -109,10 +125,13 @@ func NewEditor(term terminal.Terminal) *Editor {
 		running:     true,
 		glyphs:      make(map[rune][GridRows]uint16),
 		original:    make(map[rune][GridRows]uint16),
+		metrics:     make(map[rune]asset.GlyphMetrics),
+		origMet:     make(map[rune]asset.GlyphMetrics),
 		current:     'A',
 		cursorX:     6,
 		cursorY:     5,
 		previewText: "ABCDEFG 0123456789",
+		threshold:   DefaultThreshold,
 	}
 	e.loadAssets()
 	return e
@@ -123,6 +142,9 @@ func (e *Editor) loadAssets() {
 		r := rune(MinChar + i)
 		e.glyphs[r] = asset.SplashFont[i]
 		e.original[r] = asset.SplashFont[i]
+		_, m := asset.SplashGlyph(r)
+		e.metrics[r] = m
+		e.origMet[r] = m
 	}
 }
 
@@ -165,11 +187,19 @@ func (e *Editor) handleEvent(ev terminal.Event) {
 		e.handleTypingInput(ev)
 		return
 	}
+	if e.importMode {
+		e.handleImportInput(ev)
+		return
+	}
 
 	switch ev.Key {
 	case terminal.KeyCtrlC, terminal.KeyCtrlQ:
 		e.running = false
 	case terminal.KeyEscape:
+		if e.tool != ToolPen {
+			e.toolCancel()
+			return
+		}
 		e.running = false
 
 	case terminal.KeyUp:
@@ -185,9 +215,17 @@ func (e *Editor) handleEvent(ev terminal.Event) {
 		e.toggleBit(e.cursorY, e.cursorX)
 		e.modified = true
 	case terminal.KeyEnter:
+		if e.tool != ToolPen {
+			e.toolEnter()
+			return
+		}
 		e.setBit(e.cursorY, e.cursorX, true)
 		e.modified = true
 	case terminal.KeyBackspace, terminal.KeyDelete:
+		if e.shapeReady() {
+			e.commitShape(false)
+			return
+		}
 		e.setBit(e.cursorY, e.cursorX, false)
 		e.modified = true
 
@@ -311,6 +349,7 @@ func (e *Editor) handleRuneInput(r rune) {
 	case 'r':
 		if orig, ok := e.original[e.current]; ok {
 			e.glyphs[e.current] = orig
+			e.metrics[e.current] = e.origMet[e.current]
 			e.modified = false
 			e.setStatus("Reset to original", 1)
 		}
@@ -361,6 +400,30 @@ func (e *Editor) handleRuneInput(r rune) {
 	case 'E':
 		e.exportAllGlyphs()
 
+	// Drawing tools
+	case 'T':
+		e.cycleTool()
+
+	// Metrics
+	case '(':
+		e.adjustBearing(-1)
+	case ')':
+		e.adjustBearing(1)
+	case '{':
+		e.adjustAdvance(-1)
+	case '}':
+		e.adjustAdvance(1)
+	case 'M':
+		e.fitMetrics()
+
+	// Import
+	case 'I':
+		e.startImport()
+	case '+', '=':
+		e.adjustThreshold(ThresholdStep)
+	case '-':
+		e.adjustThreshold(-ThresholdStep)
+
 	// Preview text
 	case 't':
 		e.typingMode = true
@@ -591,7 +654,8 @@ func (e *Editor) exportAllGlyphs() {
 		}
 		fmt.Fprintln(&buf, "\t},")
 	}
-	buf.WriteString("}\n")
+	buf.WriteString("}\n\n")
+	buf.WriteString(e.metricsCode())
 
 	code := buf.String()
 
@@ -699,7 +763,7 @@ func (e *Editor) drawHeader(cells []terminal.Cell) {
 	if e.modified {
 		modMark = "*"
 	}
-	header := fmt.Sprintf(" VI-FIGHTER FONT EDITOR │ '%c' (0x%02X)%s ", e.current, e.current, modMark)
+	header := fmt.Sprintf(" VI-FIGHTER FONT EDITOR │ '%c' (0x%02X)%s │ %s ", e.current, e.current, modMark, toolNames[e.tool])
 	startX := max(0, (e.width-len(header))/2)
 	e.drawText(cells, startX, 1, header, ColorText, ColorBg, terminal.AttrBold)
 }
@@ -723,6 +787,12 @@ func (e *Editor) drawGrid(cells []terminal.Cell) {
 		e.drawText(cells, startX+2+(col*2), startY+1, val, c, ColorBg, 0)
 	}
 
+	// Shape preview once all anchors are down; fill previews from the cursor directly
+	var preview [GridRows]uint16
+	if e.shapeReady() {
+		preview = e.shapeMask()
+	}
+
 	// Row indicators and grid
 	for r := range GridRows {
 		rowNum := fmt.Sprintf("%X", r)
@@ -745,6 +815,14 @@ func (e *Editor) drawGrid(cells []terminal.Cell) {
 			} else {
 				cell = terminal.Cell{Rune: DotMiddle, Fg: ColorDim, Bg: ColorGridBg}
 			}
+			if preview[r]&(1<<(15-c)) != 0 {
+				cell = terminal.Cell{Rune: DotMiddle, Fg: ColorBg, Bg: ColorPreview}
+			}
+			for i := range e.anchorCount {
+				if e.anchors[i].X == c && e.anchors[i].Y == r {
+					cell.Bg = ColorAnchor
+				}
+			}
 
 			if isCursor {
 				if active {
@@ -763,6 +841,8 @@ func (e *Editor) drawGrid(cells []terminal.Cell) {
 		}
 	}
 
+	e.drawMetrics(cells, startX+2, startY+2+GridRows)
+
 	// Hex values on right side
 	hexX := startX + boxW + 1
 	for r := range GridRows {
@@ -799,28 +879,35 @@ func (e *Editor) drawPreview(cells []terminal.Cell) {
 	}
 	e.drawText(cells, startX+2, previewY, displayText, ColorDim, ColorBg, 0)
 
-	// Calculate how many chars fit per row
+	// Glyphs advance by their metrics, wrapping when the next advance would overflow
 	pAreaX := startX + 2
 	pAreaW := boxW - 4
-	charsPerRow := max(1, pAreaW/(GridCols+1))
 
 	// Render preview glyphs in wrapped rows
 	charIdx := 0
 	glyphRowStart := startY + 2
 
 	for rowNum := 0; rowNum < 2 && charIdx < len(e.previewText); rowNum++ {
-		renderX := pAreaX
+		pen := pAreaX
 		pAreaY := glyphRowStart + (rowNum * 7) // 6 screen rows per glyph row + 1 spacing
 
-		for col := 0; col < charsPerRow && charIdx < len(e.previewText); col++ {
+		for charIdx < len(e.previewText) {
 			r := rune(e.previewText[charIdx])
+			m, ok := e.metrics[r]
+			if !ok {
+				m = asset.GlyphMetrics{Advance: GridCols}
+			}
+			if pen > pAreaX && pen+int(m.Advance) > pAreaX+pAreaW {
+				break
+			}
 			charIdx++
 
 			glyph, ok := e.glyphs[r]
 			if !ok {
-				renderX += GridCols + 1
+				pen += int(m.Advance)
 				continue
 			}
+			renderX := pen + int(m.Bearing)
 
 			// Draw using half-block characters (2 glyph rows per screen row)
 			for y := 0; y < GridRows && y/2 < 6; y += 2 {
@@ -830,13 +917,16 @@ func (e *Editor) drawPreview(cells []terminal.Cell) {
 				}
 
 				for x := range GridCols {
-					if renderX+x >= pAreaX+pAreaW {
-						break
+					if renderX+x < pAreaX || renderX+x >= pAreaX+pAreaW {
+						continue
 					}
 
 					mask := uint16(1) << (15 - x)
 					top := (glyph[y] & mask) != 0
 					bot := y+1 < GridRows && (glyph[y+1]&mask) != 0
+					if !top && !bot {
+						continue // Overlapping neighbors keep their ink
+					}
 
 					fg := ColorPixelOn
 					if r == e.current {
@@ -849,16 +939,14 @@ func (e *Editor) drawPreview(cells []terminal.Cell) {
 						cell.Rune = BlockFull
 					case top:
 						cell.Rune = BlockUpper
-					case bot:
-						cell.Rune = BlockLower
 					default:
-						cell.Rune = ' '
+						cell.Rune = BlockLower
 					}
 
 					e.setCell(cells, renderX+x, screenY, cell)
 				}
 			}
-			renderX += GridCols + 1
+			pen += int(m.Advance)
 		}
 	}
 }
@@ -899,7 +987,7 @@ func (e *Editor) drawCharSelector(cells []terminal.Cell) {
 	startX := 2
 	startY := 20
 	boxW := 46
-	boxH := e.height - startY - 5
+	boxH := e.height - startY - 6
 	if boxH < 4 {
 		return
 	}
@@ -934,7 +1022,7 @@ func (e *Editor) drawCharSelector(cells []terminal.Cell) {
 }
 
 func (e *Editor) drawHelp(cells []terminal.Cell) {
-	y := e.height - 5
+	y := e.height - 6
 	if y < 0 {
 		return
 	}
@@ -942,8 +1030,9 @@ func (e *Editor) drawHelp(cells []terminal.Cell) {
 	help := []string{
 		"MoveEntity: WASD/HJKL/Arrows  │  Toggle: SPACE  │  Set: o/ENTER  │  Clear: x/DEL  │  Char: [/]",
 		"Shift: <>/^v  │  Flip: |/_  │  Clear: c  │  Invert: i  │  Reset: r  │  Glyph: Y=copy p=paste",
-		"Row: X=clear F=fill R=yank P=paste O=ins↑ N=ins↓ Z=del  │  Preview: t  │  Jump: /",
-		"Export: y (char) E (all)  │  Quit: q/ESC",
+		"Row: X=clear F=fill R=yank P=paste O=ins↑ N=ins↓ Z=del  │  Preview: t  │  Metrics: ()=bearing {}=advance M=fit",
+		"Tool: T=cycle pen/line/rect/ellipse/curve/fill  │  ENTER=anchor, then draw  │  DEL=erase shape  │  ESC=cancel",
+		"Export: y (char) E (all)  │  Import: I=png/font path  +/-=threshold  │  Quit: q/ESC",
 	}
 
 	for i, h := range help {
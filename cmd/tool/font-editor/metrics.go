@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset"
+)
+
+// Metric editing limits; the bitmap may be pulled fully left of the pen or pushed one width right
+const (
+	MinBearing = -GridCols
+	MaxBearing = GridCols
+	MinAdvance = 1
+	MaxAdvance = 2 * GridCols
+	FitSpacing = 1 // Blank columns kept right of the ink when fitting
+)
+
+// Metric ruler colors
+var (
+	ColorAdvance = ColorAnchor
+	ColorInk     = ColorPreview
+)
+
+// adjustBearing shifts the current glyph's bitmap relative to the pen
+func (e *Editor) adjustBearing(delta int) {
+	m := e.metrics[e.current]
+	m.Bearing = int8(max(MinBearing, min(MaxBearing, int(m.Bearing)+delta)))
+	e.metrics[e.current] = m
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Bearing %d", m.Bearing), 0)
+}
+
+// adjustAdvance widens or narrows the pen step after the current glyph
+func (e *Editor) adjustAdvance(delta int) {
+	m := e.metrics[e.current]
+	m.Advance = int8(max(MinAdvance, min(MaxAdvance, int(m.Advance)+delta)))
+	e.metrics[e.current] = m
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Advance %d", m.Advance), 0)
+}
+
+// fitMetrics sets the metrics to the ink's horizontal extent, starting at the pen with
+// FitSpacing columns after it; a blank glyph keeps its bearing and gets half a cell
+func (e *Editor) fitMetrics() {
+	var ink uint16
+	for _, row := range e.glyphs[e.current] {
+		ink |= row
+	}
+
+	var m asset.GlyphMetrics
+	if ink == 0 {
+		m = asset.GlyphMetrics{Bearing: e.metrics[e.current].Bearing, Advance: GridCols / 2}
+	} else {
+		left := bits.LeadingZeros16(ink)
+		right := 15 - bits.TrailingZeros16(ink)
+		m = asset.GlyphMetrics{Bearing: int8(-left), Advance: int8(right - left + 1 + FitSpacing)}
+	}
+	e.metrics[e.current] = m
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Fit: bearing %d advance %d", m.Bearing, m.Advance), 1)
+}
+
+// metricsCode renders SplashFontMetrics with only the entries that differ from monospaced
+func (e *Editor) metricsCode() string {
+	var buf bytes.Buffer
+	buf.WriteString("var SplashFontMetrics = [95]GlyphMetrics{\n")
+	for i := range 95 {
+		r := rune(MinChar + i)
+		m := e.metrics[r]
+		if m.Bearing == 0 && int(m.Advance) == asset.SplashGlyphWidth {
+			continue
+		}
+		fmt.Fprintf(&buf, "\t0x%02X - 32: {Bearing: %d, Advance: %d}, // '%c'\n", r, m.Bearing, m.Advance, r)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// drawMetrics draws a ruler under the grid: the advance span from the pen, ink columns, and values
+func (e *Editor) drawMetrics(cells []terminal.Cell, gridX, y int) {
+	m := e.metrics[e.current]
+	var ink uint16
+	for _, row := range e.glyphs[e.current] {
+		ink |= row
+	}
+
+	// Pen and advance end in bitmap columns; either may fall outside the grid
+	pen := -int(m.Bearing)
+	end := pen + int(m.Advance)
+	for c := range GridCols {
+		cell := terminal.Cell{Rune: ' ', Bg: ColorBg}
+		if c >= pen && c < end {
+			cell = terminal.Cell{Rune: BoxHorizontal, Fg: ColorAdvance, Bg: ColorBg}
+		}
+		if ink&(1<<(15-c)) != 0 {
+			cell.Fg = ColorInk
+		}
+		e.setCell(cells, gridX+c*2, y, cell)
+		e.setCell(cells, gridX+c*2+1, y, cell)
+	}
+	if pen >= 0 && pen < GridCols {
+		e.setCell(cells, gridX+pen*2, y, terminal.Cell{Rune: '├', Fg: ColorAdvance, Bg: ColorBg})
+	}
+	if end > 0 && end <= GridCols {
+		e.setCell(cells, gridX+end*2-1, y, terminal.Cell{Rune: '┤', Fg: ColorAdvance, Bg: ColorBg})
+	}
+
+	e.drawText(cells, gridX+GridCols*2+3, y, fmt.Sprintf("B%+d A%d", m.Bearing, m.Advance), ColorDim, ColorBg, 0)
+}
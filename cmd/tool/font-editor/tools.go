@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Tool is the active drawing tool; pen is plain pixel editing
+type Tool int
+
+const (
+	ToolPen Tool = iota
+	ToolLine
+	ToolRect
+	ToolEllipse
+	ToolCurve
+	ToolFill
+	toolCount
+)
+
+var toolNames = [toolCount]string{"pen", "line", "rect", "ellipse", "curve", "fill"}
+
+// toolAnchors is how many anchors a tool takes before the cursor completes the shape
+// Curve anchors are start and end, the cursor is the control point
+var toolAnchors = [toolCount]int{ToolLine: 1, ToolRect: 1, ToolEllipse: 1, ToolCurve: 2}
+
+// curveSteps is the sample count along a quadratic curve, consecutive samples are joined by lines
+const curveSteps = 32
+
+// cycleTool switches to the next tool and drops any placed anchors
+func (e *Editor) cycleTool() {
+	e.tool = (e.tool + 1) % toolCount
+	e.anchorCount = 0
+	e.setStatus(fmt.Sprintf("Tool: %s", toolNames[e.tool]), 0)
+}
+
+// shapeReady reports whether all anchors are down, so the cursor completes a previewable shape
+func (e *Editor) shapeReady() bool {
+	return e.tool != ToolPen && e.anchorCount == toolAnchors[e.tool]
+}
+
+// toolEnter places the next anchor, or draws the shape once all anchors are down
+func (e *Editor) toolEnter() {
+	if !e.shapeReady() {
+		e.anchors[e.anchorCount] = core.Point{X: e.cursorX, Y: e.cursorY}
+		e.anchorCount++
+		e.setStatus(fmt.Sprintf("%s: anchor %d at %X,%X", toolNames[e.tool], e.anchorCount, e.cursorX, e.cursorY), 0)
+		return
+	}
+	e.commitShape(true)
+}
+
+// toolCancel drops placed anchors, or returns to the pen when none are placed
+func (e *Editor) toolCancel() {
+	if e.anchorCount > 0 {
+		e.anchorCount = 0
+		e.setStatus(fmt.Sprintf("%s: cancelled", toolNames[e.tool]), 0)
+		return
+	}
+	e.tool = ToolPen
+	e.setStatus("Tool: pen", 0)
+}
+
+// commitShape sets or clears the previewed shape's pixels and drops the anchors
+func (e *Editor) commitShape(on bool) {
+	mask := e.shapeMask()
+	g := e.glyphs[e.current]
+	for r := range GridRows {
+		if on {
+			g[r] |= mask[r]
+		} else {
+			g[r] &^= mask[r]
+		}
+	}
+	e.glyphs[e.current] = g
+	e.modified = true
+	e.anchorCount = 0
+
+	verb := "Drew"
+	if !on {
+		verb = "Erased"
+	}
+	e.setStatus(fmt.Sprintf("%s %s", verb, toolNames[e.tool]), 1)
+}
+
+// shapeMask returns the pixels the current tool would touch, in glyph row layout
+func (e *Editor) shapeMask() [GridRows]uint16 {
+	var mask [GridRows]uint16
+	cursor := core.Point{X: e.cursorX, Y: e.cursorY}
+
+	switch e.tool {
+	case ToolLine:
+		plotLine(&mask, e.anchors[0], cursor)
+	case ToolRect:
+		x0, y0 := min(e.anchors[0].X, cursor.X), min(e.anchors[0].Y, cursor.Y)
+		x1, y1 := max(e.anchors[0].X, cursor.X), max(e.anchors[0].Y, cursor.Y)
+		plotLine(&mask, core.Point{X: x0, Y: y0}, core.Point{X: x1, Y: y0})
+		plotLine(&mask, core.Point{X: x0, Y: y1}, core.Point{X: x1, Y: y1})
+		plotLine(&mask, core.Point{X: x0, Y: y0}, core.Point{X: x0, Y: y1})
+		plotLine(&mask, core.Point{X: x1, Y: y0}, core.Point{X: x1, Y: y1})
+	case ToolEllipse:
+		plotEllipse(&mask, e.anchors[0], cursor)
+	case ToolCurve:
+		plotCurve(&mask, e.anchors[0], e.anchors[1], cursor)
+	case ToolFill:
+		e.floodMask(&mask, cursor)
+	}
+	return mask
+}
+
+// plot sets one pixel, ignoring points outside the grid
+func plot(mask *[GridRows]uint16, x, y int) {
+	if x < 0 || x >= GridCols || y < 0 || y >= GridRows {
+		return
+	}
+	mask[y] |= 1 << (15 - x)
+}
+
+// plotLine draws a Bresenham line between two grid points, both ends included
+func plotLine(mask *[GridRows]uint16, a, b core.Point) {
+	dx, dy := vmath.IntAbs(b.X-a.X), -vmath.IntAbs(b.Y-a.Y)
+	sx, sy := step(a.X, b.X), step(a.Y, b.Y)
+	err := dx + dy
+	x, y := a.X, a.Y
+	for {
+		plot(mask, x, y)
+		if x == b.X && y == b.Y {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y += sy
+		}
+	}
+}
+
+// step is the unit direction from a toward b along one axis
+func step(a, b int) int {
+	switch {
+	case b > a:
+		return 1
+	case b < a:
+		return -1
+	}
+	return 0
+}
+
+// plotEllipse draws the outline of the ellipse inscribed in the box spanned by two corners
+// Cells whose centers fall inside are the disc; those with a 4-neighbor outside form the outline
+func plotEllipse(mask *[GridRows]uint16, a, b core.Point) {
+	x0, y0 := min(a.X, b.X), min(a.Y, b.Y)
+	x1, y1 := max(a.X, b.X), max(a.Y, b.Y)
+	cx, cy := float64(x0+x1)/2, float64(y0+y1)/2
+	rx, ry := float64(x1-x0)/2+0.5, float64(y1-y0)/2+0.5
+
+	inside := func(x, y int) bool {
+		nx, ny := (float64(x)-cx)/rx, (float64(y)-cy)/ry
+		return nx*nx+ny*ny <= 1
+	}
+	for y := y0; y <= y1; y++ {
+		for x := x0; x <= x1; x++ {
+			if inside(x, y) && (!inside(x-1, y) || !inside(x+1, y) || !inside(x, y-1) || !inside(x, y+1)) {
+				plot(mask, x, y)
+			}
+		}
+	}
+}
+
+// plotCurve draws a quadratic Bézier from start to end bent toward the control point
+func plotCurve(mask *[GridRows]uint16, start, end, control core.Point) {
+	prev := start
+	for i := 1; i <= curveSteps; i++ {
+		t := float64(i) / curveSteps
+		u := 1 - t
+		next := core.Point{
+			X: int(math.Round(u*u*float64(start.X) + 2*u*t*float64(control.X) + t*t*float64(end.X))),
+			Y: int(math.Round(u*u*float64(start.Y) + 2*u*t*float64(control.Y) + t*t*float64(end.Y))),
+		}
+		plotLine(mask, prev, next)
+		prev = next
+	}
+}
+
+// floodMask marks the 4-connected region of pixels sharing the value at from
+func (e *Editor) floodMask(mask *[GridRows]uint16, from core.Point) {
+	want := e.getBit(from.Y, from.X)
+	stack := []core.Point{from}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if p.X < 0 || p.X >= GridCols || p.Y < 0 || p.Y >= GridRows {
+			continue
+		}
+		bit := uint16(1) << (15 - p.X)
+		if mask[p.Y]&bit != 0 || e.getBit(p.Y, p.X) != want {
+			continue
+		}
+		mask[p.Y] |= bit
+		stack = append(stack,
+			core.Point{X: p.X + 1, Y: p.Y}, core.Point{X: p.X - 1, Y: p.Y},
+			core.Point{X: p.X, Y: p.Y + 1}, core.Point{X: p.X, Y: p.Y - 1})
+	}
+}
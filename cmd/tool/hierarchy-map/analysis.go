@@ -9,7 +9,8 @@ import (
 )
 
 // AnalyzeFileDependencies parses a Go file to extract symbol usage from imports.
-func AnalyzeFileDependencies(path string, modPath string) (*DependencyAnalysis, error) {
+// Symbols are recorded for imports of any indexed module.
+func AnalyzeFileDependencies(path string, index *Index) (*DependencyAnalysis, error) {
 	fset := token.NewFileSet()
 	// Parse full file (not ImportsOnly) to traverse AST
 	node, err := parser.ParseFile(fset, path, nil, 0)
@@ -61,7 +62,7 @@ func AnalyzeFileDependencies(path string, modPath string) (*DependencyAnalysis,
 
 					// Only record symbols for internal modules if requested,
 					// but requirement is to show symbols for local repo.
-					if strings.HasPrefix(importPath, modPath) {
+					if _, ok := index.ImportDir(importPath); ok {
 						analysis.UsedSymbols[importPath] = appendUnique(analysis.UsedSymbols[importPath], symbol)
 					}
 					// For external libs, we don't record symbols to save space/noise,
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/terminal/tui"
 )
@@ -46,6 +46,8 @@ type EditorState struct {
 	FileState *tui.TreeState
 
 	RawInput *tui.TextFieldState
+
+	Preview *TagPreview // Dry-run diff, nil when not previewing
 }
 
 type EditorTagNode struct {
@@ -450,11 +452,20 @@ func (app *AppState) handleEditorEvent(ev terminal.Event) bool {
 		return false
 	}
 
+	if e.Preview != nil {
+		app.handleTagPreviewEvent(ev)
+		return true
+	}
+
 	switch ev.Key {
 	case terminal.KeyEscape:
 		app.CloseEditor()
 		return true
 
+	case terminal.KeyCtrlP:
+		app.openTagPreview()
+		return true
+
 	case terminal.KeyCtrlS:
 		if e.Dirty() {
 			app.executeEditorSave() // Direct save, no confirmation
@@ -920,26 +931,50 @@ func (app *AppState) executeEditorSave() {
 		return
 	}
 
-	modified := 0
-	for _, path := range e.SelectedFiles {
-		if app.applyTagChangesToFile(path, e.Additions, e.Deletions) {
-			modified++
-		}
+	changes, err := app.planTagChanges(e.Additions, e.Deletions)
+	if err == nil {
+		err = writeTagChanges(changes)
+	}
+	if err != nil {
+		app.Message = fmt.Sprintf("save failed, no files written: %v", err)
+		return
 	}
 
 	app.ReindexAll()
-	app.Message = fmt.Sprintf("modified %d files", modified)
+	app.Message = fmt.Sprintf("modified %d files", len(changes))
 	app.CloseEditor()
 }
 
-func (app *AppState) applyTagChangesToFile(path string, additions []TagRef, deletions []TagDeletion) bool {
+// tagFileChange is the rewritten content of one file with its hierarchy line before and after
+type tagFileChange struct {
+	Path    string
+	OldLine string // Empty when the file had no hierarchy line
+	NewLine string // Empty when the hierarchy line is removed
+	Content []byte
+}
+
+// planTagChanges computes rewrites for all selected files without touching disk, unchanged files are omitted
+func (app *AppState) planTagChanges(additions []TagRef, deletions []TagDeletion) ([]tagFileChange, error) {
+	var changes []tagFileChange
+	for _, path := range app.Editor.SelectedFiles {
+		change, err := app.computeTagChange(path, additions, deletions)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	return changes, nil
+}
+
+// computeTagChange applies additions and deletions to one file's tags, nil when the file is unchanged
+func (app *AppState) computeTagChange(path string, additions []TagRef, deletions []TagDeletion) (*tagFileChange, error) {
 	content, err := os.ReadFile(path)
 	if err != nil {
-		return false
+		return nil, err
 	}
 
-	hasTrailingNewline := len(content) > 0 && content[len(content)-1] == '\n'
-
 	lines := strings.Split(string(content), "\n")
 	var hierarchyLineIdx = -1
 	var packageLineIdx = -1
@@ -972,17 +1007,9 @@ func (app *AppState) applyTagChangesToFile(path string, additions []TagRef, dele
 
 	// Apply deletions - check if this file is in deletion scope
 	for _, del := range deletions {
-		found := false
-		for _, f := range del.Files {
-			if f == path {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if !slices.Contains(del.Files, path) {
 			continue
 		}
-
 		app.deleteRefFromTags(tags, del.TagRef)
 	}
 
@@ -995,15 +1022,7 @@ func (app *AppState) applyTagChangesToFile(path string, additions []TagRef, dele
 			tags[add.Category][add.Group] = make(map[string][]string)
 		}
 		if add.Tag != "" {
-			// Check if tag already exists
-			found := false
-			for _, t := range tags[add.Category][add.Group][add.Module] {
-				if t == add.Tag {
-					found = true
-					break
-				}
-			}
-			if !found {
+			if !slices.Contains(tags[add.Category][add.Group][add.Module], add.Tag) {
 				tags[add.Category][add.Group][add.Module] = append(
 					tags[add.Category][add.Group][add.Module], add.Tag)
 			}
@@ -1018,6 +1037,14 @@ func (app *AppState) applyTagChangesToFile(path string, additions []TagRef, dele
 	// Serialize
 	hierarchyLine := serializeTags(tags)
 
+	change := &tagFileChange{Path: path, NewLine: hierarchyLine}
+	if hierarchyLineIdx >= 0 {
+		change.OldLine = lines[hierarchyLineIdx]
+	}
+	if change.OldLine == change.NewLine {
+		return nil, nil
+	}
+
 	// Update or insert hierarchy line
 	if hierarchyLine == "" {
 		if hierarchyLineIdx >= 0 {
@@ -1028,32 +1055,151 @@ func (app *AppState) applyTagChangesToFile(path string, additions []TagRef, dele
 	} else if packageLineIdx >= 0 {
 		// Insert AFTER package line
 		lines = slices.Insert(lines, packageLineIdx+1, hierarchyLine)
+	} else {
+		return nil, fmt.Errorf("%s: no package clause", path)
+	}
+
+	// Split leaves a trailing empty element for a final newline, Join restores it
+	change.Content = []byte(strings.Join(lines, "\n"))
+	return change, nil
+}
+
+// writeTagChanges writes every file to a temp sibling first and renames only once all writes
+// succeed, so a failure leaves the tree untouched rather than half-edited
+func writeTagChanges(changes []tagFileChange) error {
+	tmpPaths := make([]string, 0, len(changes))
+	cleanup := func() {
+		for _, tmp := range tmpPaths {
+			os.Remove(tmp)
+		}
+	}
+
+	for _, c := range changes {
+		mode := os.FileMode(0644)
+		if info, err := os.Stat(c.Path); err == nil {
+			mode = info.Mode().Perm()
+		}
+		tmp := c.Path + ".tmp"
+		if err := os.WriteFile(tmp, c.Content, mode); err != nil {
+			os.Remove(tmp)
+			cleanup()
+			return err
+		}
+		tmpPaths = append(tmpPaths, tmp)
 	}
 
-	// Write back
-	tmpPath := path + ".tmp"
-	f, err := os.Create(tmpPath)
+	for i, c := range changes {
+		if err := os.Rename(tmpPaths[i], c.Path); err != nil {
+			cleanup()
+			return fmt.Errorf("%s: %w (%d of %d files written)", c.Path, err, i, len(changes))
+		}
+	}
+	return nil
+}
+
+// --- Dry-run preview ---
+
+// TagPreview is a read-only diff of the pending save
+type TagPreview struct {
+	Changes []tagFileChange
+	Scroll  int
+}
+
+// openTagPreview computes the pending rewrites for display without writing
+func (app *AppState) openTagPreview() {
+	e := app.Editor
+	if !e.Dirty() {
+		app.Message = "no pending changes"
+		return
+	}
+	changes, err := app.planTagChanges(e.Additions, e.Deletions)
 	if err != nil {
-		return false
+		app.Message = fmt.Sprintf("preview failed: %v", err)
+		return
 	}
+	e.Preview = &TagPreview{Changes: changes}
+}
 
-	w := bufio.NewWriter(f)
-	for i, line := range lines {
-		w.WriteString(line)
-		// Always add newline between lines, final newline based on original
-		if i < len(lines)-1 || hasTrailingNewline {
-			w.WriteByte('\n')
+// previewRow is one rendered line of the preview
+type previewRow struct {
+	Text string
+	Fg   color.RGB
+	Attr terminal.Attr
+}
+
+// previewLines flattens the preview into rows: file header, removed line, added line
+func (app *AppState) previewLines(p *TagPreview) []previewRow {
+	var rows []previewRow
+	for _, c := range p.Changes {
+		rows = append(rows, previewRow{c.Path, app.Theme.FileFg, terminal.AttrBold})
+		if c.OldLine != "" {
+			rows = append(rows, previewRow{"- " + strings.TrimSpace(c.OldLine), app.Theme.Error, terminal.AttrNone})
+		}
+		if c.NewLine != "" {
+			rows = append(rows, previewRow{"+ " + c.NewLine, app.Theme.Selected, terminal.AttrNone})
 		}
+		rows = append(rows, previewRow{})
 	}
-	w.Flush()
-	f.Close()
+	return rows
+}
 
-	if err := os.Rename(tmpPath, path); err != nil {
-		os.Remove(tmpPath)
-		return false
+func (app *AppState) renderTagPreview(r tui.Region) {
+	p := app.Editor.Preview
+
+	content := r.Modal(tui.ModalOpts{
+		Title:    fmt.Sprintf("DRY RUN: %d of %d files change", len(p.Changes), len(app.Editor.SelectedFiles)),
+		Hint:     "Ctrl+S/Enter:write  Esc/q:back",
+		Border:   tui.LineDouble,
+		BorderFg: app.Theme.Border,
+		TitleFg:  app.Theme.HeaderFg,
+		HintFg:   app.Theme.StatusFg,
+		Bg:       app.Theme.Bg,
+	})
+
+	if len(p.Changes) == 0 {
+		content.TextCenter(content.H/2, "(pending edits leave all files unchanged)", app.Theme.StatusFg, app.Theme.Bg, terminal.AttrNone)
+		return
 	}
 
-	return true
+	rows := app.previewLines(p)
+	for y := 0; y < content.H && p.Scroll+y < len(rows); y++ {
+		row := rows[p.Scroll+y]
+		content.Text(1, y, tui.Truncate(row.Text, content.W-2), row.Fg, app.Theme.Bg, row.Attr)
+	}
+	if len(rows) > content.H {
+		content.ScrollBar(content.W-1, p.Scroll, content.H, len(rows), app.Theme.Border)
+	}
+}
+
+func (app *AppState) handleTagPreviewEvent(ev terminal.Event) {
+	p := app.Editor.Preview
+	page := max(1, app.Height-2)
+	maxScroll := max(0, len(app.previewLines(p))-page)
+
+	switch ev.Key {
+	case terminal.KeyEscape:
+		app.Editor.Preview = nil
+	case terminal.KeyCtrlS, terminal.KeyEnter:
+		app.Editor.Preview = nil
+		app.executeEditorSave()
+	case terminal.KeyUp:
+		p.Scroll = max(0, p.Scroll-1)
+	case terminal.KeyDown:
+		p.Scroll = min(maxScroll, p.Scroll+1)
+	case terminal.KeyPageUp:
+		p.Scroll = max(0, p.Scroll-page)
+	case terminal.KeyPageDown:
+		p.Scroll = min(maxScroll, p.Scroll+page)
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q':
+			app.Editor.Preview = nil
+		case 'j':
+			p.Scroll = min(maxScroll, p.Scroll+1)
+		case 'k':
+			p.Scroll = max(0, p.Scroll-1)
+		}
+	}
 }
 
 // deleteRefFromTags removes content at the ref's hierarchy level
@@ -1226,8 +1372,13 @@ func (app *AppState) renderEditor(r tui.Region) {
 		return
 	}
 
+	if e.Preview != nil {
+		app.renderTagPreview(r)
+		return
+	}
+
 	title := fmt.Sprintf("TAG EDITOR (%d files)", len(e.SelectedFiles))
-	hint := "Tab:pane  Space:delete  Ctrl+S:save  Esc:cancel"
+	hint := "Tab:pane  Space:delete  Ctrl+P:preview  Ctrl+S:save  Esc:cancel"
 
 	content := r.Modal(tui.ModalOpts{
 		Title:    title,
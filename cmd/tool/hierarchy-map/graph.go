@@ -0,0 +1,489 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/terminal/tui"
+)
+
+// Graph zoom levels, higher shows longer labels with wider spacing
+const (
+	GraphZoomCompact = iota // Base name cut to graphCompactLen
+	GraphZoomName           // Package base name
+	GraphZoomPath           // Full directory path
+	graphZoomCount
+)
+
+const graphCompactLen = 6
+
+// Edge line directions, combined per cell to pick box-drawing runes
+const (
+	lineUp uint8 = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+var lineRunes = [16]rune{
+	' ', '│', '│', '│',
+	'─', '┘', '┐', '┤',
+	'─', '└', '┌', '├',
+	'─', '┴', '┬', '┼',
+}
+
+// GraphMark classifies a package against the current output set
+type GraphMark uint8
+
+const (
+	GraphMarkNone     GraphMark = iota
+	GraphMarkSelected           // Has directly selected files
+	GraphMarkExpanded           // Pulled in only by dependency expansion
+)
+
+// graphNode is a placed package box
+type graphNode struct {
+	Dir   string
+	Label string
+	Layer int // Longest local import chain below this package
+	X, Y  int // Label position on the canvas
+	Mark  GraphMark
+}
+
+func (n *graphNode) center() int {
+	return n.X + tui.RuneLen(n.Label)/2
+}
+
+// graphCell is one canvas cell of edge lines
+type graphCell struct {
+	Lines uint8
+	Hot   bool // On an edge into an expanded package
+	Arrow bool
+}
+
+// graphLayout is a layered rendering of the package import graph: importers above their imports
+type graphLayout struct {
+	Nodes []*graphNode
+	byDir map[string]*graphNode
+	W, H  int
+	Cells []graphCell
+}
+
+func (g *graphLayout) cell(x, y int) *graphCell {
+	if x < 0 || x >= g.W || y < 0 || y >= g.H {
+		return nil
+	}
+	return &g.Cells[y*g.W+x]
+}
+
+// link joins a straight run of cells, each cell gets the direction toward its neighbor
+func (g *graphLayout) link(x0, y0, x1, y1 int, hot bool) {
+	dx, dy := sign(x1-x0), sign(y1-y0)
+	var fwd, back uint8
+	switch {
+	case dx > 0:
+		fwd, back = lineRight, lineLeft
+	case dx < 0:
+		fwd, back = lineLeft, lineRight
+	case dy > 0:
+		fwd, back = lineDown, lineUp
+	case dy < 0:
+		fwd, back = lineUp, lineDown
+	default:
+		return
+	}
+	for x, y := x0, y0; x != x1 || y != y1; x, y = x+dx, y+dy {
+		if c := g.cell(x, y); c != nil {
+			c.Lines |= fwd
+			c.Hot = c.Hot || hot
+		}
+		if c := g.cell(x+dx, y+dy); c != nil {
+			c.Lines |= back
+			c.Hot = c.Hot || hot
+		}
+	}
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// graphLabel shortens a package directory for the zoom level
+func graphLabel(dir string, zoom int) string {
+	switch zoom {
+	case GraphZoomPath:
+		return dir
+	case GraphZoomName:
+		return filepath.Base(dir)
+	default:
+		name := filepath.Base(dir)
+		if tui.RuneLen(name) > graphCompactLen {
+			name = string([]rune(name)[:graphCompactLen])
+		}
+		return name
+	}
+}
+
+// buildGraphLayout places packages in layers by longest import chain, orders each layer
+// by the mean position of its imports to cut crossings, then routes edges orthogonally
+// through a channel row per importing package below its layer
+func buildGraphLayout(index *Index, marks map[string]GraphMark, outputOnly bool, zoom int) *graphLayout {
+	g := &graphLayout{byDir: make(map[string]*graphNode)}
+
+	for dir := range index.Packages {
+		if outputOnly && marks[dir] == GraphMarkNone {
+			continue
+		}
+		n := &graphNode{Dir: dir, Label: graphLabel(dir, zoom), Layer: -1, Mark: marks[dir]}
+		g.Nodes = append(g.Nodes, n)
+		g.byDir[dir] = n
+	}
+	if len(g.Nodes) == 0 {
+		return g
+	}
+
+	deps := func(n *graphNode) []*graphNode {
+		var out []*graphNode
+		for _, d := range index.Packages[n.Dir].LocalDeps {
+			if t := g.byDir[d]; t != nil && t != n {
+				out = append(out, t)
+			}
+		}
+		return out
+	}
+
+	// Longest path to a leaf; in-progress marker guards against cycles through test packages
+	const visiting = -2
+	var layerOf func(n *graphNode) int
+	layerOf = func(n *graphNode) int {
+		switch n.Layer {
+		case visiting:
+			return 0
+		case -1:
+		default:
+			return n.Layer
+		}
+		n.Layer = visiting
+		layer := 0
+		for _, d := range deps(n) {
+			layer = max(layer, layerOf(d)+1)
+		}
+		n.Layer = layer
+		return layer
+	}
+	maxLayer := 0
+	for _, n := range g.Nodes {
+		maxLayer = max(maxLayer, layerOf(n))
+	}
+
+	rows := make([][]*graphNode, maxLayer+1)
+	for _, n := range g.Nodes {
+		rows[n.Layer] = append(rows[n.Layer], n)
+	}
+
+	// Bottom layer alphabetical, each layer above by barycenter of its imports
+	pos := make(map[*graphNode]float64)
+	for layer, row := range rows {
+		if layer == 0 {
+			sort.Slice(row, func(i, j int) bool { return row[i].Dir < row[j].Dir })
+		} else {
+			bary := make(map[*graphNode]float64, len(row))
+			for _, n := range row {
+				sum, cnt := 0.0, 0
+				for _, d := range deps(n) {
+					sum += pos[d]
+					cnt++
+				}
+				if cnt > 0 {
+					bary[n] = sum / float64(cnt)
+				}
+			}
+			sort.SliceStable(row, func(i, j int) bool {
+				if bary[row[i]] != bary[row[j]] {
+					return bary[row[i]] < bary[row[j]]
+				}
+				return row[i].Dir < row[j].Dir
+			})
+		}
+		for i, n := range row {
+			pos[n] = (float64(i) + 0.5) / float64(len(row))
+		}
+	}
+
+	// Horizontal placement, each layer centered on the widest
+	gap := 1 + zoom
+	widths := make([]int, len(rows))
+	for layer, row := range rows {
+		for i, n := range row {
+			if i > 0 {
+				widths[layer] += gap
+			}
+			widths[layer] += tui.RuneLen(n.Label)
+		}
+		g.W = max(g.W, widths[layer])
+	}
+
+	// Vertical placement, top layer first: arrow row, label row, one channel row per importer
+	channel := make(map[*graphNode]int)
+	y := 0
+	for layer := maxLayer; layer >= 0; layer-- {
+		row := rows[layer]
+		x := (g.W - widths[layer]) / 2
+		ch := 0
+		for _, n := range row {
+			n.X, n.Y = x, y+1
+			x += tui.RuneLen(n.Label) + gap
+			if len(deps(n)) > 0 {
+				ch++
+				channel[n] = y + 1 + ch
+			}
+		}
+		y += 2 + ch
+	}
+	g.H = y
+	g.Cells = make([]graphCell, g.W*g.H)
+
+	// Route: down from the importer to its channel, across, down to an arrow above the import
+	for _, n := range g.Nodes {
+		for _, d := range deps(n) {
+			hot := n.Mark != GraphMarkNone && d.Mark == GraphMarkExpanded
+			xs, xt, chY := n.center(), d.center(), channel[n]
+			if c := g.cell(xs, n.Y+1); c != nil {
+				c.Lines |= lineUp
+			}
+			g.link(xs, n.Y+1, xs, chY, hot)
+			g.link(xs, chY, xt, chY, hot)
+			g.link(xt, chY, xt, d.Y-1, hot)
+			if c := g.cell(xt, d.Y-1); c != nil {
+				c.Arrow = true
+			}
+		}
+	}
+
+	return g
+}
+
+// --- Graph overlay ---
+
+// GraphState manages the dependency graph overlay
+type GraphState struct {
+	Visible    bool
+	ScrollX    int
+	ScrollY    int
+	Zoom       int
+	OutputOnly bool // Show only packages in the output set
+	Layout     *graphLayout
+}
+
+// ToggleGraph opens the graph centered on the current package, or closes it
+func (app *AppState) ToggleGraph() {
+	if app.Graph != nil && app.Graph.Visible {
+		app.Graph.Visible = false
+		return
+	}
+	if app.Graph == nil {
+		app.Graph = &GraphState{Zoom: GraphZoomName}
+	}
+	app.Graph.Visible = true
+	app.rebuildGraph()
+	app.centerGraphOnCurrent()
+}
+
+// graphMarks classifies every package with output files as selected or expansion-only
+func (app *AppState) graphMarks() map[string]GraphMark {
+	marks := make(map[string]GraphMark)
+	if app.ExpandDeps && len(app.Selected) > 0 {
+		for path := range app.computeDepExpandedFiles() {
+			marks[filepath.ToSlash(filepath.Dir(path))] = GraphMarkExpanded
+		}
+	}
+	for path := range app.Selected {
+		marks[filepath.ToSlash(filepath.Dir(path))] = GraphMarkSelected
+	}
+	return marks
+}
+
+func (app *AppState) rebuildGraph() {
+	gs := app.Graph
+	gs.Layout = buildGraphLayout(app.Index, app.graphMarks(), gs.OutputOnly, gs.Zoom)
+	app.clampGraphScroll()
+}
+
+// graphViewport returns the canvas area inside the modal and legend
+func (app *AppState) graphViewport() (w, h int) {
+	return max(1, app.Width-2), max(1, app.Height-3)
+}
+
+func (app *AppState) clampGraphScroll() {
+	gs := app.Graph
+	vw, vh := app.graphViewport()
+	gs.ScrollX = max(0, min(gs.ScrollX, gs.Layout.W-vw))
+	gs.ScrollY = max(0, min(gs.ScrollY, gs.Layout.H-vh))
+}
+
+// centerGraphOnCurrent scrolls the tree cursor's package into the middle of the view
+func (app *AppState) centerGraphOnCurrent() {
+	gs := app.Graph
+	n := gs.Layout.byDir[app.getCurrentFilePackageDir()]
+	if n == nil {
+		return
+	}
+	vw, vh := app.graphViewport()
+	gs.ScrollX = n.center() - vw/2
+	gs.ScrollY = n.Y - vh/2
+	app.clampGraphScroll()
+}
+
+func (app *AppState) renderGraph(r tui.Region) {
+	gs := app.Graph
+	g := gs.Layout
+
+	mode := "all packages"
+	if gs.OutputOnly {
+		mode = "output only"
+	}
+	content := r.Modal(tui.ModalOpts{
+		Title:    fmt.Sprintf("IMPORT GRAPH: %d packages, %s, zoom %d", len(g.Nodes), mode, gs.Zoom+1),
+		Hint:     "Esc/q/v:close  hjkl:scroll  +/-:zoom  o:output  c:center",
+		Border:   tui.LineDouble,
+		BorderFg: app.Theme.Border,
+		TitleFg:  app.Theme.HeaderFg,
+		HintFg:   app.Theme.StatusFg,
+		Bg:       app.Theme.Bg,
+	})
+
+	legend, canvas := tui.SplitVFixed(content, 1)
+	x := 1
+	for _, item := range []struct {
+		text string
+		fg   color.RGB
+	}{
+		{"■ selected", app.Theme.Selected},
+		{"■ expanded", app.Theme.Expanded},
+		{"■ other", app.Theme.DirFg},
+		{"─ expansion path", app.Theme.Expanded},
+	} {
+		legend.Text(x, 0, item.text, item.fg, app.Theme.Bg, terminal.AttrNone)
+		x += tui.RuneLen(item.text) + 3
+	}
+
+	if len(g.Nodes) == 0 {
+		canvas.TextCenter(canvas.H/2, "(no packages)", app.Theme.StatusFg, app.Theme.Bg, terminal.AttrNone)
+		return
+	}
+
+	for vy := range canvas.H {
+		for vx := range canvas.W {
+			c := g.cell(vx+gs.ScrollX, vy+gs.ScrollY)
+			if c == nil || (c.Lines == 0 && !c.Arrow) {
+				continue
+			}
+			fg, attr := app.Theme.Border, terminal.AttrDim
+			if c.Hot {
+				fg, attr = app.Theme.Expanded, terminal.AttrNone
+			}
+			ch := lineRunes[c.Lines&0xF]
+			if c.Arrow {
+				ch = '▼'
+			}
+			canvas.Cell(vx, vy, ch, fg, app.Theme.Bg, attr)
+		}
+	}
+
+	current := app.getCurrentFilePackageDir()
+	for _, n := range g.Nodes {
+		fg, bg, attr := app.Theme.DirFg, app.Theme.Bg, terminal.AttrNone
+		switch n.Mark {
+		case GraphMarkSelected:
+			fg, attr = app.Theme.Selected, terminal.AttrBold
+		case GraphMarkExpanded:
+			fg = app.Theme.Expanded
+		}
+		if n.Dir == current {
+			bg = app.Theme.CursorBg
+		}
+		canvas.Text(n.X-gs.ScrollX, n.Y-gs.ScrollY, n.Label, fg, bg, attr)
+	}
+
+	if g.H > canvas.H {
+		canvas.ScrollBar(canvas.W-1, gs.ScrollY, canvas.H, g.H, app.Theme.Border)
+	}
+}
+
+func (app *AppState) handleGraphEvent(ev terminal.Event) {
+	gs := app.Graph
+	vw, vh := app.graphViewport()
+	const stepX = 4
+
+	switch ev.Key {
+	case terminal.KeyEscape:
+		gs.Visible = false
+		return
+	case terminal.KeyUp:
+		gs.ScrollY--
+	case terminal.KeyDown:
+		gs.ScrollY++
+	case terminal.KeyLeft:
+		gs.ScrollX -= stepX
+	case terminal.KeyRight:
+		gs.ScrollX += stepX
+	case terminal.KeyPageUp:
+		gs.ScrollY -= vh
+	case terminal.KeyPageDown:
+		gs.ScrollY += vh
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q', 'v':
+			gs.Visible = false
+			return
+		case 'k':
+			gs.ScrollY--
+		case 'j':
+			gs.ScrollY++
+		case 'h':
+			gs.ScrollX -= stepX
+		case 'l':
+			gs.ScrollX += stepX
+		case 'H':
+			gs.ScrollX -= vw / 2
+		case 'L':
+			gs.ScrollX += vw / 2
+		case 'g':
+			gs.ScrollX, gs.ScrollY = 0, 0
+		case 'G':
+			gs.ScrollY = gs.Layout.H
+		case '0':
+			gs.ScrollX = 0
+		case '$':
+			gs.ScrollX = gs.Layout.W
+		case '+', '=':
+			if gs.Zoom < graphZoomCount-1 {
+				gs.Zoom++
+				app.rebuildGraph()
+				app.centerGraphOnCurrent()
+			}
+		case '-':
+			if gs.Zoom > 0 {
+				gs.Zoom--
+				app.rebuildGraph()
+				app.centerGraphOnCurrent()
+			}
+		case 'o':
+			gs.OutputOnly = !gs.OutputOnly
+			app.rebuildGraph()
+			app.centerGraphOnCurrent()
+		case 'c':
+			app.centerGraphOnCurrent()
+		}
+	}
+	app.clampGraphScroll()
+}
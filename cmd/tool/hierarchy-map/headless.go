@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// HeadlessQuery is a selection made from flags instead of the TUI
+type HeadlessQuery struct {
+	Select   []string // Package dirs, file paths, or selection globs
+	Groups   []string // "group", "group.module", "group.tag", or "group.module.tag", optionally "category:"-prefixed
+	Keywords []string // Content search queries, as with /
+	Depth    int      // Dependency expansion depth, 0 disables it
+	Emit     string   // "list" or "bundle"
+}
+
+// Requested reports whether any headless flag was given
+// Writing to stdout (out is "-") also rules out the TUI, which owns the terminal
+func (q HeadlessQuery) Requested(out string) bool {
+	return out == "-" || q.Emit != "" || len(q.Select) > 0 || len(q.Groups) > 0 || len(q.Keywords) > 0
+}
+
+// runHeadless builds the index, resolves the query, and writes the output without a terminal
+func runHeadless(q HeadlessQuery, scope IndexScope, out string) error {
+	if q.Emit == "" {
+		q.Emit = "list"
+	}
+	if q.Emit != "list" && q.Emit != "bundle" {
+		return fmt.Errorf("unknown -emit %q, want list or bundle", q.Emit)
+	}
+
+	index, err := BuildIndex(".", scope)
+	if err != nil {
+		return fmt.Errorf("index build: %w", err)
+	}
+
+	_, rgErr := exec.LookPath("rg")
+	selected, err := resolveQuery(q, index, rgErr == nil)
+	if err != nil {
+		return err
+	}
+	if len(selected) == 0 {
+		return fmt.Errorf("no files selected")
+	}
+
+	// The TUI output path, with the query standing in for interactive selection
+	app := &AppState{
+		Index:            index,
+		Selected:         selected,
+		ExpandDeps:       q.Depth > 0,
+		DepthLimit:       q.Depth,
+		DepAnalysisCache: make(map[string]*DependencyAnalysis),
+	}
+	files := app.ComputeOutputFiles()
+
+	w := os.Stdout
+	if out != "-" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if q.Emit == "bundle" {
+		err = WriteBundle(w, files)
+	} else {
+		err = writeList(w, files)
+	}
+	if err != nil {
+		return err
+	}
+
+	if out != "-" {
+		fmt.Fprintf(os.Stderr, "wrote %d files (%d selected) to %s\n", len(files), len(selected), out)
+	}
+	return nil
+}
+
+// resolveQuery collects the files named by the query
+func resolveQuery(q HeadlessQuery, index *Index, rgAvailable bool) (map[string]bool, error) {
+	selected := make(map[string]bool)
+
+	for _, s := range q.Select {
+		switch {
+		case index.Packages[s] != nil:
+			for _, fi := range index.Packages[s].Files {
+				selected[fi.Path] = true
+			}
+		case index.Files[s] != nil:
+			selected[s] = true
+		case strings.Contains(s, "*"):
+			for _, p := range expandGlob(s, index) {
+				selected[p] = true
+			}
+		default:
+			return nil, fmt.Errorf("-select %q: no such package or file", s)
+		}
+	}
+
+	for _, g := range q.Groups {
+		paths := groupFiles(index, g)
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("-group %q: no tagged files", g)
+		}
+		for _, p := range paths {
+			selected[p] = true
+		}
+	}
+
+	for _, k := range q.Keywords {
+		for _, p := range searchContentRg(index, k, rgAvailable) {
+			// Search may report files the scope left out
+			if index.Files[p] != nil {
+				selected[p] = true
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// groupFiles returns the files tagged with a group, module, or tag key
+// A "category:" prefix limits the lookup to one category
+func groupFiles(index *Index, key string) []string {
+	cats := index.CategoryNames
+	if cat, rest, ok := strings.Cut(key, ":"); ok {
+		cats, key = []string{cat}, rest
+	}
+
+	var paths []string
+	for _, cat := range cats {
+		catIdx := index.Category(cat)
+		if catIdx == nil {
+			continue
+		}
+		switch strings.Count(key, ".") {
+		case 0:
+			// A bare key is a group or a category-level tag, as in #cat(tag)
+			paths = append(paths, catIdx.ByGroup[key]...)
+			paths = append(paths, catIdx.ByTag[".."+key]...)
+		case 1:
+			paths = append(paths, catIdx.ByModule[key]...)
+			// "group.tag" names a direct tag, keyed "group..tag"
+			g, t, _ := strings.Cut(key, ".")
+			paths = append(paths, catIdx.ByTag[g+".."+t]...)
+		default:
+			paths = append(paths, catIdx.ByTag[key]...)
+		}
+	}
+	sort.Strings(paths)
+	return uniqueStrings(paths)
+}
+
+// WriteBundle writes the contents of files, each under a path header
+func WriteBundle(w io.Writer, files []string) error {
+	bw := bufio.NewWriter(w)
+	for i, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		fmt.Fprintf(bw, "// ===== ./%s =====\n", file)
+		bw.Write(content)
+		if len(content) > 0 && content[len(content)-1] != '\n' {
+			bw.WriteString("\n")
+		}
+	}
+	return bw.Flush()
+}
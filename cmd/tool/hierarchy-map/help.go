@@ -39,9 +39,13 @@ var helpMain = HelpColumn{
 		{"Esc", "Clear filter"},
 		{"", ""},
 		{"r", "Reindex all"},
+		{"i", "Edit index scope"},
+		{"G", "Toggle generated files"},
 		{"d", "Toggle dep expansion"},
 		{"+/-", "Adjust depth limit"},
 		{"c", "Clear selections"},
+		{"t", "Token breakdown"},
+		{"v", "Import graph"},
 		{"", ""},
 		{"Ctrl+S", "Save output"},
 		{"Ctrl+L", "Load selection"},
@@ -92,6 +96,7 @@ var helpEditor = HelpColumn{
 	Entries: []HelpEntry{
 		{"Esc", "Close editor"},
 		{"Ctrl+S", "Save changes"},
+		{"Ctrl+P", "Dry-run preview"},
 		{"Tab", "Next pane"},
 		{"S-Tab", "Previous pane"},
 		{"", ""},
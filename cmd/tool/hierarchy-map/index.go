@@ -14,11 +14,14 @@ import (
 )
 
 // BuildIndex scans directory tree and builds complete codebase index
-func BuildIndex(root string) (*Index, error) {
-	modPath := getModulePath()
+// With a go.work in root every module it uses is indexed; otherwise root is one module
+// Files outside scope are left out entirely
+func BuildIndex(root string, scope IndexScope) (*Index, error) {
+	modules := loadModules(root)
 
 	index := &Index{
-		ModulePath: modPath,
+		ModulePath: modules[0].Path,
+		Modules:    modules,
 		Packages:   make(map[string]*PackageInfo),
 		Files:      make(map[string]*FileInfo),
 		Categories: make(map[string]*CategoryIndex),
@@ -42,6 +45,13 @@ func BuildIndex(root string) (*Index, error) {
 			if strings.HasPrefix(name, ".") && name != "." {
 				return filepath.SkipDir
 			}
+			// Nested modules outside the workspace are separate builds
+			if path != root {
+				rel, _ := filepath.Rel(root, path)
+				if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil && index.moduleAt(filepath.ToSlash(rel)) == nil {
+					return filepath.SkipDir
+				}
+			}
 			return nil
 		}
 
@@ -54,24 +64,37 @@ func BuildIndex(root string) (*Index, error) {
 
 		relPath, _ := filepath.Rel(root, path)
 		relPath = filepath.ToSlash(relPath)
-
-		fi, err := parseFile(relPath, modPath)
-		if err != nil || fi == nil {
+		if !scope.Allows(relPath) {
 			return nil
 		}
 
-		index.Files[relPath] = fi
-
 		// Strict directory-based keys. Root is "."
 		dir := filepath.Dir(relPath)
 		dir = filepath.ToSlash(dir)
 		// filepath.Dir returns "." for root files, which is what we want
 
+		// Files outside every workspace module belong to no build
+		mod := index.ModuleOf(dir)
+		if mod == nil {
+			return nil
+		}
+
+		fi, err := parseFile(relPath, index)
+		if err != nil || fi == nil {
+			return nil
+		}
+		if scope.SkipGenerated && fi.Generated {
+			return nil
+		}
+
+		index.Files[relPath] = fi
+
 		pkg, ok := index.Packages[dir]
 		if !ok {
 			pkg = &PackageInfo{
 				Name:        fi.Package,
 				Dir:         dir,
+				Module:      mod,
 				Files:       make([]*FileInfo, 0),
 				SymbolFiles: make(map[string]string),
 			}
@@ -215,7 +238,7 @@ func BuildIndex(root string) (*Index, error) {
 
 // ReindexAll rebuilds index from disk and refreshes all views
 func (app *AppState) ReindexAll() {
-	index, err := BuildIndex(".")
+	index, err := BuildIndex(".", app.Scope)
 	if err != nil {
 		app.Message = fmt.Sprintf("reindex error: %v", err)
 		return
@@ -223,6 +246,7 @@ func (app *AppState) ReindexAll() {
 
 	app.Index = index
 	app.CategoryNames = index.CategoryNames
+	app.Tokens.Reset()
 
 	// Prune stale selections for files no longer in index
 	for path := range app.Selected {
@@ -243,7 +267,8 @@ func (app *AppState) ReindexAll() {
 }
 
 // parseFile extracts metadata from a single Go source file
-func parseFile(path, modPath string) (*FileInfo, error) {
+// Imports of any indexed module resolve to package directories
+func parseFile(path string, index *Index) (*FileInfo, error) {
 	content, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
@@ -261,6 +286,12 @@ func parseFile(path, modPath string) (*FileInfo, error) {
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
 
+		// The generated marker must precede the package clause
+		if !packageFound && generatedHeader.MatchString(strings.TrimSuffix(line, "\r")) {
+			fi.Generated = true
+			continue
+		}
+
 		if !packageFound && strings.HasPrefix(trimmed, "package ") {
 			parts := strings.Fields(trimmed)
 			if len(parts) >= 2 {
@@ -330,28 +361,18 @@ func parseFile(path, modPath string) (*FileInfo, error) {
 		}
 		impPath := strings.Trim(imp.Path.Value, `"`)
 
-		// Module path stripping
+		// Module path stripping: "module/path" -> directory of path in its module
+		localPkg, ok := index.ImportDir(impPath)
+		if !ok {
+			continue
+		}
 
 		// Track blank imports separately
 		if imp.Name != nil && imp.Name.Name == "_" {
-			if impPath == modPath {
-				fi.BlankImports = append(fi.BlankImports, ".")
-			} else if strings.HasPrefix(impPath, modPath+"/") {
-				localPkg := strings.TrimPrefix(impPath, modPath+"/")
-				fi.BlankImports = append(fi.BlankImports, localPkg)
-			}
+			fi.BlankImports = append(fi.BlankImports, localPkg)
 			continue
 		}
-
-		// Regular imports
-		if impPath == modPath {
-			// Import points to module root
-			fi.Imports = append(fi.Imports, ".")
-		} else if strings.HasPrefix(impPath, modPath+"/") {
-			// Import points to submodule: strip "module/path/" -> "path"
-			localPkg := strings.TrimPrefix(impPath, modPath+"/")
-			fi.Imports = append(fi.Imports, localPkg)
-		}
+		fi.Imports = append(fi.Imports, localPkg)
 	}
 
 	return fi, nil
@@ -631,11 +652,67 @@ func parseTagList(content string) []string {
 	return tags
 }
 
-// getModulePath reads module path from go.mod file
-func getModulePath() string {
-	f, err := os.Open("go.mod")
+// loadModules lists the modules under root: those a go.work uses, else the go.mod of root
+// Sorted by directory; never empty, falling back to the default module at root
+func loadModules(root string) []*ModuleInfo {
+	var modules []*ModuleInfo
+	for _, dir := range readWorkspaceDirs(filepath.Join(root, "go.work")) {
+		dir = filepath.ToSlash(filepath.Clean(dir))
+		if modPath := getModulePath(filepath.Join(root, dir)); modPath != "" {
+			modules = append(modules, &ModuleInfo{Path: modPath, Dir: dir})
+		}
+	}
+	if len(modules) == 0 {
+		modPath := getModulePath(root)
+		if modPath == "" {
+			modPath = defaultModulePath
+		}
+		return []*ModuleInfo{{Path: modPath, Dir: "."}}
+	}
+
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Dir < modules[j].Dir
+	})
+	return modules
+}
+
+// readWorkspaceDirs returns the use directives of a go.work file, nil when absent
+func readWorkspaceDirs(path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var dirs []string
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+		case inBlock && line != "":
+			dirs = append(dirs, strings.Trim(line, `"`))
+		case line == "use (":
+			inBlock = true
+		case strings.HasPrefix(line, "use "):
+			dirs = append(dirs, strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "use")), `"`))
+		}
+	}
+	return dirs
+}
+
+// getModulePath reads module path from the go.mod file in dir, "" when absent
+func getModulePath(dir string) string {
+	f, err := os.Open(filepath.Join(dir, "go.mod"))
 	if err != nil {
-		return defaultModulePath
+		return ""
 	}
 	defer f.Close()
 
@@ -643,10 +720,10 @@ func getModulePath() string {
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 		if strings.HasPrefix(line, "module ") {
-			return strings.TrimSpace(strings.TrimPrefix(line, "module"))
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module")), `"`)
 		}
 	}
-	return defaultModulePath
+	return ""
 }
 
 // computeReverseDeps maps local import paths to lists of files that import them.
@@ -16,15 +16,56 @@ const (
 	minTermHeight = 24
 )
 
-var outputPath string
+var (
+	outputPath    string
+	charsPerToken float64
+	tokenizerCmd  string
+	tokenBudget   int
+	scope         IndexScope
+	query         HeadlessQuery
+)
 
 func init() {
-	flag.StringVar(&outputPath, "o", "catalog.txt", "output file path")
+	flag.StringVar(&outputPath, "o", "catalog.txt", "output file path, - for stdout (skips the TUI)")
+	flag.Float64Var(&charsPerToken, "cpt", 4.0, "characters per token for the token estimate")
+	flag.StringVar(&tokenizerCmd, "tokenizer", "", "command reading a file on stdin and printing its token count")
+	flag.IntVar(&tokenBudget, "budget", 100000, "output token budget, 0 disables the warning")
+	flag.Func("include", "comma-separated globs a file must match to be indexed (repeatable)", func(v string) error {
+		scope.Include = splitGlobs(scope.Include, v)
+		return nil
+	})
+	flag.Func("exclude", "comma-separated globs of files left out of the index (repeatable)", func(v string) error {
+		scope.Exclude = splitGlobs(scope.Exclude, v)
+		return nil
+	})
+	flag.Func("select", "comma-separated packages, files, or globs to select without the TUI (repeatable)", func(v string) error {
+		query.Select = splitGlobs(query.Select, v)
+		return nil
+	})
+	flag.Func("group", "comma-separated [category:]group[.module[.tag]] keys to select without the TUI (repeatable)", func(v string) error {
+		query.Groups = splitGlobs(query.Groups, v)
+		return nil
+	})
+	flag.Func("keyword", "content search selecting matching files without the TUI (repeatable)", func(v string) error {
+		query.Keywords = append(query.Keywords, v)
+		return nil
+	})
+	flag.IntVar(&query.Depth, "depth", 2, "dependency expansion depth without the TUI, 0 disables it")
+	flag.StringVar(&query.Emit, "emit", "", "run without the TUI and write a file list or a bundle of file contents: list|bundle")
+	flag.BoolVar(&scope.SkipGenerated, "no-generated", false, "leave out files with a \"Code generated ... DO NOT EDIT.\" header")
 }
 
 func main() {
 	flag.Parse()
 
+	if query.Requested(outputPath) {
+		if err := runHeadless(query, scope, outputPath); err != nil {
+			fmt.Fprintln(os.Stderr, "hierarchy-map:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	term := terminal.New()
 	if err := term.Init(); err != nil {
 		fmt.Fprintln(os.Stderr, "terminal init:", err)
@@ -34,7 +75,7 @@ func main() {
 
 	w, h := term.Size()
 
-	index, err := BuildIndex(".")
+	index, err := BuildIndex(".", scope)
 	if err != nil {
 		term.Fini()
 		fmt.Fprintln(os.Stderr, "index build:", err)
@@ -61,6 +102,9 @@ func main() {
 		TreeState:        tui.NewTreeState(h - 4),
 		TreeExpand:       tui.NewTreeExpansion(),
 		InputField:       tui.NewTextFieldState(""),
+		Tokens:           NewTokenCounter(charsPerToken, tokenizerCmd),
+		TokenBudget:      tokenBudget,
+		Scope:            scope,
 		Width:            w,
 		Height:           h,
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -16,12 +17,16 @@ func WriteOutputFile(path string, files []string) error {
 		return err
 	}
 	defer f.Close()
+	return writeList(f, files)
+}
 
-	w := bufio.NewWriter(f)
+// writeList writes file paths in the catalog format
+func writeList(w io.Writer, files []string) error {
+	bw := bufio.NewWriter(w)
 	for _, file := range files {
-		fmt.Fprintf(w, "./%s\n", file)
+		fmt.Fprintf(bw, "./%s\n", file)
 	}
-	return w.Flush()
+	return bw.Flush()
 }
 
 // LoadSelectionFile reads catalog file and returns matched paths
@@ -72,40 +77,36 @@ func LoadSelectionFile(path string, index *Index) ([]string, error) {
 }
 
 // expandGlob matches pattern against indexed files
-// Supports simple globs: * matches any sequence within path segment
-// For patterns like "cmd/*" or "cmd/*.go", matches all files under cmd/
 func expandGlob(pattern string, index *Index) []string {
 	var matches []string
+	for path := range index.Files {
+		if matchGlob(pattern, path) {
+			matches = append(matches, path)
+		}
+	}
+	return matches
+}
 
+// matchGlob reports whether a file path matches a selection glob
+// Supports simple globs: * matches any sequence within path segment
+// For patterns like "cmd/*" or "cmd/**", matches all files under cmd/
+// Patterns without a slash, like "*_gen.go", also match the file name alone
+func matchGlob(pattern, path string) bool {
 	// Check if pattern is directory prefix (ends with /* or contains /*)
 	if strings.HasSuffix(pattern, "/*") || strings.HasSuffix(pattern, "/**") {
 		// Directory recursive match
 		prefix := strings.TrimSuffix(strings.TrimSuffix(pattern, "**"), "*")
 		prefix = strings.TrimSuffix(prefix, "/")
-		for path := range index.Files {
-			if strings.HasPrefix(path, prefix+"/") || path == prefix {
-				matches = append(matches, path)
-			}
-		}
-		return matches
+		return strings.HasPrefix(path, prefix+"/") || path == prefix
 	}
 
 	// Use filepath.Match for standard glob patterns
-	for path := range index.Files {
-		ok, err := filepath.Match(pattern, path)
-		if err == nil && ok {
-			matches = append(matches, path)
-			continue
-		}
-
-		// Also try matching just the filename for patterns like "*_test.go"
-		if !strings.Contains(pattern, "/") {
-			ok, err = filepath.Match(pattern, filepath.Base(path))
-			if err == nil && ok {
-				matches = append(matches, path)
-			}
-		}
+	if ok, err := filepath.Match(pattern, path); err == nil && ok {
+		return true
 	}
-
-	return matches
-}
\ No newline at end of file
+	if !strings.Contains(pattern, "/") {
+		ok, err := filepath.Match(pattern, filepath.Base(path))
+		return err == nil && ok
+	}
+	return false
+}
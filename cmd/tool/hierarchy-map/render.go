@@ -23,6 +23,14 @@ func (app *AppState) Render() {
 	if app.Help != nil && app.Help.Visible {
 		app.renderMain(root)
 		app.renderHelp(root)
+	} else if app.Graph != nil && app.Graph.Visible {
+		app.renderGraph(root)
+	} else if app.TokenView != nil && app.TokenView.Visible {
+		app.renderMain(root)
+		app.renderTokenView(root)
+	} else if app.ScopeEditor != nil && app.ScopeEditor.Visible {
+		app.renderMain(root)
+		app.renderScopeEditor(root)
 	} else if app.Editor != nil && app.Editor.Visible {
 		app.renderEditor(root)
 	} else if app.Viewer != nil && app.Viewer.Visible {
@@ -54,6 +62,7 @@ func (app *AppState) renderHeader(r tui.Region) {
 
 	// Stats on the right using StatusBar
 	totalFiles, depFiles, totalSize, depSize := app.computeOutputStats()
+	tokens := app.computeOutputTokens()
 
 	sections := []tui.BarSection{
 		{
@@ -74,6 +83,12 @@ func (app *AppState) renderHeader(r tui.Region) {
 			LabelStyle: tui.Style{Fg: app.Theme.StatusFg},
 			ValueStyle: tui.Style{Fg: app.sizeColor(totalSize)},
 		},
+		{
+			Label:      "Tokens: ",
+			Value:      app.formatTokensValue(tokens),
+			LabelStyle: tui.Style{Fg: app.Theme.StatusFg},
+			ValueStyle: tui.Style{Fg: app.tokensColor(tokens)},
+		},
 	}
 
 	r.StatusBar(0, sections, tui.BarOpts{
@@ -110,6 +125,14 @@ func (app *AppState) sizeColor(size int64) color.RGB {
 	return app.Theme.HeaderFg
 }
 
+// tokensColor returns warning color if tokens exceed the budget
+func (app *AppState) tokensColor(tokens int) color.RGB {
+	if app.overBudget(tokens) {
+		return app.Theme.Warning
+	}
+	return app.Theme.HeaderFg
+}
+
 // renderPanes draws the 4-pane layout with dividers
 func (app *AppState) renderPanes(r tui.Region) {
 	panes := tui.SplitHEqual(r, 4, 1)
@@ -421,6 +444,9 @@ func (app *AppState) buildTreeNodes() []tui.TreeNode {
 				if suffix != "" {
 					suffix = " " + suffix
 				}
+				if tn.FileInfo.Generated {
+					suffix = " (gen)" + suffix
+				}
 			}
 
 			node = tui.TreeNode{
@@ -622,6 +648,12 @@ func (app *AppState) renderStatus(r tui.Region) {
 		msg = fmt.Sprintf("Selected: %d files", len(app.Selected))
 	}
 	r.Text(1, 1, msg, app.Theme.StatusFg, app.Theme.Bg, terminal.AttrNone)
+
+	// Budget warning stays visible alongside messages
+	if tokens := app.computeOutputTokens(); app.overBudget(tokens) {
+		warn := fmt.Sprintf("over token budget by ~%s (t: breakdown) ", formatTokens(tokens-app.TokenBudget))
+		r.TextRight(1, warn, app.Theme.Warning, app.Theme.Bg, terminal.AttrBold)
+	}
 }
 
 // --- Style helper methods ---
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/terminal/tui"
+)
+
+// generatedHeader is the Go convention marking a machine-generated file
+var generatedHeader = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// IndexScope limits which files are indexed
+type IndexScope struct {
+	Include       []string // Globs a file must match one of; empty includes all
+	Exclude       []string // Globs removing matching files
+	SkipGenerated bool     // Drop files with a "Code generated ... DO NOT EDIT." header
+}
+
+// Allows reports whether the scope globs admit path
+func (s IndexScope) Allows(path string) bool {
+	if len(s.Include) > 0 {
+		included := false
+		for _, p := range s.Include {
+			if matchGlob(p, path) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, p := range s.Exclude {
+		if matchGlob(p, path) {
+			return false
+		}
+	}
+	return true
+}
+
+// Active reports whether the scope drops anything
+func (s IndexScope) Active() bool {
+	return len(s.Include) > 0 || len(s.Exclude) > 0 || s.SkipGenerated
+}
+
+// String summarizes the scope for status lines
+func (s IndexScope) String() string {
+	var parts []string
+	if len(s.Include) > 0 {
+		parts = append(parts, "+"+strings.Join(s.Include, ",+"))
+	}
+	if len(s.Exclude) > 0 {
+		parts = append(parts, "-"+strings.Join(s.Exclude, ",-"))
+	}
+	if s.SkipGenerated {
+		parts = append(parts, "no generated")
+	}
+	if len(parts) == 0 {
+		return "all files"
+	}
+	return strings.Join(parts, " ")
+}
+
+// splitGlobs appends the comma-separated globs of a flag value
+func splitGlobs(dst []string, value string) []string {
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimPrefix(strings.TrimSpace(p), "./"); p != "" {
+			dst = append(dst, p)
+		}
+	}
+	return dst
+}
+
+// --- Scope editor overlay ---
+
+// ScopeEditorState edits a draft of the index scope; applying it reindexes
+type ScopeEditorState struct {
+	Visible bool
+	Draft   IndexScope
+	Cursor  int                 // Row: 0 is the generated toggle, then includes, then excludes
+	Adding  int                 // 0 browsing, 1 typing an include, 2 typing an exclude
+	Input   *tui.TextFieldState // Glob being typed
+}
+
+// rows returns the number of cursor rows
+func (se *ScopeEditorState) rows() int {
+	return 1 + len(se.Draft.Include) + len(se.Draft.Exclude)
+}
+
+// ToggleScopeEditor opens the editor on a copy of the current scope, or closes it
+func (app *AppState) ToggleScopeEditor() {
+	if app.ScopeEditor != nil && app.ScopeEditor.Visible {
+		app.ScopeEditor.Visible = false
+		return
+	}
+	draft := IndexScope{
+		Include:       append([]string(nil), app.Scope.Include...),
+		Exclude:       append([]string(nil), app.Scope.Exclude...),
+		SkipGenerated: app.Scope.SkipGenerated,
+	}
+	app.ScopeEditor = &ScopeEditorState{
+		Visible: true,
+		Draft:   draft,
+		Input:   tui.NewTextFieldState(""),
+	}
+}
+
+// ToggleGenerated flips generated-file exclusion and reindexes
+func (app *AppState) ToggleGenerated() {
+	app.Scope.SkipGenerated = !app.Scope.SkipGenerated
+	app.ReindexAll()
+	if app.Scope.SkipGenerated {
+		app.Message += ", generated files excluded"
+	} else {
+		app.Message += ", generated files included"
+	}
+}
+
+// renderScopeEditor draws the include/exclude lists as a modal
+func (app *AppState) renderScopeEditor(r tui.Region) {
+	se := app.ScopeEditor
+	if se == nil || !se.Visible {
+		return
+	}
+
+	hint := "i:include  x:exclude  d:delete  g:generated  Enter:apply  Esc:cancel"
+	if se.Adding != 0 {
+		hint = "Enter:add  Esc:cancel"
+	}
+	content := r.Modal(tui.ModalOpts{
+		Title:    "INDEX SCOPE",
+		Hint:     hint,
+		Border:   tui.LineDouble,
+		BorderFg: app.Theme.Border,
+		TitleFg:  app.Theme.HeaderFg,
+		HintFg:   app.Theme.StatusFg,
+		Bg:       app.Theme.Bg,
+	})
+
+	y := 0
+	row := 0
+	line := func(text string, fg tui.Style) {
+		if y >= content.H-2 {
+			return
+		}
+		bg := app.Theme.Bg
+		if row == se.Cursor && se.Adding == 0 {
+			bg = app.Theme.CursorBg
+		}
+		content.Text(1, y, fmt.Sprintf("%-*s", max(0, content.W-2), text), fg.Fg, bg, fg.Attr)
+		y++
+		row++
+	}
+	heading := func(text string) {
+		y++
+		content.Text(1, y, text, app.Theme.HeaderFg, app.Theme.Bg, terminal.AttrBold)
+		y++
+	}
+
+	check := "[ ]"
+	if se.Draft.SkipGenerated {
+		check = "[x]"
+	}
+	line(check+" Exclude generated files", tui.Style{Fg: app.Theme.Fg})
+
+	heading("INCLUDE (empty includes all)")
+	for _, p := range se.Draft.Include {
+		line("+ "+p, tui.Style{Fg: app.Theme.Selected})
+	}
+	heading("EXCLUDE")
+	for _, p := range se.Draft.Exclude {
+		line("- "+p, tui.Style{Fg: app.Theme.Warning})
+	}
+
+	if se.Adding != 0 {
+		label := "Include: "
+		if se.Adding == 2 {
+			label = "Exclude: "
+		}
+		content.Sub(0, content.H-1, content.W, 1).Input(0, tui.InputOpts{
+			Label:    label,
+			LabelFg:  app.Theme.StatusFg,
+			Text:     se.Input.Value(),
+			Cursor:   se.Input.Cursor,
+			CursorBg: app.Theme.HeaderFg,
+			TextFg:   app.Theme.HeaderFg,
+			Bg:       app.Theme.InputBg,
+		})
+	}
+}
+
+// handleScopeEditorEvent processes keyboard input for the scope editor
+func (app *AppState) handleScopeEditorEvent(ev terminal.Event) {
+	se := app.ScopeEditor
+
+	if se.Adding != 0 {
+		switch ev.Key {
+		case terminal.KeyEscape:
+			se.Adding = 0
+			se.Input.Clear()
+		case terminal.KeyEnter:
+			if se.Adding == 1 {
+				se.Draft.Include = splitGlobs(se.Draft.Include, se.Input.Value())
+			} else {
+				se.Draft.Exclude = splitGlobs(se.Draft.Exclude, se.Input.Value())
+			}
+			se.Adding = 0
+			se.Input.Clear()
+		default:
+			se.Input.HandleKey(ev.Key, ev.Rune, ev.Modifiers)
+		}
+		return
+	}
+
+	switch ev.Key {
+	case terminal.KeyEscape:
+		se.Visible = false
+	case terminal.KeyEnter:
+		se.Visible = false
+		app.Scope = se.Draft
+		app.ReindexAll()
+		app.Message += " (" + app.Scope.String() + ")"
+	case terminal.KeyUp:
+		se.Cursor = max(0, se.Cursor-1)
+	case terminal.KeyDown:
+		se.Cursor = min(se.rows()-1, se.Cursor+1)
+	case terminal.KeyDelete:
+		app.deleteScopeRow()
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q':
+			se.Visible = false
+		case 'j':
+			se.Cursor = min(se.rows()-1, se.Cursor+1)
+		case 'k':
+			se.Cursor = max(0, se.Cursor-1)
+		case 'i':
+			se.Adding = 1
+		case 'x':
+			se.Adding = 2
+		case 'd':
+			app.deleteScopeRow()
+		case 'g':
+			se.Draft.SkipGenerated = !se.Draft.SkipGenerated
+		}
+	}
+}
+
+// deleteScopeRow removes the glob under the cursor
+func (app *AppState) deleteScopeRow() {
+	se := app.ScopeEditor
+	i := se.Cursor - 1
+	switch {
+	case i < 0:
+		return
+	case i < len(se.Draft.Include):
+		se.Draft.Include = append(se.Draft.Include[:i], se.Draft.Include[i+1:]...)
+	default:
+		i -= len(se.Draft.Include)
+		se.Draft.Exclude = append(se.Draft.Exclude[:i], se.Draft.Exclude[i+1:]...)
+	}
+	se.Cursor = min(se.Cursor, se.rows()-1)
+}
@@ -4,7 +4,6 @@ import (
 	"maps"
 	"slices"
 	"sort"
-	"strings"
 )
 
 // ExpandDepsFileLevel expands selected files with file-level granularity
@@ -41,7 +40,7 @@ func ExpandDepsFileLevel(selectedFiles map[string]bool, index *Index, cache map[
 		// GetComponent or compute analysis
 		analysis := cache[current.path]
 		if analysis == nil {
-			a, err := AnalyzeFileDependencies(current.path, index.ModulePath)
+			a, err := AnalyzeFileDependencies(current.path, index)
 			if err == nil {
 				analysis = a
 				cache[current.path] = a
@@ -51,7 +50,7 @@ func ExpandDepsFileLevel(selectedFiles map[string]bool, index *Index, cache map[
 		if analysis != nil {
 			// Process symbol usage
 			for importPath, symbols := range analysis.UsedSymbols {
-				pkgDir := importPathToDir(importPath, index.ModulePath)
+				pkgDir, _ := index.ImportDir(importPath)
 				pkg := index.Packages[pkgDir]
 				if pkg == nil {
 					continue
@@ -92,17 +91,6 @@ func ExpandDepsFileLevel(selectedFiles map[string]bool, index *Index, cache map[
 	return result
 }
 
-// importPathToDir converts full import path to package directory
-func importPathToDir(importPath, modPath string) string {
-	if importPath == modPath {
-		return "."
-	}
-	if strings.HasPrefix(importPath, modPath+"/") {
-		return strings.TrimPrefix(importPath, modPath+"/")
-	}
-	return ""
-}
-
 // ComputeOutputFiles generates final deduplicated file list for export
 func (app *AppState) ComputeOutputFiles() []string {
 	fileSet := make(map[string]bool)
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/terminal/tui"
+)
+
+// Tokenizer estimates the token count of a single file
+type Tokenizer interface {
+	Count(fi *FileInfo) (int, error)
+}
+
+// RatioTokenizer approximates tokens from file size at a fixed chars-per-token ratio
+type RatioTokenizer struct {
+	CharsPerToken float64
+}
+
+// Count divides file size by the ratio, rounding up
+func (t RatioTokenizer) Count(fi *FileInfo) (int, error) {
+	cpt := t.CharsPerToken
+	if cpt <= 0 {
+		cpt = 4
+	}
+	return int(math.Ceil(float64(fi.Size) / cpt)), nil
+}
+
+// CommandTokenizer pipes file content to an external command that prints a token count
+type CommandTokenizer struct {
+	Args []string
+}
+
+// Count runs the command with the file on stdin and parses the first field of its output
+func (t CommandTokenizer) Count(fi *FileInfo) (int, error) {
+	content, err := os.ReadFile(fi.Path)
+	if err != nil {
+		return 0, err
+	}
+	cmd := exec.Command(t.Args[0], t.Args[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("tokenizer printed no count")
+	}
+	return strconv.Atoi(fields[0])
+}
+
+// tokenEntry caches a file's count against the size it was computed for
+type tokenEntry struct {
+	size   int64
+	tokens int
+}
+
+// TokenCounter caches per-file token counts, falling back to the ratio estimate on tokenizer errors
+type TokenCounter struct {
+	Tokenizer Tokenizer
+	Fallback  RatioTokenizer
+	cache     map[string]tokenEntry
+	LastErr   error // Most recent tokenizer failure, nil when all counts are exact
+}
+
+// NewTokenCounter creates a counter using the external command when given, else the ratio
+func NewTokenCounter(charsPerToken float64, command string) *TokenCounter {
+	fallback := RatioTokenizer{CharsPerToken: charsPerToken}
+	var tk Tokenizer = fallback
+	if args := strings.Fields(command); len(args) > 0 {
+		tk = CommandTokenizer{Args: args}
+	}
+	return &TokenCounter{
+		Tokenizer: tk,
+		Fallback:  fallback,
+		cache:     make(map[string]tokenEntry),
+	}
+}
+
+// Count returns the cached or freshly computed token count for a file
+func (tc *TokenCounter) Count(fi *FileInfo) int {
+	if e, ok := tc.cache[fi.Path]; ok && e.size == fi.Size {
+		return e.tokens
+	}
+	n, err := tc.Tokenizer.Count(fi)
+	if err != nil {
+		tc.LastErr = err
+		n, _ = tc.Fallback.Count(fi)
+	}
+	tc.cache[fi.Path] = tokenEntry{size: fi.Size, tokens: n}
+	return n
+}
+
+// Reset drops cached counts, called on reindex
+func (tc *TokenCounter) Reset() {
+	tc.cache = make(map[string]tokenEntry)
+	tc.LastErr = nil
+}
+
+// PackageTokens is one row of the per-package breakdown
+type PackageTokens struct {
+	Dir      string
+	Files    int
+	DepFiles int // Files included only through dependency expansion
+	Tokens   int
+}
+
+// computeOutputTokens returns the total token estimate of the output set
+func (app *AppState) computeOutputTokens() int {
+	total := 0
+	for _, path := range app.ComputeOutputFiles() {
+		if fi := app.Index.Files[path]; fi != nil {
+			total += app.Tokens.Count(fi)
+		}
+	}
+	return total
+}
+
+// computePackageTokens groups the output set by package directory, largest first
+func (app *AppState) computePackageTokens() []PackageTokens {
+	byDir := make(map[string]*PackageTokens)
+	for _, path := range app.ComputeOutputFiles() {
+		fi := app.Index.Files[path]
+		if fi == nil {
+			continue
+		}
+		dir := filepath.Dir(path)
+		pt := byDir[dir]
+		if pt == nil {
+			pt = &PackageTokens{Dir: dir}
+			byDir[dir] = pt
+		}
+		pt.Files++
+		if !app.Selected[path] && !fi.IsAll {
+			pt.DepFiles++
+		}
+		pt.Tokens += app.Tokens.Count(fi)
+	}
+
+	result := make([]PackageTokens, 0, len(byDir))
+	for _, pt := range byDir {
+		result = append(result, *pt)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Tokens != result[j].Tokens {
+			return result[i].Tokens > result[j].Tokens
+		}
+		return result[i].Dir < result[j].Dir
+	})
+	return result
+}
+
+// overBudget reports whether a token total exceeds the configured budget
+func (app *AppState) overBudget(tokens int) bool {
+	return app.TokenBudget > 0 && tokens > app.TokenBudget
+}
+
+// formatTokens formats a token count compactly: 950, 12.3k, 1.2M
+func formatTokens(n int) string {
+	switch {
+	case n < 1000:
+		return strconv.Itoa(n)
+	case n < 1000000:
+		return fmt.Sprintf("%.1fk", float64(n)/1000)
+	default:
+		return fmt.Sprintf("%.1fM", float64(n)/1000000)
+	}
+}
+
+// formatTokensValue formats the header token estimate with the budget
+func (app *AppState) formatTokensValue(tokens int) string {
+	s := "~" + formatTokens(tokens)
+	if app.TokenBudget > 0 {
+		s += "/" + formatTokens(app.TokenBudget)
+	}
+	return s
+}
+
+// --- Token breakdown overlay ---
+
+// TokenViewState manages the per-package token breakdown overlay
+type TokenViewState struct {
+	Visible bool
+	Scroll  int
+	Rows    []PackageTokens // Snapshot taken when opened
+	Total   int
+}
+
+// ToggleTokenView opens the breakdown with a fresh snapshot, or closes it
+func (app *AppState) ToggleTokenView() {
+	if app.TokenView != nil && app.TokenView.Visible {
+		app.TokenView.Visible = false
+		return
+	}
+	rows := app.computePackageTokens()
+	total := 0
+	for _, r := range rows {
+		total += r.Tokens
+	}
+	app.TokenView = &TokenViewState{Visible: true, Rows: rows, Total: total}
+}
+
+// renderTokenView draws the per-package breakdown as a modal table with share bars
+func (app *AppState) renderTokenView(r tui.Region) {
+	tv := app.TokenView
+	if tv == nil || !tv.Visible {
+		return
+	}
+
+	title := fmt.Sprintf("TOKENS: ~%s in %d packages", formatTokens(tv.Total), len(tv.Rows))
+	content := r.Modal(tui.ModalOpts{
+		Title:    title,
+		Hint:     "Esc/q/t:close  j/k:scroll",
+		Border:   tui.LineDouble,
+		BorderFg: app.Theme.Border,
+		TitleFg:  app.Theme.HeaderFg,
+		HintFg:   app.Theme.StatusFg,
+		Bg:       app.Theme.Bg,
+	})
+
+	const (
+		filesW  = 10
+		tokensW = 9
+		shareW  = 7
+		barW    = 20
+	)
+	dirW := max(10, content.W-filesW-tokensW-shareW-barW-5)
+
+	header := fmt.Sprintf("%-*s %*s %*s %*s", dirW, "PACKAGE", filesW, "FILES", tokensW, "TOKENS", shareW, "SHARE")
+	content.Text(1, 0, header, app.Theme.HeaderFg, app.Theme.Bg, terminal.AttrBold)
+	content.HLine(1, tui.LineSingle, app.Theme.Border)
+
+	status := fmt.Sprintf("budget: %s", formatTokens(app.TokenBudget))
+	statusFg := app.Theme.StatusFg
+	if app.TokenBudget <= 0 {
+		status = "budget: off"
+	} else if app.overBudget(tv.Total) {
+		status = fmt.Sprintf("over budget by ~%s", formatTokens(tv.Total-app.TokenBudget))
+		statusFg = app.Theme.Warning
+	}
+	if app.Tokens.LastErr != nil {
+		status += fmt.Sprintf("  tokenizer error, ratio estimate used: %v", app.Tokens.LastErr)
+		statusFg = app.Theme.Warning
+	}
+	content.Text(1, content.H-1, status, statusFg, app.Theme.Bg, terminal.AttrNone)
+
+	listH := content.H - 3
+	for i := tv.Scroll; i < len(tv.Rows) && i-tv.Scroll < listH; i++ {
+		row := tv.Rows[i]
+		y := 2 + i - tv.Scroll
+
+		files := strconv.Itoa(row.Files)
+		if row.DepFiles > 0 {
+			files = fmt.Sprintf("%d (+%d)", row.Files-row.DepFiles, row.DepFiles)
+		}
+		share := 0.0
+		if tv.Total > 0 {
+			share = float64(row.Tokens) / float64(tv.Total)
+		}
+
+		dir := row.Dir
+		if len(dir) > dirW {
+			dir = "…" + dir[len(dir)-dirW+1:]
+		}
+		fg := app.Theme.DirFg
+		if row.DepFiles == row.Files {
+			fg = app.Theme.Expanded
+		}
+		content.Text(1, y, fmt.Sprintf("%-*s", dirW, dir), fg, app.Theme.Bg, terminal.AttrNone)
+		line := fmt.Sprintf("%*s %*s %*.1f%%", filesW, files, tokensW, formatTokens(row.Tokens), shareW-1, share*100)
+		content.Text(dirW+2, y, line, app.Theme.Fg, app.Theme.Bg, terminal.AttrNone)
+		content.Progress(dirW+filesW+tokensW+shareW+5, y, barW, share, app.Theme.TagFg, app.Theme.InputBg)
+	}
+
+	if len(tv.Rows) > listH {
+		content.ScrollBar(content.W-1, tv.Scroll, listH, len(tv.Rows), app.Theme.Border)
+	}
+}
+
+// handleTokenViewEvent processes keyboard input for the breakdown overlay
+func (app *AppState) handleTokenViewEvent(ev terminal.Event) {
+	tv := app.TokenView
+	page := max(1, app.Height-5) // Modal border and table header
+	maxScroll := max(0, len(tv.Rows)-page)
+
+	switch ev.Key {
+	case terminal.KeyEscape:
+		tv.Visible = false
+	case terminal.KeyUp:
+		tv.Scroll = max(0, tv.Scroll-1)
+	case terminal.KeyDown:
+		tv.Scroll = min(maxScroll, tv.Scroll+1)
+	case terminal.KeyPageUp:
+		tv.Scroll = max(0, tv.Scroll-page)
+	case terminal.KeyPageDown:
+		tv.Scroll = min(maxScroll, tv.Scroll+page)
+	case terminal.KeyRune:
+		switch ev.Rune {
+		case 'q', 't':
+			tv.Visible = false
+		case 'j':
+			tv.Scroll = min(maxScroll, tv.Scroll+1)
+		case 'k':
+			tv.Scroll = max(0, tv.Scroll-1)
+		case 'g':
+			tv.Scroll = 0
+		case 'G':
+			tv.Scroll = maxScroll
+		}
+	}
+}
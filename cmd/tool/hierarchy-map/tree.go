@@ -7,6 +7,7 @@ import (
 )
 
 // BuildTree constructs hierarchical tree from flat index.
+// Within a workspace each module is a top-level node holding its packages.
 func BuildTree(index *Index) *TreeNode {
 	root := &TreeNode{
 		Name:     ".",
@@ -19,6 +20,24 @@ func BuildTree(index *Index) *TreeNode {
 	dirNodes := make(map[string]*TreeNode)
 	dirNodes["."] = root
 
+	if index.Workspace() {
+		for _, m := range index.Modules {
+			node := &TreeNode{
+				Name:        m.Path,
+				Path:        m.Dir,
+				IsDir:       true,
+				Expanded:    true,
+				Children:    make([]*TreeNode, 0),
+				Parent:      root,
+				PackageInfo: index.Packages[m.Dir],
+				Module:      m,
+				Depth:       1,
+			}
+			root.Children = append(root.Children, node)
+			dirNodes[m.Dir] = node
+		}
+	}
+
 	dirs := make([]string, 0, len(index.Packages))
 	for dir := range index.Packages {
 		dirs = append(dirs, dir)
@@ -26,7 +45,7 @@ func BuildTree(index *Index) *TreeNode {
 	sort.Strings(dirs)
 
 	for _, dir := range dirs {
-		if dir == "." {
+		if dirNodes[dir] != nil {
 			continue
 		}
 		ensureDirNode(root, dir, dirNodes, index)
@@ -62,14 +81,23 @@ func BuildTree(index *Index) *TreeNode {
 }
 
 // ensureDirNode creates directory node and ancestors as needed.
+// Within a workspace the chain starts at the node of the package's module.
 func ensureDirNode(root *TreeNode, dir string, dirNodes map[string]*TreeNode, index *Index) *TreeNode {
 	if node, ok := dirNodes[dir]; ok {
 		return node
 	}
 
-	parts := strings.Split(dir, "/")
 	current := root
 	currentPath := ""
+	rel := dir
+	if m := index.ModuleOf(dir); index.Workspace() && m != nil {
+		current = dirNodes[m.Dir]
+		if m.Dir != "." {
+			currentPath = m.Dir
+			rel = strings.TrimPrefix(dir, m.Dir+"/")
+		}
+	}
+	parts := strings.Split(rel, "/")
 
 	for _, part := range parts {
 		if currentPath == "" {
@@ -113,6 +141,9 @@ func sortChildren(node *TreeNode) {
 	}
 
 	sort.Slice(node.Children, func(i, j int) bool {
+		if (node.Children[i].Module != nil) != (node.Children[j].Module != nil) {
+			return node.Children[i].Module != nil
+		}
 		if node.Children[i].IsDir != node.Children[j].IsDir {
 			return node.Children[i].IsDir
 		}
@@ -157,7 +188,7 @@ func collectFiles(node *TreeNode, files *[]string) {
 
 // collapseAllRecursive recursively collapses directory nodes.
 func collapseAllRecursive(node *TreeNode) {
-	if node.IsDir && node.Path != "." {
+	if node.IsDir && node.Parent != nil {
 		node.Expanded = false
 	}
 	for _, child := range node.Children {
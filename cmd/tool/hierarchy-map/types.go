@@ -1,6 +1,9 @@
 package main
 
 import (
+	"path"
+	"strings"
+
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/terminal/tui"
 )
@@ -42,9 +45,17 @@ type AppState struct {
 	Width  int
 	Height int
 
-	Viewer *FileViewerState // File viewer overlay state
-	Editor *EditorState     // Editor overlay state
-	Help   *HelpState       // Help overlay state
+	Tokens      *TokenCounter // Per-file token estimates
+	TokenBudget int           // Output token budget, 0 disables the warning
+
+	Viewer    *FileViewerState // File viewer overlay state
+	Editor    *EditorState     // Editor overlay state
+	Help      *HelpState       // Help overlay state
+	TokenView *TokenViewState  // Token breakdown overlay state
+	Graph     *GraphState      // Import graph overlay state
+
+	Scope       IndexScope        // Files admitted to the index
+	ScopeEditor *ScopeEditorState // Scope editor overlay state
 }
 
 // DetailPaneState manages UI state for dependency panes (DepBy, DepOn)
@@ -151,6 +162,7 @@ type FileInfo struct {
 	BlankImports []string // blank imports (e.g., _ "pkg")
 	Definitions  []string // Exported symbols defined in this file
 	HasInit      bool     // true if file contains init() function
+	Generated    bool     // true if file has a "Code generated ... DO NOT EDIT." header
 	IsAll        bool
 	Size         int64
 }
@@ -177,11 +189,18 @@ type PackageInfo struct {
 	Name        string
 	Dir         string
 	Files       []*FileInfo
+	Module      *ModuleInfo // Module the package belongs to
 	LocalDeps   []string
 	HasAll      bool
 	SymbolFiles map[string]string // symbol name → file path
 }
 
+// ModuleInfo is one indexed Go module
+type ModuleInfo struct {
+	Path string // Module path from go.mod
+	Dir  string // Directory relative to the index root, "." for root
+}
+
 // CategoryIndex provides fast lookups for a single hierarchy category
 type CategoryIndex struct {
 	Groups   []string                       // sorted group names
@@ -206,7 +225,8 @@ func NewCategoryIndex() *CategoryIndex {
 
 // Index holds the complete parsed project state
 type Index struct {
-	ModulePath  string
+	ModulePath  string        // Path of the first module; the only one outside a workspace
+	Modules     []*ModuleInfo // Indexed modules sorted by directory
 	Packages    map[string]*PackageInfo
 	Files       map[string]*FileInfo
 	ReverseDeps map[string][]string // package dir → packages that import it
@@ -215,6 +235,79 @@ type Index struct {
 	CategoryNames []string                  // sorted category names
 }
 
+// Workspace reports whether the index spans several modules
+func (idx *Index) Workspace() bool {
+	return len(idx.Modules) > 1
+}
+
+// moduleAt returns the module rooted exactly at dir
+func (idx *Index) moduleAt(dir string) *ModuleInfo {
+	for _, m := range idx.Modules {
+		if m.Dir == dir {
+			return m
+		}
+	}
+	return nil
+}
+
+// ModuleOf returns the innermost module containing package directory dir
+func (idx *Index) ModuleOf(dir string) *ModuleInfo {
+	var best *ModuleInfo
+	for _, m := range idx.Modules {
+		if m.Dir == "." || dir == m.Dir || strings.HasPrefix(dir, m.Dir+"/") {
+			if best == nil || len(m.Dir) > len(best.Dir) || best.Dir == "." {
+				best = m
+			}
+		}
+	}
+	return best
+}
+
+// ImportDir converts a full import path to the package directory of the indexed module
+// providing it; false for imports outside every module
+func (idx *Index) ImportDir(importPath string) (string, bool) {
+	var best *ModuleInfo
+	for _, m := range idx.Modules {
+		if importPath == m.Path || strings.HasPrefix(importPath, m.Path+"/") {
+			if best == nil || len(m.Path) > len(best.Path) {
+				best = m
+			}
+		}
+	}
+	if best == nil {
+		return "", false
+	}
+	return path.Join(best.Dir, strings.TrimPrefix(importPath, best.Path)), true
+}
+
+// ImportPath converts a package directory to its full import path
+func (idx *Index) ImportPath(dir string) string {
+	m := idx.ModuleOf(dir)
+	if m == nil {
+		return dir
+	}
+	rel := strings.TrimPrefix(strings.TrimPrefix(dir, m.Dir), "/")
+	if m.Dir == "." {
+		rel = dir
+	}
+	if rel == "." || rel == "" {
+		return m.Path
+	}
+	return m.Path + "/" + rel
+}
+
+// PackageLabel names a package directory for display
+// Within a workspace the import path qualifies it by module
+func (idx *Index) PackageLabel(dir string) string {
+	if idx.Workspace() {
+		return idx.ImportPath(dir)
+	}
+	if dir == "." {
+		return "(root)"
+	}
+	return dir
+}
+
 // Category returns the index for a specific category name
 func (idx *Index) Category(name string) *CategoryIndex {
 	if idx.Categories == nil {
@@ -271,6 +364,7 @@ type TreeNode struct {
 	Parent      *TreeNode    // Parent node (nil for root)
 	FileInfo    *FileInfo    // Non-nil for files
 	PackageInfo *PackageInfo // Non-nil for package directories
+	Module      *ModuleInfo  // Non-nil for workspace module nodes
 	Depth       int          // Nesting level for indentation
 }
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"path/filepath"
 	"sort"
-	"strings"
 
 	"github.com/lixenwraith/terminal"
 )
@@ -20,6 +19,21 @@ func (app *AppState) HandleEvent(ev terminal.Event) (quit, output bool) {
 		return false, false
 	}
 
+	if app.Graph != nil && app.Graph.Visible {
+		app.handleGraphEvent(ev)
+		return false, false
+	}
+
+	if app.TokenView != nil && app.TokenView.Visible {
+		app.handleTokenViewEvent(ev)
+		return false, false
+	}
+
+	if app.ScopeEditor != nil && app.ScopeEditor.Visible {
+		app.handleScopeEditorEvent(ev)
+		return false, false
+	}
+
 	// Handle editor events second if visible
 	if app.Editor != nil && app.Editor.Visible {
 		app.handleEditorEvent(ev)
@@ -46,6 +60,12 @@ func (app *AppState) HandleEvent(ev terminal.Event) (quit, output bool) {
 		case 'e':
 			app.OpenEditor()
 			return false, false
+		case 't':
+			app.ToggleTokenView()
+			return false, false
+		case 'v':
+			app.ToggleGraph()
+			return false, false
 		case 'f':
 			app.applyCurrentPaneFilter()
 			return false, false
@@ -56,6 +76,12 @@ func (app *AppState) HandleEvent(ev terminal.Event) (quit, output bool) {
 		case 'r':
 			app.ReindexAll()
 			return false, false
+		case 'i':
+			app.ToggleScopeEditor()
+			return false, false
+		case 'G':
+			app.ToggleGenerated()
+			return false, false
 		case 'd':
 			app.ExpandDeps = !app.ExpandDeps
 			if app.ExpandDeps {
@@ -1118,7 +1144,7 @@ func (app *AppState) triggerAnalysis() {
 	}
 
 	if _, ok := app.DepAnalysisCache[node.Path]; !ok {
-		analysis, err := AnalyzeFileDependencies(node.Path, app.Index.ModulePath)
+		analysis, err := AnalyzeFileDependencies(node.Path, app.Index)
 		if err == nil {
 			app.DepAnalysisCache[node.Path] = analysis
 		}
@@ -1152,10 +1178,7 @@ func (app *AppState) rebuildDepByFlat() {
 		}
 	}
 
-	fullImportPath := app.Index.ModulePath
-	if pkgDir != "." {
-		fullImportPath += "/" + pkgDir
-	}
+	fullImportPath := app.Index.ImportPath(pkgDir)
 
 	// Group files by package
 	type depFile struct {
@@ -1171,7 +1194,7 @@ func (app *AppState) rebuildDepByFlat() {
 		if targetDefs != nil {
 			analysis, ok := app.DepAnalysisCache[fPath]
 			if !ok {
-				a, err := AnalyzeFileDependencies(fPath, app.Index.ModulePath)
+				a, err := AnalyzeFileDependencies(fPath, app.Index)
 				if err == nil {
 					analysis = a
 					app.DepAnalysisCache[fPath] = a
@@ -1223,10 +1246,7 @@ func (app *AppState) rebuildDepByFlat() {
 		}
 		isExpanded := app.DepByState.Expansion.IsExpanded(p)
 
-		label := p
-		if label == "." {
-			label = "(root)"
-		}
+		label := app.Index.PackageLabel(p)
 
 		app.DepByState.FlatItems = append(app.DepByState.FlatItems, DetailItem{
 			Level:    0,
@@ -1275,16 +1295,7 @@ func (app *AppState) rebuildDepOnFlat() {
 	sort.Strings(importPaths)
 
 	for _, path := range importPaths {
-		isLocal := strings.HasPrefix(path, app.Index.ModulePath)
-
-		var pkgDir string
-		if isLocal {
-			if path == app.Index.ModulePath {
-				pkgDir = "."
-			} else {
-				pkgDir = strings.TrimPrefix(path, app.Index.ModulePath+"/")
-			}
-		}
+		pkgDir, isLocal := app.Index.ImportDir(path)
 
 		// Default to expanded if not set
 		if _, known := app.DepOnState.Expansion.State[path]; !known {
@@ -1294,10 +1305,7 @@ func (app *AppState) rebuildDepOnFlat() {
 
 		dispName := path
 		if isLocal {
-			dispName = strings.TrimPrefix(path, app.Index.ModulePath+"/")
-			if dispName == "" {
-				dispName = "(root)"
-			}
+			dispName = app.Index.PackageLabel(pkgDir)
 		}
 
 		symbols := analysis.UsedSymbols[path]
@@ -0,0 +1,1305 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset"
+	"github.com/lixenwraith/vi-fighter/asset/sprite"
+	"github.com/lixenwraith/vi-fighter/core"
+)
+
+// Editor constants
+const (
+	PreviewTick   = 150 * time.Millisecond // sandbox tick rate, drives TicksPerFrame playback
+	DefaultWidth  = 8
+	DefaultHeight = 3
+	DefaultTicks  = 4
+	MaxSize       = 64
+)
+
+// Editing layers, in Tab order
+const (
+	LayerArt = iota
+	LayerFg
+	LayerBg
+	LayerAttr
+	layerCount
+)
+
+var layerNames = [layerCount]string{"ART", "FG", "BG", "ATTR"}
+
+// UI Colors
+var (
+	ColorBg        = color.RGB{R: 16, G: 16, B: 20}
+	ColorGridBg    = color.RGB{R: 30, G: 30, B: 35}
+	ColorCursor    = color.RGB{R: 255, G: 0, B: 255}
+	ColorText      = color.RGB{R: 200, G: 200, B: 220}
+	ColorHighlight = color.RGB{R: 255, G: 200, B: 0}
+	ColorDim       = color.RGB{R: 100, G: 100, B: 110}
+	ColorOnion     = color.RGB{R: 70, G: 90, B: 110}
+	ColorBorder    = color.RGB{R: 80, G: 80, B: 100}
+	ColorSuccess   = color.RGB{R: 50, G: 200, B: 100}
+	ColorError     = color.RGB{R: 200, G: 50, B: 50}
+)
+
+// Box drawing characters
+const (
+	BoxTopLeft     = '┌'
+	BoxTopRight    = '┐'
+	BoxBottomLeft  = '└'
+	BoxBottomRight = '┘'
+	BoxHorizontal  = '─'
+	BoxVertical    = '│'
+	BlockFull      = '█'
+	DotMiddle      = '·'
+)
+
+type Editor struct {
+	term    terminal.Terminal
+	running bool
+	width   int
+	height  int
+
+	// Data
+	path      string
+	templates []sprite.Template
+	current   int
+	frame     int
+	modified  bool
+
+	// Cursor
+	cursorX int
+	cursorY int
+	layer   int
+	palIdx  int
+
+	// Preview
+	onion     bool
+	playing   bool
+	tick      int
+	startTime time.Time
+
+	// UI State
+	insertMode  bool
+	replaceNext bool
+	quitArmed   bool
+	statusMsg   string
+	statusType  int // 0=info, 1=success, 2=error
+	statusTimer time.Time
+
+	// Single-line prompt, submit returns an error to keep the prompt open
+	promptLabel  string
+	promptText   string
+	promptSubmit func(string) error
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: sprite-editor FILE.sprite\n\n")
+		fmt.Fprintf(os.Stderr, "Opens FILE, or the embedded bestiary file of the same name if FILE does not exist yet\n")
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	path := flag.Arg(0)
+	templates, err := loadTemplates(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	term := terminal.New(terminal.ColorModeTrueColor)
+	if err := term.Init(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize terminal: %v\n", err)
+		os.Exit(1)
+	}
+	core.SetCrashTerminal(term)
+	defer term.Fini()
+	defer core.RecoverAndRestore()
+
+	ticker := time.NewTicker(PreviewTick)
+	defer ticker.Stop()
+	core.Go(func() {
+		for range ticker.C {
+			term.PostEvent(terminal.Event{Type: terminal.EventKey, Key: terminal.KeyNone})
+		}
+	})
+
+	editor := NewEditor(term, path, templates)
+	editor.Run()
+}
+
+// loadTemplates reads path, falling back to the embedded bestiary and then to a blank sprite
+func loadTemplates(path string) ([]sprite.Template, error) {
+	templates, err := sprite.LoadFile(path)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return templates, err
+	}
+
+	base := filepath.Base(path)
+	if _, err := fs.Stat(asset.DefaultBestiary, base); err == nil {
+		return sprite.Load(asset.DefaultBestiary, base)
+	}
+
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	return []sprite.Template{newTemplate(name)}, nil
+}
+
+// newTemplate returns a single blank frame sprite with one foreground color
+func newTemplate(name string) sprite.Template {
+	t := sprite.Template{
+		Name:          name,
+		Width:         DefaultWidth,
+		Height:        DefaultHeight,
+		FgPalette:     []color.RGB{ColorText},
+		TicksPerFrame: DefaultTicks,
+	}
+	t.Frames = []sprite.Frame{blankFrame(t.Width, t.Height)}
+	return t
+}
+
+func blankFrame(w, h int) sprite.Frame {
+	var f sprite.Frame
+	for i := range layerCount {
+		rows := layerRows(&f, i)
+		for range h {
+			*rows = append(*rows, strings.Repeat(" ", w))
+		}
+	}
+	return f
+}
+
+// layerRows returns the row slice backing an editing layer
+func layerRows(f *sprite.Frame, layer int) *[]string {
+	switch layer {
+	case LayerFg:
+		return &f.Fg
+	case LayerBg:
+		return &f.Bg
+	case LayerAttr:
+		return &f.Attr
+	default:
+		return &f.Art
+	}
+}
+
+func NewEditor(term terminal.Terminal, path string, templates []sprite.Template) *Editor {
+	return &Editor{
+		term:      term,
+		running:   true,
+		path:      path,
+		templates: templates,
+		onion:     true,
+		playing:   true,
+		startTime: time.Now(),
+	}
+}
+
+func (e *Editor) Run() {
+	w, h := e.term.Size()
+	e.width = w
+	e.height = h
+
+	e.draw()
+
+	for e.running {
+		ev := e.term.PollEvent()
+
+		// Clear expired status
+		if !e.statusTimer.IsZero() && time.Now().After(e.statusTimer) {
+			e.statusMsg = ""
+			e.statusTimer = time.Time{}
+		}
+
+		switch ev.Type {
+		case terminal.EventResize:
+			e.width = ev.Width
+			e.height = ev.Height
+			e.term.Sync()
+
+		case terminal.EventKey:
+			if ev.Key == terminal.KeyNone {
+				if e.playing {
+					e.tick++
+				}
+				break
+			}
+			e.handleEvent(ev)
+
+		case terminal.EventClosed, terminal.EventError:
+			e.running = false
+			continue
+		}
+
+		e.draw()
+	}
+}
+
+func (e *Editor) tmpl() *sprite.Template {
+	return &e.templates[e.current]
+}
+
+func (e *Editor) curFrame() *sprite.Frame {
+	return &e.tmpl().Frames[e.frame]
+}
+
+// palette returns the palette addressed by the current layer, nil for art and attr
+func (e *Editor) palette() *[]color.RGB {
+	switch e.layer {
+	case LayerFg:
+		return &e.tmpl().FgPalette
+	case LayerBg:
+		return &e.tmpl().BgPalette
+	}
+	return nil
+}
+
+func (e *Editor) notes() *[]string {
+	if e.layer == LayerBg {
+		return &e.tmpl().BgNotes
+	}
+	return &e.tmpl().FgNotes
+}
+
+func (e *Editor) handleEvent(ev terminal.Event) {
+	if e.promptSubmit != nil {
+		e.handlePromptInput(ev)
+		return
+	}
+	if e.insertMode {
+		e.handleInsertInput(ev)
+		return
+	}
+	if e.replaceNext {
+		e.replaceNext = false
+		switch {
+		case ev.Key == terminal.KeySpace:
+			e.setCellByte(LayerArt, e.cursorX, e.cursorY, ' ')
+		case ev.Key == terminal.KeyRune && ev.Rune >= 0x20 && ev.Rune < 0x7f:
+			e.setCellByte(LayerArt, e.cursorX, e.cursorY, byte(ev.Rune))
+		case ev.Key == terminal.KeyRune:
+			e.setStatus("Art is limited to printable ASCII", 2)
+		}
+		return
+	}
+
+	quitArmed := e.quitArmed
+	e.quitArmed = false
+
+	switch ev.Key {
+	case terminal.KeyCtrlC, terminal.KeyCtrlQ:
+		e.running = false
+	case terminal.KeyEscape:
+		e.quit(quitArmed)
+	case terminal.KeyCtrlS:
+		e.save()
+
+	case terminal.KeyUp:
+		e.moveCursor(0, -1)
+	case terminal.KeyDown:
+		e.moveCursor(0, 1)
+	case terminal.KeyLeft:
+		e.moveCursor(-1, 0)
+	case terminal.KeyRight:
+		e.moveCursor(1, 0)
+
+	case terminal.KeyTab:
+		e.layer = (e.layer + 1) % layerCount
+		e.clampPalIdx()
+	case terminal.KeyBacktab:
+		e.layer = (e.layer + layerCount - 1) % layerCount
+		e.clampPalIdx()
+
+	case terminal.KeySpace:
+		e.paint()
+	case terminal.KeyBackspace, terminal.KeyDelete:
+		e.setCellByte(e.layer, e.cursorX, e.cursorY, ' ')
+
+	case terminal.KeyRune:
+		e.handleRuneInput(ev.Rune, quitArmed)
+	}
+}
+
+func (e *Editor) handleRuneInput(r rune, quitArmed bool) {
+	switch r {
+	case 'q':
+		e.quit(quitArmed)
+	case 'w':
+		e.save()
+
+	case 'h':
+		e.moveCursor(-1, 0)
+	case 'j':
+		e.moveCursor(0, 1)
+	case 'k':
+		e.moveCursor(0, -1)
+	case 'l':
+		e.moveCursor(1, 0)
+
+	case 'x':
+		e.setCellByte(e.layer, e.cursorX, e.cursorY, ' ')
+	case 'r':
+		e.replaceNext = true
+		e.setStatus("Replace art: type character", 0)
+	case 'i':
+		e.layer = LayerArt
+		e.insertMode = true
+		e.setStatus("INSERT - type art (ESC to exit)", 0)
+	case 'c':
+		e.pickColor()
+
+	// Palette
+	case '[':
+		e.cyclePalIdx(-1)
+	case ']':
+		e.cyclePalIdx(1)
+	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		e.selectPalIdx(int(r - '0'))
+	case 'a':
+		e.promptAddColor()
+	case 'e':
+		e.promptEditColor()
+	case 'd':
+		e.deleteColor()
+	case 'm':
+		e.promptColorNote()
+
+	// Frames
+	case ',':
+		e.selectFrame(e.frame - 1)
+	case '.':
+		e.selectFrame(e.frame + 1)
+	case 'n':
+		e.duplicateFrame()
+	case 'D':
+		e.deleteFrame()
+	case '<':
+		e.moveFrame(-1)
+	case '>':
+		e.moveFrame(1)
+	case 'o':
+		e.onion = !e.onion
+	case 'L':
+		e.promptFrameLabel()
+
+	// Sprites
+	case '{':
+		e.selectSprite(e.current - 1)
+	case '}':
+		e.selectSprite(e.current + 1)
+	case 'N':
+		e.promptNewSprite()
+	case 'R':
+		e.promptRename()
+	case 's':
+		e.promptResize()
+
+	// Animation
+	case 'p':
+		e.playing = !e.playing
+	case '+', '=':
+		e.tmpl().TicksPerFrame++
+		e.modified = true
+	case '-':
+		if e.tmpl().TicksPerFrame > 1 {
+			e.tmpl().TicksPerFrame--
+			e.modified = true
+		}
+	case 'u':
+		e.promptAura()
+	}
+}
+
+func (e *Editor) handleInsertInput(ev terminal.Event) {
+	t := e.tmpl()
+	switch ev.Key {
+	case terminal.KeyEscape:
+		e.insertMode = false
+		e.setStatus("Editor mode", 0)
+	case terminal.KeyUp:
+		e.moveCursor(0, -1)
+	case terminal.KeyDown:
+		e.moveCursor(0, 1)
+	case terminal.KeyLeft:
+		e.moveCursor(-1, 0)
+	case terminal.KeyRight:
+		e.moveCursor(1, 0)
+	case terminal.KeyEnter:
+		e.cursorX = 0
+		e.moveCursor(0, 1)
+	case terminal.KeyBackspace:
+		if e.cursorX > 0 {
+			e.cursorX--
+		} else if e.cursorY > 0 {
+			e.cursorY--
+			e.cursorX = t.Width - 1
+		}
+		e.setCellByte(LayerArt, e.cursorX, e.cursorY, ' ')
+	case terminal.KeySpace, terminal.KeyRune:
+		r := ev.Rune
+		if ev.Key == terminal.KeySpace {
+			r = ' '
+		}
+		if r < 0x20 || r >= 0x7f {
+			e.setStatus("Art is limited to printable ASCII", 2)
+			return
+		}
+		e.setCellByte(LayerArt, e.cursorX, e.cursorY, byte(r))
+		if e.cursorX < t.Width-1 {
+			e.cursorX++
+		} else if e.cursorY < t.Height-1 {
+			e.cursorX = 0
+			e.cursorY++
+		}
+	}
+}
+
+func (e *Editor) handlePromptInput(ev terminal.Event) {
+	switch ev.Key {
+	case terminal.KeyEscape:
+		e.promptSubmit = nil
+	case terminal.KeyEnter:
+		if err := e.promptSubmit(strings.TrimSpace(e.promptText)); err != nil {
+			e.setStatus(err.Error(), 2)
+			return
+		}
+		e.promptSubmit = nil
+	case terminal.KeyBackspace:
+		if len(e.promptText) > 0 {
+			e.promptText = e.promptText[:len(e.promptText)-1]
+		}
+	case terminal.KeySpace:
+		e.promptText += " "
+	case terminal.KeyRune:
+		if ev.Rune >= 0x20 && ev.Rune < 0x7f {
+			e.promptText += string(ev.Rune)
+		}
+	}
+}
+
+func (e *Editor) prompt(label, initial string, submit func(string) error) {
+	e.promptLabel = label
+	e.promptText = initial
+	e.promptSubmit = submit
+}
+
+func (e *Editor) quit(confirmed bool) {
+	if e.modified && !confirmed {
+		e.quitArmed = true
+		e.setStatus("Unsaved changes - press q again to quit", 2)
+		return
+	}
+	e.running = false
+}
+
+func (e *Editor) save() {
+	// Validate and encode before touching the file so a bad sprite never truncates it
+	var buf bytes.Buffer
+	if err := sprite.Write(&buf, e.templates); err != nil {
+		e.setStatus(err.Error(), 2)
+		return
+	}
+	if err := os.WriteFile(e.path, buf.Bytes(), 0644); err != nil {
+		e.setStatus(err.Error(), 2)
+		return
+	}
+	e.modified = false
+	e.setStatus(fmt.Sprintf("Saved %d sprites to %s", len(e.templates), e.path), 1)
+}
+
+func (e *Editor) setStatus(msg string, msgType int) {
+	e.statusMsg = msg
+	e.statusType = msgType
+	e.statusTimer = time.Now().Add(3 * time.Second)
+}
+
+// === Cell editing ===
+
+func (e *Editor) moveCursor(dx, dy int) {
+	t := e.tmpl()
+	e.cursorX = max(0, min(t.Width-1, e.cursorX+dx))
+	e.cursorY = max(0, min(t.Height-1, e.cursorY+dy))
+}
+
+func (e *Editor) cellByte(f *sprite.Frame, layer, x, y int) byte {
+	rows := *layerRows(f, layer)
+	if y >= len(rows) || x >= len(rows[y]) {
+		return ' '
+	}
+	return rows[y][x]
+}
+
+// setCellByte writes one layer byte, materializing an absent optional layer first
+func (e *Editor) setCellByte(layer, x, y int, b byte) {
+	t := e.tmpl()
+	rows := layerRows(e.curFrame(), layer)
+	if len(*rows) != t.Height {
+		*rows = blankRows(*rows, t.Width, t.Height)
+	}
+	row := []byte((*rows)[y])
+	if row[x] == b {
+		return
+	}
+	row[x] = b
+	(*rows)[y] = string(row)
+	e.modified = true
+}
+
+// paint applies the current brush: palette index for fg/bg, attribute cycle for attr
+func (e *Editor) paint() {
+	switch e.layer {
+	case LayerArt:
+		e.replaceNext = true
+		e.setStatus("Replace art: type character", 0)
+	case LayerFg, LayerBg:
+		if len(*e.palette()) == 0 {
+			e.setStatus("Palette empty - add a color with a", 2)
+			return
+		}
+		e.setCellByte(e.layer, e.cursorX, e.cursorY, sprite.EncodeIndex(e.palIdx))
+	case LayerAttr:
+		next := byte(sprite.AttrBold)
+		switch e.cellByte(e.curFrame(), LayerAttr, e.cursorX, e.cursorY) {
+		case sprite.AttrBold:
+			next = sprite.AttrDim
+		case sprite.AttrDim:
+			next = sprite.AttrNone
+		}
+		e.setCellByte(LayerAttr, e.cursorX, e.cursorY, next)
+	}
+}
+
+// pickColor selects the palette index under the cursor
+func (e *Editor) pickColor() {
+	if e.palette() == nil {
+		e.setStatus("Pick works on FG/BG layers", 2)
+		return
+	}
+	idx := sprite.PaletteIndex(e.cellByte(e.curFrame(), e.layer, e.cursorX, e.cursorY))
+	if idx < 0 {
+		e.setStatus("No color at cursor", 2)
+		return
+	}
+	e.palIdx = idx
+}
+
+func blankRows(rows []string, w, h int) []string {
+	out := make([]string, h)
+	for y := range h {
+		row := ""
+		if y < len(rows) {
+			row = rows[y]
+		}
+		if len(row) > w {
+			row = row[:w]
+		}
+		out[y] = row + strings.Repeat(" ", w-len(row))
+	}
+	return out
+}
+
+// === Palette ===
+
+func (e *Editor) clampPalIdx() {
+	pal := e.palette()
+	if pal == nil {
+		return
+	}
+	e.palIdx = max(0, min(len(*pal)-1, e.palIdx))
+}
+
+func (e *Editor) selectPalIdx(idx int) {
+	pal := e.palette()
+	if pal == nil {
+		e.setStatus("Palette applies to FG/BG layers", 2)
+		return
+	}
+	if idx >= len(*pal) {
+		e.setStatus(fmt.Sprintf("No color %c", sprite.EncodeIndex(idx)), 2)
+		return
+	}
+	e.palIdx = idx
+}
+
+func (e *Editor) cyclePalIdx(delta int) {
+	pal := e.palette()
+	if pal == nil || len(*pal) == 0 {
+		return
+	}
+	n := len(*pal)
+	e.palIdx = (e.palIdx + delta + n) % n
+}
+
+func (e *Editor) promptAddColor() {
+	pal := e.palette()
+	if pal == nil {
+		e.setStatus("Palette applies to FG/BG layers", 2)
+		return
+	}
+	if len(*pal) >= sprite.MaxPalette {
+		e.setStatus(fmt.Sprintf("Palette full (%d colors)", sprite.MaxPalette), 2)
+		return
+	}
+	e.prompt("New "+layerNames[e.layer]+" color (#rrggbb)", "#", func(s string) error {
+		c, err := color.ParseHex(s)
+		if err != nil {
+			return err
+		}
+		*pal = append(*pal, c)
+		e.palIdx = len(*pal) - 1
+		e.modified = true
+		return nil
+	})
+}
+
+func (e *Editor) promptEditColor() {
+	pal := e.palette()
+	if pal == nil || len(*pal) == 0 {
+		e.setStatus("No color selected", 2)
+		return
+	}
+	idx := e.palIdx
+	e.prompt(fmt.Sprintf("%s color %c (#rrggbb)", layerNames[e.layer], sprite.EncodeIndex(idx)), (*pal)[idx].Hex(), func(s string) error {
+		c, err := color.ParseHex(s)
+		if err != nil {
+			return err
+		}
+		(*pal)[idx] = c
+		e.modified = true
+		return nil
+	})
+}
+
+func (e *Editor) promptColorNote() {
+	pal := e.palette()
+	if pal == nil || len(*pal) == 0 {
+		e.setStatus("No color selected", 2)
+		return
+	}
+	notes := e.notes()
+	idx := e.palIdx
+	initial := ""
+	if idx < len(*notes) {
+		initial = (*notes)[idx]
+	}
+	e.prompt(fmt.Sprintf("%s color %c note", layerNames[e.layer], sprite.EncodeIndex(idx)), initial, func(s string) error {
+		for len(*notes) <= idx {
+			*notes = append(*notes, "")
+		}
+		(*notes)[idx] = s
+		e.modified = true
+		return nil
+	})
+}
+
+// deleteColor removes the selected color if no frame uses it, shifting higher indices down
+func (e *Editor) deleteColor() {
+	pal := e.palette()
+	if pal == nil || len(*pal) == 0 {
+		e.setStatus("No color selected", 2)
+		return
+	}
+	t := e.tmpl()
+	idx := e.palIdx
+	target := sprite.EncodeIndex(idx)
+	for i := range t.Frames {
+		for _, row := range *layerRows(&t.Frames[i], e.layer) {
+			if strings.IndexByte(row, target) >= 0 {
+				e.setStatus(fmt.Sprintf("Color %c in use by frame %d", target, i), 2)
+				return
+			}
+		}
+	}
+
+	for i := range t.Frames {
+		rows := layerRows(&t.Frames[i], e.layer)
+		for y, row := range *rows {
+			b := []byte(row)
+			for x := range b {
+				if p := sprite.PaletteIndex(b[x]); p > idx {
+					b[x] = sprite.EncodeIndex(p - 1)
+				}
+			}
+			(*rows)[y] = string(b)
+		}
+	}
+	*pal = append((*pal)[:idx], (*pal)[idx+1:]...)
+	if notes := e.notes(); idx < len(*notes) {
+		*notes = append((*notes)[:idx], (*notes)[idx+1:]...)
+	}
+	e.clampPalIdx()
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Deleted color %c", target), 1)
+}
+
+// === Frames ===
+
+func (e *Editor) selectFrame(idx int) {
+	n := len(e.tmpl().Frames)
+	e.frame = (idx + n) % n
+}
+
+func (e *Editor) duplicateFrame() {
+	t := e.tmpl()
+	src := t.Frames[e.frame]
+	dup := sprite.Frame{Label: src.Label}
+	for i := range layerCount {
+		*layerRows(&dup, i) = append([]string(nil), *layerRows(&src, i)...)
+	}
+	t.Frames = append(t.Frames[:e.frame+1], append([]sprite.Frame{dup}, t.Frames[e.frame+1:]...)...)
+	e.frame++
+	e.modified = true
+	e.setStatus(fmt.Sprintf("Added frame %d", e.frame), 1)
+}
+
+func (e *Editor) deleteFrame() {
+	t := e.tmpl()
+	if len(t.Frames) == 1 {
+		e.setStatus("Cannot delete the only frame", 2)
+		return
+	}
+	t.Frames = append(t.Frames[:e.frame], t.Frames[e.frame+1:]...)
+	e.frame = min(e.frame, len(t.Frames)-1)
+	e.modified = true
+	e.setStatus("Deleted frame", 1)
+}
+
+func (e *Editor) moveFrame(delta int) {
+	t := e.tmpl()
+	to := e.frame + delta
+	if to < 0 || to >= len(t.Frames) {
+		return
+	}
+	t.Frames[e.frame], t.Frames[to] = t.Frames[to], t.Frames[e.frame]
+	e.frame = to
+	e.modified = true
+}
+
+func (e *Editor) promptFrameLabel() {
+	f := e.curFrame()
+	e.prompt(fmt.Sprintf("Frame %d label", e.frame), f.Label, func(s string) error {
+		f.Label = s
+		e.modified = true
+		return nil
+	})
+}
+
+// === Sprites ===
+
+func (e *Editor) selectSprite(idx int) {
+	n := len(e.templates)
+	e.current = (idx + n) % n
+	e.frame = 0
+	e.moveCursor(0, 0)
+	e.clampPalIdx()
+}
+
+func (e *Editor) nameTaken(name string) bool {
+	for i := range e.templates {
+		if e.templates[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Editor) promptNewSprite() {
+	e.prompt("New sprite name", "", func(s string) error {
+		if s == "" {
+			return fmt.Errorf("name required")
+		}
+		if e.nameTaken(s) {
+			return fmt.Errorf("sprite %q exists", s)
+		}
+		e.templates = append(e.templates, newTemplate(s))
+		e.selectSprite(len(e.templates) - 1)
+		e.modified = true
+		return nil
+	})
+}
+
+func (e *Editor) promptRename() {
+	t := e.tmpl()
+	e.prompt("Rename sprite", t.Name, func(s string) error {
+		if s == "" {
+			return fmt.Errorf("name required")
+		}
+		if s != t.Name && e.nameTaken(s) {
+			return fmt.Errorf("sprite %q exists", s)
+		}
+		t.Name = s
+		e.modified = true
+		return nil
+	})
+}
+
+func (e *Editor) promptResize() {
+	t := e.tmpl()
+	e.prompt("Size (W H)", fmt.Sprintf("%d %d", t.Width, t.Height), func(s string) error {
+		var w, h int
+		if _, err := fmt.Sscanf(s, "%d %d", &w, &h); err != nil {
+			return fmt.Errorf("size: want W H")
+		}
+		if w <= 0 || h <= 0 || w > MaxSize || h > MaxSize {
+			return fmt.Errorf("size: must be 1-%d", MaxSize)
+		}
+		// Crop or pad every layer from the top-left corner
+		for i := range t.Frames {
+			for l := range layerCount {
+				rows := layerRows(&t.Frames[i], l)
+				if l == LayerArt || len(*rows) > 0 {
+					*rows = blankRows(*rows, w, h)
+				}
+			}
+		}
+		t.Width, t.Height = w, h
+		e.moveCursor(0, 0)
+		e.modified = true
+		return nil
+	})
+}
+
+func (e *Editor) promptAura() {
+	t := e.tmpl()
+	initial := "off"
+	if t.AuraRadius != 0 {
+		initial = fmt.Sprintf("%s %s %s %s %s", t.AuraColor.Hex(), formatFloat(t.AuraRadius),
+			formatFloat(t.AuraPulseFreq), formatFloat(t.AuraRotSpeed), formatFloat(t.AuraFocusWidth))
+	}
+	e.prompt("Aura (#rrggbb RADIUS HZ [ROT FOCUS] | off)", initial, func(s string) error {
+		fields := strings.Fields(s)
+		if len(fields) == 1 && fields[0] == "off" {
+			t.AuraColor, t.AuraRadius, t.AuraPulseFreq, t.AuraRotSpeed, t.AuraFocusWidth = color.RGB{}, 0, 0, 0, 0
+			e.modified = true
+			return nil
+		}
+		if len(fields) != 3 && len(fields) != 5 {
+			return fmt.Errorf("aura: want #rrggbb RADIUS HZ [ROT FOCUS]")
+		}
+		c, err := color.ParseHex(fields[0])
+		if err != nil {
+			return fmt.Errorf("aura: %w", err)
+		}
+		nums := make([]float64, 4)
+		for i, f := range fields[1:] {
+			if nums[i], err = strconv.ParseFloat(f, 64); err != nil {
+				return fmt.Errorf("aura: %w", err)
+			}
+		}
+		if nums[0] <= 0 {
+			return fmt.Errorf("aura: radius must be positive")
+		}
+		t.AuraColor, t.AuraRadius, t.AuraPulseFreq, t.AuraRotSpeed, t.AuraFocusWidth = c, nums[0], nums[1], nums[2], nums[3]
+		e.modified = true
+		return nil
+	})
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// === Rendering ===
+
+func (e *Editor) draw() {
+	cells := make([]terminal.Cell, e.width*e.height)
+
+	bgCell := terminal.Cell{Rune: ' ', Bg: ColorBg}
+	for i := range cells {
+		cells[i] = bgCell
+	}
+
+	e.drawHeader(cells)
+	bottom := e.drawCanvas(cells)
+	bottom = e.drawPalette(cells, bottom+1)
+	e.drawFrames(cells, bottom+1)
+	e.drawHelp(cells)
+	e.drawPrompt(cells)
+	e.drawStatus(cells)
+
+	e.term.Flush(cells, e.width, e.height)
+}
+
+func (e *Editor) setCell(cells []terminal.Cell, x, y int, c terminal.Cell) {
+	if x < 0 || x >= e.width || y < 0 || y >= e.height {
+		return
+	}
+	cells[y*e.width+x] = c
+}
+
+func (e *Editor) getCell(cells []terminal.Cell, x, y int) (terminal.Cell, bool) {
+	if x < 0 || x >= e.width || y < 0 || y >= e.height {
+		return terminal.Cell{}, false
+	}
+	return cells[y*e.width+x], true
+}
+
+func (e *Editor) drawText(cells []terminal.Cell, x, y int, text string, fg, bg color.RGB, attrs terminal.Attr) {
+	i := 0
+	for _, r := range text {
+		e.setCell(cells, x+i, y, terminal.Cell{
+			Rune:  r,
+			Fg:    fg,
+			Bg:    bg,
+			Attrs: attrs,
+		})
+		i++
+	}
+}
+
+func (e *Editor) drawBox(cells []terminal.Cell, x, y, w, h int, title string) {
+	borderFg := ColorBorder
+
+	e.setCell(cells, x, y, terminal.Cell{Rune: BoxTopLeft, Fg: borderFg, Bg: ColorBg})
+	e.setCell(cells, x+w-1, y, terminal.Cell{Rune: BoxTopRight, Fg: borderFg, Bg: ColorBg})
+	e.setCell(cells, x, y+h-1, terminal.Cell{Rune: BoxBottomLeft, Fg: borderFg, Bg: ColorBg})
+	e.setCell(cells, x+w-1, y+h-1, terminal.Cell{Rune: BoxBottomRight, Fg: borderFg, Bg: ColorBg})
+
+	for i := 1; i < w-1; i++ {
+		e.setCell(cells, x+i, y, terminal.Cell{Rune: BoxHorizontal, Fg: borderFg, Bg: ColorBg})
+		e.setCell(cells, x+i, y+h-1, terminal.Cell{Rune: BoxHorizontal, Fg: borderFg, Bg: ColorBg})
+	}
+
+	for i := 1; i < h-1; i++ {
+		e.setCell(cells, x, y+i, terminal.Cell{Rune: BoxVertical, Fg: borderFg, Bg: ColorBg})
+		e.setCell(cells, x+w-1, y+i, terminal.Cell{Rune: BoxVertical, Fg: borderFg, Bg: ColorBg})
+	}
+
+	if title != "" {
+		e.drawText(cells, x+2, y, " "+title+" ", ColorHighlight, ColorBg, terminal.AttrBold)
+	}
+}
+
+func (e *Editor) drawHeader(cells []terminal.Cell) {
+	modMark := " "
+	if e.modified {
+		modMark = "*"
+	}
+	t := e.tmpl()
+	header := fmt.Sprintf(" VI-FIGHTER SPRITE EDITOR │ %s (%d/%d) %dx%d%s ",
+		t.Name, e.current+1, len(e.templates), t.Width, t.Height, modMark)
+	startX := max(0, (e.width-len([]rune(header)))/2)
+	e.drawText(cells, startX, 1, header, ColorText, ColorBg, terminal.AttrBold)
+}
+
+// composite resolves one sprite cell the way the game renders it over bg
+func composite(t *sprite.Template, f *sprite.Frame, x, y int, bg color.RGB) terminal.Cell {
+	c := terminal.Cell{Rune: rune(cellAt(f.Art, x, y)), Fg: ColorText, Bg: bg}
+	if idx := sprite.PaletteIndex(cellAt(f.Fg, x, y)); idx >= 0 && idx < len(t.FgPalette) {
+		c.Fg = t.FgPalette[idx]
+	}
+	if idx := sprite.PaletteIndex(cellAt(f.Bg, x, y)); idx >= 0 && idx < len(t.BgPalette) {
+		c.Bg = t.BgPalette[idx]
+	}
+	switch cellAt(f.Attr, x, y) {
+	case sprite.AttrBold:
+		c.Attrs = terminal.AttrBold
+	case sprite.AttrDim:
+		c.Attrs = terminal.AttrDim
+	}
+	return c
+}
+
+func cellAt(rows []string, x, y int) byte {
+	if y >= len(rows) || x >= len(rows[y]) {
+		return ' '
+	}
+	return rows[y][x]
+}
+
+// drawCanvas draws the edit grid, raw layer view, and animated preview, returning the lowest row used
+func (e *Editor) drawCanvas(cells []terminal.Cell) int {
+	t := e.tmpl()
+	f := e.curFrame()
+	top := 3
+
+	// Edit grid: composited frame at double width so the cursor and bg colors read clearly
+	gridX := 2
+	gridW := t.Width*2 + 2
+	gridH := t.Height + 2
+	e.drawBox(cells, gridX, top, gridW, gridH, "FRAME")
+
+	var onion *sprite.Frame
+	if e.onion && len(t.Frames) > 1 {
+		onion = &t.Frames[(e.frame+len(t.Frames)-1)%len(t.Frames)]
+	}
+
+	for y := range t.Height {
+		for x := range t.Width {
+			c := composite(t, f, x, y, ColorGridBg)
+			if c.Rune == ' ' {
+				if onion != nil && cellAt(onion.Art, x, y) != ' ' {
+					c.Rune, c.Fg, c.Attrs = rune(cellAt(onion.Art, x, y)), ColorOnion, 0
+				} else if c.Bg == ColorGridBg {
+					c.Rune, c.Fg = DotMiddle, ColorDim
+				}
+			}
+			pad := terminal.Cell{Rune: ' ', Bg: c.Bg}
+			if x == e.cursorX && y == e.cursorY {
+				c.Bg, pad.Bg = ColorCursor, ColorCursor
+			}
+			sx := gridX + 1 + x*2
+			e.setCell(cells, sx, top+1+y, c)
+			e.setCell(cells, sx+1, top+1+y, pad)
+		}
+	}
+
+	// Raw layer codes as they appear in the file
+	layerX := gridX + gridW + 1
+	layerW := max(t.Width+2, len(layerNames[e.layer])+6)
+	e.drawBox(cells, layerX, top, layerW, gridH, layerNames[e.layer])
+	var pal []color.RGB
+	if p := e.palette(); p != nil {
+		pal = *p
+	}
+	for y := range t.Height {
+		for x := range t.Width {
+			b := e.cellByte(f, e.layer, x, y)
+			c := terminal.Cell{Rune: rune(b), Fg: ColorText, Bg: ColorGridBg}
+			if b == ' ' {
+				c.Rune, c.Fg = DotMiddle, ColorDim
+			} else if idx := sprite.PaletteIndex(b); idx >= 0 && idx < len(pal) {
+				c.Fg = pal[idx]
+			}
+			if x == e.cursorX && y == e.cursorY {
+				c.Bg = ColorCursor
+			}
+			e.setCell(cells, layerX+1+x, top+1+y, c)
+		}
+	}
+
+	bottom := top + gridH
+	if pv := e.drawPreview(cells, layerX+layerW+1, top); pv > bottom {
+		bottom = pv
+	}
+	return bottom
+}
+
+// drawPreview animates the sprite with its aura at game scale, returning the row below the box
+func (e *Editor) drawPreview(cells []terminal.Cell, boxX, boxY int) int {
+	t := e.tmpl()
+	padX := int(math.Ceil(t.AuraRadius)) + 2
+	padY := int(math.Ceil(t.AuraRadius*0.55)) + 1
+	boxW := t.Width + padX*2 + 2
+	boxH := t.Height + padY*2 + 2
+
+	title := "PREVIEW"
+	if !e.playing {
+		title = "PAUSED"
+	}
+	e.drawBox(cells, boxX, boxY, boxW, boxH, title)
+
+	frameIdx := e.frame
+	if e.playing {
+		frameIdx = (e.tick / t.TicksPerFrame) % len(t.Frames)
+	}
+	f := &t.Frames[frameIdx]
+
+	ox := boxX + 1 + padX
+	oy := boxY + 1 + padY
+	e.drawAura(cells, t, ox, oy, boxX+1, boxY+1, boxX+boxW-2, boxY+boxH-2)
+
+	for y := range t.Height {
+		for x := range t.Width {
+			if cellAt(f.Art, x, y) == ' ' && cellAt(f.Bg, x, y) == ' ' {
+				continue
+			}
+			under, _ := e.getCell(cells, ox+x, oy+y)
+			e.setCell(cells, ox+x, oy+y, composite(t, f, x, y, under.Bg))
+		}
+	}
+
+	info := fmt.Sprintf("frame %d/%d  ticks %d", frameIdx, len(t.Frames), t.TicksPerFrame)
+	e.drawText(cells, boxX, boxY+boxH, info, ColorDim, ColorBg, 0)
+	return boxY + boxH + 1
+}
+
+// drawAura blends the pulsing elliptical aura into the preview box, same falloff as the sandboxes
+func (e *Editor) drawAura(cells []terminal.Cell, t *sprite.Template, ox, oy, minX, minY, maxX, maxY int) {
+	if t.AuraRadius <= 0 {
+		return
+	}
+
+	elapsed := time.Since(e.startTime).Seconds()
+	pulse := 0.55 + 0.45*math.Sin(elapsed*t.AuraPulseFreq*2*math.Pi)
+
+	cx := float64(ox) + float64(t.Width)/2.0
+	cy := float64(oy) + float64(t.Height)/2.0
+	rx := float64(t.Width)/2.0 + t.AuraRadius
+	ry := float64(t.Height)/2.0 + t.AuraRadius*0.55
+	invRxSq := 1.0 / (rx * rx)
+	invRySq := 1.0 / (ry * ry)
+
+	// Rotating beam direction when the aura has a rotation speed
+	beamAngle := elapsed * t.AuraRotSpeed * 2 * math.Pi
+	focus := t.AuraFocusWidth
+	if focus <= 0 {
+		focus = 1.0
+	}
+
+	for sy := minY; sy <= maxY; sy++ {
+		for sx := minX; sx <= maxX; sx++ {
+			dx := float64(sx) - cx
+			dy := float64(sy) - cy
+			distSq := dx*dx*invRxSq + dy*dy*invRySq
+			if distSq > 1.0 {
+				continue
+			}
+
+			falloff := 1.0 - math.Sqrt(distSq)
+			alpha := falloff * falloff * falloff * pulse * 0.65
+			if t.AuraRotSpeed != 0 {
+				diff := math.Abs(math.Remainder(math.Atan2(dy, dx)-beamAngle, 2*math.Pi))
+				alpha *= math.Exp(-diff * diff / (2 * focus * focus))
+			}
+			if alpha < 0.01 {
+				continue
+			}
+
+			c, ok := e.getCell(cells, sx, sy)
+			if !ok {
+				continue
+			}
+			c.Bg = color.Blend(c.Bg, t.AuraColor, alpha)
+			e.setCell(cells, sx, sy, c)
+		}
+	}
+}
+
+// drawPalette lists fg and bg palettes side by side, returning the row below the box
+func (e *Editor) drawPalette(cells []terminal.Cell, top int) int {
+	t := e.tmpl()
+	rows := max(len(t.FgPalette), len(t.BgPalette), 1)
+	boxW := min(max(e.width-4, 40), 84)
+	colW := (boxW - 2) / 2
+	e.drawBox(cells, 2, top, boxW, rows+3, "PALETTE")
+
+	drawColumn := func(x int, title string, layer int, pal []color.RGB, notes []string) {
+		titleFg := ColorDim
+		if e.layer == layer {
+			titleFg = ColorHighlight
+		}
+		e.drawText(cells, x, top+1, title, titleFg, ColorBg, terminal.AttrBold)
+		for i, c := range pal {
+			y := top + 2 + i
+			fg, bg := ColorText, ColorBg
+			if e.layer == layer && i == e.palIdx {
+				fg, bg = ColorBg, ColorHighlight
+			}
+			note := ""
+			if i < len(notes) {
+				note = notes[i]
+			}
+			line := fmt.Sprintf("%c %s %s", sprite.EncodeIndex(i), c.Hex(), note)
+			if len(line) > colW-4 {
+				line = line[:colW-4]
+			}
+			e.drawText(cells, x, y, line, fg, bg, 0)
+			e.drawText(cells, x+colW-3, y, string([]rune{BlockFull, BlockFull}), c, ColorBg, 0)
+		}
+	}
+	drawColumn(4, "FG", LayerFg, t.FgPalette, t.FgNotes)
+	drawColumn(4+colW, "BG", LayerBg, t.BgPalette, t.BgNotes)
+	return top + rows + 3
+}
+
+func (e *Editor) drawFrames(cells []terminal.Cell, y int) {
+	t := e.tmpl()
+	x := 2
+	e.drawText(cells, x, y, "Frames:", ColorDim, ColorBg, 0)
+	x += 8
+	for i := range t.Frames {
+		label := fmt.Sprintf(" %d ", i)
+		fg, bg := ColorText, ColorBg
+		if i == e.frame {
+			fg, bg = ColorBg, ColorHighlight
+		}
+		e.drawText(cells, x, y, label, fg, bg, terminal.AttrBold)
+		x += len(label)
+	}
+
+	onion := "off"
+	if e.onion {
+		onion = "on"
+	}
+	info := fmt.Sprintf("  │ onion %s", onion)
+	if label := e.curFrame().Label; label != "" {
+		info += " │ " + label
+	}
+	e.drawText(cells, x, y, info, ColorDim, ColorBg, 0)
+
+	aura := "aura off"
+	if t.AuraRadius != 0 {
+		aura = fmt.Sprintf("aura %s r=%s %sHz rot=%s focus=%s", t.AuraColor.Hex(), formatFloat(t.AuraRadius),
+			formatFloat(t.AuraPulseFreq), formatFloat(t.AuraRotSpeed), formatFloat(t.AuraFocusWidth))
+	}
+	mode := "NORMAL"
+	if e.insertMode {
+		mode = "INSERT"
+	}
+	e.drawText(cells, 2, y+1, fmt.Sprintf("%s │ layer %s │ cell %d,%d │ %s", mode, layerNames[e.layer], e.cursorX, e.cursorY, aura),
+		ColorDim, ColorBg, 0)
+}
+
+func (e *Editor) drawHelp(cells []terminal.Cell) {
+	y := e.height - 6
+	if y < 0 {
+		return
+	}
+
+	help := []string{
+		"Move: HJKL/Arrows  │  Layer: TAB/S-TAB  │  Paint: SPACE  │  Clear: x/DEL  │  Art: r=replace i=insert",
+		"Color: 0-9 [/] select  c=pick  a=add  e=edit  d=delete  m=note",
+		"Frame: ,/. select  n=duplicate  D=delete  </>=reorder  o=onion  L=label",
+		"Sprite: {/} select  N=new  R=rename  s=resize  │  Anim: p=play  +/-=ticks  u=aura  │  Save: w/^S  Quit: q/ESC",
+	}
+
+	for i, h := range help {
+		e.drawText(cells, 2, y+i, h, ColorDim, ColorBg, 0)
+	}
+}
+
+func (e *Editor) drawPrompt(cells []terminal.Cell) {
+	if e.promptSubmit == nil {
+		return
+	}
+	line := e.promptLabel + ": " + e.promptText
+	y := e.height - 2
+	e.drawText(cells, 2, y, line, ColorHighlight, ColorBg, terminal.AttrBold)
+	e.setCell(cells, 2+len(line), y, terminal.Cell{Rune: ' ', Bg: ColorCursor})
+}
+
+func (e *Editor) drawStatus(cells []terminal.Cell) {
+	if e.statusMsg == "" {
+		return
+	}
+
+	barY := e.height - 1
+	msg := " " + e.statusMsg + " "
+
+	bg := color.RGB{R: 60, G: 60, B: 80}
+	switch e.statusType {
+	case 1:
+		bg = ColorSuccess
+	case 2:
+		bg = ColorError
+	}
+
+	x := max(0, e.width-len(msg)-2)
+	e.drawText(cells, x, barY, msg, color.RGB{R: 255, G: 255, B: 255}, bg, terminal.AttrBold)
+}
@@ -0,0 +1,425 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// Channel is the HSL component under edit
+type Channel int
+
+const (
+	ChanHue Channel = iota
+	ChanSat
+	ChanLight
+	chanCount
+)
+
+var (
+	anchorNames  = [3]string{"Green", "Blue", "Red"}
+	typeNames    = [5]string{"Green", "Blue", "Red", "White", "Gold"}
+	levelNames   = [3]string{"Dark", "Normal", "Bright"}
+	channelNames = [chanCount]string{"H", "S", "L"}
+
+	colorText   = color.RGB{R: 180, G: 180, B: 180}
+	colorDim    = color.RGB{R: 100, G: 100, B: 100}
+	colorPanel  = color.RGB{R: 40, G: 40, B: 40}
+	colorSelect = color.RGB{R: 0, G: 255, B: 255}
+	colorWarn   = color.RGB{R: 255, G: 170, B: 0}
+	colorOK     = color.RGB{R: 90, G: 200, B: 90}
+)
+
+// AppState holds the theme under edit
+type AppState struct {
+	running bool
+	width   int
+	height  int
+
+	path    string
+	name    string
+	anchors [3]render.HSL
+	anchor  int
+	channel Channel
+	dirty   bool
+	status  string
+
+	hexInputActive bool
+	hexInputBuffer string
+}
+
+var (
+	term  terminal.Terminal
+	state AppState
+	buf   *render.RenderBuffer
+)
+
+func main() {
+	path := flag.String("f", "theme.toml", "Theme file to edit, created on save")
+	use256 := flag.Bool("256", false, "Force 256-color mode")
+	flag.Parse()
+
+	colorMode := terminal.DetectColorMode()
+	if *use256 {
+		colorMode = terminal.ColorMode256
+	}
+
+	state = AppState{running: true, path: *path}
+	theme, err := visual.LoadSequenceTheme(*path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		theme = visual.DefaultSequenceTheme()
+		state.status = "new theme from default palette"
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "theme %s: %v\n", *path, err)
+		os.Exit(1)
+	default:
+		state.status = "loaded " + *path
+	}
+	setTheme(theme)
+
+	term = terminal.New(colorMode)
+	if err := term.Init(); err != nil {
+		fmt.Fprintf(os.Stderr, "terminal init failed: %v\n", err)
+		os.Exit(1)
+	}
+	defer term.Fini()
+
+	state.width, state.height = term.Size()
+	buf = render.NewRenderBuffer(colorMode, state.width, state.height)
+
+	mainLoop()
+}
+
+func mainLoop() {
+	renderFrame()
+
+	for state.running {
+		ev := term.PollEvent()
+		switch ev.Type {
+		case terminal.EventKey:
+			handleInput(ev)
+		case terminal.EventResize:
+			state.width = ev.Width
+			state.height = ev.Height
+			buf.Resize(ev.Width, ev.Height)
+			term.Sync()
+		case terminal.EventError, terminal.EventClosed:
+			state.running = false
+		}
+		renderFrame()
+	}
+}
+
+// === Theme state ===
+
+func setTheme(t visual.SequenceTheme) {
+	green, blue, red, _ := t.Anchors()
+	state.name = t.Name
+	state.anchors = [3]render.HSL{render.ToHSL(green), render.ToHSL(blue), render.ToHSL(red)}
+	state.dirty = false
+}
+
+func currentTheme() visual.SequenceTheme {
+	return visual.SequenceTheme{
+		Name:  state.name,
+		Green: state.anchors[0].RGB().Hex(),
+		Blue:  state.anchors[1].RGB().Hex(),
+		Red:   state.anchors[2].RGB().Hex(),
+	}
+}
+
+func currentPalette() [5][3]color.RGB {
+	lut, _ := currentTheme().Palette() // anchors are always valid hex
+	return lut
+}
+
+// adjust moves the selected channel by step units: 1° of hue or 1% of saturation/lightness
+func adjust(step int) {
+	c := &state.anchors[state.anchor]
+	switch state.channel {
+	case ChanHue:
+		c.H = math.Mod(c.H+float64(step)+360, 360)
+	case ChanSat:
+		c.S = min(1, max(0, c.S+float64(step)/100))
+	case ChanLight:
+		c.L = min(1, max(0, c.L+float64(step)/100))
+	}
+	state.dirty = true
+}
+
+// === Input ===
+
+func handleInput(ev terminal.Event) {
+	if state.hexInputActive {
+		handleHexInput(ev)
+		return
+	}
+
+	switch ev.Key {
+	case terminal.KeyEscape, terminal.KeyCtrlC:
+		state.running = false
+		return
+	case terminal.KeyTab:
+		state.anchor = (state.anchor + 1) % 3
+		return
+	case terminal.KeyBacktab:
+		state.anchor = (state.anchor + 2) % 3
+		return
+	case terminal.KeyUp:
+		state.channel = (state.channel + chanCount - 1) % chanCount
+		return
+	case terminal.KeyDown:
+		state.channel = (state.channel + 1) % chanCount
+		return
+	case terminal.KeyLeft:
+		adjust(-1)
+		return
+	case terminal.KeyRight:
+		adjust(1)
+		return
+	case terminal.KeyRune:
+	default:
+		return
+	}
+
+	switch ev.Rune {
+	case 'q':
+		state.running = false
+	case '1', '2', '3':
+		state.anchor = int(ev.Rune - '1')
+	case 'k':
+		state.channel = (state.channel + chanCount - 1) % chanCount
+	case 'j':
+		state.channel = (state.channel + 1) % chanCount
+	case 'h':
+		adjust(-1)
+	case 'l':
+		adjust(1)
+	case 'H':
+		adjust(-10)
+	case 'L':
+		adjust(10)
+	case '#':
+		state.hexInputActive = true
+		state.hexInputBuffer = ""
+	case 'd':
+		name := state.name
+		setTheme(visual.DefaultSequenceTheme())
+		state.name = name
+		state.dirty = true
+		state.status = "reset to default palette"
+	case 'r':
+		t, err := visual.LoadSequenceTheme(state.path)
+		if err != nil {
+			state.status = "reload failed: " + err.Error()
+			return
+		}
+		setTheme(t)
+		state.status = "reloaded " + state.path
+	case 's':
+		if err := visual.SaveSequenceTheme(state.path, currentTheme()); err != nil {
+			state.status = "save failed: " + err.Error()
+			return
+		}
+		state.dirty = false
+		state.status = "saved " + state.path
+	}
+}
+
+func handleHexInput(ev terminal.Event) {
+	switch ev.Key {
+	case terminal.KeyEscape:
+		state.hexInputActive = false
+	case terminal.KeyEnter:
+		state.hexInputActive = false
+		c, err := color.ParseHex(state.hexInputBuffer)
+		if err != nil {
+			state.status = "invalid hex: " + state.hexInputBuffer
+			return
+		}
+		state.anchors[state.anchor] = render.ToHSL(c)
+		state.dirty = true
+	case terminal.KeyBackspace:
+		if len(state.hexInputBuffer) > 0 {
+			state.hexInputBuffer = state.hexInputBuffer[:len(state.hexInputBuffer)-1]
+		}
+	case terminal.KeyRune:
+		r := ev.Rune
+		if len(state.hexInputBuffer) < 6 && ((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')) {
+			state.hexInputBuffer += strings.ToLower(string(r))
+		}
+	}
+}
+
+// === Rendering ===
+
+func renderFrame() {
+	buf.Clear()
+	lut := currentPalette()
+
+	title := fmt.Sprintf(" Theme: %s  %s", state.name, state.path)
+	if state.dirty {
+		title += " [modified]"
+	}
+	fillRow(0, colorPanel)
+	drawText(0, 0, title, colorSelect, colorPanel)
+
+	y := drawAnchors(2)
+	y = drawPalette(y+1, &lut)
+	y = drawMock(y+1, &lut)
+	drawIssues(y+1, &lut)
+
+	fillRow(state.height-1, colorPanel)
+	hint := "1-3/Tab anchor · j/k channel · h/l ±1 · H/L ±10 · # hex · d default · r reload · s save · q quit"
+	if state.hexInputActive {
+		hint = "hex #" + state.hexInputBuffer + "_  Enter apply · Esc cancel"
+	}
+	drawText(1, state.height-1, hint, colorText, colorPanel)
+	if state.status != "" {
+		drawText(state.width-len(state.status)-1, state.height-2, state.status, colorDim, visual.RgbBackground)
+	}
+
+	buf.FlushToTerminal(term)
+}
+
+// drawAnchors draws one slider block per anchor and returns the next free row
+func drawAnchors(y int) int {
+	for i, hsl := range state.anchors {
+		rgb := hsl.RGB()
+		fg := colorText
+		if i == state.anchor {
+			fg = colorSelect
+		}
+		drawText(1, y, fmt.Sprintf("%d %-6s %s", i+1, anchorNames[i], rgb.Hex()), fg, color.Black)
+		drawSwatch(20, y, 6, rgb)
+
+		values := [chanCount]float64{hsl.H / 360, hsl.S, hsl.L}
+		labels := [chanCount]string{
+			fmt.Sprintf("%3.0f°", hsl.H),
+			fmt.Sprintf("%3.0f%%", hsl.S*100),
+			fmt.Sprintf("%3.0f%%", hsl.L*100),
+		}
+		x := 28
+		for ch := range chanCount {
+			sel := i == state.anchor && ch == state.channel
+			drawSlider(x, y, channelNames[ch], values[ch], labels[ch], sel)
+			x += 30
+		}
+		y++
+	}
+	return y
+}
+
+func drawSlider(x, y int, name string, v float64, label string, selected bool) {
+	const width = 18
+	fg := colorDim
+	if selected {
+		fg = colorSelect
+	}
+	drawText(x, y, name, fg, color.Black)
+	fill := int(math.Round(v * width))
+	for i := range width {
+		r := '─'
+		if i < fill {
+			r = '━'
+		}
+		drawText(x+2+i, y, string(r), fg, color.Black)
+	}
+	drawText(x+3+width, y, label, colorText, color.Black)
+}
+
+// drawPalette draws every glyph color on the game background with its contrast ratio
+func drawPalette(y int, lut *[5][3]color.RGB) int {
+	drawText(1, y, "Derived palette (contrast vs background)", colorText, color.Black)
+	y++
+	for l, name := range levelNames {
+		drawText(10+l*16, y, name, colorDim, color.Black)
+	}
+	y++
+	for t, name := range typeNames {
+		drawText(1, y, name, colorDim, color.Black)
+		for l := range levelNames {
+			x := 10 + l*16
+			c := lut[t][l]
+			drawSwatch(x, y, 3, c)
+			drawText(x+4, y, fmt.Sprintf("%5.2f", visual.Contrast(c, visual.RgbBackground)), c, visual.RgbBackground)
+		}
+		y++
+	}
+	return y
+}
+
+// mockText is cycled to fill the mock play field with sequence-like runs
+const mockText = "the quick brown fox jumps over the lazy dog"
+
+// drawMock draws a stretch of play field with glyph runs at every type and level
+func drawMock(y int, lut *[5][3]color.RGB) int {
+	const rows = 6
+	w := max(0, state.width-2)
+	for row := range rows {
+		for x := range w {
+			buf.SetWithBg(1+x, y+row, ' ', colorText, visual.RgbBackground)
+		}
+		x := 2 + row*3
+		for i := 0; x < w-10; i++ {
+			t := (row + i) % len(lut)
+			l := (row + i/len(lut)) % 3
+			word := strings.Fields(mockText)[(row*7+i)%9]
+			drawText(x, y+row, word, lut[t][l], visual.RgbBackground)
+			x += len(word) + 2 + (row+i)%4
+		}
+	}
+	return y + rows
+}
+
+// drawIssues lists ValidateGlyphPalette findings; the fit normally leaves none
+func drawIssues(y int, lut *[5][3]color.RGB) {
+	issues := visual.ValidateGlyphPalette(lut, visual.RgbBackground)
+	if len(issues) == 0 {
+		drawText(1, y, "Palette passes contrast and separation checks", colorOK, color.Black)
+		return
+	}
+	for _, is := range issues {
+		if y >= state.height-2 {
+			return
+		}
+		msg := fmt.Sprintf("%s %s: contrast %.2f below floor", typeNames[is.Type], levelNames[is.Level], is.Value)
+		if is.Other >= 0 {
+			msg = fmt.Sprintf("%s/%s %s: too similar (distance %.0f)", typeNames[is.Type], typeNames[is.Other], levelNames[is.Level], is.Value)
+		}
+		drawText(1, y, msg, colorWarn, color.Black)
+		y++
+	}
+}
+
+func drawText(x, y int, text string, fg, bg color.RGB) {
+	i := 0
+	for _, ch := range text {
+		if x+i >= 0 && x+i < state.width && y >= 0 && y < state.height {
+			buf.SetWithBg(x+i, y, ch, fg, bg)
+		}
+		i++
+	}
+}
+
+func drawSwatch(x, y, w int, c color.RGB) {
+	for i := range w {
+		drawText(x+i, y, " ", c, c)
+	}
+}
+
+func fillRow(y int, bg color.RGB) {
+	for x := range state.width {
+		buf.SetWithBg(x, y, ' ', colorText, bg)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/app"
+	"github.com/lixenwraith/vi-fighter/parameter"
 )
 
 // CLI flags
@@ -17,9 +18,24 @@ var (
 	flagAudioMute    = flag.Bool("am", false, "Start with audio muted")
 	flagAudioUnmute  = flag.Bool("au", false, "Start with audio unmuted")
 	flagContentPath  = flag.String("f", "", "Content file path or glob pattern")
+	flagPracticeFile = flag.String("file", "", "Practice file: reveal its lines in order")
 	flagGameScript   = flag.String("g", "", "Game config: game.toml path or map directory")
 	flagGameDefault  = flag.Bool("gd", false, "Force embedded default FSM script")
 	flagKeymapPath   = flag.String("k", "", "Keymap config file path (TOML)")
+	flagRecord       = flag.String("rec", "", "Record input to file")
+	flagReplay       = flag.String("replay", "", "Replay recorded input, then continue live")
+	flagAnnounce     = flag.String("announce", "", "Write screen-reader announcements to a file or named pipe")
+	flagAnnounceLvl  = flag.String("announce-level", "", "Announcement verbosity: low, normal, verbose")
+	flagChecksum     = flag.String("sum", "", "Record per-tick state checksums to file, or verify them with -replay")
+	flagScreenshot   = flag.String("shot", "", "Save the last frame on exit as a .png or .svg screenshot")
+	flagCast         = flag.String("cast", "", "Record the screen to an asciinema v2 cast file")
+	flagLeaderboard  = flag.String("lb", "", "Leaderboard endpoint URL: submit the score on exit, key in $"+parameter.LeaderboardKeyEnv)
+	flagPlayerName   = flag.String("name", "", "Player name on the leaderboard, default the OS user name")
+	flagColorBlind   = flag.String("cvd", "", "Color-blind mode: protan, deutan, tritan, mono")
+	flagTheme        = flag.String("theme", "", "Sequence color theme file from theme-editor")
+	flagSeed         = flag.Uint64("seed", 0, "Random seed for a reproducible session, 0 = time-based")
+	flagAttract      = flag.Duration("attract", parameter.AttractIdleDelay, "Idle time at startup before the demo bot plays, 0 = off")
+	flagSmoke        = flag.Duration("smoke", 0, "Run the demo bot for this long and quit, failing if it cleared nothing")
 	flagCheck        = flag.Bool("check", false, "Validate FSM config and exit")
 	flagSchema       = flag.Bool("schema", false, "Print FSM schema JSON and exit")
 )
@@ -46,12 +62,28 @@ func main() {
 // buildConfig translates parsed flags into the runtime configuration
 func buildConfig() app.Config {
 	cfg := app.Config{
-		AudioBackend: *flagAudioBackend,
-		AudioMuted:   true, // default muted
-		ContentPath:  *flagContentPath,
-		GameScript:   *flagGameScript,
-		ForceDefault: *flagGameDefault,
-		KeymapPath:   *flagKeymapPath,
+		AudioBackend:    *flagAudioBackend,
+		AudioMuted:      true, // default muted
+		ContentPath:     *flagContentPath,
+		PracticePath:    *flagPracticeFile,
+		GameScript:      *flagGameScript,
+		ForceDefault:    *flagGameDefault,
+		KeymapPath:      *flagKeymapPath,
+		RecordPath:      *flagRecord,
+		ReplayPath:      *flagReplay,
+		AnnouncePath:    *flagAnnounce,
+		AnnounceLevel:   *flagAnnounceLvl,
+		ChecksumPath:    *flagChecksum,
+		ScreenshotPath:  *flagScreenshot,
+		CastPath:        *flagCast,
+		LeaderboardURL:  *flagLeaderboard,
+		LeaderboardName: *flagPlayerName,
+		LeaderboardKey:  os.Getenv(parameter.LeaderboardKeyEnv),
+		ColorBlind:      *flagColorBlind,
+		ThemePath:       *flagTheme,
+		Seed:            *flagSeed,
+		AttractIdle:     *flagAttract,
+		SmokeTest:       *flagSmoke,
 	}
 
 	if *flagAudioUnmute {
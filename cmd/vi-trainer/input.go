@@ -0,0 +1,187 @@
+package main
+
+import (
+	"slices"
+
+	"github.com/lixenwraith/terminal"
+)
+
+// handleKey interprets one keystroke, every key during a drill counts toward its total
+func (t *Trainer) handleKey(ev terminal.Event) {
+	if ev.Key == terminal.KeyCtrlC || ev.Key == terminal.KeyCtrlQ {
+		t.running = false
+		return
+	}
+
+	if t.done {
+		switch ev.Rune {
+		case 'q':
+			t.running = false
+		case 'r':
+			t.newSession()
+		}
+		return
+	}
+
+	if t.searching {
+		t.keys++
+		t.handleSearchKey(ev)
+		t.checkTarget()
+		return
+	}
+
+	// Quit only from a clean state so q never eats a pending f/t argument
+	if ev.Rune == 'q' && t.pendingOp == 0 && t.count == 0 {
+		t.running = false
+		return
+	}
+
+	t.keys++
+
+	switch ev.Key {
+	case terminal.KeyEscape:
+		t.resetPending()
+		return
+	case terminal.KeyLeft:
+		t.motion('h')
+	case terminal.KeyDown:
+		t.motion('j')
+	case terminal.KeyUp:
+		t.motion('k')
+	case terminal.KeyRight:
+		t.motion('l')
+	case terminal.KeyRune:
+		t.handleRune(ev.Rune)
+	}
+	t.checkTarget()
+}
+
+// handleRune dispatches a normal-mode rune through counts and pending operators
+func (t *Trainer) handleRune(r rune) {
+	switch t.pendingOp {
+	case 'g':
+		t.pendingOp = 0
+		if r == 'g' {
+			t.motion('g')
+		} else {
+			t.resetPending()
+		}
+		return
+	case 'f', 'F', 't', 'T':
+		op := t.pendingOp
+		t.pendingOp = 0
+		t.lastFindOp, t.lastFindCh = op, r
+		t.find(op, r)
+		return
+	}
+
+	switch {
+	case r >= '1' && r <= '9', r == '0' && t.count > 0:
+		t.count = t.count*10 + int(r-'0')
+	case r == 'g', r == 'f', r == 'F', r == 't', r == 'T':
+		t.pendingOp = r
+	case r == ';', r == ',':
+		if t.lastFindOp == 0 {
+			t.resetPending()
+			return
+		}
+		op := t.lastFindOp
+		if r == ',' {
+			op = reverseFind(op)
+		}
+		t.markUsed(r)
+		t.find(op, t.lastFindCh)
+	case r == '/', r == '?':
+		t.searching = true
+		t.searchFwd = r == '/'
+		t.pattern = t.pattern[:0]
+		t.markUsed(r)
+	case r == 'n', r == 'N':
+		fwd := t.lastFwd
+		if r == 'N' {
+			fwd = !fwd
+		}
+		t.markUsed(r)
+		t.search(t.lastSearch, fwd)
+	default:
+		t.motion(r)
+	}
+}
+
+// handleSearchKey edits the search prompt and runs it on Enter
+func (t *Trainer) handleSearchKey(ev terminal.Event) {
+	switch ev.Key {
+	case terminal.KeyEscape:
+		t.resetPending()
+	case terminal.KeyBackspace:
+		if len(t.pattern) == 0 {
+			t.resetPending()
+			return
+		}
+		t.pattern = t.pattern[:len(t.pattern)-1]
+	case terminal.KeyEnter:
+		t.lastSearch = slices.Clone(t.pattern)
+		t.lastFwd = t.searchFwd
+		t.search(t.lastSearch, t.searchFwd)
+	case terminal.KeySpace:
+		t.pattern = append(t.pattern, ' ')
+	case terminal.KeyRune:
+		t.pattern = append(t.pattern, ev.Rune)
+	}
+}
+
+// motion applies a single-key motion with the pending count
+func (t *Trainer) motion(key rune) {
+	if p, ok := t.text.Motion(t.cursor, key, t.count); ok {
+		t.cursor = p
+		t.markUsed(key)
+	}
+	t.resetPending()
+}
+
+func (t *Trainer) find(op, ch rune) {
+	if p, ok := t.text.find(t.cursor, op, ch, max(1, t.count)); ok {
+		t.cursor = p
+	}
+	t.markUsed(op)
+	t.resetPending()
+}
+
+func (t *Trainer) search(pattern []rune, forward bool) {
+	count := max(1, t.count)
+	for range count {
+		p, ok := t.text.search(t.cursor, pattern, forward)
+		if !ok {
+			break
+		}
+		t.cursor = p
+	}
+	t.resetPending()
+}
+
+// markUsed records the family of a motion key once per drill
+func (t *Trainer) markUsed(key rune) {
+	if f := familyOf(key); f != "" && !slices.Contains(t.used, f) {
+		t.used = append(t.used, f)
+	}
+}
+
+func (t *Trainer) checkTarget() {
+	if t.cursor == t.target && !t.searching && t.pendingOp == 0 {
+		t.completeDrill()
+	}
+}
+
+// reverseFind returns the opposite-direction find for ,
+func reverseFind(op rune) rune {
+	switch op {
+	case 'f':
+		return 'F'
+	case 'F':
+		return 'f'
+	case 't':
+		return 'T'
+	default:
+		return 't'
+	}
+}
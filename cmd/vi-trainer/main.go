@@ -0,0 +1,263 @@
+// vi-trainer drills vi motions on code text
+// Each drill flashes a target cell, times the cursor's arrival, and compares keystrokes
+// against the optimal sequence; per-family results are kept as history for trend charts
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/content"
+	"github.com/lixenwraith/vi-fighter/input"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+var (
+	flagContentPath = flag.String("f", "", "Content file path or glob pattern")
+	flagDrills      = flag.Int("n", 20, "Drills per session")
+	flagHistory     = flag.String("history", "", "History file path (default: user config dir)")
+	flagRecord      = flag.String("record", "", "Record keyboard input to a file")
+	flagReplay      = flag.String("replay", "", "Replay keyboard input from a recording, then continue live")
+)
+
+// Drill constants
+const (
+	MinOptimalKeys = 3 // Targets reachable in fewer keystrokes are rerolled
+	TargetTries    = 50
+	PanelHeight    = 9 // Stats panel rows below the text
+	GutterWidth    = 4
+)
+
+// Trainer is the drill session state
+type Trainer struct {
+	term    terminal.Terminal
+	source  input.Source
+	running bool
+	width   int
+	height  int
+
+	cm          *content.ContentManager
+	rng         *vmath.FastRand
+	history     *History
+	historyPath string
+
+	// Session
+	text      *Text
+	cursor    Pos
+	drills    int
+	results   []DrillResult
+	done      bool
+	statusMsg string
+
+	// Current drill
+	target  Pos
+	started time.Time
+	keys    int
+	optimal int
+	used    []string
+
+	// Pending input
+	count      int
+	pendingOp  rune // 'g', 'f', 'F', 't', 'T' awaiting the next key
+	searching  bool
+	searchFwd  bool
+	pattern    []rune
+	lastFindOp rune
+	lastFindCh rune
+	lastSearch []rune
+	lastFwd    bool
+}
+
+func main() {
+	flag.Parse()
+
+	historyPath := *flagHistory
+	if historyPath == "" {
+		historyPath = ResolveHistory()
+	}
+	history, err := LoadHistory(historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "history %s: %v\n", historyPath, err)
+		os.Exit(1)
+	}
+
+	cm := content.NewContentManager()
+	if *flagContentPath != "" {
+		cm.SetDataDir(*flagContentPath)
+	}
+	_ = cm.DiscoverContentFiles()
+	_ = cm.PreValidateAllContent()
+
+	term := terminal.New()
+	if err := term.Init(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize terminal: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			terminal.EmergencyReset(os.Stdout)
+			fmt.Fprintf(os.Stderr, "CRASH: %v\n%s\n", r, debug.Stack())
+		} else {
+			term.Fini()
+		}
+	}()
+
+	source, closeSource, err := buildSource(term)
+	if err != nil {
+		term.Fini()
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	defer closeSource()
+
+	t := NewTrainer(term, source, cm, history, historyPath, max(1, *flagDrills))
+	t.Run()
+}
+
+// buildSource wraps the terminal with the input middleware selected by flags
+// Mouse events are dropped before recording since drills are keyboard-only
+func buildSource(term terminal.Terminal) (input.Source, func(), error) {
+	closeSource := func() {}
+	var mws []input.Middleware
+	if *flagRecord != "" {
+		f, err := os.Create(*flagRecord)
+		if err != nil {
+			return nil, nil, fmt.Errorf("input record: %w", err)
+		}
+		closeSource = func() { f.Close() }
+		mws = append(mws, input.Record(f))
+	}
+	mws = append(mws, input.Filter(func(ev terminal.Event) bool {
+		return ev.Type != terminal.EventMouse
+	}))
+	if *flagReplay != "" {
+		f, err := os.Open(*flagReplay)
+		if err != nil {
+			closeSource()
+			return nil, nil, fmt.Errorf("input replay: %w", err)
+		}
+		replay, err := input.Replay(f, 1.0)
+		f.Close()
+		if err != nil {
+			closeSource()
+			return nil, nil, fmt.Errorf("input replay %s: %w", *flagReplay, err)
+		}
+		mws = append(mws, replay)
+	}
+	return input.Chain(term, mws...), closeSource, nil
+}
+
+// NewTrainer creates a trainer and starts the first session
+func NewTrainer(term terminal.Terminal, source input.Source, cm *content.ContentManager, history *History, historyPath string, drills int) *Trainer {
+	w, h := term.Size()
+	t := &Trainer{
+		term:        term,
+		source:      source,
+		running:     true,
+		width:       w,
+		height:      h,
+		cm:          cm,
+		rng:         vmath.NewFastRand(uint64(time.Now().UnixNano())),
+		history:     history,
+		historyPath: historyPath,
+		drills:      drills,
+	}
+	t.newSession()
+	return t
+}
+
+// Run is the blocking event loop, time only advances on input so no ticker is needed
+func (t *Trainer) Run() {
+	for t.running {
+		t.draw()
+		ev := t.source.PollEvent()
+		switch ev.Type {
+		case terminal.EventResize:
+			t.width, t.height = ev.Width, ev.Height
+		case terminal.EventClosed, terminal.EventError:
+			t.running = false
+		case terminal.EventKey:
+			t.handleKey(ev)
+		}
+	}
+}
+
+// textRows is the number of text lines that fit above the stats panel
+func (t *Trainer) textRows() int {
+	return max(3, t.height-2-PanelHeight)
+}
+
+// newSession loads a fresh content block and resets the drill counters
+func (t *Trainer) newSession() {
+	lines, _, _ := t.cm.SelectRandomBlockWithValidation()
+	if len(lines) > t.textRows() {
+		lines = lines[:t.textRows()]
+	}
+	t.text = NewText(lines)
+	if t.text.Height() == 0 {
+		t.text = NewText(t.cm.GetDefaultContent())
+	}
+	t.cursor = Pos{}
+	t.results = t.results[:0]
+	t.done = false
+	t.statusMsg = ""
+	t.newDrill()
+}
+
+// newDrill picks a target far enough from the cursor to need a real motion
+func (t *Trainer) newDrill() {
+	t.target = t.cursor
+	t.optimal = 0
+	for range TargetTries {
+		y := t.rng.Intn(t.text.Height())
+		x := t.rng.Intn(len(t.text.lines[y]))
+		p := Pos{x, y}
+		if class(t.text.At(p), false) == classSpace || p == t.cursor {
+			continue
+		}
+		t.target = p
+		t.optimal = t.text.Optimal(t.cursor, p)
+		if t.optimal >= MinOptimalKeys {
+			break
+		}
+	}
+	t.keys = 0
+	t.used = t.used[:0]
+	t.resetPending()
+	t.started = time.Now()
+}
+
+func (t *Trainer) resetPending() {
+	t.count = 0
+	t.pendingOp = 0
+	t.searching = false
+	t.pattern = t.pattern[:0]
+}
+
+// completeDrill records the drill and advances, saving history when the session ends
+func (t *Trainer) completeDrill() {
+	res := DrillResult{
+		Duration: time.Since(t.started),
+		Keys:     t.keys,
+		Optimal:  t.optimal,
+		Families: append([]string(nil), t.used...),
+	}
+	t.results = append(t.results, res)
+	t.statusMsg = fmt.Sprintf("%.2fs, %d keys (optimal %d)", res.Duration.Seconds(), res.Keys, res.Optimal)
+
+	if len(t.results) < t.drills {
+		t.newDrill()
+		return
+	}
+
+	t.done = true
+	t.resetPending()
+	t.history.Sessions = append(t.history.Sessions, Summarize(t.results, time.Now()))
+	if err := SaveHistory(t.historyPath, t.history); err != nil {
+		t.statusMsg = fmt.Sprintf("history not saved: %v", err)
+	}
+}
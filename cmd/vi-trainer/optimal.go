@@ -0,0 +1,118 @@
+package main
+
+import "strconv"
+
+// Optimal search limits
+const (
+	MaxOptimalCount  = 20 // Largest count prefix tried for repeatable motions
+	MaxSearchPattern = 12 // Longest search pattern tried to land on the target
+)
+
+// countedMotions take a count prefix directly
+var countedMotions = []rune{'h', 'j', 'k', 'l', 'H', 'L'}
+
+// repeatMotions are motions where a count equals repeating the key, stepped incrementally
+var repeatMotions = []rune{'w', 'b', 'e', 'W', 'B', 'E'}
+
+// plainMotions ignore counts in the optimal search
+var plainMotions = []rune{'0', '^', '$', 'M'}
+
+// countCost returns the keystrokes of a count prefix, 0 for no count
+func countCost(count int) int {
+	if count <= 1 {
+		return 0
+	}
+	return len(strconv.Itoa(count))
+}
+
+// Optimal returns the fewest keystrokes from start to target
+// Considers counted motions, line jumps, f/F/t/T, and a / search landing directly on the target;
+// ; , n N repeats are not modeled so a player can occasionally beat it
+func (t *Text) Optimal(start, target Pos) int {
+	if start == target {
+		return 0
+	}
+
+	// Dijkstra over cells with small integer edge costs, buckets indexed by cost
+	best := make(map[Pos]int)
+	buckets := [][]Pos{{start}}
+	best[start] = 0
+
+	relax := func(q Pos, cost int) {
+		if old, ok := best[q]; ok && old <= cost {
+			return
+		}
+		best[q] = cost
+		for len(buckets) <= cost {
+			buckets = append(buckets, nil)
+		}
+		buckets[cost] = append(buckets[cost], q)
+	}
+
+	for cost := 0; cost < len(buckets); cost++ {
+		for i := 0; i < len(buckets[cost]); i++ {
+			p := buckets[cost][i]
+			if best[p] != cost {
+				continue // Stale entry
+			}
+			if p == target {
+				return cost
+			}
+
+			for _, key := range repeatMotions {
+				q := p
+				for count := 1; count <= MaxOptimalCount; count++ {
+					q, _ = t.Motion(q, key, 1)
+					relax(q, cost+1+countCost(count))
+				}
+			}
+			for _, key := range countedMotions {
+				for count := 1; count <= MaxOptimalCount; count++ {
+					q, _ := t.Motion(p, key, count)
+					relax(q, cost+1+countCost(count))
+				}
+			}
+			for _, key := range plainMotions {
+				q, _ := t.Motion(p, key, 0)
+				relax(q, cost+1)
+			}
+
+			// gg, G, and {n}G
+			q, _ := t.Motion(p, 'g', 0)
+			relax(q, cost+2)
+			for line := 1; line <= t.Height(); line++ {
+				q, _ := t.Motion(p, 'G', line)
+				relax(q, cost+1+countCost(line))
+			}
+			q, _ = t.Motion(p, 'G', 0)
+			relax(q, cost+1)
+
+			// f/F/t/T to every rune on the line, first occurrence only
+			seen := make(map[rune]bool)
+			for _, r := range t.lines[p.Y] {
+				if seen[r] {
+					continue
+				}
+				seen[r] = true
+				for _, op := range []rune{'f', 'F', 't', 'T'} {
+					if q, ok := t.find(p, op, r, 1); ok {
+						relax(q, cost+2)
+					}
+				}
+			}
+
+			// Search pattern from the target: / + pattern + Enter, skipped when it cannot improve
+			if b, ok := best[target]; ok && b <= cost+3 {
+				continue
+			}
+			line := t.lines[target.Y]
+			for n := 1; n <= MaxSearchPattern && target.X+n <= len(line); n++ {
+				if q, ok := t.search(p, line[target.X:target.X+n], true); ok && q == target {
+					relax(target, cost+2+n)
+					break
+				}
+			}
+		}
+	}
+	return best[target]
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/lixenwraith/toml"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// HistoryFile is the trainer history filename under the app config dir
+const HistoryFile = "trainer.toml"
+
+// Motion families reported separately
+var families = []string{"hjkl", "word", "line", "find", "search", "jump"}
+
+// familyOf maps a motion key to its family, "" for non-motion keys
+func familyOf(key rune) string {
+	switch key {
+	case 'h', 'j', 'k', 'l':
+		return "hjkl"
+	case 'w', 'b', 'e', 'W', 'B', 'E':
+		return "word"
+	case '0', '^', '$':
+		return "line"
+	case 'f', 'F', 't', 'T', ';', ',':
+		return "find"
+	case '/', '?', 'n', 'N':
+		return "search"
+	case 'g', 'G', 'H', 'M', 'L':
+		return "jump"
+	}
+	return ""
+}
+
+// DrillResult is one completed drill
+type DrillResult struct {
+	Duration time.Duration
+	Keys     int
+	Optimal  int
+	Families []string // Families of the motions used, in first-use order
+}
+
+// Efficiency is optimal over used keystrokes, capped at 1 since repeats are not modeled
+func (d DrillResult) Efficiency() float64 {
+	if d.Keys == 0 {
+		return 1
+	}
+	return min(1, float64(d.Optimal)/float64(d.Keys))
+}
+
+// FamilyRecord aggregates drills that used a motion family
+type FamilyRecord struct {
+	Drills     int     `toml:"drills"`
+	AvgMs      int64   `toml:"avg_ms"`
+	Efficiency float64 `toml:"efficiency"`
+}
+
+// SessionRecord summarizes one completed session
+type SessionRecord struct {
+	Date       string                  `toml:"date"`
+	Drills     int                     `toml:"drills"`
+	AvgMs      int64                   `toml:"avg_ms"`
+	Efficiency float64                 `toml:"efficiency"`
+	Families   map[string]FamilyRecord `toml:"families"`
+}
+
+// History is the persisted list of sessions, oldest first
+type History struct {
+	Sessions []SessionRecord `toml:"session"`
+}
+
+// Summarize folds drill results into a session record
+func Summarize(results []DrillResult, date time.Time) SessionRecord {
+	rec := SessionRecord{
+		Date:     date.Format(time.RFC3339),
+		Drills:   len(results),
+		Families: make(map[string]FamilyRecord),
+	}
+	if len(results) == 0 {
+		return rec
+	}
+
+	var totalMs int64
+	var totalEff float64
+	famMs := make(map[string]int64)
+	famEff := make(map[string]float64)
+	famN := make(map[string]int)
+	for _, r := range results {
+		ms := r.Duration.Milliseconds()
+		totalMs += ms
+		totalEff += r.Efficiency()
+		for _, f := range r.Families {
+			famMs[f] += ms
+			famEff[f] += r.Efficiency()
+			famN[f]++
+		}
+	}
+	rec.AvgMs = totalMs / int64(len(results))
+	rec.Efficiency = totalEff / float64(len(results))
+	for f, n := range famN {
+		rec.Families[f] = FamilyRecord{
+			Drills:     n,
+			AvgMs:      famMs[f] / int64(n),
+			Efficiency: famEff[f] / float64(n),
+		}
+	}
+	return rec
+}
+
+// Trend returns a per-session series for the sparkline, oldest first
+// A family the session never used repeats the previous value so the line stays continuous
+func (h *History) Trend(family string, value func(FamilyRecord) float64) []float64 {
+	var out []float64
+	for _, s := range h.Sessions {
+		rec := FamilyRecord{Drills: s.Drills, AvgMs: s.AvgMs, Efficiency: s.Efficiency}
+		if family != "" {
+			var ok bool
+			if rec, ok = s.Families[family]; !ok {
+				if len(out) > 0 {
+					out = append(out, out[len(out)-1])
+				}
+				continue
+			}
+		}
+		out = append(out, value(rec))
+	}
+	return out
+}
+
+// ResolveHistory returns the history path under the user config dir, "" when unavailable
+func ResolveHistory() string {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, parameter.AppConfigDirName, HistoryFile)
+}
+
+// LoadHistory reads the history file, a missing file yields an empty history
+func LoadHistory(path string) (*History, error) {
+	h := &History{}
+	if path == "" {
+		return h, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return h, nil
+		}
+		return h, err
+	}
+	if err := toml.Unmarshal(data, h); err != nil {
+		return &History{}, err
+	}
+	h.Sessions = slices.DeleteFunc(h.Sessions, func(s SessionRecord) bool { return s.Drills == 0 })
+	return h, nil
+}
+
+// SaveHistory writes the history file through a temp file, as app.SaveSettings does
+func SaveHistory(path string, h *History) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := toml.Marshal(h)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,289 @@
+package main
+
+import "unicode"
+
+// Pos is a cell in the drill text
+type Pos struct {
+	X, Y int
+}
+
+// Text is the drill buffer, lines are non-empty and trimmed by the content loader
+type Text struct {
+	lines [][]rune
+}
+
+// NewText converts content lines into a drill buffer
+func NewText(lines []string) *Text {
+	t := &Text{}
+	for _, l := range lines {
+		if r := []rune(l); len(r) > 0 {
+			t.lines = append(t.lines, r)
+		}
+	}
+	return t
+}
+
+// Height returns the number of lines
+func (t *Text) Height() int {
+	return len(t.lines)
+}
+
+// At returns the rune at p, ' ' outside the text
+func (t *Text) At(p Pos) rune {
+	if p.Y < 0 || p.Y >= len(t.lines) || p.X < 0 || p.X >= len(t.lines[p.Y]) {
+		return ' '
+	}
+	return t.lines[p.Y][p.X]
+}
+
+// clampX keeps a column inside line y
+func (t *Text) clampX(x, y int) int {
+	return max(0, min(x, len(t.lines[y])-1))
+}
+
+// Character classes for word motions
+const (
+	classSpace = iota
+	classWord
+	classPunct
+)
+
+// class returns the word-motion class of r, bigWord merges word and punctuation
+func class(r rune, bigWord bool) int {
+	switch {
+	case unicode.IsSpace(r):
+		return classSpace
+	case bigWord:
+		return classWord
+	case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+		return classWord
+	default:
+		return classPunct
+	}
+}
+
+// next steps one cell forward across line ends, the line end itself reads as a space cell
+func (t *Text) next(p Pos) (Pos, bool) {
+	if p.X < len(t.lines[p.Y]) {
+		return Pos{p.X + 1, p.Y}, true
+	}
+	if p.Y+1 < len(t.lines) {
+		return Pos{0, p.Y + 1}, true
+	}
+	return p, false
+}
+
+// prev steps one cell backward across line starts
+func (t *Text) prev(p Pos) (Pos, bool) {
+	if p.X > 0 {
+		return Pos{p.X - 1, p.Y}, true
+	}
+	if p.Y > 0 {
+		return Pos{len(t.lines[p.Y-1]), p.Y - 1}, true
+	}
+	return p, false
+}
+
+func (t *Text) classAt(p Pos, bigWord bool) int {
+	return class(t.At(p), bigWord)
+}
+
+// wordForward implements w and W
+func (t *Text) wordForward(p Pos, bigWord bool) Pos {
+	start := p
+	cls := t.classAt(p, bigWord)
+	ok := true
+	if cls != classSpace {
+		for ok && t.classAt(p, bigWord) == cls {
+			p, ok = t.next(p)
+		}
+	}
+	for ok && t.classAt(p, bigWord) == classSpace {
+		p, ok = t.next(p)
+	}
+	if !ok || t.classAt(p, bigWord) == classSpace {
+		return start
+	}
+	return p
+}
+
+// wordEnd implements e and E
+func (t *Text) wordEnd(p Pos, bigWord bool) Pos {
+	start := p
+	p, ok := t.next(p)
+	for ok && t.classAt(p, bigWord) == classSpace {
+		p, ok = t.next(p)
+	}
+	if !ok {
+		return start
+	}
+	cls := t.classAt(p, bigWord)
+	for {
+		n, ok := t.next(p)
+		if !ok || t.classAt(n, bigWord) != cls {
+			return p
+		}
+		p = n
+	}
+}
+
+// wordBack implements b and B
+func (t *Text) wordBack(p Pos, bigWord bool) Pos {
+	start := p
+	p, ok := t.prev(p)
+	for ok && t.classAt(p, bigWord) == classSpace {
+		p, ok = t.prev(p)
+	}
+	if !ok && t.classAt(p, bigWord) == classSpace {
+		return start
+	}
+	cls := t.classAt(p, bigWord)
+	for {
+		n, ok := t.prev(p)
+		if !ok || t.classAt(n, bigWord) != cls {
+			return p
+		}
+		p = n
+	}
+}
+
+// firstNonBlank returns the ^ column of line y
+func (t *Text) firstNonBlank(y int) int {
+	for x, r := range t.lines[y] {
+		if !unicode.IsSpace(r) {
+			return x
+		}
+	}
+	return 0
+}
+
+// find implements f, F, t and T within the cursor line
+func (t *Text) find(p Pos, op, ch rune, count int) (Pos, bool) {
+	line := t.lines[p.Y]
+	dir := 1
+	if op == 'F' || op == 'T' {
+		dir = -1
+	}
+	x := p.X
+	for x += dir; x >= 0 && x < len(line); x += dir {
+		if line[x] != ch {
+			continue
+		}
+		if count--; count > 0 {
+			continue
+		}
+		switch op {
+		case 't':
+			x--
+		case 'T':
+			x++
+		}
+		return Pos{x, p.Y}, true
+	}
+	return p, false
+}
+
+// search finds the next literal match of pattern after p, wrapping around the text
+func (t *Text) search(p Pos, pattern []rune, forward bool) (Pos, bool) {
+	if len(pattern) == 0 {
+		return p, false
+	}
+	total := 0
+	for _, l := range t.lines {
+		total += len(l)
+	}
+
+	q := p
+	for range total {
+		if forward {
+			q.X++
+			if q.X >= len(t.lines[q.Y]) {
+				q = Pos{0, (q.Y + 1) % len(t.lines)}
+			}
+		} else {
+			q.X--
+			if q.X < 0 {
+				y := (q.Y - 1 + len(t.lines)) % len(t.lines)
+				q = Pos{len(t.lines[y]) - 1, y}
+			}
+		}
+		if t.matchAt(q, pattern) {
+			return q, true
+		}
+	}
+	return p, false
+}
+
+// matchAt reports whether pattern starts at q, matches do not span lines
+func (t *Text) matchAt(q Pos, pattern []rune) bool {
+	line := t.lines[q.Y]
+	if q.X+len(pattern) > len(line) {
+		return false
+	}
+	for i, r := range pattern {
+		if line[q.X+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// Motion applies a count-prefixed single-key motion
+// ok is false for unknown keys so the caller can treat them as misses
+func (t *Text) Motion(p Pos, key rune, count int) (Pos, bool) {
+	n := max(1, count)
+	switch key {
+	case 'h':
+		p.X = max(0, p.X-n)
+	case 'l':
+		p.X = t.clampX(p.X+n, p.Y)
+	case 'j':
+		p.Y = min(len(t.lines)-1, p.Y+n)
+		p.X = t.clampX(p.X, p.Y)
+	case 'k':
+		p.Y = max(0, p.Y-n)
+		p.X = t.clampX(p.X, p.Y)
+	case 'w', 'W':
+		for range n {
+			p = t.wordForward(p, key == 'W')
+		}
+	case 'e', 'E':
+		for range n {
+			p = t.wordEnd(p, key == 'E')
+		}
+	case 'b', 'B':
+		for range n {
+			p = t.wordBack(p, key == 'B')
+		}
+	case '0':
+		p.X = 0
+	case '^':
+		p.X = t.firstNonBlank(p.Y)
+	case '$':
+		p.X = len(t.lines[p.Y]) - 1
+	case 'G':
+		p.Y = len(t.lines) - 1
+		if count > 0 {
+			p.Y = min(count, len(t.lines)) - 1
+		}
+		p.X = t.firstNonBlank(p.Y)
+	case 'g': // gg
+		p.Y = 0
+		if count > 0 {
+			p.Y = min(count, len(t.lines)) - 1
+		}
+		p.X = t.firstNonBlank(p.Y)
+	case 'H':
+		p.Y = min(n, len(t.lines)) - 1
+		p.X = t.firstNonBlank(p.Y)
+	case 'M':
+		p.Y = (len(t.lines) - 1) / 2
+		p.X = t.firstNonBlank(p.Y)
+	case 'L':
+		p.Y = max(0, len(t.lines)-n)
+		p.X = t.firstNonBlank(p.Y)
+	default:
+		return p, false
+	}
+	return p, true
+}
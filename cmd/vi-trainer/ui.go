@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/terminal/tui"
+)
+
+// UI Colors
+var (
+	ColorBg       = color.RGB{R: 16, G: 16, B: 20}
+	ColorHeaderBg = color.RGB{R: 40, G: 60, B: 90}
+	ColorText     = color.RGB{R: 200, G: 200, B: 220}
+	ColorDim      = color.RGB{R: 100, G: 100, B: 110}
+	ColorCursor   = color.RGB{R: 255, G: 165, B: 0}
+	ColorTarget   = color.RGB{R: 0, G: 255, B: 150}
+	ColorGood     = color.RGB{R: 50, G: 200, B: 100}
+	ColorWarn     = color.RGB{R: 255, G: 200, B: 0}
+	ColorBad      = color.RGB{R: 200, G: 50, B: 50}
+	ColorSpark    = color.RGB{R: 100, G: 180, B: 200}
+)
+
+// TrendWidth is the number of past sessions drawn per sparkline
+const TrendWidth = 24
+
+func (t *Trainer) draw() {
+	w, h := t.width, t.height
+	if w <= 0 || h <= 0 {
+		return
+	}
+	cells := make([]terminal.Cell, w*h)
+	for i := range cells {
+		cells[i] = terminal.Cell{Rune: ' ', Fg: ColorText, Bg: ColorBg}
+	}
+	root := tui.NewRegion(cells, w, 0, 0, w, h)
+
+	header, body := tui.SplitVFixed(root, 1)
+	t.drawHeader(header)
+
+	rows := t.textRows()
+	textArea, rest := tui.SplitVFixed(body, rows)
+	panel, status := tui.SplitVFixed(rest, max(0, rest.H-1))
+
+	t.drawText(textArea)
+	t.drawPanel(panel)
+	t.drawStatus(status)
+
+	t.term.Flush(cells, w, h)
+}
+
+func (t *Trainer) drawHeader(r tui.Region) {
+	r.Fill(ColorHeaderBg)
+	r.Text(1, 0, "vi-trainer", color.White, ColorHeaderBg, terminal.AttrBold)
+
+	progress := fmt.Sprintf("drill %d/%d", min(len(t.results)+1, t.drills), t.drills)
+	if t.done {
+		progress = "session complete"
+	}
+	r.TextRight(0, progress+" ", ColorText, ColorHeaderBg, terminal.AttrNone)
+}
+
+func (t *Trainer) drawText(r tui.Region) {
+	for y, line := range t.text.lines {
+		if y >= r.H {
+			break
+		}
+		r.Text(0, y, fmt.Sprintf("%*d", GutterWidth-1, y+1), ColorDim, ColorBg, terminal.AttrNone)
+		for x, ch := range line {
+			fg, bg, attr := ColorText, ColorBg, terminal.AttrNone
+			p := Pos{x, y}
+			switch {
+			case p == t.cursor:
+				fg, bg = ColorBg, ColorCursor
+			case p == t.target && !t.done:
+				fg, bg, attr = ColorBg, ColorTarget, terminal.AttrBold
+			}
+			r.Cell(GutterWidth+x, y, ch, fg, bg, attr)
+		}
+	}
+
+	if t.done {
+		msg := "Session complete: r new session, q quit"
+		r.TextCenter(r.H/2, " "+msg+" ", color.White, ColorHeaderBg, terminal.AttrBold)
+	}
+}
+
+// drawPanel shows per-family session stats with efficiency trends from history
+func (t *Trainer) drawPanel(r tui.Region) {
+	if r.H < 2 {
+		return
+	}
+	r.Text(0, 0, tui.RepeatRune('─', r.W), ColorDim, ColorBg, terminal.AttrNone)
+	r.Text(1, 1, fmt.Sprintf("%-8s %6s %8s %6s  %s", "family", "drills", "avg", "eff", "efficiency trend"),
+		ColorDim, ColorBg, terminal.AttrBold)
+
+	session := Summarize(t.results, t.started)
+	all := FamilyRecord{Drills: session.Drills, AvgMs: session.AvgMs, Efficiency: session.Efficiency}
+	t.drawFamilyRow(r, 2, "all", all, t.history.Trend("", efficiencyOf))
+
+	row := 3
+	for _, f := range families {
+		if row >= r.H {
+			break
+		}
+		t.drawFamilyRow(r, row, f, session.Families[f], t.history.Trend(f, efficiencyOf))
+		row++
+	}
+}
+
+func efficiencyOf(rec FamilyRecord) float64 {
+	return rec.Efficiency
+}
+
+func (t *Trainer) drawFamilyRow(r tui.Region, y int, name string, rec FamilyRecord, trend []float64) {
+	avg, eff := "-", "-"
+	effColor := ColorDim
+	if rec.Drills > 0 {
+		avg = fmt.Sprintf("%.2fs", float64(rec.AvgMs)/1000)
+		eff = strconv.Itoa(int(rec.Efficiency*100)) + "%"
+		switch {
+		case rec.Efficiency >= 0.8:
+			effColor = ColorGood
+		case rec.Efficiency >= 0.5:
+			effColor = ColorWarn
+		default:
+			effColor = ColorBad
+		}
+	}
+	r.Text(1, y, fmt.Sprintf("%-8s %6d %8s", name, rec.Drills, avg), ColorText, ColorBg, terminal.AttrNone)
+	r.Text(25, y, fmt.Sprintf("%6s", eff), effColor, ColorBg, terminal.AttrNone)
+	r.Sparkline(33, y, TrendWidth, trend, tui.SparklineOpts{
+		Min:   0,
+		Max:   1,
+		Style: tui.Style{Fg: ColorSpark, Bg: ColorBg},
+	})
+}
+
+// drawStatus shows the search prompt or pending input, then the last drill result
+func (t *Trainer) drawStatus(r tui.Region) {
+	if r.H < 1 {
+		return
+	}
+	r.Fill(ColorHeaderBg)
+
+	prompt := ""
+	switch {
+	case t.searching:
+		prefix := "/"
+		if !t.searchFwd {
+			prefix = "?"
+		}
+		prompt = prefix + string(t.pattern)
+	case t.count > 0 || t.pendingOp != 0:
+		if t.count > 0 {
+			prompt = strconv.Itoa(t.count)
+		}
+		if t.pendingOp != 0 {
+			prompt += string(t.pendingOp)
+		}
+	}
+	r.Text(1, 0, prompt, color.White, ColorHeaderBg, terminal.AttrBold)
+	r.TextRight(0, t.statusMsg+"  q quit ", ColorText, ColorHeaderBg, terminal.AttrNone)
+}
@@ -0,0 +1,9 @@
+package component
+
+import "github.com/lixenwraith/vi-fighter/core"
+
+// BracketComponent links a spawned bracket glyph to its matching pair member
+// '%' jumps between the two; typing both back to back awards the pair bonus
+type BracketComponent struct {
+	Partner core.Entity
+}
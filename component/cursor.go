@@ -8,4 +8,7 @@ import (
 type CursorComponent struct {
 	// ErrorFlashRemaining is the duration remaining for the error flash
 	ErrorFlashRemaining time.Duration
+
+	// ForgiveRemaining is the time left to Backspace over a typing error before its penalty applies
+	ForgiveRemaining time.Duration
 }
\ No newline at end of file
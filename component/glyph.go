@@ -1,5 +1,7 @@
 package component
 
+import "github.com/lixenwraith/vi-fighter/parameter"
+
 // GlyphComponent represents a typeable spawned character entity
 type GlyphComponent struct {
 	Rune  rune
@@ -10,7 +12,7 @@ type GlyphComponent struct {
 // GlyphType represents the semantic type affecting game mechanics
 type GlyphType int
 
-// NOTE: Changing values breaks GlyphColorLUT in parameter/visual/color.go
+// NOTE: Changing values breaks GlyphColorLUT and the CVD LUTs in parameter/visual
 const (
 	GlyphGreen GlyphType = 0
 	GlyphBlue  GlyphType = 1
@@ -19,12 +21,41 @@ const (
 	GlyphGold  GlyphType = 4
 )
 
+// GlyphTypeNames is the display name indexed by GlyphType
+var GlyphTypeNames = [...]string{
+	GlyphGreen: "green",
+	GlyphBlue:  "blue",
+	GlyphRed:   "red",
+	GlyphWhite: "white",
+	GlyphGold:  "gold",
+}
+
+// EnergyBase returns the energy per heat point for typing a glyph of this type, 0 = none
+func (t GlyphType) EnergyBase() int {
+	switch t {
+	case GlyphBlue:
+		return parameter.EnergyBaseBlue
+	case GlyphGreen:
+		return parameter.EnergyBaseGreen
+	case GlyphRed:
+		return parameter.EnergyBaseRed
+	}
+	return 0
+}
+
 // GlyphLevel represents brightness
 type GlyphLevel int
 
-// NOTE: Changing values breaks GlyphColorLUT in parameter/visual/color.go
+// NOTE: Changing values breaks GlyphColorLUT and the CVD LUTs in parameter/visual
 const (
 	GlyphDark   GlyphLevel = 0
 	GlyphNormal GlyphLevel = 1
 	GlyphBright GlyphLevel = 2
-)
\ No newline at end of file
+)
+
+// GlyphLevelNames is the display name indexed by GlyphLevel
+var GlyphLevelNames = [...]string{
+	GlyphDark:   "dark",
+	GlyphNormal: "normal",
+	GlyphBright: "bright",
+}
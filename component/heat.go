@@ -2,6 +2,15 @@ package component
 
 import (
 	"time"
+
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// Heat tiers derived from Current
+const (
+	HeatTierCool = iota
+	HeatTierWarm
+	HeatTierHot
 )
 
 // HeatComponent tracks the heat state
@@ -10,7 +19,27 @@ type HeatComponent struct {
 	Overheat            int
 	BurstFlashRemaining time.Duration
 
+	// Idle decay: starts HeatDecayGrace after the last gain
+	LastGainTime time.Time
+	DecayTime    time.Time
+
+	// Overheat warning latch and post-burst lockout on heat gains
+	OverheatWarned   bool
+	LockoutRemaining time.Duration
+
 	// Ember state
 	EmberActive    bool
 	EmberDecayTime time.Time
-}
\ No newline at end of file
+}
+
+// Tier returns the heat tier for spawn scaling
+func (h *HeatComponent) Tier() int {
+	switch {
+	case h.Current >= parameter.HeatTierHot:
+		return HeatTierHot
+	case h.Current >= parameter.HeatTierWarm:
+		return HeatTierWarm
+	default:
+		return HeatTierCool
+	}
+}
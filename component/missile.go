@@ -24,6 +24,10 @@ type MissileComponent struct {
 	TargetEntity core.Entity // Header for composite, entity for single
 	HitEntity    core.Entity // Specific member to hit (same as Target for non-composite)
 
+	// Seeker clears the glyph sequence at SeekX, SeekY instead of hunting combat targets
+	Seeker       bool
+	SeekX, SeekY int
+
 	// Timing
 	Lifetime      time.Duration // Time since spawn
 	LastTrailEmit time.Duration // Lifetime at last trail emission
@@ -0,0 +1,15 @@
+package component
+
+import (
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/core"
+)
+
+// TurretComponent holds a player-placed turret that fires when its bound word is typed
+type TurretComponent struct {
+	Word   []core.Entity // Glyphs of the bound word, nil while rearming
+	Typed  int           // Word glyphs typed so far
+	Primed bool          // Word completed, fires at the next available target
+	Rearm  time.Duration // Remaining delay before a new word is bound
+}
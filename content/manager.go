@@ -87,6 +87,11 @@ func NewContentManager() *ContentManager {
 	}
 }
 
+// Reseed restarts block selection from seed, for reproducible sessions
+func (cm *ContentManager) Reseed(seed uint64) {
+	cm.rng.SetState(seed)
+}
+
 // SetDataDir overrides the default data directory path
 func (cm *ContentManager) SetDataDir(path string) {
 	cm.dataDir = path
@@ -359,6 +364,22 @@ func (cm *ContentManager) loadAndProcessFile(filePath string) ([]string, error)
 	return allLines, nil
 }
 
+// LoadPracticeLines loads a user file for practice mode, keeping line order
+// Lines are processed like content files: sanitized, trimmed, comments and blanks dropped
+func (cm *ContentManager) LoadPracticeLines(filePath string) ([]string, error) {
+	if err := cm.validateFileEncoding(filePath); err != nil {
+		return nil, err
+	}
+	lines, err := cm.loadAndProcessFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no practice lines in %s", filePath)
+	}
+	return lines, nil
+}
+
 // GetContentFiles returns the list of discovered content files
 func (cm *ContentManager) GetContentFiles() []string {
 	return cm.contentFiles
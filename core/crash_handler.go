@@ -1,20 +1,53 @@
 package core
 
+import "sync"
+
 var crashTerminal interface{ Fini() }
 
+var (
+	cleanupMu sync.Mutex
+	cleanups  []func()
+)
+
 // SetCrashTerminal registers terminal for crash cleanup
 func SetCrashTerminal(t interface{ Fini() }) {
 	crashTerminal = t
 }
 
+// RegisterCleanup adds fn to run on crash before the terminal is restored, latest registered runs first
+func RegisterCleanup(fn func()) {
+	cleanupMu.Lock()
+	cleanups = append(cleanups, fn)
+	cleanupMu.Unlock()
+}
+
+// runCleanups runs and drains registered cleanups, a panicking cleanup does not stop the rest
+func runCleanups() {
+	cleanupMu.Lock()
+	fns := cleanups
+	cleanups = nil
+	cleanupMu.Unlock()
+
+	for i := len(fns) - 1; i >= 0; i-- {
+		func() {
+			defer func() { recover() }()
+			fns[i]()
+		}()
+	}
+}
+
+// RecoverAndRestore must be deferred directly, it turns a panic into HandleCrash
+// Usage: defer core.RecoverAndRestore() at the top of any goroutine that may run while the terminal is raw
+func RecoverAndRestore() {
+	if r := recover(); r != nil {
+		HandleCrash(r)
+	}
+}
+
 // Go to be used instead of 'go' to run a function in a new goroutine with panic recovery, to cleanup terminal on crash
 func Go(fn func()) {
 	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				HandleCrash(r)
-			}
-		}()
+		defer RecoverAndRestore()
 		fn()
 	}()
-}
\ No newline at end of file
+}
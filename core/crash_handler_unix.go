@@ -6,21 +6,29 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"sync"
+	"time"
 
 	"github.com/lixenwraith/terminal"
 )
 
+// crashFiniTimeout bounds a terminal Fini that blocks on a lock held by the panicking goroutine
+const crashFiniTimeout = 500 * time.Millisecond
+
+// crashMu is never released, a second panicking goroutine waits for the first to exit
+var crashMu sync.Mutex
+
 // HandleCrash is the unified panic handler that resets the terminal and prints the stack trace
 func HandleCrash(r any) {
 	if r == nil {
 		return
 	}
+	crashMu.Lock()
 
-	// Terminal cleanup if available
-	if crashTerminal != nil {
-		crashTerminal.Fini()
-	} else {
-		// Fallback for edge cases
+	runCleanups()
+
+	// Terminal cleanup if available, falling back when Fini panics or hangs
+	if !finiTerminal() {
 		terminal.EmergencyReset(os.Stdout)
 	}
 
@@ -28,4 +36,29 @@ func HandleCrash(r any) {
 	fmt.Fprintf(os.Stderr, "Stack Trace:\n%s\n", debug.Stack())
 
 	os.Exit(1)
-}
\ No newline at end of file
+}
+
+// finiTerminal restores the registered terminal, reports false if none is registered or Fini did not complete
+func finiTerminal() bool {
+	if crashTerminal == nil {
+		return false
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		defer func() {
+			if recover() != nil {
+				done <- false
+			}
+		}()
+		crashTerminal.Fini()
+		done <- true
+	}()
+
+	select {
+	case ok := <-done:
+		return ok
+	case <-time.After(crashFiniTimeout):
+		return false
+	}
+}
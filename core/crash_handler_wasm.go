@@ -14,6 +14,8 @@ func HandleCrash(r any) {
 		return
 	}
 
+	runCleanups()
+
 	// Clean up xterm.js state if terminal registered
 	if crashTerminal != nil {
 		crashTerminal.Fini()
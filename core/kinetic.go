@@ -1,5 +1,7 @@
 package core
 
+import "github.com/lixenwraith/vi-fighter/vmath"
+
 type Kinetic struct {
 	// PreciseX and PreciseY are sub-pixel coordinates in Q32.32 format
 	PreciseX, PreciseY int64
@@ -7,4 +9,47 @@ type Kinetic struct {
 	VelX, VelY int64
 	// AccelX and AccelY represent acceleration in cells per second squared (Q32.32)
 	AccelX, AccelY int64
-}
\ No newline at end of file
+}
+
+// Step integrates acceleration then velocity over dt (Q32.32 seconds) and returns the grid position
+func (k *Kinetic) Step(dt int64) (x, y int) {
+	k.VelX += vmath.Mul(k.AccelX, dt)
+	k.VelY += vmath.Mul(k.AccelY, dt)
+	return k.Move(dt)
+}
+
+// Move advances position by velocity over dt, ignoring acceleration, and returns the grid position
+func (k *Kinetic) Move(dt int64) (x, y int) {
+	k.PreciseX += vmath.Mul(k.VelX, dt)
+	k.PreciseY += vmath.Mul(k.VelY, dt)
+	return vmath.ToInt(k.PreciseX), vmath.ToInt(k.PreciseY)
+}
+
+// ApplyForce accelerates by fx,fy (cells per second squared, unit mass) over dt
+// Unlike AccelX/AccelY the force is transient and applies only to this call
+func (k *Kinetic) ApplyForce(fx, fy, dt int64) {
+	k.VelX += vmath.Mul(fx, dt)
+	k.VelY += vmath.Mul(fy, dt)
+}
+
+// ApplyImpulse adds a velocity delta (momentum transfer)
+func (k *Kinetic) ApplyImpulse(vx, vy int64) {
+	k.VelX += vx
+	k.VelY += vy
+}
+
+// SetVelocity overrides velocity (hard redirect/stun)
+func (k *Kinetic) SetVelocity(vx, vy int64) {
+	k.VelX = vx
+	k.VelY = vy
+}
+
+// GridPos returns the current integer grid position
+func (k *Kinetic) GridPos() (x, y int) {
+	return vmath.ToInt(k.PreciseX), vmath.ToInt(k.PreciseY)
+}
+
+// SetGridPos sets the precise position to the center of a grid cell
+func (k *Kinetic) SetGridPos(x, y int) {
+	k.PreciseX, k.PreciseY = vmath.CenteredFromGrid(x, y)
+}
@@ -5,6 +5,12 @@ type OverlayContent struct {
 	Title  string
 	Items  []OverlayItem
 	Custom bool // Custom rendering mode (bypasses masonry cards)
+
+	// Selectable overlays move a selection over entries with an Action instead of scrolling
+	Selectable bool
+
+	// ResetOnClose starts a new game when the overlay is closed (game over)
+	ResetOnClose bool
 }
 
 // OverlayItem is implemented by all overlay component types
@@ -24,6 +30,25 @@ func (OverlayCard) overlayItem() {}
 type CardEntry struct {
 	Key   string
 	Value string
+
+	// Action is the :set argument applied when the entry is activated, "" = read-only
+	Action string
+}
+
+// OverlayChart displays a titled chart of a sampled series
+type OverlayChart struct {
+	Title   string
+	Values  []float64
+	Markers []ChartMarker
+}
+
+func (OverlayChart) overlayItem() {}
+
+// ChartMarker flags one sample of a chart series
+type ChartMarker struct {
+	Index int // Sample index into Values
+	Rune  rune
+	Best  bool // Best or worst highlight
 }
 
 // Cards extracts all OverlayCard items from content
@@ -38,4 +63,31 @@ func (c *OverlayContent) Cards() []OverlayCard {
 		}
 	}
 	return cards
-}
\ No newline at end of file
+}
+
+// Charts extracts all OverlayChart items from content
+func (c *OverlayContent) Charts() []OverlayChart {
+	if c == nil {
+		return nil
+	}
+	var charts []OverlayChart
+	for _, item := range c.Items {
+		if chart, ok := item.(OverlayChart); ok {
+			charts = append(charts, chart)
+		}
+	}
+	return charts
+}
+
+// Actions returns the activatable entries of all cards in display order
+func (c *OverlayContent) Actions() []CardEntry {
+	var actions []CardEntry
+	for _, card := range c.Cards() {
+		for _, e := range card.Entries {
+			if e.Action != "" {
+				actions = append(actions, e)
+			}
+		}
+	}
+	return actions
+}
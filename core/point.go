@@ -3,4 +3,19 @@ package core
 // Point represents a 2D coordinate
 type Point struct {
 	X, Y int
-}
\ No newline at end of file
+}
+
+// Add returns the component-wise sum
+func (p Point) Add(o Point) Point {
+	return Point{X: p.X + o.X, Y: p.Y + o.Y}
+}
+
+// Sub returns the component-wise difference
+func (p Point) Sub(o Point) Point {
+	return Point{X: p.X - o.X, Y: p.Y - o.Y}
+}
+
+// Scale returns the point with both components multiplied by k
+func (p Point) Scale(k int) Point {
+	return Point{X: p.X * k, Y: p.Y * k}
+}
@@ -0,0 +1,98 @@
+package core
+
+import "github.com/lixenwraith/vi-fighter/vmath"
+
+// Rect is an axis-aligned cell rectangle, X and Y inclusive at the top-left, Width and Height exclusive
+type Rect struct {
+	X, Y          int // Top-left corner
+	Width, Height int // Dimensions, empty at zero or below
+}
+
+// RectFromCorners builds the rectangle spanning two inclusive corner cells in any order
+func RectFromCorners(x0, y0, x1, y1 int) Rect {
+	return Rect{X: min(x0, x1), Y: min(y0, y1), Width: max(x0, x1) - min(x0, x1) + 1, Height: max(y0, y1) - min(y0, y1) + 1}
+}
+
+// Grow returns the rectangle expanded by n cells on every side, shrunk for negative n
+func (r Rect) Grow(n int) Rect {
+	return Rect{X: r.X - n, Y: r.Y - n, Width: r.Width + 2*n, Height: r.Height + 2*n}
+}
+
+// Right returns the first column past the rectangle
+func (r Rect) Right() int {
+	return r.X + r.Width
+}
+
+// Bottom returns the first row past the rectangle
+func (r Rect) Bottom() int {
+	return r.Y + r.Height
+}
+
+// Empty reports whether the rectangle covers no cells
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Contains reports whether the cell is inside the rectangle
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// ContainsPoint reports whether p is inside the rectangle
+func (r Rect) ContainsPoint(p Point) bool {
+	return r.Contains(p.X, p.Y)
+}
+
+// Intersect returns the overlap of both rectangles, empty if they do not overlap
+func (r Rect) Intersect(o Rect) Rect {
+	x0, y0 := max(r.X, o.X), max(r.Y, o.Y)
+	x1, y1 := min(r.Right(), o.Right()), min(r.Bottom(), o.Bottom())
+	if x0 >= x1 || y0 >= y1 {
+		return Rect{}
+	}
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// Intersects reports whether the rectangles share any cell
+func (r Rect) Intersects(o Rect) bool {
+	return !r.Empty() && !o.Empty() &&
+		r.X < o.Right() && o.X < r.Right() && r.Y < o.Bottom() && o.Y < r.Bottom()
+}
+
+// Clamp returns the cell inside the rectangle nearest to x,y; the rectangle must not be empty
+func (r Rect) Clamp(x, y int) (int, int) {
+	return max(r.X, min(x, r.Right()-1)), max(r.Y, min(y, r.Bottom()-1))
+}
+
+// ClampPoint returns the point inside the rectangle nearest to p
+func (r Rect) ClampPoint(p Point) Point {
+	x, y := r.Clamp(p.X, p.Y)
+	return Point{X: x, Y: y}
+}
+
+// Center returns the center cell, rounded toward the top-left
+func (r Rect) Center() Point {
+	return Point{X: r.X + r.Width/2, Y: r.Y + r.Height/2}
+}
+
+// RandomPoint returns a random cell within the rectangle
+func (r Rect) RandomPoint(rng *vmath.FastRand) Point {
+	x, y := r.X, r.Y
+	if r.Width > 1 {
+		x += rng.Intn(r.Width)
+	}
+	if r.Height > 1 {
+		y += rng.Intn(r.Height)
+	}
+	return Point{X: x, Y: y}
+}
+
+// DistributePoint returns the index-th cell in row-major order for even distribution
+// Falls back to random once index exceeds the rectangle's capacity
+func (r Rect) DistributePoint(index int, rng *vmath.FastRand) Point {
+	capacity := r.Width * r.Height
+	if capacity <= 1 || index >= capacity {
+		return r.RandomPoint(rng)
+	}
+	return Point{X: r.X + index%r.Width, Y: r.Y + index/r.Width}
+}
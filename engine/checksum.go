@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Checksum hashes the simulation state that must match between runs of the same seed and
+// input: entity positions, glyphs, player energy and heat, typing counters, and the random
+// stream states; cosmetic state such as blinks and flashes is left out
+// Entity terms are summed so store iteration order does not matter
+// Caller must hold the world lock
+func (w *World) Checksum() uint64 {
+	var sum uint64
+	for _, e := range w.Positions.Entities() {
+		if pos, ok := w.Positions.GetPosition(e); ok {
+			sum += checksumMix(uint64(e), uint64(pos.X), uint64(pos.Y), 1)
+		}
+	}
+	w.Components.Glyph.Each(func(e core.Entity, g *component.GlyphComponent) bool {
+		sum += checksumMix(uint64(e), uint64(g.Rune), uint64(g.Type)<<8|uint64(g.Level), 2)
+		return true
+	})
+
+	h := checksumMix(sum, uint64(w.nextEntityID), 0, 0)
+	player := w.Resources.Player.Entity
+	if energy, ok := w.Components.Energy.GetComponent(player); ok {
+		h = checksumMix(h, uint64(energy.Current), 0, 3)
+	}
+	if heat, ok := w.Components.Heat.GetComponent(player); ok {
+		h = checksumMix(h, uint64(heat.Current), uint64(heat.Overheat), 4)
+	}
+	if status := w.Resources.Status; status != nil {
+		h = checksumMix(h, uint64(status.Ints.Get("typing.correct").Load()), uint64(status.Ints.Get("typing.errors").Load()), 5)
+	}
+	if rs := w.Resources.Rand; rs != nil {
+		h = checksumMix(h, rs.Seed(), 0, 6)
+		for stream := range vmath.RandStreamCount {
+			h = checksumMix(h, rs.Stream(stream).State(), uint64(stream), 7)
+		}
+	}
+	return h
+}
+
+// checksumMix folds four words into a well-mixed hash, splitmix64 finalized
+func checksumMix(a, b, c, d uint64) uint64 {
+	x := a*0x9E3779B97F4A7C15 ^ b*0xC2B2AE3D27D4EB4F ^ c*0x165667B19E3779F9 ^ d*0xD6E8FEB86659FD93
+	x = (x ^ (x >> 30)) * 0xBF58476D1CE4E5B9
+	x = (x ^ (x >> 27)) * 0x94D049BB133111EB
+	return x ^ (x >> 31)
+}
+
+// ChecksumTrace records per-tick world checksums, or verifies ticks against a recorded trace
+// The scheduler feeds it after each tick's systems run; not safe for concurrent use
+type ChecksumTrace struct {
+	w    *bufio.Writer // Record mode
+	want []uint64      // Verify mode
+
+	tick     int
+	diverged int // First divergent tick, -1 while in sync
+
+	// OnDiverge is called once, at the first tick whose checksum differs from the recording
+	// Runs under the world lock
+	OnDiverge func(tick int, want, got uint64)
+}
+
+// NewChecksumRecorder writes one "tick checksum" line per tick to w
+func NewChecksumRecorder(w io.Writer) *ChecksumTrace {
+	return &ChecksumTrace{w: bufio.NewWriter(w), diverged: -1}
+}
+
+// NewChecksumVerifier reads a recorded trace and compares later ticks against it
+func NewChecksumVerifier(r io.Reader) (*ChecksumTrace, error) {
+	t := &ChecksumTrace{diverged: -1}
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("checksum line %d: want \"tick checksum\"", line)
+		}
+		tick, err := strconv.Atoi(fields[0])
+		if err != nil || tick != len(t.want) {
+			return nil, fmt.Errorf("checksum line %d: tick %q out of sequence", line, fields[0])
+		}
+		sum, err := strconv.ParseUint(fields[1], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("checksum line %d: %w", line, err)
+		}
+		t.want = append(t.want, sum)
+	}
+	return t, sc.Err()
+}
+
+// Observe records or verifies the checksum of the next tick
+func (t *ChecksumTrace) Observe(sum uint64) {
+	tick := t.tick
+	t.tick++
+	if t.w != nil {
+		fmt.Fprintf(t.w, "%d %016x\n", tick, sum)
+		return
+	}
+	if t.diverged >= 0 || tick >= len(t.want) || t.want[tick] == sum {
+		return
+	}
+	t.diverged = tick
+	if t.OnDiverge != nil {
+		t.OnDiverge(tick, t.want[tick], sum)
+	}
+}
+
+// Ticks returns the number of ticks observed
+func (t *ChecksumTrace) Ticks() int {
+	return t.tick
+}
+
+// Diverged returns the first divergent tick, or -1 while the trace matches
+func (t *ChecksumTrace) Diverged() int {
+	return t.diverged
+}
+
+// Flush writes buffered records
+func (t *ChecksumTrace) Flush() error {
+	if t.w == nil {
+		return nil
+	}
+	return t.w.Flush()
+}
@@ -46,6 +46,9 @@ type ClockScheduler struct {
 	// Finite GameState Machine
 	fsm *fsm.Machine[*World]
 
+	// Per-tick state checksums for desync detection, nil when off
+	trace *ChecksumTrace
+
 	// Event loop configuration
 	eventLoopInterval   time.Duration
 	eventLoopBackoffMax int
@@ -174,6 +177,22 @@ func (cs *ClockScheduler) Stop() {
 	})
 }
 
+// SetChecksumTrace feeds the world checksum of every tick to trace; call before Start or Step
+func (cs *ClockScheduler) SetChecksumTrace(trace *ChecksumTrace) {
+	cs.trace = trace
+}
+
+// Step runs one tick synchronously, settling a pending reset first
+// For headless drivers that own the clock; never mix with Start
+func (cs *ClockScheduler) Step() {
+	select {
+	case <-cs.resetChan:
+		cs.executeReset()
+	default:
+	}
+	cs.processTick()
+}
+
 // schedulerLoop runs the main scheduling loop with pause awareness
 func (cs *ClockScheduler) schedulerLoop() {
 	defer cs.wg.Done()
@@ -450,6 +469,9 @@ func (cs *ClockScheduler) processTick() {
 
 		// 7. System Execution: Systems run on the final, settled state for this tick
 		cs.world.UpdateLocked()
+		if cs.trace != nil {
+			cs.trace.Observe(cs.world.Checksum())
+		}
 
 		// 8. Snapshot store-derived stats while the lock is held
 		// Position has no internal locking; CountEntities outside this
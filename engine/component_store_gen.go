@@ -19,6 +19,7 @@ const (
 	WallBit
 	LootBit
 	GatewayBit
+	BracketBit
 	EnergyBit
 	HeatBit
 	ShieldBit
@@ -53,6 +54,7 @@ const (
 	SnakeMemberBit
 	EyeBit
 	TowerBit
+	TurretBit
 	HeaderBit
 	MemberBit
 	FlashBit
@@ -76,6 +78,7 @@ type Component struct {
 	Wall         *Store[component.WallComponent]
 	Loot         *Store[component.LootComponent]
 	Gateway      *Store[component.GatewayComponent]
+	Bracket      *Store[component.BracketComponent]
 	Energy       *Store[component.EnergyComponent]
 	Heat         *Store[component.HeatComponent]
 	Shield       *Store[component.ShieldComponent]
@@ -110,6 +113,7 @@ type Component struct {
 	SnakeMember  *Store[component.SnakeMemberComponent]
 	Eye          *Store[component.EyeComponent]
 	Tower        *Store[component.TowerComponent]
+	Turret       *Store[component.TurretComponent]
 	Header       *Store[component.HeaderComponent]
 	Member       *Store[component.MemberComponent]
 	Flash        *Store[component.FlashComponent]
@@ -132,6 +136,7 @@ func initComponents(w *World) {
 	w.Components.Wall = NewStore[component.WallComponent](w, WallBit)
 	w.Components.Loot = NewStore[component.LootComponent](w, LootBit)
 	w.Components.Gateway = NewStore[component.GatewayComponent](w, GatewayBit)
+	w.Components.Bracket = NewStore[component.BracketComponent](w, BracketBit)
 	w.Components.Energy = NewStore[component.EnergyComponent](w, EnergyBit)
 	w.Components.Heat = NewStore[component.HeatComponent](w, HeatBit)
 	w.Components.Shield = NewStore[component.ShieldComponent](w, ShieldBit)
@@ -166,6 +171,7 @@ func initComponents(w *World) {
 	w.Components.SnakeMember = NewStore[component.SnakeMemberComponent](w, SnakeMemberBit)
 	w.Components.Eye = NewStore[component.EyeComponent](w, EyeBit)
 	w.Components.Tower = NewStore[component.TowerComponent](w, TowerBit)
+	w.Components.Turret = NewStore[component.TurretComponent](w, TurretBit)
 	w.Components.Header = NewStore[component.HeaderComponent](w, HeaderBit)
 	w.Components.Member = NewStore[component.MemberComponent](w, MemberBit)
 	w.Components.Flash = NewStore[component.FlashComponent](w, FlashBit)
@@ -221,6 +227,9 @@ func (w *World) removeEntity(e core.Entity) {
 	if mask&GatewayBit != 0 {
 		w.Components.Gateway.RemoveEntity(e, true)
 	}
+	if mask&BracketBit != 0 {
+		w.Components.Bracket.RemoveEntity(e, true)
+	}
 	if mask&EnergyBit != 0 {
 		w.Components.Energy.RemoveEntity(e, true)
 	}
@@ -323,6 +332,9 @@ func (w *World) removeEntity(e core.Entity) {
 	if mask&TowerBit != 0 {
 		w.Components.Tower.RemoveEntity(e, true)
 	}
+	if mask&TurretBit != 0 {
+		w.Components.Turret.RemoveEntity(e, true)
+	}
 	if mask&HeaderBit != 0 {
 		w.Components.Header.RemoveEntity(e, true)
 	}
@@ -396,6 +408,9 @@ func (w *World) removeEntitiesBatch(entities []core.Entity) {
 	if union&GatewayBit != 0 {
 		w.Components.Gateway.RemoveBatch(entities, true)
 	}
+	if union&BracketBit != 0 {
+		w.Components.Bracket.RemoveBatch(entities, true)
+	}
 	if union&EnergyBit != 0 {
 		w.Components.Energy.RemoveBatch(entities, true)
 	}
@@ -498,6 +513,9 @@ func (w *World) removeEntitiesBatch(entities []core.Entity) {
 	if union&TowerBit != 0 {
 		w.Components.Tower.RemoveBatch(entities, true)
 	}
+	if union&TurretBit != 0 {
+		w.Components.Turret.RemoveBatch(entities, true)
+	}
 	if union&HeaderBit != 0 {
 		w.Components.Header.RemoveBatch(entities, true)
 	}
@@ -543,6 +561,7 @@ func (w *World) wipeAll() {
 	w.Components.Wall.ClearAllComponents()
 	w.Components.Loot.ClearAllComponents()
 	w.Components.Gateway.ClearAllComponents()
+	w.Components.Bracket.ClearAllComponents()
 	w.Components.Energy.ClearAllComponents()
 	w.Components.Heat.ClearAllComponents()
 	w.Components.Shield.ClearAllComponents()
@@ -577,6 +596,7 @@ func (w *World) wipeAll() {
 	w.Components.SnakeMember.ClearAllComponents()
 	w.Components.Eye.ClearAllComponents()
 	w.Components.Tower.ClearAllComponents()
+	w.Components.Turret.ClearAllComponents()
 	w.Components.Header.ClearAllComponents()
 	w.Components.Member.ClearAllComponents()
 	w.Components.Flash.ClearAllComponents()
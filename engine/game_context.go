@@ -9,6 +9,7 @@ import (
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
 	"github.com/lixenwraith/vi-fighter/status"
+	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
 // GameContext holds all game state including the ECS world
@@ -30,6 +31,7 @@ type GameContext struct {
 	FrameNumber atomic.Int64 // Render frame counter; incremented by main loop
 
 	IsPaused atomic.Bool // Pause flag; actual timing handled by PausableClock
+	TooSmall atomic.Bool // Terminal below MinTerminalWidth/Height; game held paused
 
 	MacroRecording      atomic.Bool  // True when macro is recording
 	MacroRecordingLabel atomic.Int32 // Current recording label (rune), 0 if not recording
@@ -47,6 +49,8 @@ type GameContext struct {
 	Width, Height            int // Terminal dimensions
 	GameXOffset, GameYOffset int // Game area offset from terminal origin
 
+	InputMeter *InputMeter // Keystroke rate and input-to-render latency
+
 	// === Context Exclusive ===
 
 	// No sync required
@@ -69,6 +73,7 @@ type GameContext struct {
 	overlayActive  atomic.Bool
 	overlayTitle   atomic.Pointer[string]
 	overlayScroll  atomic.Int32
+	overlaySelect  atomic.Int32 // Index into OverlayContent.Actions for selectable overlays
 	overlayContent atomic.Pointer[core.OverlayContent]
 
 	// Cached FPS state
@@ -108,6 +113,8 @@ func NewGameContext(world *World, width, height int) *GameContext {
 		CameraX:        0,
 		CameraY:        0,
 		CropOnResize:   true,
+		Assist:         DefaultAssistSettings(),
+		Popups:         DefaultPopupSettings(),
 	}
 
 	// 3. Time Resource (Initial state)
@@ -120,6 +127,7 @@ func NewGameContext(world *World, width, height int) *GameContext {
 
 	// 4. Event Queue Resource
 	world.Resources.Event = &EventQueueResource{Queue: event.NewEventQueue()}
+	world.Resources.Timer = &TimerResource{}
 
 	// 5. Game GameState
 	ctx.State = NewGameState()
@@ -128,12 +136,18 @@ func NewGameContext(world *World, width, height int) *GameContext {
 	// 6. Transient Resource
 	world.Resources.Transient = NewTransientResource()
 
+	// 6. Random Streams, time-seeded until the app applies a configured or replayed seed
+	world.Resources.Rand = vmath.NewRandStreams(uint64(pausableClock.RealTime().UnixNano()))
+
 	// 7. Cursor Entity
 	ctx.World.CreateCursorEntity()
 
 	// 8. Target Resource
 	world.Resources.Target = &TargetResource{}
 
+	// 8. Session Timeline Resource
+	world.Resources.Timeline = &TimelineResource{}
+
 	// 8. Initialize atomic string pointers to empty strings
 	empty := ""
 	ctx.commandText.Store(&empty)
@@ -149,6 +163,12 @@ func NewGameContext(world *World, width, height int) *GameContext {
 	ctx.statFPS = ctx.World.Resources.Status.Ints.Get("engine.fps")
 	ctx.lastFPSUpdate = ctx.PausableClock.RealTime()
 
+	// 11. Input meter
+	ctx.InputMeter = NewInputMeter(ctx.World.Resources.Status)
+
+	// 12. Size guard, for a terminal already too small at startup
+	ctx.updateSizeGuard()
+
 	return ctx
 }
 
@@ -190,10 +210,9 @@ func (ctx *GameContext) HandleResizeLocked() {
 	config.ViewportHeight = viewportHeight
 
 	if config.CropOnResize {
-		// Resize map to match viewport, cleanup OOB entities
+		// Resize map to match viewport; OOB entities are remapped once the grid matches
 		config.MapWidth = viewportWidth
 		config.MapHeight = viewportHeight
-		ctx.cleanupOutOfBoundsEntities(config.MapWidth, config.MapHeight)
 		// Reset camera
 		config.CameraX = 0
 		config.CameraY = 0
@@ -204,6 +223,9 @@ func (ctx *GameContext) HandleResizeLocked() {
 
 	// Grid tracks map dimensions (grow-only, no reallocation on shrink)
 	ctx.World.Positions.ResizeGrid(config.MapWidth, config.MapHeight)
+	if config.CropOnResize {
+		ctx.remapOutOfBoundsEntities(config.MapWidth, config.MapHeight)
+	}
 
 	// Clamp cursor to Map bounds
 	cursorEntity := ctx.World.Resources.Player.Entity
@@ -225,6 +247,17 @@ func (ctx *GameContext) HandleResizeLocked() {
 	if newX, newY, moved := ctx.World.PushEntityFromBlocked(cursorEntity, component.WallBlockCursor); moved {
 		ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: newX, Y: newY})
 	}
+
+	ctx.updateSizeGuard()
+}
+
+// updateSizeGuard flags a terminal below the playable minimum, announcing each crossing
+// MetaSystem holds the pause while flagged; renderers read TooSmall directly
+func (ctx *GameContext) updateSizeGuard() {
+	tooSmall := ctx.Width < parameter.MinTerminalWidth || ctx.Height < parameter.MinTerminalHeight
+	if ctx.TooSmall.Swap(tooSmall) != tooSmall {
+		ctx.PushEvent(event.EventMetaSizeGuard, &event.MetaSizeGuardPayload{TooSmall: tooSmall})
+	}
 }
 
 // clampCamera constrains camera position to valid range
@@ -247,27 +280,6 @@ func (ctx *GameContext) clampCamera(config *ConfigResource) {
 	}
 }
 
-// cleanupOutOfBoundsEntities tags entities outside valid map area for destruction
-func (ctx *GameContext) cleanupOutOfBoundsEntities(width, height int) {
-	deathStore := ctx.World.Components.Death
-
-	// Unified cleanup: single Positions iteration handles all entity types
-	allEntities := ctx.World.Positions.AllEntities()
-	for _, e := range allEntities {
-		// Skip cursor entity (special case)
-		if e == ctx.World.Resources.Player.Entity {
-			continue
-		}
-
-		// Mark entities outside valid coordinate space [0, width) × [0, height)
-		// Death systems informs respective systems of their entity destruction
-		pos, _ := ctx.World.Positions.GetPosition(e)
-		if pos.X >= width || pos.Y >= height || pos.X < 0 || pos.Y < 0 {
-			deathStore.SetComponent(e, component.DeathComponent{})
-		}
-	}
-}
-
 // === Frame Number Accessories ===
 
 // GetFrameNumber returns the live render frame index
@@ -440,6 +452,14 @@ func (ctx *GameContext) SetOverlayScroll(scroll int) {
 	ctx.overlayScroll.Store(int32(scroll))
 }
 
+func (ctx *GameContext) GetOverlaySelect() int {
+	return int(ctx.overlaySelect.Load())
+}
+
+func (ctx *GameContext) SetOverlaySelect(index int) {
+	ctx.overlaySelect.Store(int32(index))
+}
+
 func (ctx *GameContext) GetOverlayContent() *core.OverlayContent {
 	return ctx.overlayContent.Load()
 }
@@ -462,6 +482,7 @@ func (ctx *GameContext) SetOverlayContent(content *core.OverlayContent) {
 		ctx.overlayTitle.Store(&empty)
 	}
 	ctx.overlayScroll.Store(0)
+	ctx.overlaySelect.Store(0)
 }
 
 // === Pause ===
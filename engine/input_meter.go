@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/status"
+)
+
+// InputMeter tracks rolling keystrokes per minute and input-to-render latency
+// Latency runs from the oldest keystroke's poll timestamp to the end of the next terminal flush
+// Owned by the main loop: keystrokes are marked and frames flushed on the same goroutine
+// Results are published to the status registry for other readers
+type InputMeter struct {
+	counts  [parameter.KPMWindowSeconds]int   // Keystrokes per second, ring indexed by Unix second
+	seconds [parameter.KPMWindowSeconds]int64 // Unix second each ring slot counts
+
+	pending time.Time     // Oldest keystroke not yet on screen, zero when none
+	last    time.Duration // Most recent measurement
+	smooth  time.Duration // EWMA over measurements
+
+	statKPM       *atomic.Int64
+	statLatencyMs *status.AtomicFloat
+}
+
+// NewInputMeter creates a meter publishing to reg
+func NewInputMeter(reg *status.Registry) *InputMeter {
+	return &InputMeter{
+		statKPM:       reg.Ints.Get("input.kpm"),
+		statLatencyMs: reg.Floats.Get("input.latency_ms"),
+	}
+}
+
+// Keystroke counts a key event polled at the given time and starts a latency measurement if none is pending
+func (m *InputMeter) Keystroke(at time.Time) {
+	sec := at.Unix()
+	slot := sec % parameter.KPMWindowSeconds
+	if m.seconds[slot] != sec {
+		m.seconds[slot] = sec
+		m.counts[slot] = 0
+	}
+	m.counts[slot]++
+
+	if m.pending.IsZero() {
+		m.pending = at
+	}
+	m.statKPM.Store(int64(m.KPM(at)))
+}
+
+// Flushed completes the pending measurement at the end of a terminal flush
+func (m *InputMeter) Flushed(end time.Time) {
+	if m.pending.IsZero() {
+		return
+	}
+	m.last = end.Sub(m.pending)
+	m.pending = time.Time{}
+
+	if m.smooth == 0 {
+		m.smooth = m.last
+	} else {
+		m.smooth += time.Duration(parameter.LatencySmoothing * float64(m.last-m.smooth))
+	}
+	m.statLatencyMs.Set(float64(m.smooth) / float64(time.Millisecond))
+}
+
+// KPM returns keystrokes within the rolling window ending at now
+func (m *InputMeter) KPM(now time.Time) int {
+	oldest := now.Unix() - parameter.KPMWindowSeconds
+	total := 0
+	for i, sec := range m.seconds {
+		if sec > oldest {
+			total += m.counts[i]
+		}
+	}
+	return total
+}
+
+// Latency returns the smoothed and most recent input-to-render latency
+func (m *InputMeter) Latency() (smoothed, last time.Duration) {
+	return m.smooth, m.last
+}
@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"runtime"
+	"time"
+)
+
+// Loop sleep tuning
+const (
+	loopOversleepKeep = 0.9 // EMA weight of the previous oversleep estimate
+	loopDefaultCatch  = 5
+)
+
+// Loop paces a frame loop: renders are capped at FrameInterval, and updates
+// run at a fixed Step from an accumulator so simulation rate is independent of
+// render rate. A frame that falls far behind drops its backlog instead of
+// bursting, so load degrades to slow motion rather than a catch-up spiral
+type Loop struct {
+	Step          time.Duration // Fixed update step, 0 runs one variable update per frame
+	FrameInterval time.Duration // Minimum time between frames, 0 is uncapped
+	MaxCatchUp    int           // Updates per frame before the backlog is dropped
+	SpinWindow    time.Duration // Tail of each wait spent yielding instead of sleeping, 0 sleeps only
+
+	started   bool
+	last      time.Time     // Start of the previous frame
+	next      time.Time     // Deadline of the next frame
+	acc       time.Duration // Unconsumed update time
+	oversleep time.Duration // Estimated time.Sleep overshoot, subtracted from later sleeps
+
+	frames  uint64
+	dropped uint64 // Update steps discarded by catch-up limiting
+	late    uint64 // Frames that started a whole interval late
+}
+
+// NewLoop creates a loop with a fixed update step and a frame cap
+func NewLoop(step, frameInterval time.Duration) *Loop {
+	return &Loop{
+		Step:          step,
+		FrameInterval: frameInterval,
+		MaxCatchUp:    loopDefaultCatch,
+	}
+}
+
+// Wait blocks until the next frame is due and returns the time since the previous frame
+// The first call returns immediately with zero
+func (l *Loop) Wait() time.Duration {
+	now := time.Now()
+	if !l.started {
+		l.started = true
+		l.last, l.next = now, now.Add(l.FrameInterval)
+		l.frames++
+		return 0
+	}
+
+	if l.FrameInterval > 0 {
+		if now.Before(l.next) {
+			l.sleepUntil(l.next)
+			now = time.Now()
+		}
+		// Deadlines advance on schedule so pacing does not drift; a frame a whole
+		// interval late resyncs to now instead of firing a burst to catch up
+		l.next = l.next.Add(l.FrameInterval)
+		if now.After(l.next) {
+			l.late++
+			l.next = now.Add(l.FrameInterval)
+		}
+	}
+
+	dt := now.Sub(l.last)
+	l.last = now
+	l.frames++
+	return dt
+}
+
+// Until returns the time left before the next frame is due, for select-based loops
+// that wait on a timer alongside other channels and call Wait when it fires
+func (l *Loop) Until() time.Duration {
+	if !l.started {
+		return 0
+	}
+	return max(0, time.Until(l.next))
+}
+
+// Updates adds dt to the accumulator and returns the fixed steps to run this frame
+// Steps beyond MaxCatchUp are dropped so a stall never snowballs
+func (l *Loop) Updates(dt time.Duration) int {
+	if l.Step <= 0 {
+		return 1
+	}
+	l.acc += dt
+	n := int(l.acc / l.Step)
+	if l.MaxCatchUp > 0 && n > l.MaxCatchUp {
+		l.dropped += uint64(n - l.MaxCatchUp)
+		n = l.MaxCatchUp
+		l.acc %= l.Step
+		return n
+	}
+	l.acc -= time.Duration(n) * l.Step
+	return n
+}
+
+// Alpha is the fraction of a step left in the accumulator, for render interpolation
+func (l *Loop) Alpha() float64 {
+	if l.Step <= 0 {
+		return 0
+	}
+	return float64(l.acc) / float64(l.Step)
+}
+
+// Run drives update and render until either returns false
+// update receives Step, or the frame dt when Step is 0
+func (l *Loop) Run(update func(dt time.Duration) bool, render func() bool) {
+	for {
+		dt := l.Wait()
+		steps := l.Updates(dt)
+		for range steps {
+			stepDt := l.Step
+			if stepDt <= 0 {
+				stepDt = dt
+			}
+			if !update(stepDt) {
+				return
+			}
+		}
+		if !render() {
+			return
+		}
+	}
+}
+
+// Frames returns the number of frames started
+func (l *Loop) Frames() uint64 {
+	return l.frames
+}
+
+// Dropped returns the number of update steps discarded under load
+func (l *Loop) Dropped() uint64 {
+	return l.dropped
+}
+
+// Late returns the number of frames that missed their slot by a whole interval
+func (l *Loop) Late() uint64 {
+	return l.late
+}
+
+// sleepUntil sleeps to deadline shortened by the measured oversleep so wakeups
+// land close to on time without burning CPU; with SpinWindow set, the tail of
+// the wait yields in a loop instead for tighter pacing at the cost of a core
+func (l *Loop) sleepUntil(deadline time.Time) {
+	if d := time.Until(deadline) - l.SpinWindow - l.oversleep; d > 0 {
+		start := time.Now()
+		time.Sleep(d)
+		over := max(0, time.Since(start)-d)
+		l.oversleep = time.Duration(float64(l.oversleep)*loopOversleepKeep + float64(over)*(1-loopOversleepKeep))
+	}
+	if l.SpinWindow <= 0 {
+		return
+	}
+	for time.Now().Before(deadline) {
+		runtime.Gosched()
+	}
+}
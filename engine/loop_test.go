@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoopUpdatesCatchUp(t *testing.T) {
+	l := NewLoop(10*time.Millisecond, 0)
+
+	// Partial steps carry over in the accumulator
+	if n := l.Updates(25 * time.Millisecond); n != 2 {
+		t.Fatalf("25ms: %d steps, want 2", n)
+	}
+	if a := l.Alpha(); a != 0.5 {
+		t.Errorf("alpha = %v, want 0.5", a)
+	}
+	if n := l.Updates(5 * time.Millisecond); n != 1 {
+		t.Fatalf("carry: %d steps, want 1", n)
+	}
+
+	// A backlog within MaxCatchUp runs in full
+	if n := l.Updates(50 * time.Millisecond); n != 5 || l.Dropped() != 0 {
+		t.Fatalf("50ms: %d steps, %d dropped, want 5, 0", n, l.Dropped())
+	}
+
+	// Beyond it the excess is dropped and only the partial step is kept
+	if n := l.Updates(123 * time.Millisecond); n != 5 {
+		t.Fatalf("stall: %d steps, want %d", n, 5)
+	}
+	if d := l.Dropped(); d != 7 {
+		t.Errorf("dropped = %d, want 7", d)
+	}
+	if n := l.Updates(7 * time.Millisecond); n != 1 {
+		t.Errorf("after stall: %d steps, want 1 (3ms kept + 7ms)", n)
+	}
+
+	// Variable step runs once per frame
+	if n := NewLoop(0, 0).Updates(time.Second); n != 1 {
+		t.Errorf("variable step: %d, want 1", n)
+	}
+}
+
+func TestLoopWaitPacing(t *testing.T) {
+	const interval = 5 * time.Millisecond
+	l := NewLoop(0, interval)
+
+	if dt := l.Wait(); dt != 0 {
+		t.Fatalf("first wait = %v, want 0", dt)
+	}
+	start := time.Now()
+	for range 4 {
+		if dt := l.Wait(); dt <= 0 {
+			t.Fatalf("dt = %v, want positive", dt)
+		}
+	}
+	// Deadlines advance on schedule; allow for an early wake from oversleep correction
+	if elapsed := time.Since(start); elapsed < 4*interval-2*time.Millisecond {
+		t.Errorf("4 frames took %v, want about %v", elapsed, 4*interval)
+	}
+	if l.Frames() != 5 {
+		t.Errorf("frames = %d, want 5", l.Frames())
+	}
+
+	// A stall longer than an interval resyncs instead of bursting
+	time.Sleep(3 * interval)
+	l.Wait()
+	if l.Late() != 1 {
+		t.Fatalf("late = %d, want 1", l.Late())
+	}
+	if u := l.Until(); u <= 0 || u > interval {
+		t.Errorf("until after resync = %v, want within (0, %v]", u, interval)
+	}
+}
+
+func TestLoopRunStops(t *testing.T) {
+	l := NewLoop(time.Millisecond, time.Millisecond)
+	updates, renders := 0, 0
+	l.Run(func(dt time.Duration) bool {
+		if dt != time.Millisecond {
+			t.Errorf("update dt = %v, want the fixed step", dt)
+		}
+		updates++
+		return updates < 3
+	}, func() bool {
+		renders++
+		return true
+	})
+	if updates != 3 {
+		t.Errorf("updates = %d, want 3", updates)
+	}
+}
@@ -6,13 +6,18 @@ import (
 	"time"
 )
 
-// PausableClock provides pausable game time with pause duration tracking
+// PausableClock provides pausable, rate-scaled game time with pause duration tracking
 type PausableClock struct {
 	mu sync.RWMutex
 
-	// Base time tracking
-	realStartTime time.Time // When clock was created (real time)
-	gameStartTime time.Time // Game time epoch (adjusted for pauses)
+	// now reads the underlying clock; time.Now unless a headless driver supplies virtual time
+	now func() time.Time
+
+	// Game time is piecewise linear in real time; each pause, resume, or rate
+	// change rebases the segment so earlier elapsed time is never rescaled
+	anchorReal time.Time // Real time at segment start
+	anchorGame time.Time // Game time at segment start
+	ratePct    int64     // Game time advance per real time, percent
 
 	// Pause state
 	isPaused        atomic.Bool
@@ -20,63 +25,115 @@ type PausableClock struct {
 	totalPausedTime time.Duration // Cumulative pause duration
 }
 
-// NewPausableClock creates a new pausable clock
+// NewPausableClock creates a new pausable clock running at real-time rate
 func NewPausableClock() *PausableClock {
 	now := time.Now()
 	return &PausableClock{
-		realStartTime: now,
-		gameStartTime: now,
+		now:        time.Now,
+		anchorReal: now,
+		anchorGame: now,
+		ratePct:    100,
+	}
+}
+
+// SetTimeSource replaces the underlying clock, e.g. with virtual time stepped by a headless driver
+// The clock restarts unpaused from the source's current time; call before any scheduler reads it
+func (pc *PausableClock) SetTimeSource(now func() time.Time) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	pc.now = now
+	start := now()
+	pc.anchorReal = start
+	pc.anchorGame = start
+	pc.pauseStartTime = time.Time{}
+	pc.totalPausedTime = 0
+	pc.isPaused.Store(false)
+}
+
+// gameAtLocked projects real time into the current segment; caller holds mu
+func (pc *PausableClock) gameAtLocked(real time.Time) time.Time {
+	elapsed := real.Sub(pc.anchorReal)
+	if pc.ratePct != 100 {
+		elapsed = elapsed * time.Duration(pc.ratePct) / 100
 	}
+	return pc.anchorGame.Add(elapsed)
 }
 
-// Now returns current game time (affected by pause)
+// Now returns current game time (affected by pause and rate)
 func (pc *PausableClock) Now() time.Time {
 	pc.mu.RLock()
 	defer pc.mu.RUnlock()
 
 	if pc.isPaused.Load() {
-		// During pause: return frozen time at pause point
-		return pc.gameStartTime.Add(pc.pauseStartTime.Sub(pc.realStartTime) - pc.totalPausedTime)
+		// During pause: segment was rebased at pause point, time is frozen there
+		return pc.anchorGame
 	}
-
-	// After resume or during normal operation:
-	// Game elapsed = real elapsed - total paused time
-	realElapsed := time.Now().Sub(pc.realStartTime)
-	gameElapsed := realElapsed - pc.totalPausedTime
-	return pc.gameStartTime.Add(gameElapsed)
+	return pc.gameAtLocked(pc.now())
 }
 
 // RealTime returns actual wall clock time (unaffected by pause)
 func (pc *PausableClock) RealTime() time.Time {
-	return time.Now()
+	return pc.now()
 }
 
 // Pause stops game time advancement
+// State flips under mu so Now never observes a pause flag without its rebased segment
 func (pc *PausableClock) Pause() {
-	if pc.isPaused.CompareAndSwap(false, true) {
-		pc.mu.Lock()
-		defer pc.mu.Unlock()
-		pc.pauseStartTime = time.Now()
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if pc.isPaused.Load() {
+		return
 	}
+
+	now := pc.now()
+	pc.anchorGame = pc.gameAtLocked(now)
+	pc.anchorReal = now
+	pc.pauseStartTime = now
+	pc.isPaused.Store(true)
 }
 
 // Resume continues game time advancement
 func (pc *PausableClock) Resume() {
-	if pc.isPaused.CompareAndSwap(true, false) {
-		pc.mu.Lock()
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if !pc.isPaused.Load() {
+		return
+	}
 
-		if !pc.pauseStartTime.IsZero() {
-			// Calculate pause duration and add to total
-			pauseDuration := time.Now().Sub(pc.pauseStartTime)
-			pc.totalPausedTime += pauseDuration
-			pc.pauseStartTime = time.Time{}
+	now := pc.now()
+	if !pc.pauseStartTime.IsZero() {
+		// Calculate pause duration and add to total
+		pc.totalPausedTime += now.Sub(pc.pauseStartTime)
+		pc.pauseStartTime = time.Time{}
+	}
+	// Game time continues from the frozen point
+	pc.anchorReal = now
+	pc.isPaused.Store(false)
+}
 
-			pc.mu.Unlock()
+// SetRate changes game time advance in percent of real time (100 = real time)
+// Elapsed game time is preserved; only subsequent advancement is scaled
+func (pc *PausableClock) SetRate(percent int) {
+	if percent <= 0 {
+		return
+	}
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
 
-		} else {
-			pc.mu.Unlock()
-		}
+	if !pc.isPaused.Load() {
+		now := pc.now()
+		pc.anchorGame = pc.gameAtLocked(now)
+		pc.anchorReal = now
 	}
+	pc.ratePct = int64(percent)
+}
+
+// Rate returns the game time advance in percent of real time
+func (pc *PausableClock) Rate() int {
+	pc.mu.RLock()
+	defer pc.mu.RUnlock()
+	return int(pc.ratePct)
 }
 
 // IsPaused returns current pause state
@@ -92,7 +149,7 @@ func (pc *PausableClock) GetTotalPauseDuration() time.Duration {
 	total := pc.totalPausedTime
 	if pc.isPaused.Load() && !pc.pauseStartTime.IsZero() {
 		// Include current pause duration
-		total += time.Now().Sub(pc.pauseStartTime)
+		total += pc.now().Sub(pc.pauseStartTime)
 	}
 	return total
 }
@@ -109,5 +166,5 @@ func (pc *PausableClock) GetCurrentPauseDuration() time.Duration {
 	if pc.pauseStartTime.IsZero() {
 		return 0
 	}
-	return time.Now().Sub(pc.pauseStartTime)
-}
\ No newline at end of file
+	return pc.now().Sub(pc.pauseStartTime)
+}
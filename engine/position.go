@@ -329,7 +329,7 @@ func (p *Position) isAreaFreeUnsafe(x, y, width, height int, mask component.Wall
 
 // IsOutOfBounds checks if position is outside spatial grid bounds
 func (p *Position) IsOutOfBounds(x, y int) bool {
-	return x < 0 || x >= p.world.Resources.Config.MapWidth || y < 0 || y >= p.world.Resources.Config.MapHeight
+	return !p.world.Resources.Config.MapRect().Contains(x, y)
 }
 
 // CheckBlockedBatch checks multiple points for blocking (OOB or wall)
@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"slices"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// remapOutOfBoundsEntities moves sequences and composites cut off by a shrink back onto the map
+// Standalone glyph runs and root composites shift inward as a unit, then search nearby rows
+// for free cells; units that can't fit and all other out-of-bounds entities are tagged for destruction
+// Runs after ResizeGrid so occupancy reflects the new bounds
+func (ctx *GameContext) remapOutOfBoundsEntities(width, height int) {
+	w := ctx.World
+	cursorEntity := w.Resources.Player.Entity
+
+	// Keyed by cell so runs reach into out-of-bounds cells, which the grid no longer holds
+	glyphAt := make(map[core.Point]core.Entity)
+	for _, e := range w.Components.Glyph.Entities() {
+		if w.Components.Member.HasEntity(e) {
+			continue
+		}
+		if pos, ok := w.Positions.GetPosition(e); ok {
+			glyphAt[core.Point{X: pos.X, Y: pos.Y}] = e
+		}
+	}
+
+	handled := make(map[core.Entity]bool)
+	var unit []core.Entity
+	for _, e := range w.Positions.AllEntities() {
+		if e == cursorEntity || handled[e] {
+			continue
+		}
+		pos, _ := w.Positions.GetPosition(e)
+		if pos.X >= 0 && pos.X < width && pos.Y >= 0 && pos.Y < height {
+			continue
+		}
+
+		unit = unit[:0]
+		var header core.Entity
+		if member, ok := w.Components.Member.GetComponent(e); ok {
+			header = member.HeaderEntity
+		} else if w.Components.Header.HasEntity(e) {
+			header = e
+		}
+
+		switch {
+		case header != 0:
+			unit = ctx.compositeUnit(header, unit)
+		case glyphAt[core.Point{X: pos.X, Y: pos.Y}] == e:
+			unit = glyphRun(glyphAt, pos.X, pos.Y, unit)
+		}
+
+		if len(unit) == 0 {
+			handled[e] = true
+			w.Components.Death.SetComponent(e, component.DeathComponent{})
+			continue
+		}
+		if header != 0 {
+			handled[header] = true
+		}
+		for _, u := range unit {
+			handled[u] = true
+		}
+		if !ctx.relocateUnit(unit, header, width, height) {
+			for _, u := range unit {
+				w.Components.Death.SetComponent(u, component.DeathComponent{})
+			}
+			if header != 0 {
+				w.Components.Death.SetComponent(header, component.DeathComponent{})
+			}
+		}
+	}
+}
+
+// compositeUnit appends the positioned live members of a root composite
+// Nested composites are left to their root's lifecycle and yield nothing
+func (ctx *GameContext) compositeUnit(header core.Entity, unit []core.Entity) []core.Entity {
+	hc, ok := ctx.World.Components.Header.GetComponent(header)
+	if !ok || hc.ParentHeader != 0 {
+		return unit
+	}
+	for _, m := range hc.MemberEntries {
+		if m.Entity != 0 && ctx.World.Positions.HasPosition(m.Entity) && !ctx.World.Components.Header.HasEntity(m.Entity) {
+			unit = append(unit, m.Entity)
+		}
+	}
+	return unit
+}
+
+// glyphRun appends the horizontally adjacent standalone glyphs containing (x, y), left to right
+func glyphRun(glyphAt map[core.Point]core.Entity, x, y int, unit []core.Entity) []core.Entity {
+	start := x
+	for {
+		if _, ok := glyphAt[core.Point{X: start - 1, Y: y}]; !ok {
+			break
+		}
+		start--
+	}
+	for cx := start; ; cx++ {
+		e, ok := glyphAt[core.Point{X: cx, Y: y}]
+		if !ok {
+			break
+		}
+		unit = append(unit, e)
+	}
+	return unit
+}
+
+// relocateUnit shifts the unit the least distance inward, then tries rows alternately below
+// and above until every cell is in bounds, unwalled and free of other entities
+// The header, if any, moves by the same offset so member offsets stay valid
+func (ctx *GameContext) relocateUnit(unit []core.Entity, header core.Entity, width, height int) bool {
+	positions := ctx.World.Positions
+
+	minX, minY := width, height
+	maxX, maxY := -1, -1
+	for i, e := range unit {
+		pos, _ := positions.GetPosition(e)
+		if i == 0 {
+			minX, maxX, minY, maxY = pos.X, pos.X, pos.Y, pos.Y
+			continue
+		}
+		minX, maxX = min(minX, pos.X), max(maxX, pos.X)
+		minY, maxY = min(minY, pos.Y), max(maxY, pos.Y)
+	}
+	if maxX-minX >= width || maxY-minY >= height {
+		return false
+	}
+
+	dx := max(0, -minX) + min(0, width-1-maxX)
+	dy := max(0, -minY) + min(0, height-1-maxY)
+
+	var buf [parameter.MaxEntitiesPerCell]core.Entity
+	fits := func(dy int) bool {
+		if minY+dy < 0 || maxY+dy >= height {
+			return false
+		}
+		for _, e := range unit {
+			pos, _ := positions.GetPosition(e)
+			x, y := pos.X+dx, pos.Y+dy
+			if positions.IsBlocked(x, y, component.WallBlockSpawn) {
+				return false
+			}
+			n := positions.GetAllEntitiesAtInto(x, y, buf[:])
+			for _, other := range buf[:n] {
+				if !slices.Contains(unit, other) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	for step := 0; step <= parameter.ResizeRemapSearchRows; step++ {
+		for _, shift := range [2]int{step, -step} {
+			if !fits(dy + shift) {
+				continue
+			}
+			ctx.translateUnit(unit, header, dx, dy+shift)
+			return true
+		}
+	}
+	return false
+}
+
+// translateUnit moves every unit entity and the header by (dx, dy)
+func (ctx *GameContext) translateUnit(unit []core.Entity, header core.Entity, dx, dy int) {
+	positions := ctx.World.Positions
+	if header != 0 {
+		unit = append(unit, header)
+	}
+	for _, e := range unit {
+		if pos, ok := positions.GetPosition(e); ok {
+			pos.X += dx
+			pos.Y += dy
+			positions.SetPosition(e, pos)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+func TestResizeRemapsGlyphRuns(t *testing.T) {
+	w := NewWorld()
+	ctx := NewGameContext(w, 60, 20)
+	ctx.HandleResizeLocked()
+
+	place := func(x, y int) core.Entity {
+		e := w.CreateEntity()
+		w.Positions.SetPosition(e, component.PositionComponent{X: x, Y: y})
+		w.Components.Glyph.SetComponent(e, component.GlyphComponent{Rune: 'a'})
+		return e
+	}
+	run := []core.Entity{place(50, 5), place(51, 5), place(52, 5)}
+	blocker := place(35, 5) // In bounds after the shrink, where the run would land
+
+	ctx.Width = 40
+	ctx.HandleResizeLocked()
+	width := ctx.World.Resources.Config.MapWidth
+
+	for i, e := range run {
+		pos, _ := w.Positions.GetPosition(e)
+		if want := (core.Point{X: width - 3 + i, Y: 6}); pos.X != want.X || pos.Y != want.Y {
+			t.Errorf("run[%d] at %d,%d, want %v", i, pos.X, pos.Y, want)
+		}
+		if w.Components.Death.HasEntity(e) {
+			t.Errorf("run[%d] tagged for death", i)
+		}
+	}
+	if pos, _ := w.Positions.GetPosition(blocker); pos.X != 35 || pos.Y != 5 {
+		t.Errorf("blocker moved to %d,%d", pos.X, pos.Y)
+	}
+	if ctx.TooSmall.Load() {
+		t.Fatal("size guard set at minimum width")
+	}
+
+	ctx.Width = parameter.MinTerminalWidth - 1
+	ctx.HandleResizeLocked()
+	if !ctx.TooSmall.Load() {
+		t.Fatal("size guard not set below minimum width")
+	}
+}
@@ -2,6 +2,8 @@ package engine
 
 import (
 	"math/rand/v2"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,7 +16,10 @@ import (
 	"github.com/lixenwraith/vi-fighter/genetic/registry"
 	"github.com/lixenwraith/vi-fighter/navigation"
 	"github.com/lixenwraith/vi-fighter/network"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/status"
+	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
 // Resource holds singleton game resources, initialized during GameContext creation, accessed via World.Resources
@@ -25,6 +30,7 @@ type Resource struct {
 	Game   *GameStateResource
 	Player *PlayerResource
 	Event  *EventQueueResource
+	Timer  *TimerResource
 
 	// Targeting
 	Target *TargetResource
@@ -41,13 +47,18 @@ type Resource struct {
 	// Transient visual effects
 	Transient *TransientResource
 
+	// Seeded random channels shared by game systems
+	Rand *vmath.RandStreams
+
 	// Telemetry
-	Status *status.Registry
+	Status   *status.Registry
+	Timeline *TimelineResource
 
 	// Bridged resources from services
-	Content *ContentResource
-	Audio   *AudioResource
-	Network *NetworkResource
+	Content  *ContentResource
+	Audio    *AudioResource
+	Network  *NetworkResource
+	Announce *AnnounceResource
 }
 
 // === World Resources ===
@@ -109,9 +120,195 @@ type ConfigResource struct {
 	// false: Map persists, Viewport/Camera updated, entities preserved
 	CropOnResize bool `toml:"crop_on_resize"`
 
+	// FieldWidth/FieldHeight fix the map size independent of the terminal, set by :set field=WxH
+	// Zero follows the viewport; applied on game reset
+	FieldWidth  int `toml:"field_width"`
+	FieldHeight int `toml:"field_height"`
+
 	// ColorMode for rendering pipeline (256-color vs TrueColor)
 	// Set after terminal initialization
 	ColorMode terminal.ColorMode `toml:"color_mode"`
+
+	// Accessibility selects the color-vision-deficiency glyph palette and shape cues
+	// Set from startup config, cycled at runtime by :cvd
+	Accessibility visual.CVDMode `toml:"accessibility"`
+
+	// CRT enables the scanline/phosphor post effect, toggled by :set crt
+	CRT bool `toml:"crt"`
+
+	// Dither smooths background gradients in 256-color mode, set by :set dither=mode
+	Dither visual.DitherMode `toml:"dither"`
+
+	// NoAmbience disables the background ambience layer, toggled by :set ambience
+	NoAmbience bool `toml:"no_ambience"`
+
+	// Trail selects the cursor trail particle style, set by :set trail=style
+	Trail visual.TrailStyle `toml:"trail"`
+
+	// Minimap shows a glyph density overview on large terminals, toggled by :set minimap
+	Minimap bool `toml:"minimap"`
+
+	// InputHUD shows keystroke rate, APM, and input latency, toggled by :set hud
+	InputHUD bool `toml:"input_hud"`
+
+	// Preview describes the sequence nearest the cursor in a corner panel, toggled by :set preview
+	Preview bool `toml:"preview"`
+
+	// SpawnBlocks places content blocks as whole multi-row snippets, toggled by :set blocks
+	SpawnBlocks bool `toml:"spawn_blocks"`
+
+	// SpawnPattern lays out spawned lines when blocks are off, set by :set spawn=pattern
+	SpawnPattern parameter.SpawnPattern `toml:"spawn_pattern"`
+
+	// Spawn overrides spawn pacing and content shape for balancing runs; zero values keep defaults
+	Spawn SpawnTuning `toml:"spawn"`
+
+	// Practice replaces random spawning with the practice file revealed line by line
+	// Set from startup config when a practice file is given
+	Practice bool `toml:"practice"`
+
+	// Assist holds player assist options, persisted with the settings file
+	Assist AssistSettings `toml:"assist"`
+
+	// Popups controls floating score and combo text, persisted with the settings file
+	Popups PopupSettings `toml:"popups"`
+}
+
+// CameraDeadZone returns the viewport-relative bounds the cursor may occupy without
+// the camera following; margins are capped at half the viewport
+func (c *ConfigResource) CameraDeadZone() (left, top, right, bottom int) {
+	marginX := min(parameter.CameraDeadZoneMarginX, c.ViewportWidth/2)
+	marginY := min(parameter.CameraDeadZoneMarginY, c.ViewportHeight/2)
+	return marginX, marginY, c.ViewportWidth - marginX - 1, c.ViewportHeight - marginY - 1
+}
+
+// BaseMapSize returns the map size a level or reset falls back to: the fixed field
+// when set, otherwise the viewport with crop-on-resize
+func (c *ConfigResource) BaseMapSize() (width, height int, cropOnResize bool) {
+	if c.FieldWidth > 0 && c.FieldHeight > 0 {
+		return c.FieldWidth, c.FieldHeight, false
+	}
+	return c.ViewportWidth, c.ViewportHeight, true
+}
+
+// ParseFieldSize parses "WxH" within the field bounds, or "auto" as 0x0 (follow terminal)
+func ParseFieldSize(s string) (width, height int, ok bool) {
+	if s == "auto" || s == "" {
+		return 0, 0, true
+	}
+	ws, hs, found := strings.Cut(s, "x")
+	if !found {
+		return 0, 0, false
+	}
+	width, errW := strconv.Atoi(ws)
+	height, errH := strconv.Atoi(hs)
+	if errW != nil || errH != nil ||
+		width < parameter.FieldMinWidth || width > parameter.FieldMaxWidth ||
+		height < parameter.FieldMinHeight || height > parameter.FieldMaxHeight {
+		return 0, 0, false
+	}
+	return width, height, true
+}
+
+// FormatFieldSize is the inverse of ParseFieldSize
+func FormatFieldSize(width, height int) string {
+	if width <= 0 || height <= 0 {
+		return "auto"
+	}
+	return strconv.Itoa(width) + "x" + strconv.Itoa(height)
+}
+
+// MapRect returns the map as a rectangle at the origin
+func (c *ConfigResource) MapRect() core.Rect {
+	return core.Rect{Width: c.MapWidth, Height: c.MapHeight}
+}
+
+// MaxCamera returns the largest camera position per axis, 0 where the map fits the viewport
+func (c *ConfigResource) MaxCamera() (maxX, maxY int) {
+	return max(0, c.MapWidth-c.ViewportWidth), max(0, c.MapHeight-c.ViewportHeight)
+}
+
+// AssistSettings holds options that make the game approachable for newer vi users
+type AssistSettings struct {
+	// SpeedPercent is the game time rate, [AssistSpeedMin, AssistSpeedMax]
+	SpeedPercent int `toml:"speed_percent"`
+
+	// NoErrorPenalty keeps heat and boost on typing errors
+	NoErrorPenalty bool `toml:"no_error_penalty"`
+
+	// ErrorHeat is the heat lost per typing error, [0, HeatMax]
+	ErrorHeat int `toml:"error_heat"`
+
+	// ForgiveMs is the Backspace window after a typing error before its penalty applies,
+	// 0 = off, [0, AssistForgiveMaxMs]
+	ForgiveMs int `toml:"forgive_ms"`
+
+	// LongErrorFlash extends the cursor error flash
+	LongErrorFlash bool `toml:"long_error_flash"`
+
+	// LargeCursor draws a halo around the cursor cell
+	LargeCursor bool `toml:"large_cursor"`
+}
+
+// DefaultAssistSettings returns assists off at real-time speed
+func DefaultAssistSettings() AssistSettings {
+	return AssistSettings{
+		SpeedPercent: parameter.AssistSpeedDefault,
+		ErrorHeat:    parameter.HeatTypingErrorPenalty,
+	}
+}
+
+// Clamp bounds numeric settings to their supported ranges
+func (a *AssistSettings) Clamp() {
+	a.SpeedPercent = max(parameter.AssistSpeedMin, min(a.SpeedPercent, parameter.AssistSpeedMax))
+	a.ErrorHeat = max(0, min(a.ErrorHeat, parameter.HeatMax))
+	a.ForgiveMs = max(0, min(a.ForgiveMs, parameter.AssistForgiveMaxMs))
+}
+
+// PopupSettings controls floating score, combo, and milestone text at hit locations
+type PopupSettings struct {
+	// Off hides all popups, toggled by :set popups
+	Off bool `toml:"off"`
+
+	// ComboMin is the streak from which score popups show the combo count, set by :set combo=N
+	ComboMin int `toml:"combo_min"`
+
+	// Milestone is the streak interval announced with a banner, 0 = none, set by :set milestone=N
+	Milestone int `toml:"milestone"`
+}
+
+// DefaultPopupSettings returns popups on with the stock thresholds
+func DefaultPopupSettings() PopupSettings {
+	return PopupSettings{
+		ComboMin:  parameter.PopupComboMinDefault,
+		Milestone: parameter.PopupMilestoneDefault,
+	}
+}
+
+// Clamp bounds the thresholds to the supported range
+func (p *PopupSettings) Clamp() {
+	p.ComboMin = max(1, min(p.ComboMin, parameter.PopupThresholdMax))
+	p.Milestone = max(0, min(p.Milestone, parameter.PopupThresholdMax))
+}
+
+// SpawnTuning adjusts the glyph spawner; the zero value is the stock game
+type SpawnTuning struct {
+	// IntervalMs is the base delay between spawns before density and heat scaling, 0 = parameter.SpawnIntervalMs
+	IntervalMs int `toml:"interval_ms"`
+
+	// MaxLine crops spawned lines to this many runes, 0 = map width
+	MaxLine int `toml:"max_line"`
+
+	// RedRatio is the probability a spawn is red instead of the census pick, [0, 1]
+	RedRatio float64 `toml:"red_ratio"`
+}
+
+// Interval returns the base spawn delay
+func (t SpawnTuning) Interval() time.Duration {
+	if t.IntervalMs > 0 {
+		return time.Duration(t.IntervalMs) * time.Millisecond
+	}
+	return time.Duration(parameter.SpawnIntervalMs) * time.Millisecond
 }
 
 // --- EventQueue Resource ---
@@ -381,6 +578,7 @@ func (gr *GeneticResource) SampleScout(speciesID uint8, populationID uint32) ([]
 type ContentProvider interface {
 	CurrentContent() *core.PreparedContent
 	NotifyConsumed(count int)
+	PracticeLines() []string
 }
 
 // ContentResource wraps a ContentProvider for the Resource
@@ -395,6 +593,18 @@ type AudioResource struct {
 	Engine *audio.AudioEngine
 }
 
+// Announcer receives plain-text announcement lines; must not block
+type Announcer interface {
+	Announce(line string)
+}
+
+// AnnounceResource is the screen-reader announcement channel
+// Nil Resources.Announce = announcements off
+type AnnounceResource struct {
+	Port  Announcer
+	Level parameter.AnnounceLevel
+}
+
 // TODO: refactor wrapper after network stub developement
 
 // NetworkPort is the service-side endpoint driven by NetworkSystem.
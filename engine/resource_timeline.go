@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// TimelineSample is one per-second snapshot of player state
+type TimelineSample struct {
+	Second  int   // Game seconds since session start
+	Energy  int64 // Score
+	Heat    int
+	Correct int64 // Cumulative correct keystrokes
+	Errors  int64 // Cumulative typing errors
+	APM     uint64
+	Streak  int64 // Current correct-typing streak
+	Boost   bool
+	Shield  bool
+}
+
+// TimelineResource holds the per-second session timeline
+// TimelineSystem writes, MetaSystem reads for the analysis overlay and CSV export
+type TimelineResource struct {
+	Current []TimelineSample
+	// Last is the previous session, kept across reset for post-game analysis
+	Last []TimelineSample
+}
+
+// Record appends a sample, dropping it once the session reaches TimelineMaxSamples
+func (r *TimelineResource) Record(sample TimelineSample) {
+	if len(r.Current) >= parameter.TimelineMaxSamples {
+		return
+	}
+	r.Current = append(r.Current, sample)
+}
+
+// Reset archives the current session as Last and starts an empty one
+func (r *TimelineResource) Reset() {
+	if len(r.Current) > 0 {
+		r.Last = r.Current
+	}
+	r.Current = nil
+}
+
+// Session returns the timeline to analyze, the current session or the previous one if nothing is recorded yet
+func (r *TimelineResource) Session() []TimelineSample {
+	if len(r.Current) > 0 {
+		return r.Current
+	}
+	return r.Last
+}
+
+// BestStreak returns the index of the sample where the longest typing streak peaked, -1 if none
+func BestStreak(samples []TimelineSample) int {
+	best := -1
+	var peak int64
+	for i, s := range samples {
+		if s.Streak > peak {
+			peak = s.Streak
+			best = i
+		}
+	}
+	return best
+}
+
+// WorstStreak returns the last sample index and length of the longest run of consecutive seconds
+// that each added errors, -1 if no errors were made
+func WorstStreak(samples []TimelineSample) (end, length int) {
+	end = -1
+	run := 0
+	for i := 1; i < len(samples); i++ {
+		if samples[i].Errors > samples[i-1].Errors {
+			run++
+		} else {
+			run = 0
+		}
+		if run > length {
+			length = run
+			end = i
+		}
+	}
+	return end, length
+}
+
+// timelineCSVHeader names the columns written by WriteTimelineCSV
+var timelineCSVHeader = []string{"second", "energy", "heat", "correct", "errors", "apm", "streak", "boost", "shield"}
+
+// WriteTimelineCSV writes samples as CSV with a header row
+func WriteTimelineCSV(w io.Writer, samples []TimelineSample) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(timelineCSVHeader); err != nil {
+		return err
+	}
+
+	boolCol := func(v bool) string {
+		if v {
+			return "1"
+		}
+		return "0"
+	}
+
+	for _, s := range samples {
+		record := []string{
+			strconv.Itoa(s.Second),
+			strconv.FormatInt(s.Energy, 10),
+			strconv.Itoa(s.Heat),
+			strconv.FormatInt(s.Correct, 10),
+			strconv.FormatInt(s.Errors, 10),
+			strconv.FormatUint(s.APM, 10),
+			strconv.FormatInt(s.Streak, 10),
+			boolCol(s.Boost),
+			boolCol(s.Shield),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
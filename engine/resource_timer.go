@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// TimerHandle identifies a scheduled event; zero is never issued
+type TimerHandle uint64
+
+// scheduledEvent is an event waiting for its tick
+type scheduledEvent struct {
+	handle   TimerHandle
+	due      uint64 // Game tick the event fires on
+	interval uint64 // Ticks between repeats, 0 = once
+	typ      event.EventType
+	payload  any
+}
+
+// TimerResource schedules events on game ticks, fired by the timekeeper system into the
+// event queue; pushes land in the next dispatch pass
+// Time is counted in ticks, so timers stop while paused and fire on the same tick in
+// replays and headless runs; wall-clock timers and time.AfterFunc give neither
+// Caller MUST hold the world lock
+type TimerResource struct {
+	tick   uint64 // Last tick fired, as counted by GameState.GameTicks
+	next   TimerHandle
+	timers []scheduledEvent
+	fired  []scheduledEvent // Reused per tick
+}
+
+// durationTicks rounds d up to whole ticks, at least one
+func durationTicks(d time.Duration) uint64 {
+	n := (d + parameter.GameUpdateInterval - 1) / parameter.GameUpdateInterval
+	return uint64(max(n, 1))
+}
+
+// After pushes typ with payload once, d of game time from the current tick
+func (r *TimerResource) After(d time.Duration, typ event.EventType, payload any) TimerHandle {
+	return r.schedule(r.tick+durationTicks(d), 0, typ, payload)
+}
+
+// At pushes typ with payload once on game tick t, the GT status value
+// A tick already reached fires on the next one
+func (r *TimerResource) At(t uint64, typ event.EventType, payload any) TimerHandle {
+	return r.schedule(max(t, r.tick+1), 0, typ, payload)
+}
+
+// Every pushes typ with payload each interval of game time until cancelled
+// The same payload value is pushed every time, so handlers must not mutate it
+func (r *TimerResource) Every(interval time.Duration, typ event.EventType, payload any) TimerHandle {
+	n := durationTicks(interval)
+	return r.schedule(r.tick+n, n, typ, payload)
+}
+
+func (r *TimerResource) schedule(due, interval uint64, typ event.EventType, payload any) TimerHandle {
+	r.next++
+	r.timers = append(r.timers, scheduledEvent{handle: r.next, due: due, interval: interval, typ: typ, payload: payload})
+	return r.next
+}
+
+// Cancel stops a timer; false if it already fired its last time or was cancelled
+func (r *TimerResource) Cancel(h TimerHandle) bool {
+	i := slices.IndexFunc(r.timers, func(t scheduledEvent) bool { return t.handle == h })
+	if i < 0 {
+		return false
+	}
+	r.timers = slices.Delete(r.timers, i, i+1)
+	return true
+}
+
+// Pending reports whether h is still scheduled
+func (r *TimerResource) Pending(h TimerHandle) bool {
+	return slices.ContainsFunc(r.timers, func(t scheduledEvent) bool { return t.handle == h })
+}
+
+// Remaining returns the game time until h next fires; false when not scheduled
+func (r *TimerResource) Remaining(h TimerHandle) (time.Duration, bool) {
+	i := slices.IndexFunc(r.timers, func(t scheduledEvent) bool { return t.handle == h })
+	if i < 0 {
+		return 0, false
+	}
+	return time.Duration(r.timers[i].due-r.tick) * parameter.GameUpdateInterval, true
+}
+
+// Advance moves to tick and pushes every event due by then, in due then scheduling order
+// Repeating timers are rescheduled from their due tick so they don't drift
+func (r *TimerResource) Advance(tick uint64, push func(event.EventType, any)) {
+	r.tick = tick
+	r.fired = r.fired[:0]
+	kept := r.timers[:0]
+	for _, t := range r.timers {
+		if t.due > tick {
+			kept = append(kept, t)
+			continue
+		}
+		r.fired = append(r.fired, t)
+		if t.interval > 0 {
+			for t.due <= tick {
+				t.due += t.interval
+			}
+			kept = append(kept, t)
+		}
+	}
+	clear(r.timers[len(kept):])
+	r.timers = kept
+
+	if len(r.fired) == 0 {
+		return
+	}
+	slices.SortFunc(r.fired, func(a, b scheduledEvent) int {
+		return cmp.Or(cmp.Compare(a.due, b.due), cmp.Compare(a.handle, b.handle))
+	})
+	for _, t := range r.fired {
+		push(t.typ, t.payload)
+	}
+	clear(r.fired)
+}
+
+// Reset drops all timers and restarts at tick zero with GameState
+// Handles keep counting so stale ones never match a new timer
+func (r *TimerResource) Reset() {
+	clear(r.timers)
+	r.timers = r.timers[:0]
+	r.tick = 0
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+func TestTimerResourceSchedule(t *testing.T) {
+	var r TimerResource
+	var got []string
+	push := func(_ event.EventType, payload any) { got = append(got, payload.(string)) }
+	tick := parameter.GameUpdateInterval
+
+	r.After(2*tick, event.EventGameReset, "after")
+	r.At(2, event.EventGameReset, "at") // Same tick, scheduled later
+	every := r.Every(3*tick, event.EventGameReset, "every")
+	cancelled := r.After(tick/2, event.EventGameReset, "cancelled") // Rounds up to one tick
+
+	if !r.Cancel(cancelled) || r.Cancel(cancelled) {
+		t.Fatal("cancel should succeed once")
+	}
+	if d, ok := r.Remaining(every); !ok || d != 3*tick {
+		t.Fatalf("remaining = %v, %v", d, ok)
+	}
+
+	for n := uint64(1); n <= 7; n++ {
+		r.Advance(n, push)
+	}
+	want := []string{"after", "at", "every", "every"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("fired %v, want %v", got, want)
+	}
+
+	// A skipped tick range fires a repeating timer once and keeps its phase
+	got = got[:0]
+	r.Advance(13, push)
+	r.Advance(14, push)
+	r.Advance(15, push)
+	if !slices.Equal(got, []string{"every", "every"}) {
+		t.Fatalf("after gap fired %v", got)
+	}
+
+	r.Reset()
+	if r.Pending(every) {
+		t.Fatal("reset kept a timer")
+	}
+	if h := r.After(tick, event.EventGameReset, "new"); h <= every {
+		t.Fatalf("handle %d reused after reset", h)
+	}
+}
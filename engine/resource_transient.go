@@ -19,6 +19,10 @@ type TransientResource struct {
 	ExplosionBacking [parameter.ExplosionCenterCap]ExplosionCenter
 	ExplosionCount   int
 	ExplosionDurNano int64
+
+	// Floating score and combo text (fixed backing, zero alloc)
+	PopupBacking [parameter.PopupCap]Popup
+	PopupCount   int
 }
 
 // GrayoutState controls screen desaturation effect
@@ -45,6 +49,25 @@ type ExplosionCenter struct {
 	Type      event.ExplosionType // Explosion variant for palette selection
 }
 
+// PopupKind selects a popup's palette
+type PopupKind uint8
+
+const (
+	PopupScore   PopupKind = iota // Energy gained at a hit, merged into a running total
+	PopupPenalty                  // Energy lost at a hit, merged into a running total
+	PopupBanner                   // Streak milestone or line bonus text
+)
+
+// Popup represents floating text rising from a map cell for rendering
+type Popup struct {
+	X, Y  int // Map cell the text is centered above
+	Text  string
+	Kind  PopupKind
+	Delta int   // Running energy total of a score or penalty popup
+	Age   int64 // Nanoseconds since spawn or last merge
+	Life  int64 // Nanoseconds until removal
+}
+
 // NewTransientResource creates initialized resource
 func NewTransientResource() *TransientResource {
 	return &TransientResource{
@@ -57,6 +80,7 @@ func (r *TransientResource) Reset() {
 	r.Grayout = GrayoutState{}
 	r.Strobe = StrobeState{}
 	r.ExplosionCount = 0
+	r.ClearPopups()
 }
 
 // --- Explosion API (prep for Phase 3) ---
@@ -71,3 +95,15 @@ func (r *TransientResource) ClearExplosions() {
 	r.ExplosionCount = 0
 }
 
+// --- Popup API ---
+
+// Popups returns active slice view (no allocation)
+func (r *TransientResource) Popups() []Popup {
+	return r.PopupBacking[:r.PopupCount]
+}
+
+// ClearPopups resets popup state, releasing popup text
+func (r *TransientResource) ClearPopups() {
+	clear(r.PopupBacking[:r.PopupCount])
+	r.PopupCount = 0
+}
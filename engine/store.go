@@ -151,6 +151,38 @@ func (s *Store[T]) Each(fn func(e core.Entity, c *T) bool) {
 	}
 }
 
+// Join iterates entities holding both components with direct pointers, driving from the smaller store.
+// Return false to stop. Same removal contract as Each, on both stores.
+// Order follows the driving store's dense order.
+func Join[A, B any](a *Store[A], b *Store[B], fn func(e core.Entity, ca *A, cb *B) bool) {
+	if len(a.entities) <= len(b.entities) {
+		n := len(a.entities)
+		for i := range n {
+			e := a.entities[i]
+			j, ok := b.index[e]
+			if !ok {
+				continue
+			}
+			if !fn(e, &a.dense[i], &b.dense[j]) {
+				return
+			}
+		}
+		return
+	}
+
+	n := len(b.entities)
+	for j := range n {
+		e := b.entities[j]
+		i, ok := a.index[e]
+		if !ok {
+			continue
+		}
+		if !fn(e, &a.dense[i], &b.dense[j]) {
+			return
+		}
+	}
+}
+
 // CountEntities returns number of entities with this component
 func (s *Store[T]) CountEntities() int {
 	return len(s.entities)
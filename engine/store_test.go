@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/vi-fighter/core"
+)
+
+const benchEntities = 10_000
+
+type benchPos struct{ X, Y int }
+type benchVel struct{ DX, DY int }
+
+// Bits above the generated component range, masks are bookkeeping only here
+const (
+	benchPosBit uint64 = 1 << 62
+	benchVelBit uint64 = 1 << 63
+)
+
+// newBenchStores fills positions for every entity and velocities for every other one
+func newBenchStores(n int) (*World, *Store[benchPos], *Store[benchVel]) {
+	w := NewWorld()
+	pos := NewStore[benchPos](w, benchPosBit)
+	vel := NewStore[benchVel](w, benchVelBit)
+	for i := range n {
+		e := w.CreateEntity()
+		pos.SetComponent(e, benchPos{X: i, Y: i})
+		if i%2 == 0 {
+			vel.SetComponent(e, benchVel{DX: 1, DY: -1})
+		}
+	}
+	return w, pos, vel
+}
+
+func TestJoin(t *testing.T) {
+	_, pos, vel := newBenchStores(10)
+	// Removal shuffles dense order, Join must still pair by entity
+	pos.RemoveEntity(1)
+	vel.RemoveEntity(3)
+
+	visited := map[core.Entity]bool{}
+	Join(pos, vel, func(e core.Entity, p *benchPos, v *benchVel) bool {
+		visited[e] = true
+		p.X += v.DX
+		return true
+	})
+
+	for _, e := range []core.Entity{5, 7, 9} {
+		if !visited[e] {
+			t.Errorf("entity %d not visited", e)
+		}
+	}
+	if len(visited) != 3 {
+		t.Errorf("visited %d entities, want 3", len(visited))
+	}
+	if p, _ := pos.GetComponent(5); p.X != 5 {
+		t.Errorf("entity 5 X = %d, want 5", p.X)
+	}
+	if p, _ := pos.GetComponent(3); p.X != 2 {
+		t.Errorf("entity 3 X = %d, want 2 (velocity removed)", p.X)
+	}
+
+	stopped := 0
+	Join(pos, vel, func(core.Entity, *benchPos, *benchVel) bool {
+		stopped++
+		return false
+	})
+	if stopped != 1 {
+		t.Errorf("early stop visited %d, want 1", stopped)
+	}
+}
+
+func BenchmarkStoreEach(b *testing.B) {
+	_, pos, _ := newBenchStores(benchEntities)
+	for b.Loop() {
+		sum := 0
+		pos.Each(func(_ core.Entity, p *benchPos) bool {
+			sum += p.X
+			return true
+		})
+	}
+}
+
+func BenchmarkStoreJoin(b *testing.B) {
+	_, pos, vel := newBenchStores(benchEntities)
+	for b.Loop() {
+		Join(pos, vel, func(_ core.Entity, p *benchPos, v *benchVel) bool {
+			p.X += v.DX
+			p.Y += v.DY
+			return true
+		})
+	}
+}
+
+// BenchmarkStoreLookupJoin is the pattern systems use today: range one store, look up the other
+func BenchmarkStoreLookupJoin(b *testing.B) {
+	_, pos, vel := newBenchStores(benchEntities)
+	for b.Loop() {
+		for _, e := range pos.Entities() {
+			v, ok := vel.GetPtr(e)
+			if !ok {
+				continue
+			}
+			p, _ := pos.GetPtr(e)
+			p.X += v.DX
+			p.Y += v.DY
+		}
+	}
+}
+
+// BenchmarkSliceOfStructs is the baseline: one struct per entity with an optional velocity
+func BenchmarkSliceOfStructs(b *testing.B) {
+	type object struct {
+		entity core.Entity
+		pos    benchPos
+		vel    benchVel
+		hasVel bool
+	}
+	objects := make([]object, benchEntities)
+	for i := range objects {
+		objects[i] = object{entity: core.Entity(i + 1), pos: benchPos{X: i, Y: i}, vel: benchVel{DX: 1, DY: -1}, hasVel: i%2 == 0}
+	}
+	for b.Loop() {
+		for i := range objects {
+			o := &objects[i]
+			if !o.hasVel {
+				continue
+			}
+			o.pos.X += o.vel.DX
+			o.pos.Y += o.vel.DY
+		}
+	}
+}
+
+func BenchmarkStoreCreateDestroy(b *testing.B) {
+	w, pos, vel := newBenchStores(0)
+	entities := make([]core.Entity, benchEntities)
+	for b.Loop() {
+		for i := range entities {
+			e := w.CreateEntity()
+			entities[i] = e
+			pos.SetComponent(e, benchPos{X: i})
+			vel.SetComponent(e, benchVel{DX: 1})
+		}
+		for _, e := range entities {
+			pos.RemoveEntity(e)
+			vel.RemoveEntity(e)
+		}
+	}
+}
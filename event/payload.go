@@ -152,6 +152,18 @@ type MetaStatusMessagePayload struct {
 	DurationOverride bool          `toml:"duration_override"`
 }
 
+// MetaHelpRequestPayload scopes the help overlay to the mode it was opened from
+// A nil payload (e.g. :help) shows the full reference
+type MetaHelpRequestPayload struct {
+	Mode core.GameMode `toml:"mode"`
+}
+
+// MetaAnalysisRequestPayload selects the analysis output
+// An empty ExportPath shows the overlay, otherwise the timeline is written there as CSV
+type MetaAnalysisRequestPayload struct {
+	ExportPath string `toml:"export_path"`
+}
+
 // MetaSystemCommandPayload contains commands to the systems (currently only enable/disable functionality)
 type MetaSystemCommandPayload struct {
 	SystemName string `toml:"system_name"`
@@ -163,6 +175,29 @@ type GamePausePayload struct {
 	Paused bool `toml:"paused"`
 }
 
+// MetaSizeGuardPayload carries whether the terminal is below the minimum size
+type MetaSizeGuardPayload struct {
+	TooSmall bool `toml:"too_small"`
+}
+
+// GameOverPayload contains the final score of the ended run
+type GameOverPayload struct {
+	Score int64 `toml:"score"`
+}
+
+// MetaLeaderboardResultPayload contains a score submission status and, once accepted, the top scores
+type MetaLeaderboardResultPayload struct {
+	Status string           `toml:"status"`
+	Top    []LeaderboardRow `toml:"top"`
+}
+
+// LeaderboardRow is one top-score entry, Own marks the submitted score
+type LeaderboardRow struct {
+	Player string `toml:"player"`
+	Score  int64  `toml:"score"`
+	Own    bool   `toml:"own"`
+}
+
 // --- Nugget ---
 
 // NuggetCollectedPayload signals successful nugget collection
@@ -234,8 +269,11 @@ type EnergySetPayload struct {
 
 // EnergyGlyphConsumedPayload contains glyph data for centralized energy calculation
 type EnergyGlyphConsumedPayload struct {
-	Type  component.GlyphType  `toml:"type"`
-	Level component.GlyphLevel `toml:"level"`
+	Entity core.Entity          `toml:"entity"` // Typed glyph, 0 when not tracked
+	Type   component.GlyphType  `toml:"type"`
+	Level  component.GlyphLevel `toml:"level"`
+	X      int                  `toml:"x"` // Hit location
+	Y      int                  `toml:"y"`
 }
 
 // EnergyBlinkPayload triggers visual blink state
@@ -258,6 +296,12 @@ type WeaponAddRequestPayload struct {
 	Weapon component.WeaponType `toml:"weapon"` // 0=rod, 1=launcher, 2=spray
 }
 
+// SeekerFireRequestPayload names the map cell whose sequence a seeker clears
+type SeekerFireRequestPayload struct {
+	X int `toml:"x"`
+	Y int `toml:"y"`
+}
+
 // --- Heat ---
 
 // HeatAddRequestPayload contains heat delta
@@ -313,6 +357,21 @@ type DeleteRequestPayload struct {
 	EndY      int             `toml:"end_y"`
 }
 
+// ReplaceRequestPayload contains the first replaced cell, the new character, and how many
+// cells to the right are replaced (0 is treated as 1)
+type ReplaceRequestPayload struct {
+	X     int  `toml:"x"`
+	Y     int  `toml:"y"`
+	Char  rune `toml:"char"`
+	Count int  `toml:"count"`
+}
+
+// JoinRequestPayload contains a cell of the word that pulls its right neighbor
+type JoinRequestPayload struct {
+	X int `toml:"x"`
+	Y int `toml:"y"`
+}
+
 // --- Ping ---
 
 // PingGridRequestPayload carries configuration for the ping grid activation
@@ -640,6 +699,16 @@ type MissileSpawnRequestPayload struct {
 	Count        int           `toml:"count"`
 }
 
+// SeekerSpawnRequestPayload contains seeker spawn parameters
+type SeekerSpawnRequestPayload struct {
+	OwnerEntity  core.Entity `toml:"owner_entity"`  // Cursor
+	OriginEntity core.Entity `toml:"origin_entity"` // Launcher orb
+	OriginX      int         `toml:"origin_x"`
+	OriginY      int         `toml:"origin_y"`
+	TargetX      int         `toml:"target_x"` // Sequence cell
+	TargetY      int         `toml:"target_y"`
+}
+
 // --- Bullet ---
 
 // BulletSpawnRequestPayload requests creation of a linear projectile
@@ -819,6 +888,22 @@ type FadeoutSpawnPayload struct {
 	BgColor color.RGB
 }
 
+// --- Popup ---
+
+// PopupScorePayload contains an energy change to show above a hit
+type PopupScorePayload struct {
+	X     int `toml:"x"`
+	Y     int `toml:"y"`
+	Delta int `toml:"delta"`
+}
+
+// PopupTextPayload contains banner text to show above a map cell
+type PopupTextPayload struct {
+	X    int    `toml:"x"`
+	Y    int    `toml:"y"`
+	Text string `toml:"text"`
+}
+
 // --- Pylon ---
 
 // PylonSpawnRequestPayload contains parameters for pylon creation
@@ -951,6 +1036,21 @@ type TowerDestroyedPayload struct {
 	Y            int         `toml:"y"`
 }
 
+// --- Turret ---
+
+// TurretPlacePayload contains the turret cell, the bound word extends to its right
+type TurretPlacePayload struct {
+	X int `toml:"x"`
+	Y int `toml:"y"`
+}
+
+// TurretPlacedPayload contains turret placement data
+type TurretPlacedPayload struct {
+	Entity core.Entity `toml:"entity"`
+	X      int         `toml:"x"`
+	Y      int         `toml:"y"`
+}
+
 // --- Gateway ---
 
 // GatewaySpawnRequestPayload requests creation of a gateway entity
@@ -4,7 +4,7 @@ package event
 
 // EventTypeCount is the number of declared EventType constants, including EventNone
 // Values are contiguous in [0, EventTypeCount)
-const EventTypeCount = 167
+const EventTypeCount = 182
 
 // InitRegistry populates the registry from the EventType const block in type.go
 // Must be called once at startup
@@ -34,12 +34,17 @@ func InitRegistry() {
 	RegisterType("EventNetworkError", EventNetworkError, &NetworkErrorPayload{})
 	RegisterType("EventGameReset", EventGameReset, nil)
 	RegisterType("EventMetaDebugRequest", EventMetaDebugRequest, nil)
-	RegisterType("EventMetaHelpRequest", EventMetaHelpRequest, nil)
+	RegisterType("EventMetaHelpRequest", EventMetaHelpRequest, &MetaHelpRequestPayload{})
 	RegisterType("EventMetaAboutRequest", EventMetaAboutRequest, nil)
+	RegisterType("EventMetaSettingsRequest", EventMetaSettingsRequest, nil)
+	RegisterType("EventMetaAnalysisRequest", EventMetaAnalysisRequest, &MetaAnalysisRequestPayload{})
 	RegisterType("EventMetaStatusMessageRequest", EventMetaStatusMessageRequest, &MetaStatusMessagePayload{})
 	RegisterType("EventMetaSystemCommandRequest", EventMetaSystemCommandRequest, &MetaSystemCommandPayload{})
 	RegisterType("EventGamePauseRequest", EventGamePauseRequest, &GamePausePayload{})
 	RegisterType("EventGamePauseChanged", EventGamePauseChanged, &GamePausePayload{})
+	RegisterType("EventMetaSizeGuard", EventMetaSizeGuard, &MetaSizeGuardPayload{})
+	RegisterType("EventGameOver", EventGameOver, &GameOverPayload{})
+	RegisterType("EventMetaLeaderboardResult", EventMetaLeaderboardResult, &MetaLeaderboardResultPayload{})
 	RegisterType("EventCycleDamageMultiplierIncrease", EventCycleDamageMultiplierIncrease, nil)
 	RegisterType("EventCycleDamageMultiplierReset", EventCycleDamageMultiplierReset, nil)
 	RegisterType("EventNuggetCollected", EventNuggetCollected, &NuggetCollectedPayload{})
@@ -70,6 +75,7 @@ func InitRegistry() {
 	RegisterType("EventWeaponAddRequest", EventWeaponAddRequest, &WeaponAddRequestPayload{})
 	RegisterType("EventWeaponFireRequest", EventWeaponFireRequest, nil)
 	RegisterType("EventFireSpecialRequest", EventFireSpecialRequest, nil)
+	RegisterType("EventSeekerFireRequest", EventSeekerFireRequest, &SeekerFireRequestPayload{})
 	RegisterType("EventHeatAddRequest", EventHeatAddRequest, &HeatAddRequestPayload{})
 	RegisterType("EventHeatSetRequest", EventHeatSetRequest, &HeatSetRequestPayload{})
 	RegisterType("EventHeatBurst", EventHeatBurst, nil)
@@ -78,6 +84,9 @@ func InitRegistry() {
 	RegisterType("EventBoostExtend", EventBoostExtend, &BoostExtendPayload{})
 	RegisterType("EventCharacterTyped", EventCharacterTyped, &CharacterTypedPayload{})
 	RegisterType("EventDeleteRequest", EventDeleteRequest, &DeleteRequestPayload{})
+	RegisterType("EventReplaceRequest", EventReplaceRequest, &ReplaceRequestPayload{})
+	RegisterType("EventJoinRequest", EventJoinRequest, &JoinRequestPayload{})
+	RegisterType("EventTypingErrorForgive", EventTypingErrorForgive, nil)
 	RegisterType("EventPingGridRequest", EventPingGridRequest, &PingGridRequestPayload{})
 	RegisterType("EventMaterializeRequest", EventMaterializeRequest, &MaterializeRequestPayload{})
 	RegisterType("EventMaterializeComplete", EventMaterializeComplete, &MaterializeCompletedPayload{})
@@ -132,6 +141,7 @@ func InitRegistry() {
 	RegisterType("EventEnemyKilled", EventEnemyKilled, &EnemyKilledPayload{})
 	RegisterType("EventLootSpawnRequest", EventLootSpawnRequest, &LootSpawnRequestPayload{})
 	RegisterType("EventMissileSpawnRequest", EventMissileSpawnRequest, &MissileSpawnRequestPayload{})
+	RegisterType("EventSeekerSpawnRequest", EventSeekerSpawnRequest, &SeekerSpawnRequestPayload{})
 	RegisterType("EventBulletSpawnRequest", EventBulletSpawnRequest, &BulletSpawnRequestPayload{})
 	RegisterType("EventMarkerSpawnRequest", EventMarkerSpawnRequest, &MarkerSpawnRequestPayload{})
 	RegisterType("EventMotionMarkerShowColored", EventMotionMarkerShowColored, &MotionMarkerShowPayload{})
@@ -150,6 +160,8 @@ func InitRegistry() {
 	RegisterType("EventWallDespawnAll", EventWallDespawnAll, nil)
 	RegisterType("EventFadeoutSpawnOne", EventFadeoutSpawnOne, &FadeoutSpawnPayload{})
 	RegisterType("EventFadeoutSpawnBatch", EventFadeoutSpawnBatch, nil)
+	RegisterType("EventPopupScore", EventPopupScore, &PopupScorePayload{})
+	RegisterType("EventPopupText", EventPopupText, &PopupTextPayload{})
 	RegisterType("EventPylonSpawnRequest", EventPylonSpawnRequest, &PylonSpawnRequestPayload{})
 	RegisterType("EventPylonSpawnFailed", EventPylonSpawnFailed, nil)
 	RegisterType("EventPylonSpawned", EventPylonSpawned, &PylonSpawnedPayload{})
@@ -173,6 +185,9 @@ func InitRegistry() {
 	RegisterType("EventTowerSpawned", EventTowerSpawned, &TowerSpawnedPayload{})
 	RegisterType("EventTowerDestroyed", EventTowerDestroyed, &TowerDestroyedPayload{})
 	RegisterType("EventTowerCancelRequest", EventTowerCancelRequest, nil)
+	RegisterType("EventTurretPlaceRequest", EventTurretPlaceRequest, &TurretPlacePayload{})
+	RegisterType("EventTurretPlaced", EventTurretPlaced, &TurretPlacedPayload{})
+	RegisterType("EventTurretCancelRequest", EventTurretCancelRequest, nil)
 	RegisterType("EventGatewaySpawnRequest", EventGatewaySpawnRequest, &GatewaySpawnRequestPayload{})
 	RegisterType("EventGatewayDespawnRequest", EventGatewayDespawnRequest, &GatewayDespawnRequestPayload{})
 	RegisterType("EventGatewayDespawned", EventGatewayDespawned, &GatewayDespawnedPayload{})
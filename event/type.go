@@ -107,10 +107,14 @@ const (
 	EventGameReset
 	// EventMetaDebugRequest signals a request to show debug overlay
 	EventMetaDebugRequest
-	// EventMetaHelpRequest signals a request to show help overlay
+	// EventMetaHelpRequest (MetaHelpRequestPayload) signals a request to show help overlay
 	EventMetaHelpRequest
 	// EventMetaAboutRequest signals a request to show about overlay
 	EventMetaAboutRequest
+	// EventMetaSettingsRequest signals a request to show settings overlay
+	EventMetaSettingsRequest
+	// EventMetaAnalysisRequest (MetaAnalysisRequestPayload) signals a request to show the session analysis overlay or export the timeline
+	EventMetaAnalysisRequest
 	// EventMetaStatusMessageRequest (MetaStatusMessagePayload) signals a request to display a message in status bar
 	EventMetaStatusMessageRequest
 	// EventMetaSystemCommandRequest (MetaSystemCommandPayload) signals a request to execute a system command
@@ -119,6 +123,12 @@ const (
 	EventGamePauseRequest
 	// EventGamePauseChanged (GamePausePayload) announces applied pause state; systems react in their own domain
 	EventGamePauseChanged
+	// EventMetaSizeGuard (MetaSizeGuardPayload) signals the terminal crossing the minimum playable size
+	EventMetaSizeGuard
+	// EventGameOver (GameOverPayload) signals the player ended the run, fixing the final score
+	EventGameOver
+	// EventMetaLeaderboardResult (MetaLeaderboardResultPayload) carries score submission progress for the game-over overlay
+	EventMetaLeaderboardResult
 
 	// --- FSM ---
 
@@ -203,6 +213,8 @@ const (
 	EventWeaponFireRequest
 	// EventFireSpecialRequest signals player intent to fire special ability
 	EventFireSpecialRequest
+	// EventSeekerFireRequest (SeekerFireRequestPayload) signals player intent to fire a launcher seeker at a sequence
+	EventSeekerFireRequest
 
 	// --- Heat ---
 
@@ -228,6 +240,12 @@ const (
 	EventCharacterTyped
 	// EventDeleteRequest (DeleteRequestPayload) signals a deletion operation (x, d, etc.)
 	EventDeleteRequest
+	// EventReplaceRequest (ReplaceRequestPayload) signals a single glyph replacement (r + char)
+	EventReplaceRequest
+	// EventJoinRequest (JoinRequestPayload) signals pulling the next word on a row against the cursor word (J)
+	EventJoinRequest
+	// EventTypingErrorForgive signals Backspace over a pending typing error, cancelling its penalty
+	EventTypingErrorForgive
 
 	// --- Ping ---
 
@@ -402,6 +420,8 @@ const (
 
 	// EventMissileSpawnRequest (MissileSpawnRequestPayload) signals launcher buff firing a cluster missile
 	EventMissileSpawnRequest
+	// EventSeekerSpawnRequest (SeekerSpawnRequestPayload) signals launcher firing a seeker at a sequence cell
+	EventSeekerSpawnRequest
 
 	// --- Bullet ---
 
@@ -457,6 +477,13 @@ const (
 	// EventFadeoutSpawnBatch (BatchPayload[FadeoutSpawnEntry]) signals intent to spawn multiple fadeout effects
 	EventFadeoutSpawnBatch
 
+	// --- Popup ---
+
+	// EventPopupScore (PopupScorePayload) signals an energy change at a hit location for a score popup
+	EventPopupScore
+	// EventPopupText (PopupTextPayload) signals banner text at a map location, such as a line bonus
+	EventPopupText
+
 	// --- Pylon ---
 
 	// EventPylonSpawnRequest (PylonSpawnRequestPayload) signals pylon creation at location
@@ -518,6 +545,15 @@ const (
 	// EventTowerCancelRequest signals forced destruction of all towers
 	EventTowerCancelRequest
 
+	// --- Turret ---
+
+	// EventTurretPlaceRequest (TurretPlacePayload) signals the player buying a turret at location
+	EventTurretPlaceRequest
+	// EventTurretPlaced (TurretPlacedPayload) signals turret creation
+	EventTurretPlaced
+	// EventTurretCancelRequest signals removal of all turrets
+	EventTurretCancelRequest
+
 	// --- Gateway ---
 
 	// EventGatewaySpawnRequest (GatewaySpawnRequestPayload) signals GatewaySystem to create a gateway entity anchored to a parent
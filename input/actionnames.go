@@ -8,6 +8,15 @@ func init() {
 	actionRegistry = buildActionRegistry()
 }
 
+// actionAliases maps alternate action names to canonical ones, resolved by ActionEntry
+// H, M and L were half page left, map middle row and half page right until they became
+// window motions; a keymap restoring them may use either the canonical or the vim names
+var actionAliases = map[string]string{
+	"motion_half_screen_left":  "motion_half_page_left",  // vim name for zH
+	"motion_half_screen_right": "motion_half_page_right", // vim name for zL
+	"motion_screen_middle":     "motion_screen_vertical_mid",
+}
+
 func buildActionRegistry() map[string]KeyEntry {
 	return map[string]KeyEntry{
 		// Unbind sentinel
@@ -18,6 +27,7 @@ func buildActionRegistry() map[string]KeyEntry {
 		"escape":             {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentEscape},
 		"toggle_effect_mute": {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentToggleEffectMute},
 		"toggle_music_mute":  {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentToggleMusicMute},
+		"help":               {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentHelp},
 
 		// Basic motions
 		"motion_left":             {BehaviorMotion, MotionLeft, SpecialNone, ModeTargetNone, IntentNone},
@@ -39,6 +49,9 @@ func buildActionRegistry() map[string]KeyEntry {
 		"motion_screen_horizontal_mid": {BehaviorMotion, MotionScreenHorizontalMid, SpecialNone, ModeTargetNone, IntentNone},
 		"motion_screen_top":            {BehaviorMotion, MotionScreenTop, SpecialNone, ModeTargetNone, IntentNone},
 		"motion_screen_bottom":         {BehaviorMotion, MotionScreenBottom, SpecialNone, ModeTargetNone, IntentNone},
+		"motion_window_top":            {BehaviorMotion, MotionWindowTop, SpecialNone, ModeTargetNone, IntentNone},
+		"motion_window_middle":         {BehaviorMotion, MotionWindowMiddle, SpecialNone, ModeTargetNone, IntentNone},
+		"motion_window_bottom":         {BehaviorMotion, MotionWindowBottom, SpecialNone, ModeTargetNone, IntentNone},
 
 		// Paragraph motions
 		"motion_para_back":    {BehaviorMotion, MotionParaBack, SpecialNone, ModeTargetNone, IntentNone},
@@ -57,6 +70,8 @@ func buildActionRegistry() map[string]KeyEntry {
 		"motion_half_page_right": {BehaviorMotion, MotionHalfPageRight, SpecialNone, ModeTargetNone, IntentNone},
 		"motion_half_page_up":    {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
 		"motion_half_page_down":  {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
+		"motion_page_up":         {BehaviorMotion, MotionPageUp, SpecialNone, ModeTargetNone, IntentNone},
+		"motion_page_down":       {BehaviorMotion, MotionPageDown, SpecialNone, ModeTargetNone, IntentNone},
 
 		// Column motions
 		"motion_column_up":   {BehaviorMotion, MotionColumnUp, SpecialNone, ModeTargetNone, IntentNone},
@@ -74,6 +89,7 @@ func buildActionRegistry() map[string]KeyEntry {
 		// Prefix keys
 		"prefix_g":          {BehaviorPrefix, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 		"prefix_macro_play": {BehaviorPrefixMacro, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
+		"prefix_z":          {BehaviorPrefixZ, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 
 		// Marker start (g + direction)
 		"marker_glyph_left":  {BehaviorMarkerStart, MotionColoredGlyphLeft, SpecialNone, ModeTargetNone, IntentNone},
@@ -81,6 +97,17 @@ func buildActionRegistry() map[string]KeyEntry {
 		"marker_glyph_up":    {BehaviorMarkerStart, MotionColoredGlyphUp, SpecialNone, ModeTargetNone, IntentNone},
 		"marker_glyph_down":  {BehaviorMarkerStart, MotionColoredGlyphDown, SpecialNone, ModeTargetNone, IntentNone},
 
+		// Viewport scrolling
+		"scroll_line_down":      {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollLineDown},
+		"scroll_line_up":        {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollLineUp},
+		"scroll_half_page_down": {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollHalfPageDown},
+		"scroll_half_page_up":   {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollHalfPageUp},
+		"scroll_page_down":      {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollPageDown},
+		"scroll_page_up":        {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollPageUp},
+		"scroll_cursor_center":  {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorCenter},
+		"scroll_cursor_top":     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorTop},
+		"scroll_cursor_bottom":  {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorBottom},
+
 		// Mode switches
 		"mode_insert":  {BehaviorModeSwitch, MotionNone, SpecialNone, ModeTargetInsert, IntentNone},
 		"mode_visual":  {BehaviorModeSwitch, MotionNone, SpecialNone, ModeTargetVisual, IntentNone},
@@ -94,10 +121,13 @@ func buildActionRegistry() map[string]KeyEntry {
 		"special_search_prev":     {BehaviorSpecial, MotionNone, SpecialSearchPrev, ModeTargetNone, IntentNone},
 		"special_repeat_find":     {BehaviorSpecial, MotionNone, SpecialRepeatFind, ModeTargetNone, IntentNone},
 		"special_repeat_find_rev": {BehaviorSpecial, MotionNone, SpecialRepeatFindRev, ModeTargetNone, IntentNone},
+		"special_join":            {BehaviorSpecial, MotionNone, SpecialJoin, ModeTargetNone, IntentNone},
+		"replace_char":            {BehaviorReplaceWait, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 
 		// Actions
 		"fire_main":           {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireMain},
 		"fire_special":        {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSpecial},
+		"fire_seeker":         {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSeeker},
 		"nugget_jump":         {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentNuggetJump},
 		"gold_jump":           {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentGoldJump},
 		"append":              {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentAppend},
@@ -117,24 +147,27 @@ func buildActionRegistry() map[string]KeyEntry {
 	}
 }
 
-// ActionEntry resolves a canonical action name to its KeyEntry
+// ActionEntry resolves a canonical or alias action name to its KeyEntry
 // Returns zero KeyEntry and false if name is unknown
 func ActionEntry(name string) (KeyEntry, bool) {
+	if canonical, ok := actionAliases[name]; ok {
+		name = canonical
+	}
 	entry, ok := actionRegistry[name]
 	return entry, ok
 }
 
-// IsActionName returns true if name is a registered action
+// IsActionName returns true if name is a registered action or alias
 func IsActionName(name string) bool {
-	_, ok := actionRegistry[name]
+	_, ok := ActionEntry(name)
 	return ok
 }
 
-// ActionNames returns all registered action names (for documentation/validation)
+// ActionNames returns all canonical action names (for documentation/validation), aliases excluded
 func ActionNames() []string {
 	names := make([]string, 0, len(actionRegistry))
 	for name := range actionRegistry {
 		names = append(names, name)
 	}
 	return names
-}
\ No newline at end of file
+}
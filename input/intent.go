@@ -12,6 +12,7 @@ const (
 	IntentToggleEffectMute // Ctrl+S
 	IntentToggleMusicMute  // Ctrl+G
 	IntentResize           // Terminal resize event
+	IntentHelp             // ?, F1 - context help overlay
 
 	// Normal mode navigation
 	IntentMotion     // h,j,k,l,w,b,0,$,G,gg,arrows,etc
@@ -23,11 +24,12 @@ const (
 	IntentOperatorCharMotion // d + f/t + char (e.g., df;)
 
 	// Normal mode special commands
-	IntentSpecial     // x, D, n, N, ;, ,
+	IntentSpecial     // x, D, n, N, ;, ,, J, r + char
 	IntentNuggetJump  // Tab
 	IntentGoldJump    // Shift+Tab
 	IntentFireMain    // Enter in Normal mode
-	IntentFireSpecial // \ in Normal mode
+	IntentFireSpecial // Space or Backspace in Normal mode
+	IntentFireSeeker  // \ in Normal mode, the next f/F/t/T or search picks the target
 
 	// Motion markers
 	IntentMotionMarkerShow // gl/gh/gk/gj - show markers, await color
@@ -59,6 +61,17 @@ const (
 	// Cursor movement undo (keyboard source only)
 	IntentUndo // u - motion undo, return to previous position
 
+	// Viewport scrolling (cursor follows only to stay visible)
+	IntentScrollLineDown     // Ctrl+E
+	IntentScrollLineUp       // Ctrl+Y
+	IntentScrollHalfPageDown // Ctrl+D - scroll and move cursor
+	IntentScrollHalfPageUp   // Ctrl+U - scroll and move cursor
+	IntentScrollPageDown     // Ctrl+F - scroll and move cursor
+	IntentScrollPageUp       // Ctrl+B - scroll and move cursor
+	IntentScrollCursorCenter // zz
+	IntentScrollCursorTop    // zt
+	IntentScrollCursorBottom // zb
+
 	// Overlay mode
 	IntentOverlayScroll   // j/k/arrows
 	IntentOverlayActivate // Enter/Space (future: section toggle)
@@ -94,7 +107,7 @@ const (
 	MotionLineStart                    // 0, Home
 	MotionLineEnd                      // $, End
 	MotionFirstNonWS                   // ^
-	MotionScreenVerticalMid            // M
+	MotionScreenVerticalMid            // Map middle row
 	MotionScreenHorizontalMid          // m
 	MotionScreenTop                    // gg
 	MotionScreenBottom                 // G
@@ -108,10 +121,15 @@ const (
 	MotionFindBack                     // F + char
 	MotionTillForward                  // t + char
 	MotionTillBack                     // T + char
-	MotionHalfPageLeft                 // H
-	MotionHalfPageRight                // L
-	MotionHalfPageUp                   // K, PgUp
-	MotionHalfPageDown                 // J, PgDown
+	MotionHalfPageLeft                 // zH
+	MotionHalfPageRight                // zL
+	MotionHalfPageUp                   // K, zK, PgUp, d+Ctrl+U
+	MotionHalfPageDown                 // zJ, dJ, PgDown, d+Ctrl+D
+	MotionPageUp                       // d+Ctrl+B
+	MotionPageDown                     // d+Ctrl+F
+	MotionWindowTop                    // H
+	MotionWindowMiddle                 // M
+	MotionWindowBottom                 // L
 	MotionColumnUp                     // [, O
 	MotionColumnDown                   // ], o
 	MotionColoredGlyphRight            // gl + color
@@ -139,6 +157,8 @@ const (
 	SpecialSearchPrev              // N
 	SpecialRepeatFind              // ;
 	SpecialRepeatFindRev           // ,
+	SpecialJoin                    // J
+	SpecialReplaceChar             // r + char
 )
 
 // ModeTarget identifies mode switch destination
@@ -22,7 +22,9 @@ var sectionDefs = []struct {
 	{"normal", false},
 	{"normal_keys", true},
 	{"operator", false},
+	{"operator_keys", true},
 	{"prefix_g", false},
+	{"prefix_z", false},
 	{"overlay", false},
 	{"overlay_keys", true},
 	{"text_keys", true},
@@ -59,6 +61,8 @@ func LoadKeyConfig(data []byte) (*KeyTable, error) {
 			switch def.name {
 			case "normal_keys":
 				kt.SpecialKeys = keyMap
+			case "operator_keys":
+				kt.OperatorKeys = keyMap
 			case "overlay_keys":
 				kt.OverlayKeys = keyMap
 			case "text_keys":
@@ -76,6 +80,8 @@ func LoadKeyConfig(data []byte) (*KeyTable, error) {
 				kt.OperatorMotions = runeMap
 			case "prefix_g":
 				kt.PrefixG = runeMap
+			case "prefix_z":
+				kt.PrefixZ = runeMap
 			case "overlay":
 				kt.OverlayRunes = runeMap
 			}
@@ -172,9 +178,11 @@ func MergeKeyTable(base, override *KeyTable) *KeyTable {
 	mergeRuneMap(result.NormalRunes, override.NormalRunes)
 	mergeRuneMap(result.OperatorMotions, override.OperatorMotions)
 	mergeRuneMap(result.PrefixG, override.PrefixG)
+	mergeRuneMap(result.PrefixZ, override.PrefixZ)
 	mergeRuneMap(result.OverlayRunes, override.OverlayRunes)
 
 	mergeKeyMap(result.SpecialKeys, override.SpecialKeys)
+	mergeKeyMap(result.OperatorKeys, override.OperatorKeys)
 	mergeKeyMap(result.OverlayKeys, override.OverlayKeys)
 	mergeKeyMap(result.TextNavKeys, override.TextNavKeys)
 
@@ -0,0 +1,43 @@
+package input
+
+import "testing"
+
+func TestKeymapRestoresRebindings(t *testing.T) {
+	def := DefaultKeyTable()
+	if got := def.NormalRunes['H'].Motion; got != MotionWindowTop {
+		t.Fatalf("default H = %v, want MotionWindowTop", got)
+	}
+	if got := def.PrefixZ['H'].Motion; got != MotionHalfPageLeft {
+		t.Fatalf("default zH = %v, want MotionHalfPageLeft", got)
+	}
+
+	// Old bindings restored through canonical and alias names
+	override, err := LoadKeyConfig([]byte(`
+[normal]
+H = "motion_half_screen_left"
+L = "motion_half_page_right"
+M = "motion_screen_middle"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kt := MergeKeyTable(def, override)
+	for r, want := range map[rune]MotionOp{'H': MotionHalfPageLeft, 'L': MotionHalfPageRight, 'M': MotionScreenVerticalMid} {
+		if got := kt.NormalRunes[r].Motion; got != want {
+			t.Errorf("%c = %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestHalfPageDownBindings(t *testing.T) {
+	def := DefaultKeyTable()
+	if got := def.PrefixZ['J'].Motion; got != MotionHalfPageDown {
+		t.Errorf("zJ = %v, want MotionHalfPageDown", got)
+	}
+	if got := def.OperatorMotions['J'].Motion; got != MotionHalfPageDown {
+		t.Errorf("dJ = %v, want MotionHalfPageDown", got)
+	}
+	if got := def.NormalRunes['J'].Special; got != SpecialJoin {
+		t.Errorf("J = %v, want SpecialJoin", got)
+	}
+}
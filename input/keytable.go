@@ -17,6 +17,9 @@ const (
 	BehaviorSystem
 	BehaviorAction
 	BehaviorMarkerStart // g+direction triggers marker show, transitions to color await
+	BehaviorScroll      // Viewport scroll, carries count
+	BehaviorPrefixZ     // z prefix → StatePrefixZ
+	BehaviorReplaceWait // r → StateReplaceWait, awaiting replacement char
 )
 
 // KeyEntry describes a key's behavior without function pointers
@@ -39,9 +42,15 @@ type KeyTable struct {
 	// Motions valid after operator (d)
 	OperatorMotions map[rune]KeyEntry
 
+	// Special keys valid after operator (d), scroll keys resolve to their motions
+	OperatorKeys map[terminal.Key]KeyEntry
+
 	// Keys after g prefix
 	PrefixG map[rune]KeyEntry
 
+	// Keys after z prefix
+	PrefixZ map[rune]KeyEntry
+
 	// Overlay mode bindings
 	OverlayRunes map[rune]KeyEntry
 	OverlayKeys  map[terminal.Key]KeyEntry
@@ -59,6 +68,7 @@ func DefaultKeyTable() *KeyTable {
 			terminal.KeyCtrlS:     {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentToggleEffectMute},
 			terminal.KeyCtrlG:     {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentToggleMusicMute},
 			terminal.KeyEscape:    {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentEscape},
+			terminal.KeyF1:        {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentHelp},
 			terminal.KeyUp:        {BehaviorMotion, MotionUp, SpecialNone, ModeTargetNone, IntentNone},
 			terminal.KeyDown:      {BehaviorMotion, MotionDown, SpecialNone, ModeTargetNone, IntentNone},
 			terminal.KeyLeft:      {BehaviorMotion, MotionLeft, SpecialNone, ModeTargetNone, IntentNone},
@@ -71,6 +81,12 @@ func DefaultKeyTable() *KeyTable {
 			terminal.KeyBackspace: {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSpecial},
 			terminal.KeyPageUp:    {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
 			terminal.KeyPageDown:  {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyCtrlE:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollLineDown},
+			terminal.KeyCtrlY:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollLineUp},
+			terminal.KeyCtrlD:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollHalfPageDown},
+			terminal.KeyCtrlU:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollHalfPageUp},
+			terminal.KeyCtrlF:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollPageDown},
+			terminal.KeyCtrlB:     {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollPageUp},
 		},
 
 		NormalRunes: map[rune]KeyEntry{
@@ -79,10 +95,7 @@ func DefaultKeyTable() *KeyTable {
 			'j': {BehaviorMotion, MotionDown, SpecialNone, ModeTargetNone, IntentNone},
 			'k': {BehaviorMotion, MotionUp, SpecialNone, ModeTargetNone, IntentNone},
 			'l': {BehaviorMotion, MotionRight, SpecialNone, ModeTargetNone, IntentNone},
-			'H': {BehaviorMotion, MotionHalfPageLeft, SpecialNone, ModeTargetNone, IntentNone},
-			'J': {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
 			'K': {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
-			'L': {BehaviorMotion, MotionHalfPageRight, SpecialNone, ModeTargetNone, IntentNone},
 
 			// Append
 			'a': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentAppend},
@@ -110,7 +123,9 @@ func DefaultKeyTable() *KeyTable {
 			'u': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentUndo},
 
 			// Screen motions
-			'M': {BehaviorMotion, MotionScreenVerticalMid, SpecialNone, ModeTargetNone, IntentNone},
+			'H': {BehaviorMotion, MotionWindowTop, SpecialNone, ModeTargetNone, IntentNone},
+			'M': {BehaviorMotion, MotionWindowMiddle, SpecialNone, ModeTargetNone, IntentNone},
+			'L': {BehaviorMotion, MotionWindowBottom, SpecialNone, ModeTargetNone, IntentNone},
 			'm': {BehaviorMotion, MotionScreenHorizontalMid, SpecialNone, ModeTargetNone, IntentNone},
 			'G': {BehaviorMotion, MotionScreenBottom, SpecialNone, ModeTargetNone, IntentNone},
 
@@ -132,9 +147,10 @@ func DefaultKeyTable() *KeyTable {
 
 			// Prefix
 			'g': {BehaviorPrefix, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
+			'z': {BehaviorPrefixZ, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 
 			// Actions
-			// '\\': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSpecial},
+			'\\': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSeeker},
 			' ': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentFireSpecial},
 
 			// Mode switches
@@ -143,6 +159,9 @@ func DefaultKeyTable() *KeyTable {
 			'/': {BehaviorModeSwitch, MotionNone, SpecialNone, ModeTargetSearch, IntentNone},
 			':': {BehaviorModeSwitch, MotionNone, SpecialNone, ModeTargetCommand, IntentNone},
 
+			// Help
+			'?': {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentHelp},
+
 			// Special commands
 			'x': {BehaviorSpecial, MotionNone, SpecialDeleteChar, ModeTargetNone, IntentNone},
 			'D': {BehaviorSpecial, MotionNone, SpecialDeleteToEnd, ModeTargetNone, IntentNone},
@@ -150,6 +169,10 @@ func DefaultKeyTable() *KeyTable {
 			'N': {BehaviorSpecial, MotionNone, SpecialSearchPrev, ModeTargetNone, IntentNone},
 			';': {BehaviorSpecial, MotionNone, SpecialRepeatFind, ModeTargetNone, IntentNone},
 			',': {BehaviorSpecial, MotionNone, SpecialRepeatFindRev, ModeTargetNone, IntentNone},
+			'J': {BehaviorSpecial, MotionNone, SpecialJoin, ModeTargetNone, IntentNone},
+
+			// Replace
+			'r': {BehaviorReplaceWait, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 
 			// Macro
 			'q': {BehaviorAction, MotionNone, SpecialNone, ModeTargetNone, IntentMacroRecordToggle}, // Router intercepts based on context
@@ -174,10 +197,11 @@ func DefaultKeyTable() *KeyTable {
 			'j': {BehaviorMotion, MotionDown, SpecialNone, ModeTargetNone, IntentNone},
 			'k': {BehaviorMotion, MotionUp, SpecialNone, ModeTargetNone, IntentNone},
 			'l': {BehaviorMotion, MotionRight, SpecialNone, ModeTargetNone, IntentNone},
-			'H': {BehaviorMotion, MotionHalfPageLeft, SpecialNone, ModeTargetNone, IntentNone},
+			'H': {BehaviorMotion, MotionWindowTop, SpecialNone, ModeTargetNone, IntentNone},
+			'M': {BehaviorMotion, MotionWindowMiddle, SpecialNone, ModeTargetNone, IntentNone},
+			'L': {BehaviorMotion, MotionWindowBottom, SpecialNone, ModeTargetNone, IntentNone},
 			'J': {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
 			'K': {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
-			'L': {BehaviorMotion, MotionHalfPageRight, SpecialNone, ModeTargetNone, IntentNone},
 			' ': {BehaviorMotion, MotionRight, SpecialNone, ModeTargetNone, IntentNone},
 			'f': {BehaviorCharWait, MotionFindForward, SpecialNone, ModeTargetNone, IntentNone},
 			'F': {BehaviorCharWait, MotionFindBack, SpecialNone, ModeTargetNone, IntentNone},
@@ -186,6 +210,15 @@ func DefaultKeyTable() *KeyTable {
 			'g': {BehaviorPrefix, MotionNone, SpecialNone, ModeTargetNone, IntentNone},
 		},
 
+		OperatorKeys: map[terminal.Key]KeyEntry{
+			terminal.KeyCtrlD:    {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyCtrlU:    {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyCtrlF:    {BehaviorMotion, MotionPageDown, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyCtrlB:    {BehaviorMotion, MotionPageUp, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyPageUp:   {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
+			terminal.KeyPageDown: {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
+		},
+
 		PrefixG: map[rune]KeyEntry{
 			'g': {BehaviorMotion, MotionScreenTop, SpecialNone, ModeTargetNone, IntentNone},
 			'o': {BehaviorMotion, MotionOrigin, SpecialNone, ModeTargetNone, IntentNone},
@@ -197,17 +230,31 @@ func DefaultKeyTable() *KeyTable {
 			'l': {BehaviorMarkerStart, MotionColoredGlyphRight, SpecialNone, ModeTargetNone, IntentNone},
 		},
 
+		PrefixZ: map[rune]KeyEntry{
+			'z': {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorCenter},
+			't': {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorTop},
+			'b': {BehaviorScroll, MotionNone, SpecialNone, ModeTargetNone, IntentScrollCursorBottom},
+			'H': {BehaviorMotion, MotionHalfPageLeft, SpecialNone, ModeTargetNone, IntentNone},
+			'L': {BehaviorMotion, MotionHalfPageRight, SpecialNone, ModeTargetNone, IntentNone},
+			'J': {BehaviorMotion, MotionHalfPageDown, SpecialNone, ModeTargetNone, IntentNone},
+			'K': {BehaviorMotion, MotionHalfPageUp, SpecialNone, ModeTargetNone, IntentNone},
+		},
+
 		OverlayRunes: map[rune]KeyEntry{
 			'j': {BehaviorMotion, MotionDown, SpecialNone, ModeTargetNone, IntentNone},
 			'k': {BehaviorMotion, MotionUp, SpecialNone, ModeTargetNone, IntentNone},
 			'q': {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayClose},
+			'?': {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayClose},
+			' ': {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayActivate},
 		},
 
 		OverlayKeys: map[terminal.Key]KeyEntry{
 			terminal.KeyUp:       {BehaviorMotion, MotionUp, SpecialNone, ModeTargetNone, IntentNone},
 			terminal.KeyDown:     {BehaviorMotion, MotionDown, SpecialNone, ModeTargetNone, IntentNone},
 			terminal.KeyEscape:   {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayClose},
+			terminal.KeyF1:       {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayClose},
 			terminal.KeyEnter:    {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayActivate},
+			terminal.KeySpace:    {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayActivate},
 			terminal.KeyPageUp:   {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayPageUp},
 			terminal.KeyPageDown: {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentOverlayPageDown},
 		},
@@ -229,6 +276,7 @@ func DefaultKeyTable() *KeyTable {
 			terminal.KeyCtrlQ:     {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentQuit},
 			terminal.KeyCtrlC:     {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentQuit},
 			terminal.KeyCtrlS:     {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentToggleEffectMute},
+			terminal.KeyF1:        {BehaviorSystem, MotionNone, SpecialNone, ModeTargetNone, IntentHelp},
 		},
 	}
 }
@@ -239,7 +287,9 @@ func (kt *KeyTable) Clone() *KeyTable {
 		SpecialKeys:     cloneKeyMap(kt.SpecialKeys),
 		NormalRunes:     cloneRuneMap(kt.NormalRunes),
 		OperatorMotions: cloneRuneMap(kt.OperatorMotions),
+		OperatorKeys:    cloneKeyMap(kt.OperatorKeys),
 		PrefixG:         cloneRuneMap(kt.PrefixG),
+		PrefixZ:         cloneRuneMap(kt.PrefixZ),
 		OverlayRunes:    cloneRuneMap(kt.OverlayRunes),
 		OverlayKeys:     cloneKeyMap(kt.OverlayKeys),
 		TextNavKeys:     cloneKeyMap(kt.TextNavKeys),
@@ -170,6 +170,13 @@ func (m *Machine) processNormal(ev terminal.Event) *Intent {
 			return &Intent{Type: IntentMacroStopAll}
 		}
 
+		// Operator-pending: page keys resolve to motions (d Ctrl+D)
+		if m.state == StateOperatorWait {
+			if entry, ok := m.keyTable.OperatorKeys[ev.Key]; ok && entry.Behavior == BehaviorMotion {
+				return m.buildOperatorMotionIntent(entry.Motion)
+			}
+		}
+
 		if entry, ok := m.keyTable.SpecialKeys[ev.Key]; ok {
 			return m.handleNormalEntry(entry, 0)
 		}
@@ -182,6 +189,8 @@ func (m *Machine) processNormal(ev terminal.Event) *Intent {
 		return m.processIdleOrCount(ev.Rune)
 	case StateCharWait:
 		return m.completeCharMotion(ev.Rune)
+	case StateReplaceWait:
+		return m.completeReplace(ev.Rune)
 	case StateOperatorWait:
 		return m.processOperatorWait(ev.Rune)
 	case StateOperatorCharWait:
@@ -190,6 +199,8 @@ func (m *Machine) processNormal(ev terminal.Event) *Intent {
 		return m.processPrefixG(ev.Rune)
 	case StateOperatorPrefixG:
 		return m.processOperatorPrefixG(ev.Rune)
+	case StatePrefixZ:
+		return m.processPrefixZ(ev.Rune)
 	case StateMarkerAwaitColor:
 		return m.processMarkerAwaitColor(ev.Rune)
 	case StateMacroRecordAwait:
@@ -250,6 +261,18 @@ func (m *Machine) handleNormalEntry(entry KeyEntry, key rune) *Intent {
 		m.state = StateMacroPlayAwait
 		return nil
 
+	case BehaviorPrefixZ:
+		m.prefix = key
+		m.state = StatePrefixZ
+		return nil
+
+	case BehaviorReplaceWait:
+		m.state = StateReplaceWait
+		return nil
+
+	case BehaviorScroll:
+		return m.buildScrollIntent(entry.IntentType)
+
 	case BehaviorModeSwitch:
 		return m.buildModeSwitchIntent(entry.ModeTarget)
 
@@ -282,6 +305,21 @@ func (m *Machine) completeCharMotion(char rune) *Intent {
 	}
 }
 
+func (m *Machine) completeReplace(char rune) *Intent {
+	m.cmdBuffer = append(m.cmdBuffer, char)
+	count := m.effectiveCount()
+	cmd := m.captureCommand()
+	m.Reset()
+
+	return &Intent{
+		Type:    IntentSpecial,
+		Special: SpecialReplaceChar,
+		Count:   count,
+		Char:    char,
+		Command: cmd,
+	}
+}
+
 func (m *Machine) processOperatorWait(key rune) *Intent {
 	m.cmdBuffer = append(m.cmdBuffer, key)
 
@@ -327,18 +365,7 @@ func (m *Machine) processOperatorWait(key rune) *Intent {
 	}
 
 	// Standard motion after operator
-	count := m.effectiveCount()
-	operator := m.operator
-	cmd := m.captureCommand()
-	m.Reset()
-
-	return &Intent{
-		Type:     IntentOperatorMotion,
-		Operator: operator,
-		Motion:   entry.Motion,
-		Count:    count,
-		Command:  cmd,
-	}
+	return m.buildOperatorMotionIntent(entry.Motion)
 }
 
 func (m *Machine) completeOperatorCharMotion(char rune) *Intent {
@@ -404,6 +431,26 @@ func (m *Machine) processOperatorPrefixG(key rune) *Intent {
 	}
 }
 
+func (m *Machine) processPrefixZ(key rune) *Intent {
+	m.cmdBuffer = append(m.cmdBuffer, key)
+
+	entry, ok := m.keyTable.PrefixZ[key]
+	if !ok {
+		m.Reset()
+		return nil
+	}
+
+	switch entry.Behavior {
+	case BehaviorScroll:
+		return m.buildScrollIntent(entry.IntentType)
+	case BehaviorMotion:
+		return m.buildMotionIntent(entry.Motion)
+	}
+
+	m.Reset()
+	return nil
+}
+
 func (m *Machine) processMarkerAwaitColor(key rune) *Intent {
 	m.cmdBuffer = append(m.cmdBuffer, key)
 
@@ -717,6 +764,21 @@ func (m *Machine) buildMotionIntent(motion MotionOp) *Intent {
 	}
 }
 
+func (m *Machine) buildOperatorMotionIntent(motion MotionOp) *Intent {
+	count := m.effectiveCount()
+	operator := m.operator
+	cmd := m.captureCommand()
+	m.Reset()
+
+	return &Intent{
+		Type:     IntentOperatorMotion,
+		Operator: operator,
+		Motion:   motion,
+		Count:    count,
+		Command:  cmd,
+	}
+}
+
 func (m *Machine) buildModeSwitchIntent(target ModeTarget) *Intent {
 	m.Reset()
 	return &Intent{
@@ -754,6 +816,18 @@ func (m *Machine) buildActionIntent(intentType IntentType) *Intent {
 	return &Intent{Type: intentType}
 }
 
+func (m *Machine) buildScrollIntent(intentType IntentType) *Intent {
+	count := m.effectiveCount()
+	cmd := m.captureCommand()
+	m.Reset()
+
+	return &Intent{
+		Type:    intentType,
+		Count:   count,
+		Command: cmd,
+	}
+}
+
 func (m *Machine) effectiveCount() int {
 	c1, c2 := m.count1, m.count2
 	if c1 == 0 {
@@ -0,0 +1,313 @@
+package input
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/lixenwraith/terminal"
+)
+
+// Source yields terminal events, terminal.Terminal satisfies it
+// Binaries poll a Source instead of the terminal so middleware can sit in between
+type Source interface {
+	PollEvent() terminal.Event
+}
+
+// SourceFunc adapts a function to Source
+type SourceFunc func() terminal.Event
+
+// PollEvent calls f
+func (f SourceFunc) PollEvent() terminal.Event {
+	return f()
+}
+
+// Middleware wraps a Source, e.g. to record, filter, remap, or inject events
+type Middleware func(Source) Source
+
+// Chain wraps src with middleware, the first listed is outermost and sees events last
+// Chain(term, Record(w), Remap(fn)) records events after remapping
+func Chain(src Source, mws ...Middleware) Source {
+	for i := len(mws) - 1; i >= 0; i-- {
+		src = mws[i](src)
+	}
+	return src
+}
+
+// Filter drops events for which keep returns false
+// EventClosed and EventError always pass so a filtered loop can still end
+func Filter(keep func(terminal.Event) bool) Middleware {
+	return func(next Source) Source {
+		return SourceFunc(func() terminal.Event {
+			for {
+				ev := next.PollEvent()
+				if ev.Type == terminal.EventClosed || ev.Type == terminal.EventError || keep(ev) {
+					return ev
+				}
+			}
+		})
+	}
+}
+
+// Remap rewrites each event
+func Remap(fn func(terminal.Event) terminal.Event) Middleware {
+	return func(next Source) Source {
+		return SourceFunc(func() terminal.Event {
+			return fn(next.PollEvent())
+		})
+	}
+}
+
+// RemapRunes swaps printable key runes, other events pass unchanged
+func RemapRunes(table map[rune]rune) Middleware {
+	return Remap(func(ev terminal.Event) terminal.Event {
+		if ev.Type == terminal.EventKey && ev.Key == terminal.KeyRune {
+			if r, ok := table[ev.Rune]; ok {
+				ev.Rune = r
+			}
+		}
+		return ev
+	})
+}
+
+// === Recording ===
+
+// recordedEvent is one line of a recording, At is the offset from the first event
+// A line carrying Meta is a header written by WriteRecordingMeta, not an event
+type recordedEvent struct {
+	At     int64  `json:"at_ms"`
+	Type   uint8  `json:"type"`
+	Key    uint16 `json:"key,omitempty"`
+	Rune   rune   `json:"rune,omitempty"`
+	Mod    uint8  `json:"mod,omitempty"`
+	Width  int    `json:"w,omitempty"`
+	Height int    `json:"h,omitempty"`
+	MouseX int    `json:"mx,omitempty"`
+	MouseY int    `json:"my,omitempty"`
+	Btn    uint8  `json:"btn,omitempty"`
+	Action uint8  `json:"act,omitempty"`
+
+	Meta json.RawMessage `json:"meta,omitempty"`
+}
+
+// WriteRecordingMeta writes v as a recording header line, call it before Record writes events
+// Replay skips header lines; ReadRecordingMeta reads them back
+func WriteRecordingMeta(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Meta json.RawMessage `json:"meta"`
+	}{data})
+}
+
+// ReadRecordingMeta decodes the first header line of a recording into v, false if there is none
+func ReadRecordingMeta(r io.Reader, v any) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var rec recordedEvent
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return false, err
+		}
+		if rec.Meta == nil {
+			return false, nil // headers only precede events
+		}
+		return true, json.Unmarshal(rec.Meta, v)
+	}
+	return false, scanner.Err()
+}
+
+func toRecord(ev terminal.Event, at time.Duration) recordedEvent {
+	return recordedEvent{
+		At:     at.Milliseconds(),
+		Type:   uint8(ev.Type),
+		Key:    uint16(ev.Key),
+		Rune:   ev.Rune,
+		Mod:    uint8(ev.Modifiers),
+		Width:  ev.Width,
+		Height: ev.Height,
+		MouseX: ev.MouseX,
+		MouseY: ev.MouseY,
+		Btn:    uint8(ev.MouseBtn),
+		Action: uint8(ev.MouseAction),
+	}
+}
+
+func (r recordedEvent) event() terminal.Event {
+	return terminal.Event{
+		Type:        terminal.EventType(r.Type),
+		Key:         terminal.Key(r.Key),
+		Rune:        r.Rune,
+		Modifiers:   terminal.Modifier(r.Mod),
+		Width:       r.Width,
+		Height:      r.Height,
+		MouseX:      r.MouseX,
+		MouseY:      r.MouseY,
+		MouseBtn:    terminal.MouseButton(r.Btn),
+		MouseAction: terminal.MouseAction(r.Action),
+	}
+}
+
+// Record writes every event as a JSON line with its time offset, for Replay
+// Write errors stop recording silently; input keeps flowing
+func Record(w io.Writer) Middleware {
+	return func(next Source) Source {
+		enc := json.NewEncoder(w)
+		var start time.Time
+		failed := false
+		return SourceFunc(func() terminal.Event {
+			ev := next.PollEvent()
+			if failed || ev.Type == terminal.EventClosed || ev.Type == terminal.EventError {
+				return ev
+			}
+			now := time.Now()
+			if start.IsZero() {
+				start = now
+			}
+			if err := enc.Encode(toRecord(ev, now.Sub(start))); err != nil {
+				failed = true
+			}
+			return ev
+		})
+	}
+}
+
+// TimedEvent is a recorded event with its offset from the first event
+type TimedEvent struct {
+	At    time.Duration
+	Event terminal.Event
+}
+
+// ReadRecording decodes the events of a recording in order, header lines skipped
+func ReadRecording(r io.Reader) ([]TimedEvent, error) {
+	var events []TimedEvent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var rec recordedEvent
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, err
+		}
+		if rec.Meta != nil {
+			continue
+		}
+		events = append(events, TimedEvent{At: time.Duration(rec.At) * time.Millisecond, Event: rec.event()})
+	}
+	return events, scanner.Err()
+}
+
+// Replay plays a recording with its original timing scaled by speed, then hands over to the wrapped source
+// Resize records are skipped since the live terminal owns its size, header lines carry no event
+// speed <= 0 plays without delays
+func Replay(r io.Reader, speed float64) (Middleware, error) {
+	all, err := ReadRecording(r)
+	if err != nil {
+		return nil, err
+	}
+	records := all[:0]
+	for _, rec := range all {
+		if rec.Event.Type != terminal.EventResize {
+			records = append(records, rec)
+		}
+	}
+
+	return func(next Source) Source {
+		var start time.Time
+		index := 0
+		return SourceFunc(func() terminal.Event {
+			if index >= len(records) {
+				return next.PollEvent()
+			}
+			if start.IsZero() {
+				start = time.Now()
+			}
+			rec := records[index]
+			index++
+			if speed > 0 {
+				due := start.Add(time.Duration(float64(rec.At) / speed))
+				if wait := time.Until(due); wait > 0 {
+					time.Sleep(wait)
+				}
+			}
+			return rec.Event
+		})
+	}, nil
+}
+
+// === Injection ===
+
+// Injector merges synthetic events into a source, for demos, bots, and tests
+// Source events are read ahead by one event on a pump goroutine so injection never waits on input
+type Injector struct {
+	ch chan terminal.Event
+}
+
+// NewInjector creates an injector holding up to buffer pending events
+func NewInjector(buffer int) *Injector {
+	return &Injector{ch: make(chan terminal.Event, buffer)}
+}
+
+// Inject queues an event without blocking, false when the buffer is full
+func (in *Injector) Inject(ev terminal.Event) bool {
+	select {
+	case in.ch <- ev:
+		return true
+	default:
+		return false
+	}
+}
+
+// Drain discards pending injected events, returning how many were dropped
+func (in *Injector) Drain() int {
+	n := 0
+	for {
+		select {
+		case <-in.ch:
+			n++
+		default:
+			return n
+		}
+	}
+}
+
+// Middleware returns the merging middleware, injected events are returned before pending source events
+func (in *Injector) Middleware() Middleware {
+	return func(next Source) Source {
+		pump := make(chan terminal.Event)
+		var once sync.Once
+		startPump := func() {
+			go func() {
+				for {
+					ev := next.PollEvent()
+					pump <- ev
+					if ev.Type == terminal.EventClosed || ev.Type == terminal.EventError {
+						return
+					}
+				}
+			}()
+		}
+
+		return SourceFunc(func() terminal.Event {
+			once.Do(startPump)
+			select {
+			case ev := <-in.ch:
+				return ev
+			default:
+			}
+			select {
+			case ev := <-in.ch:
+				return ev
+			case ev := <-pump:
+				return ev
+			}
+		})
+	}
+}
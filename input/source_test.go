@@ -0,0 +1,100 @@
+package input
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lixenwraith/terminal"
+)
+
+// scripted returns the events in order, then EventClosed forever
+func scripted(events ...terminal.Event) Source {
+	return SourceFunc(func() terminal.Event {
+		if len(events) == 0 {
+			return terminal.Event{Type: terminal.EventClosed}
+		}
+		ev := events[0]
+		events = events[1:]
+		return ev
+	})
+}
+
+func key(r rune) terminal.Event {
+	return terminal.Event{Type: terminal.EventKey, Key: terminal.KeyRune, Rune: r}
+}
+
+func TestRecordReadRecordingRoundTrip(t *testing.T) {
+	want := []terminal.Event{
+		key('j'),
+		{Type: terminal.EventKey, Key: terminal.KeyEscape},
+		{Type: terminal.EventResize, Width: 120, Height: 40},
+		{Type: terminal.EventMouse, MouseX: 3, MouseY: 7, MouseBtn: terminal.MouseBtnLeft, MouseAction: terminal.MouseActionPress},
+		key('x'),
+	}
+
+	var rec bytes.Buffer
+	if err := WriteRecordingMeta(&rec, map[string]int{"seed": 42}); err != nil {
+		t.Fatal(err)
+	}
+	src := Chain(scripted(want...), Record(&rec))
+	for range want {
+		src.PollEvent()
+	}
+	if ev := src.PollEvent(); ev.Type != terminal.EventClosed {
+		t.Fatalf("source not exhausted: %+v", ev)
+	}
+
+	got, err := ReadRecording(bytes.NewReader(rec.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %d events, want %d (closed must not be recorded)", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Event != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, got[i].Event, want[i])
+		}
+		if i > 0 && got[i].At < got[i-1].At {
+			t.Errorf("event %d: offset %v before previous %v", i, got[i].At, got[i-1].At)
+		}
+	}
+
+	var meta map[string]int
+	if ok, err := ReadRecordingMeta(bytes.NewReader(rec.Bytes()), &meta); !ok || err != nil || meta["seed"] != 42 {
+		t.Errorf("meta = %v, %v, %v", meta, ok, err)
+	}
+}
+
+func TestFilterPassesClosed(t *testing.T) {
+	src := Chain(scripted(key('a'), key('b')), Filter(func(terminal.Event) bool { return false }))
+
+	// Both keys are dropped; the closed event must still surface or the loop would spin forever
+	if ev := src.PollEvent(); ev.Type != terminal.EventClosed {
+		t.Fatalf("got %+v, want EventClosed", ev)
+	}
+}
+
+func TestReplaySkipsResizeAndHeaders(t *testing.T) {
+	recording := strings.Join([]string{
+		`{"meta":{"seed":1}}`,
+		`{"at_ms":0,"type":0,"key":1,"rune":106}`,
+		`{"at_ms":5,"type":1,"w":80,"h":24}`,
+		``,
+		`{"at_ms":10,"type":0,"key":1,"rune":107}`,
+	}, "\n")
+
+	replay, err := Replay(strings.NewReader(recording), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := key('z')
+	src := Chain(scripted(live), replay)
+
+	for i, want := range []terminal.Event{key('j'), key('k'), live} {
+		if ev := src.PollEvent(); ev != want {
+			t.Errorf("event %d: got %+v, want %+v", i, ev, want)
+		}
+	}
+}
@@ -25,6 +25,8 @@ const (
 	StateOperatorCharWait                     // After operator + f/F/t/T, awaiting target character
 	StatePrefixG                              // After 'g' prefix, awaiting second key (g/G/l/h/k/j)
 	StateOperatorPrefixG                      // After operator + 'g', awaiting motion (e.g., dgg)
+	StatePrefixZ                              // After 'z' prefix, awaiting scroll target (z/t/b)
+	StateReplaceWait                          // After 'r', awaiting replacement character
 	StateMarkerAwaitColor                     // After g+direction, awaiting color (r/g/b) or repeat direction
 	StateMacroRecordAwait                     // After 'q', awaiting label [a-z] or '@' (stop-all)
 	StateMacroPlayAwait                       // After '@', awaiting label [a-z] or '@' (infinite prefix)
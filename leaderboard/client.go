@@ -0,0 +1,258 @@
+// Package leaderboard submits signed scores to an online leaderboard and fetches the top
+// scores, queueing submissions on disk while the server is unreachable
+//
+// Protocol, relative to the configured endpoint:
+//
+//	POST scores              body: Score JSON; X-Signature: hex HMAC-SHA256 of the body
+//	GET  scores?limit=N      response: []Score, best first
+package leaderboard
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body
+const SignatureHeader = "X-Signature"
+
+// ErrQueued reports a score kept in the offline queue for the next submit
+var ErrQueued = errors.New("leaderboard unreachable, score queued")
+
+// Score is one game result
+type Score struct {
+	Player     string    `json:"player"`
+	Seed       uint64    `json:"seed"`
+	Score      int64     `json:"score"`
+	ReplayHash string    `json:"replay_hash,omitempty"` // Hex SHA-256 of the input recording
+	Time       time.Time `json:"time"`
+}
+
+// StatusError is a non-2xx server response
+type StatusError struct {
+	Code int
+	Body string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("leaderboard: %s", http.StatusText(e.Code))
+	}
+	return fmt.Sprintf("leaderboard: %s: %s", http.StatusText(e.Code), e.Body)
+}
+
+// transient reports whether a failed request is worth retrying later
+// Network errors and server-side failures are; rejections of the score itself are not
+func transient(err error) bool {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.Code >= 500 || se.Code == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// Client talks to one leaderboard endpoint
+type Client struct {
+	endpoint  *url.URL
+	key       []byte
+	queuePath string // "" keeps no offline queue
+	http      *http.Client
+}
+
+// NewClient creates a client for endpoint, signing with key
+// queuePath holds scores that could not be submitted; "" disables the offline queue
+func NewClient(endpoint string, key []byte, queuePath string) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboard endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("leaderboard endpoint %q: want an http or https URL", endpoint)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("leaderboard: empty signing key")
+	}
+	return &Client{
+		endpoint:  u.JoinPath("scores"),
+		key:       key,
+		queuePath: queuePath,
+		http:      &http.Client{Timeout: parameter.LeaderboardTimeout},
+	}, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body under key, as sent in SignatureHeader
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Submit sends queued scores oldest first, then s
+// The first transient failure stops sending and queues the rest, s included, reported as
+// ErrQueued; queued scores the server rejects are dropped
+func (c *Client) Submit(ctx context.Context, s Score) error {
+	pending, err := c.loadQueue()
+	if err != nil {
+		return err
+	}
+	pending = append(pending, s)
+
+	var sendErr error
+	sent := 0
+	for ; sent < len(pending); sent++ {
+		err := c.post(ctx, pending[sent])
+		if err != nil && transient(err) {
+			sendErr = err
+			break
+		}
+		if sent == len(pending)-1 {
+			sendErr = err // A rejection of s itself
+		}
+	}
+
+	if err := c.saveQueue(pending[sent:]); err != nil {
+		return err
+	}
+	if sent < len(pending) && c.queuePath != "" {
+		return fmt.Errorf("%w: %v", ErrQueued, sendErr)
+	}
+	return sendErr
+}
+
+// post sends one signed score
+func (c *Client) post(ctx context.Context, s Score) error {
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(c.key, body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkStatus(resp)
+}
+
+// Top fetches the best n scores, best first
+func (c *Client) Top(ctx context.Context, n int) ([]Score, error) {
+	u := *c.endpoint
+	u.RawQuery = url.Values{"limit": {strconv.Itoa(n)}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return nil, err
+	}
+
+	var scores []Score
+	if err := json.NewDecoder(resp.Body).Decode(&scores); err != nil {
+		return nil, fmt.Errorf("leaderboard: top scores: %w", err)
+	}
+	return scores[:min(n, len(scores))], nil
+}
+
+// checkStatus turns a non-2xx response into a StatusError with the start of its body
+func checkStatus(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+	return &StatusError{Code: resp.StatusCode, Body: string(bytes.TrimSpace(msg))}
+}
+
+// Queued returns the scores waiting in the offline queue, oldest first
+func (c *Client) Queued() ([]Score, error) {
+	return c.loadQueue()
+}
+
+// loadQueue reads the offline queue; a missing file is empty and unreadable lines are skipped
+func (c *Client) loadQueue() ([]Score, error) {
+	if c.queuePath == "" {
+		return nil, nil
+	}
+	f, err := os.Open(c.queuePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("leaderboard queue: %w", err)
+	}
+	defer f.Close()
+
+	var scores []Score
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var s Score
+		if json.Unmarshal(sc.Bytes(), &s) == nil {
+			scores = append(scores, s)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("leaderboard queue: %w", err)
+	}
+	return scores, nil
+}
+
+// saveQueue replaces the offline queue, keeping the newest parameter.LeaderboardQueueMax
+// Written to a sibling temp file and renamed so a crash never loses queued scores
+func (c *Client) saveQueue(scores []Score) error {
+	if c.queuePath == "" {
+		return nil
+	}
+	if len(scores) == 0 {
+		if err := os.Remove(c.queuePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("leaderboard queue: %w", err)
+		}
+		return nil
+	}
+	scores = scores[max(len(scores)-parameter.LeaderboardQueueMax, 0):]
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, s := range scores {
+		if err := enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(c.queuePath), 0755); err != nil {
+		return fmt.Errorf("leaderboard queue: %w", err)
+	}
+	tmp := c.queuePath + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("leaderboard queue: %w", err)
+	}
+	if err := os.Rename(tmp, c.queuePath); err != nil {
+		return fmt.Errorf("leaderboard queue: %w", err)
+	}
+	return nil
+}
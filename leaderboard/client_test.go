@@ -0,0 +1,98 @@
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSubmitQueuesOffline(t *testing.T) {
+	key := []byte("secret")
+	var (
+		mu     sync.Mutex
+		down   = true
+		scores []Score
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if down {
+			http.Error(w, "maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if r.Header.Get(SignatureHeader) != Sign(key, body) {
+				http.Error(w, "bad signature", http.StatusUnauthorized)
+				return
+			}
+			var s Score
+			json.Unmarshal(body, &s)
+			if s.Score < 0 {
+				http.Error(w, "negative score", http.StatusBadRequest)
+				return
+			}
+			scores = append(scores, s)
+		case http.MethodGet:
+			best := slices.Clone(scores)
+			slices.SortFunc(best, func(a, b Score) int { return int(b.Score - a.Score) })
+			json.NewEncoder(w).Encode(best)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL+"/v1", key, filepath.Join(t.TempDir(), "queue.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// Server down: both scores land in the queue
+	for _, s := range []int64{10, -5} {
+		if err := c.Submit(ctx, Score{Player: "a", Score: s}); !errors.Is(err, ErrQueued) {
+			t.Fatalf("submit %d while down: got %v, want ErrQueued", s, err)
+		}
+	}
+	if q, _ := c.Queued(); len(q) != 2 {
+		t.Fatalf("queued %d scores, want 2", len(q))
+	}
+
+	// Back up: the queue drains first, the rejected queued score is dropped
+	mu.Lock()
+	down = false
+	mu.Unlock()
+	if err := c.Submit(ctx, Score{Player: "b", Score: 30}); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if q, _ := c.Queued(); len(q) != 0 {
+		t.Fatalf("queue holds %d scores after flush", len(q))
+	}
+
+	// A rejection of the submitted score itself is reported and not queued
+	var se *StatusError
+	if err := c.Submit(ctx, Score{Player: "c", Score: -1}); !errors.As(err, &se) || se.Code != http.StatusBadRequest {
+		t.Fatalf("rejected submit: got %v", err)
+	}
+
+	top, err := c.Top(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(top) != 1 || top[0].Player != "b" || top[0].Score != 30 {
+		t.Fatalf("top = %+v", top)
+	}
+
+	// A wrong key is a permanent rejection
+	bad, _ := NewClient(srv.URL+"/v1", []byte("wrong"), "")
+	if err := bad.Submit(ctx, Score{Score: 1}); !errors.As(err, &se) || se.Code != http.StatusUnauthorized {
+		t.Fatalf("wrong key: got %v", err)
+	}
+}
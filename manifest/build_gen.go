@@ -26,11 +26,14 @@ func BuildSystems(w *engine.World) []engine.System {
 		system.NewCompositeSystem(w),
 		system.NewWallSystem(w),
 		system.NewTowerSystem(w),
+		system.NewTurretSystem(w),
 		system.NewGatewaySystem(w),
 		system.NewLootSystem(w),
 		system.NewGlyphSystem(w),
+		system.NewPracticeSystem(w),
 		system.NewNuggetSystem(w),
 		system.NewDecaySystem(w),
+		system.NewCorruptionSystem(w),
 		system.NewBlossomSystem(w),
 		system.NewGoldSystem(w),
 		system.NewMaterializeSystem(w),
@@ -57,6 +60,7 @@ func BuildSystems(w *engine.World) []engine.System {
 		system.NewExplosionSystem(w),
 		system.NewMotionMarkerSystem(w),
 		system.NewSplashSystem(w),
+		system.NewPopupSystem(w),
 		system.NewEnvironmentSystem(w),
 		system.NewDeathSystem(w),
 		system.NewTimerSystem(w),
@@ -64,6 +68,8 @@ func BuildSystems(w *engine.World) []engine.System {
 		system.NewGeneticSystem(w),
 		system.NewAudioSystem(w),
 		system.NewMusicSystem(w),
+		system.NewTimelineSystem(w),
+		system.NewAnnounceSystem(w),
 		system.NewDiagSystem(w),
 	}
 }
@@ -73,7 +79,9 @@ func BuildSystems(w *engine.World) []engine.System {
 // so manifest order breaks ties between renderers at the same layer
 func BuildRenderers(ctx *engine.GameContext) []render.Registration {
 	return []render.Registration{
+		{Renderer: renderer.NewAmbienceRenderer(ctx), Priority: render.PriorityBackground},
 		{Renderer: renderer.NewPingRenderer(ctx), Priority: render.PriorityPing},
+		{Renderer: renderer.NewTrailRenderer(ctx), Priority: render.PriorityTrail},
 		{Renderer: renderer.NewChargeLineRenderer(ctx), Priority: render.PriorityChargeLine},
 		{Renderer: renderer.NewWallRenderer(ctx), Priority: render.PriorityWall},
 		{Renderer: renderer.NewGlyphRenderer(ctx), Priority: render.PriorityGlyph},
@@ -82,6 +90,7 @@ func BuildRenderers(ctx *engine.GameContext) []render.Registration {
 		{Renderer: renderer.NewHealthBarRenderer(ctx), Priority: render.PriorityHealthBar},
 		{Renderer: renderer.NewPylonRenderer(ctx), Priority: render.PriorityPylon},
 		{Renderer: renderer.NewTowerRenderer(ctx), Priority: render.PriorityTower},
+		{Renderer: renderer.NewTurretRenderer(ctx), Priority: render.PriorityTurret},
 		{Renderer: renderer.NewEyeRenderer(ctx), Priority: render.PriorityEye},
 		{Renderer: renderer.NewSnakeRenderer(ctx), Priority: render.PrioritySnake},
 		{Renderer: renderer.NewDrainRenderer(ctx), Priority: render.PriorityDrain},
@@ -102,6 +111,7 @@ func BuildRenderers(ctx *engine.GameContext) []render.Registration {
 		{Renderer: renderer.NewFadeoutRenderer(ctx), Priority: render.PriorityFadeout},
 		{Renderer: renderer.NewExplosionRenderer(ctx), Priority: render.PriorityExplosion},
 		{Renderer: renderer.NewSpiritRenderer(ctx), Priority: render.PrioritySpirit},
+		{Renderer: renderer.NewPopupRenderer(ctx), Priority: render.PriorityPopup},
 		{Renderer: renderer.NewSplashRenderer(ctx), Priority: render.PrioritySplash},
 		{Renderer: renderer.NewMarkerRenderer(ctx), Priority: render.PriorityMarker},
 		{Renderer: renderer.NewGrayoutRenderer(ctx), Priority: render.PriorityGrayout},
@@ -109,10 +119,14 @@ func BuildRenderers(ctx *engine.GameContext) []render.Registration {
 		{Renderer: renderer.NewDimRenderer(ctx), Priority: render.PriorityDim},
 		{Renderer: renderer.NewHeatRenderer(ctx), Priority: render.PriorityHeat},
 		{Renderer: renderer.NewIndicatorRenderer(ctx), Priority: render.PriorityIndicator},
+		{Renderer: renderer.NewMinimapRenderer(ctx), Priority: render.PriorityMinimap},
+		{Renderer: renderer.NewPreviewRenderer(ctx), Priority: render.PriorityPreview},
+		{Renderer: renderer.NewInputHUDRenderer(ctx), Priority: render.PriorityInputHUD},
 		{Renderer: renderer.NewStatusBarRenderer(ctx), Priority: render.PriorityStatusBar},
 		{Renderer: renderer.NewCursorRenderer(ctx), Priority: render.PriorityCursor},
 		{Renderer: renderer.NewOverlayRenderer(ctx), Priority: render.PriorityOverlay},
 		{Renderer: renderer.NewFlowFieldDebugRenderer(ctx), Priority: render.PriorityFlowField},
+		{Renderer: renderer.NewSizeGuardRenderer(ctx), Priority: render.PrioritySizeGuard},
 	}
 }
 
@@ -132,11 +146,14 @@ func ActiveSystems() []string {
 		"composite",
 		"wall",
 		"tower",
+		"turret",
 		"gateway",
 		"loot",
 		"glyph",
+		"practice",
 		"nugget",
 		"decay",
+		"corruption",
 		"blossom",
 		"gold",
 		"materialize",
@@ -163,6 +180,7 @@ func ActiveSystems() []string {
 		"explosion",
 		"motion_marker",
 		"splash",
+		"popup",
 		"environment",
 		"death",
 		"timer",
@@ -170,6 +188,8 @@ func ActiveSystems() []string {
 		"genetic",
 		"audio",
 		"music",
+		"timeline",
+		"announce",
 		"diag",
 	}
 }
@@ -34,6 +34,7 @@ var Components = []ComponentDef{
 	{"Wall", "WallComponent"},
 	{"Loot", "LootComponent"},
 	{"Gateway", "GatewayComponent"},
+	{"Bracket", "BracketComponent"},
 
 	// --- Player State ---
 	{"Energy", "EnergyComponent"},
@@ -74,6 +75,7 @@ var Components = []ComponentDef{
 	{"SnakeMember", "SnakeMemberComponent"},
 	{"Eye", "EyeComponent"},
 	{"Tower", "TowerComponent"},
+	{"Turret", "TurretComponent"},
 
 	// --- Composite ---
 	{"Header", "HeaderComponent"},
@@ -113,13 +115,16 @@ var Systems = []SystemDef{
 	{"composite", "NewCompositeSystem"},
 	{"wall", "NewWallSystem"},
 	{"tower", "NewTowerSystem"},
+	{"turret", "NewTurretSystem"},
 	{"gateway", "NewGatewaySystem"},
 
 	// --- Entity Behaviors ---
 	{"loot", "NewLootSystem"},
 	{"glyph", "NewGlyphSystem"},
+	{"practice", "NewPracticeSystem"},
 	{"nugget", "NewNuggetSystem"},
 	{"decay", "NewDecaySystem"},
+	{"corruption", "NewCorruptionSystem"},
 	{"blossom", "NewBlossomSystem"},
 	{"gold", "NewGoldSystem"},
 
@@ -158,6 +163,7 @@ var Systems = []SystemDef{
 	{"explosion", "NewExplosionSystem"},
 	{"motion_marker", "NewMotionMarkerSystem"},
 	{"splash", "NewSplashSystem"},
+	{"popup", "NewPopupSystem"},
 
 	// --- Environment ---
 	{"environment", "NewEnvironmentSystem"},
@@ -173,6 +179,8 @@ var Systems = []SystemDef{
 	{"music", "NewMusicSystem"},
 
 	// --- Diagnostics ---
+	{"timeline", "NewTimelineSystem"},
+	{"announce", "NewAnnounceSystem"},
 	{"diag", "NewDiagSystem"},
 }
 
@@ -181,7 +189,9 @@ var Systems = []SystemDef{
 // Generator produces: RegisterRenderers(), ActiveRenderers()
 var Renderers = []RendererDef{
 	// --- Background / Grid ---
+	{"ambience", "NewAmbienceRenderer", "PriorityBackground"},
 	{"ping", "NewPingRenderer", "PriorityPing"},
+	{"trail", "NewTrailRenderer", "PriorityTrail"},
 	{"chargeline", "NewChargeLineRenderer", "PriorityChargeLine"},
 
 	// --- Environment ---
@@ -196,6 +206,7 @@ var Renderers = []RendererDef{
 	// --- Species (back to front) ---
 	{"pylon", "NewPylonRenderer", "PriorityPylon"},
 	{"tower", "NewTowerRenderer", "PriorityTower"},
+	{"turret", "NewTurretRenderer", "PriorityTurret"},
 	{"eye", "NewEyeRenderer", "PriorityEye"},
 	{"snake", "NewSnakeRenderer", "PrioritySnake"},
 	{"drain", "NewDrainRenderer", "PriorityDrain"},
@@ -224,6 +235,7 @@ var Renderers = []RendererDef{
 	{"fadeout", "NewFadeoutRenderer", "PriorityFadeout"},
 	{"explosion", "NewExplosionRenderer", "PriorityExplosion"},
 	{"spirit", "NewSpiritRenderer", "PrioritySpirit"},
+	{"popup", "NewPopupRenderer", "PriorityPopup"},
 
 	// --- Overlays ---
 	{"splash", "NewSplashRenderer", "PrioritySplash"},
@@ -237,10 +249,14 @@ var Renderers = []RendererDef{
 	// --- UI ---
 	{"heat", "NewHeatRenderer", "PriorityHeat"},
 	{"indicator", "NewIndicatorRenderer", "PriorityIndicator"},
+	{"minimap", "NewMinimapRenderer", "PriorityMinimap"},
+	{"preview", "NewPreviewRenderer", "PriorityPreview"},
+	{"input_hud", "NewInputHUDRenderer", "PriorityInputHUD"},
 	{"statusbar", "NewStatusBarRenderer", "PriorityStatusBar"},
 	{"cursor", "NewCursorRenderer", "PriorityCursor"},
 
 	// --- Debug ---
 	{"overlay", "NewOverlayRenderer", "PriorityOverlay"},
 	{"flowfield", "NewFlowFieldDebugRenderer", "PriorityFlowField"},
+	{"sizeguard", "NewSizeGuardRenderer", "PrioritySizeGuard"},
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 )
 
 // CommandResult represents the outcome of command execution
@@ -42,10 +43,16 @@ func ExecuteCommand(ctx *engine.GameContext, command string) CommandResult {
 		return handleQuitCommand(ctx)
 	case "n", "new":
 		return handleNewCommand(ctx)
+	case "end":
+		return handleEndCommand(ctx)
 	case "s", "system":
 		return handleSystemCommand(ctx, args)
 	case "m", "mouse":
 		return handleMouseCommand(ctx, args)
+	case "cvd":
+		return handleCVDCommand(ctx, args)
+	case "se", "set":
+		return handleSetCommand(ctx, args)
 	case "e", "emit", "event":
 		return handleEmitCommand(ctx, args)
 	case "d", "debug":
@@ -54,6 +61,8 @@ func ExecuteCommand(ctx *engine.GameContext, command string) CommandResult {
 		return handleHelpCommand(ctx)
 	case "a", "about":
 		return handleAboutCommand(ctx)
+	case "an", "analysis":
+		return handleAnalysisCommand(ctx, args)
 	case "energy":
 		return handleEnergyCommand(ctx, args)
 	case "heat":
@@ -72,6 +81,8 @@ func ExecuteCommand(ctx *engine.GameContext, command string) CommandResult {
 		return handleCleanerCommand(ctx)
 	case "dust":
 		return handleDustCommand(ctx)
+	case "turret":
+		return handleTurretCommand(ctx)
 	default:
 		setCommandError(ctx, fmt.Sprintf("Unknown command: %s", cmd))
 		return CommandResult{Continue: true, KeepPaused: false}
@@ -97,6 +108,19 @@ func handleNewCommand(ctx *engine.GameContext) CommandResult {
 	return CommandResult{Continue: true, KeepPaused: true}
 }
 
+// handleEndCommand ends the run with the current energy as its score
+// MetaSystem shows the game-over overlay, closing it starts a new game
+func handleEndCommand(ctx *engine.GameContext) CommandResult {
+	var score int64
+	if energy, ok := ctx.World.Components.Energy.GetComponent(ctx.World.Resources.Player.Entity); ok {
+		score = energy.Current
+	}
+	ctx.SetMode(core.ModeOverlay)
+	ctx.PushEvent(event.EventGameOver, &event.GameOverPayload{Score: score})
+	ctx.SetLastCommand(":end")
+	return CommandResult{Continue: true, KeepPaused: true}
+}
+
 // handleSystemCommand sets the energy to a specified value
 func handleSystemCommand(ctx *engine.GameContext, args []string) CommandResult {
 	if len(args) != 2 {
@@ -181,6 +205,173 @@ func handleMouseCommand(ctx *engine.GameContext, args []string) CommandResult {
 	return CommandResult{Continue: true, KeepPaused: false}
 }
 
+// handleCVDCommand selects the color-vision-deficiency palette, cycling when no mode is given
+func handleCVDCommand(ctx *engine.GameContext, args []string) CommandResult {
+	config := ctx.World.Resources.Config
+	if len(args) > 1 {
+		setCommandError(ctx, "Usage: :cvd [off|protan|deutan|tritan|mono]")
+		return CommandResult{Continue: true, KeepPaused: false}
+	}
+
+	next := config.Accessibility.Next()
+	if len(args) == 1 {
+		m, ok := visual.ParseCVDMode(args[0])
+		if !ok {
+			setCommandError(ctx, fmt.Sprintf("Invalid CVD mode: %s", args[0]))
+			return CommandResult{Continue: true, KeepPaused: false}
+		}
+		next = m
+	}
+	config.Accessibility = next
+
+	ctx.SetStatusMessage("Color-blind mode: "+next.String(), parameter.StatusMessageDefaultTimeout, false)
+	ctx.SetLastCommand(":cvd " + next.String())
+	return CommandResult{Continue: true, KeepPaused: false}
+}
+
+// handleSetCommand applies vim-style option assignments; no arguments opens the settings overlay
+// Forms: opt, noopt, opt!, opt=value
+func handleSetCommand(ctx *engine.GameContext, args []string) CommandResult {
+	if len(args) == 0 {
+		ctx.SetMode(core.ModeOverlay)
+		ctx.PushEvent(event.EventMetaSettingsRequest, nil)
+		return CommandResult{Continue: true, KeepPaused: true}
+	}
+
+	for _, arg := range args {
+		if err := applySetOption(ctx, arg); err != nil {
+			setCommandError(ctx, err.Error())
+			return CommandResult{Continue: true, KeepPaused: false}
+		}
+	}
+
+	ctx.SetStatusMessage("Set: "+strings.Join(args, " "), parameter.StatusMessageDefaultTimeout, false)
+	ctx.SetLastCommand(":set " + strings.Join(args, " "))
+	return CommandResult{Continue: true, KeepPaused: false}
+}
+
+// applySetOption resolves a single :set argument against the assist, accessibility, display, and popup options
+func applySetOption(ctx *engine.GameContext, arg string) error {
+	config := ctx.World.Resources.Config
+	assist := &config.Assist
+	popups := &config.Popups
+
+	name, value, hasValue := strings.Cut(arg, "=")
+	if hasValue {
+		switch name {
+		case "speed":
+			pct, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+			if err != nil || pct < parameter.AssistSpeedMin || pct > parameter.AssistSpeedMax {
+				return fmt.Errorf("speed must be %d-%d", parameter.AssistSpeedMin, parameter.AssistSpeedMax)
+			}
+			assist.SpeedPercent = pct
+			ctx.PausableClock.SetRate(pct)
+		case "errorheat":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || n > parameter.HeatMax {
+				return fmt.Errorf("errorheat must be 0-%d", parameter.HeatMax)
+			}
+			assist.ErrorHeat = n
+		case "forgive":
+			ms, err := strconv.Atoi(strings.TrimSuffix(value, "ms"))
+			if err != nil || ms < 0 || ms > parameter.AssistForgiveMaxMs {
+				return fmt.Errorf("forgive must be 0-%dms", parameter.AssistForgiveMaxMs)
+			}
+			assist.ForgiveMs = ms
+		case "cvd":
+			m, ok := visual.ParseCVDMode(value)
+			if !ok {
+				return fmt.Errorf("invalid CVD mode: %s", value)
+			}
+			config.Accessibility = m
+		case "dither":
+			m, ok := visual.ParseDitherMode(value)
+			if !ok {
+				return fmt.Errorf("invalid dither mode: %s", value)
+			}
+			config.Dither = m
+		case "trail":
+			t, ok := visual.ParseTrailStyle(value)
+			if !ok {
+				return fmt.Errorf("invalid trail style: %s", value)
+			}
+			config.Trail = t
+		case "spawn":
+			p, ok := parameter.ParseSpawnPattern(value)
+			if !ok {
+				return fmt.Errorf("invalid spawn pattern: %s", value)
+			}
+			config.SpawnPattern = p
+		case "field":
+			w, h, ok := engine.ParseFieldSize(value)
+			if !ok {
+				return fmt.Errorf("field must be WxH within %dx%d-%dx%d, or auto",
+					parameter.FieldMinWidth, parameter.FieldMinHeight, parameter.FieldMaxWidth, parameter.FieldMaxHeight)
+			}
+			config.FieldWidth, config.FieldHeight = w, h
+			// Zero size resolves through BaseMapSize; entities are cleared as on any level change
+			ctx.PushEvent(event.EventLevelSetup, &event.LevelSetupPayload{Width: w, Height: h, ClearEntities: true})
+		case "combo":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > parameter.PopupThresholdMax {
+				return fmt.Errorf("combo must be 1-%d", parameter.PopupThresholdMax)
+			}
+			popups.ComboMin = n
+		case "milestone":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || n > parameter.PopupThresholdMax {
+				return fmt.Errorf("milestone must be 0-%d", parameter.PopupThresholdMax)
+			}
+			popups.Milestone = n
+		default:
+			return fmt.Errorf("unknown option: %s", name)
+		}
+		return nil
+	}
+
+	// Boolean forms: opt, noopt, opt!
+	toggle := strings.HasSuffix(name, "!")
+	name = strings.TrimSuffix(name, "!")
+	enable := true
+	if !toggle && strings.HasPrefix(name, "no") {
+		name, enable = name[2:], false
+	}
+
+	var flag *bool
+	invert := false // penalty, ambience, and popups read inverted against their off fields
+	switch name {
+	case "penalty":
+		flag, invert = &assist.NoErrorPenalty, true
+	case "longflash":
+		flag = &assist.LongErrorFlash
+	case "bigcursor":
+		flag = &assist.LargeCursor
+	case "crt":
+		flag = &config.CRT
+	case "ambience":
+		flag, invert = &config.NoAmbience, true
+	case "blocks":
+		flag = &config.SpawnBlocks
+	case "minimap":
+		flag = &config.Minimap
+	case "hud":
+		flag = &config.InputHUD
+	case "preview":
+		flag = &config.Preview
+	case "popups":
+		flag, invert = &popups.Off, true
+	default:
+		return fmt.Errorf("unknown option: %s", name)
+	}
+
+	if toggle {
+		*flag = !*flag
+	} else {
+		*flag = enable != invert
+	}
+	return nil
+}
+
 // handleEmitCommand emits an event by name with optional TOML payload (debug/testing)
 // Usage: :emit EventName
 // Usage: :emit EventName { field = value, nested = { x = 1 } }
@@ -278,6 +469,21 @@ func handleAboutCommand(ctx *engine.GameContext) CommandResult {
 	return CommandResult{Continue: true, KeepPaused: true}
 }
 
+// handleAnalysisCommand triggers the session analysis overlay, or a CSV export when given a path
+func handleAnalysisCommand(ctx *engine.GameContext, args []string) CommandResult {
+	if len(args) > 1 {
+		setCommandError(ctx, "Usage: :analysis [file.csv]")
+		return CommandResult{Continue: true, KeepPaused: false}
+	}
+	if len(args) == 1 {
+		ctx.PushEvent(event.EventMetaAnalysisRequest, &event.MetaAnalysisRequestPayload{ExportPath: args[0]})
+		return CommandResult{Continue: true, KeepPaused: false}
+	}
+	ctx.SetMode(core.ModeOverlay)
+	ctx.PushEvent(event.EventMetaAnalysisRequest, &event.MetaAnalysisRequestPayload{})
+	return CommandResult{Continue: true, KeepPaused: true}
+}
+
 // handleEnergyCommand sets the energy to a specified value
 func handleEnergyCommand(ctx *engine.GameContext, args []string) CommandResult {
 	if len(args) != 1 {
@@ -423,3 +629,17 @@ func handleGraphCommand(ctx *engine.GameContext, args []string) CommandResult {
 	return CommandResult{Continue: true, KeepPaused: false}
 }
 
+// handleTurretCommand buys a turret at the cursor, its word is bound to the right
+func handleTurretCommand(ctx *engine.GameContext) CommandResult {
+	cursorPos, ok := ctx.World.Positions.GetPosition(ctx.World.Resources.Player.Entity)
+	if !ok {
+		return CommandResult{Continue: true, KeepPaused: false}
+	}
+
+	ctx.PushEvent(event.EventTurretPlaceRequest, &event.TurretPlacePayload{
+		X: cursorPos.X,
+		Y: cursorPos.Y,
+	})
+	ctx.SetLastCommand(":turret")
+	return CommandResult{Continue: true, KeepPaused: false}
+}
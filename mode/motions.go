@@ -339,7 +339,7 @@ func MotionLineEnd(ctx *engine.GameContext, x, y, count int) MotionResult {
 	}
 }
 
-// MotionScreenVerticalMid implements 'M' motion
+// MotionScreenVerticalMid moves to the map middle row, 'M' when the map fits the viewport
 func MotionScreenVerticalMid(ctx *engine.GameContext, x, y, count int) MotionResult {
 	midY := ctx.World.Resources.Config.MapHeight / 2
 
@@ -436,8 +436,12 @@ func MotionParaForward(ctx *engine.GameContext, x, y, count int) MotionResult {
 }
 
 // MotionMatchBracket implements '%' motion
+// A linked spawned pair jumps straight to its partner, otherwise the nearest match is scanned
 func MotionMatchBracket(ctx *engine.GameContext, x, y, count int) MotionResult {
-	endX, endY := findMatchingBracket(ctx, x, y)
+	endX, endY, ok := findLinkedBracket(ctx, x, y)
+	if !ok {
+		endX, endY = findMatchingBracket(ctx, x, y)
+	}
 	if endX == -1 || endY == -1 {
 		return MotionResult{
 			StartX: x, StartY: y,
@@ -715,7 +719,7 @@ func MotionTillBack(ctx *engine.GameContext, x, y, count int, char rune) MotionR
 	}
 }
 
-// MotionHalfPageLeft implements 'H' motion
+// MotionHalfPageLeft implements 'zH' motion
 func MotionHalfPageLeft(ctx *engine.GameContext, x, y, count int) MotionResult {
 	halfWidth := ctx.World.Resources.Config.ViewportWidth / 2
 	endX := max(x-(halfWidth*count), 0)
@@ -733,7 +737,7 @@ func MotionHalfPageLeft(ctx *engine.GameContext, x, y, count int) MotionResult {
 	}
 }
 
-// MotionHalfPageRight implements 'L' motion
+// MotionHalfPageRight implements 'zL' motion
 func MotionHalfPageRight(ctx *engine.GameContext, x, y, count int) MotionResult {
 	config := ctx.World.Resources.Config
 	halfWidth := config.ViewportWidth / 2
@@ -755,7 +759,7 @@ func MotionHalfPageRight(ctx *engine.GameContext, x, y, count int) MotionResult
 	}
 }
 
-// MotionHalfPageUp implements 'K' and PgUp motion
+// MotionHalfPageUp implements 'K', PgUp, and operator Ctrl+U motion
 func MotionHalfPageUp(ctx *engine.GameContext, x, y, count int) MotionResult {
 	halfHeight := ctx.World.Resources.Config.ViewportHeight / 2
 	endY := max(y-(halfHeight*count), 0)
@@ -773,7 +777,7 @@ func MotionHalfPageUp(ctx *engine.GameContext, x, y, count int) MotionResult {
 	}
 }
 
-// MotionHalfPageDown implements 'J' and PgDown motion
+// MotionHalfPageDown implements PgDown and operator Ctrl+D motion
 func MotionHalfPageDown(ctx *engine.GameContext, x, y, count int) MotionResult {
 	config := ctx.World.Resources.Config
 	halfHeight := config.ViewportHeight / 2
@@ -795,6 +799,116 @@ func MotionHalfPageDown(ctx *engine.GameContext, x, y, count int) MotionResult {
 	}
 }
 
+// MotionPageUp implements operator Ctrl+B motion
+func MotionPageUp(ctx *engine.GameContext, x, y, count int) MotionResult {
+	endY := max(y-pageHeight(ctx.World.Resources.Config)*count, 0)
+
+	for endY < y && isCursorBlocked(ctx, x, endY) {
+		endY++
+	}
+
+	return MotionResult{
+		StartX: x, StartY: y,
+		EndX: x, EndY: endY,
+		Type: RangeLine, Style: StyleInclusive,
+		Valid: endY != y,
+	}
+}
+
+// MotionPageDown implements operator Ctrl+F motion
+func MotionPageDown(ctx *engine.GameContext, x, y, count int) MotionResult {
+	config := ctx.World.Resources.Config
+	endY := min(y+pageHeight(config)*count, config.MapHeight-1)
+
+	for endY > y && isCursorBlocked(ctx, x, endY) {
+		endY--
+	}
+
+	return MotionResult{
+		StartX: x, StartY: y,
+		EndX: x, EndY: endY,
+		Type: RangeLine, Style: StyleInclusive,
+		Valid: endY != y,
+	}
+}
+
+// MotionWindowTop implements 'H' motion: count-th visible row from the top,
+// held inside the camera dead zone so the view does not follow
+func MotionWindowTop(ctx *engine.GameContext, x, y, count int) MotionResult {
+	config := ctx.World.Resources.Config
+	minY, maxY := cursorBand(config, config.CameraY)
+	endY := max(minY, min(config.CameraY+count-1, maxY))
+
+	for endY < y && isCursorBlocked(ctx, x, endY) {
+		endY++
+	}
+	for endY > y && isCursorBlocked(ctx, x, endY) {
+		endY--
+	}
+
+	return MotionResult{
+		StartX: x, StartY: y,
+		EndX: x, EndY: endY,
+		Type: RangeLine, Style: StyleInclusive,
+		Valid: endY != y,
+	}
+}
+
+// MotionWindowMiddle implements 'M' motion: middle visible row
+func MotionWindowMiddle(ctx *engine.GameContext, x, y, count int) MotionResult {
+	config := ctx.World.Resources.Config
+	visible := min(config.ViewportHeight, config.MapHeight-config.CameraY)
+	midY := config.CameraY + visible/2
+	minY, maxY := cursorBand(config, config.CameraY)
+
+	if isCursorBlocked(ctx, x, midY) {
+		// Search both directions within the visible band
+		upY, downY := midY-1, midY+1
+		for upY >= minY || downY <= maxY {
+			if upY >= minY && !isCursorBlocked(ctx, x, upY) {
+				midY = upY
+				break
+			}
+			if downY <= maxY && !isCursorBlocked(ctx, x, downY) {
+				midY = downY
+				break
+			}
+			upY--
+			downY++
+		}
+	}
+
+	return MotionResult{
+		StartX: x, StartY: y,
+		EndX: x, EndY: midY,
+		Type: RangeLine, Style: StyleInclusive,
+		Valid: y != midY,
+	}
+}
+
+// MotionWindowBottom implements 'L' motion: count-th visible row from the bottom,
+// held inside the camera dead zone so the view does not follow
+func MotionWindowBottom(ctx *engine.GameContext, x, y, count int) MotionResult {
+	config := ctx.World.Resources.Config
+	minY, maxY := cursorBand(config, config.CameraY)
+	visible := min(config.ViewportHeight, config.MapHeight-config.CameraY)
+	endY := min(maxY, max(config.CameraY+visible-count, minY))
+
+	for endY > y && isCursorBlocked(ctx, x, endY) {
+		endY--
+	}
+	for endY < y && isCursorBlocked(ctx, x, endY) {
+		endY++
+	}
+
+	return MotionResult{
+		StartX: x, StartY: y,
+		EndX: x, EndY: endY,
+		Type: RangeLine, Style: StyleInclusive,
+		Valid: endY != y,
+	}
+}
+
 // MotionColumnUp implements [, O - jump to first non-space above in same column
 func MotionColumnUp(ctx *engine.GameContext, x, y, count int) MotionResult {
 	return motionScanDirectional(ctx, x, y, count, 0, -1)
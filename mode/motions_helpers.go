@@ -167,6 +167,20 @@ func findMatchingBracket(ctx *engine.GameContext, cursorX, cursorY int) (int, in
 	return findMatchingBracketBackward(ctx, cursorX, cursorY, currentChar, matchingChar)
 }
 
+// findLinkedBracket returns the live partner of a linked bracket glyph at the position
+func findLinkedBracket(ctx *engine.GameContext, x, y int) (int, int, bool) {
+	for _, entity := range ctx.World.Positions.GetAllEntityAt(x, y) {
+		bracket, ok := ctx.World.Components.Bracket.GetComponent(entity)
+		if !ok || !ctx.World.Components.Glyph.HasEntity(bracket.Partner) {
+			continue
+		}
+		if pos, ok := ctx.World.Positions.GetPosition(bracket.Partner); ok {
+			return pos.X, pos.Y, true
+		}
+	}
+	return 0, 0, false
+}
+
 func findMatchingBracketForward(ctx *engine.GameContext, startX, startY int, openChar, closeChar rune) (int, int) {
 	depth := 0
 	x, y := startX+1, startY
@@ -481,3 +495,24 @@ func scanBoundsBackward(ctx *engine.GameContext, cursorX, cursorY int, bounds en
 	}
 	return cursorX, cursorY
 }
+
+// cursorBand returns the rows the cursor may rest on with the camera at cameraY without
+// the camera following; the band opens to the map edge where the camera cannot scroll further
+func cursorBand(config *engine.ConfigResource, cameraY int) (minY, maxY int) {
+	_, top, _, bottom := config.CameraDeadZone()
+	_, maxCameraY := config.MaxCamera()
+
+	minY, maxY = cameraY+min(top, bottom), cameraY+max(top, bottom)
+	if cameraY <= 0 {
+		minY = 0
+	}
+	if cameraY >= maxCameraY {
+		maxY = config.MapHeight - 1
+	}
+	return max(0, minY), min(maxY, config.MapHeight-1)
+}
+
+// pageHeight is the vim full-page scroll distance, two rows of overlap kept
+func pageHeight(config *engine.ConfigResource) int {
+	return max(1, config.ViewportHeight-2)
+}
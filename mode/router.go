@@ -37,6 +37,9 @@ type Router struct {
 	lastFindForward bool   // true for f/t, false for F/T
 	lastFindType    rune   // Motion type: 'f', 'F', 't', or 'T'
 
+	// Seeker armed by \: the next find or search targets a sequence instead of moving
+	seekPending bool
+
 	// Command history ring buffer
 	cmdHistory    [cmdHistorySize]string
 	cmdHistHead   int    // next write index
@@ -104,6 +107,11 @@ func NewRouter(ctx *engine.GameContext, machine *input.Machine) *Router {
 		input.MotionHalfPageRight:       MotionHalfPageRight,
 		input.MotionHalfPageUp:          MotionHalfPageUp,
 		input.MotionHalfPageDown:        MotionHalfPageDown,
+		input.MotionPageUp:              MotionPageUp,
+		input.MotionPageDown:            MotionPageDown,
+		input.MotionWindowTop:           MotionWindowTop,
+		input.MotionWindowMiddle:        MotionWindowMiddle,
+		input.MotionWindowBottom:        MotionWindowBottom,
 		input.MotionColumnUp:            MotionColumnUp,
 		input.MotionColumnDown:          MotionColumnDown,
 	}
@@ -169,6 +177,11 @@ func (r *Router) Handle(intent *input.Intent) bool {
 		return true
 	}
 
+	// Any intent other than picking a target disarms the seeker
+	if r.seekPending && !r.keepsSeek(intent) {
+		r.seekPending = false
+	}
+
 	switch intent.Type {
 	// System
 	case input.IntentQuit:
@@ -179,6 +192,8 @@ func (r *Router) Handle(intent *input.Intent) bool {
 		return r.handleToggleEffectMute()
 	case input.IntentToggleMusicMute:
 		return r.handleToggleMusicMute()
+	case input.IntentHelp:
+		return r.handleHelp()
 	case input.IntentResize:
 		// Caller already holds the world lock
 		r.ctx.HandleResizeLocked()
@@ -194,6 +209,13 @@ func (r *Router) Handle(intent *input.Intent) bool {
 	case input.IntentMotionMarkerJump:
 		return r.handleMotionMarkerJump(intent)
 
+	// Viewport scrolling
+	case input.IntentScrollLineDown, input.IntentScrollLineUp,
+		input.IntentScrollHalfPageDown, input.IntentScrollHalfPageUp,
+		input.IntentScrollPageDown, input.IntentScrollPageUp,
+		input.IntentScrollCursorCenter, input.IntentScrollCursorTop, input.IntentScrollCursorBottom:
+		return r.handleScroll(intent)
+
 	// Normal mode operators
 	case input.IntentOperatorMotion:
 		return r.handleOperatorMotion(intent)
@@ -213,6 +235,8 @@ func (r *Router) Handle(intent *input.Intent) bool {
 		return r.handleFireMain()
 	case input.IntentFireSpecial:
 		return r.handleFireSpecial()
+	case input.IntentFireSeeker:
+		return r.handleFireSeeker()
 
 	// Mode switching
 	case input.IntentModeSwitch:
@@ -363,6 +387,26 @@ func (r *Router) handleEscape() bool {
 	return true
 }
 
+// handleHelp opens the help overlay scoped to the mode it was invoked from
+func (r *Router) handleHelp() bool {
+	fromMode := r.ctx.GetMode()
+
+	// Pending text is discarded as on ESC, overlay close returns to Normal
+	switch fromMode {
+	case core.ModeSearch:
+		r.ctx.SetSearchText("")
+	case core.ModeCommand:
+		r.ctx.SetCommandText("")
+		r.ctx.SetCommandCursorPos(0)
+		r.resetCommandHistoryBrowse()
+	}
+
+	r.ctx.SetPaused(true)
+	r.transitionMode(core.ModeOverlay)
+	r.ctx.PushEvent(event.EventMetaHelpRequest, &event.MetaHelpRequestPayload{Mode: fromMode})
+	return true
+}
+
 func (r *Router) handleToggleEffectMute() bool {
 	r.ctx.PushEvent(event.EventSoundMuteToggle, &event.SoundMuteTogglePayload{
 		Mode: event.MuteToggle, Mask: parameter.AudioChanEffects,
@@ -409,7 +453,11 @@ func (r *Router) handleCharMotion(intent *input.Intent) bool {
 
 	if pos, ok := r.ctx.World.Positions.GetPosition(r.ctx.World.Resources.Player.Entity); ok {
 		result := charFn(r.ctx, pos.X, pos.Y, intent.Count, intent.Char)
-		OpMove(r.ctx, result)
+		if r.seekPending {
+			r.fireSeekerAt(result.EndX, result.EndY, result.Valid)
+		} else {
+			OpMove(r.ctx, result)
+		}
 		// Track for ; and , repeat
 		if result.Valid {
 			r.lastFindChar = intent.Char
@@ -476,6 +524,78 @@ func (r *Router) motionToDirection(motion input.MotionOp) [2]int {
 	return [2]int{0, 0}
 }
 
+// --- Scroll Handlers ---
+
+// handleScroll moves the camera vertically, vim Ctrl+E/Y/D/U/F/B and zz/zt/zb
+// The cursor is pulled into the camera dead zone afterwards so the next motion
+// does not snap the view back, except where the camera rests at a map edge
+func (r *Router) handleScroll(intent *input.Intent) bool {
+	config := r.ctx.World.Resources.Config
+	cursorEntity := r.ctx.World.Resources.Player.Entity
+	pos, ok := r.ctx.World.Positions.GetPosition(cursorEntity)
+	if !ok {
+		return true
+	}
+
+	_, top, _, bottom := config.CameraDeadZone()
+	_, maxCameraY := config.MaxCamera()
+	half := max(1, config.ViewportHeight/2)
+	page := pageHeight(config)
+
+	cameraY := config.CameraY
+	cursorY := pos.Y
+	switch intent.Type {
+	case input.IntentScrollLineDown:
+		cameraY += intent.Count
+	case input.IntentScrollLineUp:
+		cameraY -= intent.Count
+	case input.IntentScrollHalfPageDown:
+		cameraY += half * intent.Count
+		cursorY += half * intent.Count
+	case input.IntentScrollHalfPageUp:
+		cameraY -= half * intent.Count
+		cursorY -= half * intent.Count
+	case input.IntentScrollPageDown:
+		cameraY += page * intent.Count
+		cursorY += page * intent.Count
+	case input.IntentScrollPageUp:
+		cameraY -= page * intent.Count
+		cursorY -= page * intent.Count
+	case input.IntentScrollCursorCenter:
+		cameraY = pos.Y - config.ViewportHeight/2
+	case input.IntentScrollCursorTop:
+		cameraY = pos.Y - top
+	case input.IntentScrollCursorBottom:
+		cameraY = pos.Y - bottom
+	}
+	cameraY = max(0, min(cameraY, maxCameraY))
+	config.CameraY = cameraY
+
+	minY, maxY := cursorBand(config, cameraY)
+	targetY := max(minY, min(cursorY, maxY))
+
+	// Walls: step back toward the original row until a free cell is found
+	step := 1
+	if targetY > pos.Y {
+		step = -1
+	}
+	for targetY != pos.Y && isCursorBlocked(r.ctx, pos.X, targetY) {
+		targetY += step
+	}
+
+	if targetY != pos.Y {
+		r.captureForUndo()
+		r.ctx.World.Positions.SetPosition(cursorEntity, component.PositionComponent{X: pos.X, Y: targetY})
+		r.ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: pos.X, Y: targetY})
+	}
+
+	if intent.Command != "" {
+		r.ctx.SetLastCommand(intent.Command)
+	}
+
+	return true
+}
+
 // --- Operator Handlers ---
 
 func (r *Router) handleOperatorMotion(intent *input.Intent) bool {
@@ -591,6 +711,14 @@ func (r *Router) handleSpecial(intent *input.Intent) bool {
 
 		case input.SpecialRepeatFindRev:
 			r.executeRepeatFind(true)
+
+		case input.SpecialJoin:
+			r.ctx.PushEvent(event.EventJoinRequest, &event.JoinRequestPayload{X: pos.X, Y: pos.Y})
+
+		case input.SpecialReplaceChar:
+			r.ctx.PushEvent(event.EventReplaceRequest, &event.ReplaceRequestPayload{
+				X: pos.X, Y: pos.Y, Char: intent.Char, Count: intent.Count,
+			})
 		}
 	}
 	if intent.Command != "" {
@@ -622,6 +750,35 @@ func (r *Router) handleFireSpecial() bool {
 	return true
 }
 
+// handleFireSeeker arms the seeker; the next f/F/t/T or search picks its target sequence
+func (r *Router) handleFireSeeker() bool {
+	r.seekPending = true
+	r.ctx.SetStatusMessage("Seeker: f/F/t/T or / to target", parameter.StatusMessageDefaultTimeout, false)
+	return true
+}
+
+// keepsSeek reports whether the intent continues picking a seeker target
+func (r *Router) keepsSeek(intent *input.Intent) bool {
+	switch intent.Type {
+	case input.IntentCharMotion:
+		return true
+	case input.IntentModeSwitch:
+		return intent.ModeTarget == input.ModeTargetSearch
+	case input.IntentTextChar, input.IntentTextBackspace, input.IntentTextConfirm, input.IntentTextNav:
+		return r.ctx.GetMode() == core.ModeSearch
+	}
+	return false
+}
+
+// fireSeekerAt disarms the seeker and requests a launch at the target cell, the cursor stays put
+func (r *Router) fireSeekerAt(x, y int, valid bool) {
+	r.seekPending = false
+	if !valid {
+		return
+	}
+	r.ctx.PushEvent(event.EventSeekerFireRequest, &event.SeekerFireRequestPayload{X: x, Y: y})
+}
+
 // --- Mode Switch Handler ---
 
 func (r *Router) handleModeSwitch(intent *input.Intent) bool {
@@ -781,7 +938,13 @@ func (r *Router) handleTextConfirm() bool {
 	switch currentMode {
 	case core.ModeSearch:
 		searchText := r.ctx.GetSearchText()
-		if searchText != "" {
+		if r.seekPending {
+			x, y, ok := FindSearchMatch(r.ctx, searchText, true)
+			if ok {
+				r.lastSearchText = searchText
+			}
+			r.fireSeekerAt(x, y, ok)
+		} else if searchText != "" {
 			if PerformSearch(r.ctx, searchText, true) {
 				r.lastSearchText = searchText
 			}
@@ -899,7 +1062,14 @@ func (r *Router) handleInsertDeleteForward() bool {
 }
 
 func (r *Router) handleInsertDeleteBack() bool {
-	pos, ok := r.ctx.World.Positions.GetPosition(r.ctx.World.Resources.Player.Entity)
+	cursorEntity := r.ctx.World.Resources.Player.Entity
+	// Backspace over a forgivable typing error cancels it instead of deleting
+	if cursor, ok := r.ctx.World.Components.Cursor.GetComponent(cursorEntity); ok && cursor.ForgiveRemaining > 0 {
+		r.ctx.PushEvent(event.EventTypingErrorForgive, nil)
+		return true
+	}
+
+	pos, ok := r.ctx.World.Positions.GetPosition(cursorEntity)
 	if !ok || pos.X == 0 {
 		return true
 	}
@@ -1036,15 +1206,36 @@ func (r *Router) resetCommandHistoryBrowse() {
 // --- Overlay Handlers ---
 
 func (r *Router) handleOverlayClose() bool {
+	if content := r.ctx.GetOverlayContent(); content != nil && content.ResetOnClose {
+		r.transitionMode(core.ModeNormal)
+		handleNewCommand(r.ctx)
+		return true
+	}
 	r.ctx.SetOverlayContent(nil)
 	r.ctx.SetPaused(false)
 	r.transitionMode(core.ModeNormal)
 	return true
 }
 
-// TODO: future implementation
+// handleOverlayActivate applies the selected entry's :set action and asks for a rebuilt overlay
 func (r *Router) handleOverlayActivate() bool {
-	// Stub: future section toggle/expand functionality
+	content := r.ctx.GetOverlayContent()
+	if content == nil || !content.Selectable {
+		return true
+	}
+	actions := content.Actions()
+	selected := r.ctx.GetOverlaySelect()
+	if selected < 0 || selected >= len(actions) {
+		return true
+	}
+
+	action := actions[selected].Action
+	if err := applySetOption(r.ctx, action); err != nil {
+		setCommandError(r.ctx, err.Error())
+		return true
+	}
+	r.ctx.SetStatusMessage("Set: "+action, parameter.StatusMessageDefaultTimeout, false)
+	r.ctx.PushEvent(event.EventMetaSettingsRequest, nil)
 	return true
 }
 
@@ -1069,6 +1260,15 @@ func (r *Router) handleOverlayPageScroll(direction int) bool {
 }
 
 func (r *Router) handleOverlayScroll(intent *input.Intent) bool {
+	// Selectable overlays move the selection, page keys still scroll
+	if content := r.ctx.GetOverlayContent(); content != nil && content.Selectable {
+		if n := len(content.Actions()); n > 0 {
+			selected := r.ctx.GetOverlaySelect() + int(intent.ScrollDir)
+			r.ctx.SetOverlaySelect(max(0, min(n-1, selected)))
+		}
+		return true
+	}
+
 	newScroll := r.ctx.GetOverlayScroll() + int(intent.ScrollDir)
 
 	if newScroll < 0 {
@@ -9,10 +9,23 @@ import (
 
 // PerformSearch searches for a text pattern and moves cursor to first match
 func PerformSearch(ctx *engine.GameContext, searchText string, forward bool) bool {
-	if searchText == "" {
+	x, y, ok := FindSearchMatch(ctx, searchText, forward)
+	if !ok {
 		return false
 	}
 
+	// Write cursor position to ECS
+	ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{X: x, Y: y})
+	ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: y})
+	return true
+}
+
+// FindSearchMatch returns the start of the next match from the cursor without moving it
+func FindSearchMatch(ctx *engine.GameContext, searchText string, forward bool) (int, int, bool) {
+	if searchText == "" {
+		return 0, 0, false
+	}
+
 	searchRunes := []rune(searchText)
 
 	// Build character grid from ECS
@@ -21,31 +34,14 @@ func PerformSearch(ctx *engine.GameContext, searchText string, forward bool) boo
 	// Get cursor position from ECS
 	pos, ok := ctx.World.Positions.GetPosition(ctx.World.Resources.Player.Entity)
 	if !ok {
-		return false // Cursor entity missing - should never happen
+		return 0, 0, false // Cursor entity missing - should never happen
 	}
-	cursorX := pos.X
-	cursorY := pos.Y
 
-	// Determine search start position
-	startX, startY := cursorX, cursorY
+	// Search from cursor position, starting after it forward and before it backward
 	if forward {
-		startX++ // Start after cursor
-	} else {
-		startX-- // Start before cursor
+		return searchForward(ctx, grid, searchRunes, pos.X+1, pos.Y)
 	}
-
-	// Search from cursor position
-	if forward {
-		if searchForward(ctx, grid, searchRunes, startX, startY) {
-			return true
-		}
-	} else {
-		if searchBackward(ctx, grid, searchRunes, startX, startY) {
-			return true
-		}
-	}
-
-	return false
+	return searchBackward(ctx, grid, searchRunes, pos.X-1, pos.Y)
 }
 
 // RepeatSearch repeats the last search in the specified direction
@@ -75,8 +71,8 @@ func buildCharacterGrid(ctx *engine.GameContext) map[core.Point]rune {
 	return grid
 }
 
-// searchForward searches forward from the given position
-func searchForward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []rune, startX, startY int) bool {
+// searchForward returns the first match forward from the given position, wrapping around
+func searchForward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []rune, startX, startY int) (int, int, bool) {
 	// Search from start position to end of screen
 	for y := startY; y < ctx.World.Resources.Config.MapHeight; y++ {
 		xStart := 0
@@ -86,12 +82,7 @@ func searchForward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []
 
 		for x := xStart; x <= ctx.World.Resources.Config.MapWidth-len(pattern); x++ {
 			if matchesPattern(grid, x, y, pattern) {
-				// Write cursor position to ECS
-				ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-					X: x,
-					Y: y,
-				})
-				return true
+				return x, y, true
 			}
 		}
 	}
@@ -100,13 +91,7 @@ func searchForward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []
 	for y := range startY {
 		for x := 0; x <= ctx.World.Resources.Config.MapWidth-len(pattern); x++ {
 			if matchesPattern(grid, x, y, pattern) {
-				// Write cursor position to ECS
-				ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-					X: x,
-					Y: y,
-				})
-				ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: y})
-				return true
+				return x, y, true
 			}
 		}
 	}
@@ -114,21 +99,15 @@ func searchForward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []
 	// Search remaining part of start line
 	for x := range startX {
 		if matchesPattern(grid, x, startY, pattern) {
-			// Write cursor position to ECS
-			ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-				X: x,
-				Y: startY,
-			})
-			ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: startY})
-			return true
+			return x, startY, true
 		}
 	}
 
-	return false
+	return 0, 0, false
 }
 
-// searchBackward searches backward from the given position
-func searchBackward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []rune, startX, startY int) bool {
+// searchBackward returns the first match backward from the given position, wrapping around
+func searchBackward(ctx *engine.GameContext, grid map[core.Point]rune, pattern []rune, startX, startY int) (int, int, bool) {
 	// Search from start position to beginning of screen
 	for y := startY; y >= 0; y-- {
 		xEnd := ctx.World.Resources.Config.MapWidth - len(pattern)
@@ -138,13 +117,7 @@ func searchBackward(ctx *engine.GameContext, grid map[core.Point]rune, pattern [
 
 		for x := xEnd; x >= 0; x-- {
 			if matchesPattern(grid, x, y, pattern) {
-				// Write cursor position to ECS
-				ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-					X: x,
-					Y: y,
-				})
-				ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: y})
-				return true
+				return x, y, true
 			}
 		}
 	}
@@ -153,13 +126,7 @@ func searchBackward(ctx *engine.GameContext, grid map[core.Point]rune, pattern [
 	for y := ctx.World.Resources.Config.MapHeight - 1; y > startY; y-- {
 		for x := ctx.World.Resources.Config.MapWidth - len(pattern); x >= 0; x-- {
 			if matchesPattern(grid, x, y, pattern) {
-				// Write cursor position to ECS
-				ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-					X: x,
-					Y: y,
-				})
-				ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: y})
-				return true
+				return x, y, true
 			}
 		}
 	}
@@ -167,17 +134,11 @@ func searchBackward(ctx *engine.GameContext, grid map[core.Point]rune, pattern [
 	// Search remaining part of start line
 	for x := ctx.World.Resources.Config.MapWidth - len(pattern); x > startX; x-- {
 		if matchesPattern(grid, x, startY, pattern) {
-			// Write cursor position to ECS
-			ctx.World.Positions.SetPosition(ctx.World.Resources.Player.Entity, component.PositionComponent{
-				X: x,
-				Y: startY,
-			})
-			ctx.PushEvent(event.EventCursorMoved, &event.CursorMovedPayload{X: x, Y: startY})
-			return true
+			return x, startY, true
 		}
 	}
 
-	return false
+	return 0, 0, false
 }
 
 // matchesPattern checks if the pattern matches at the given position
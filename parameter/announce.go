@@ -0,0 +1,47 @@
+package parameter
+
+import "time"
+
+// AnnounceLevel selects which state changes the announcement channel reports
+type AnnounceLevel uint8
+
+const (
+	AnnounceOff     AnnounceLevel = iota // Channel disabled
+	AnnounceLow                          // Waves, enemy arrivals, pause, reset, energy sign changes
+	AnnounceNormal                       // Also mode switches, energy milestones, gold sequences
+	AnnounceVerbose                      // Also every typing error
+	announceLevelCount
+)
+
+// AnnounceLevelNames maps AnnounceLevel to its flag name
+var AnnounceLevelNames = [announceLevelCount]string{"off", "low", "normal", "verbose"}
+
+// String returns the flag name of the level
+func (l AnnounceLevel) String() string {
+	if l >= announceLevelCount {
+		return AnnounceLevelNames[AnnounceOff]
+	}
+	return AnnounceLevelNames[l]
+}
+
+// ParseAnnounceLevel resolves a level name, "" resolves to AnnounceNormal
+func ParseAnnounceLevel(name string) (AnnounceLevel, bool) {
+	if name == "" {
+		return AnnounceNormal, true
+	}
+	for i, n := range AnnounceLevelNames {
+		if n == name {
+			return AnnounceLevel(i), true
+		}
+	}
+	return AnnounceOff, false
+}
+
+const (
+	// AnnounceBuffer is the number of lines queued for the writer before new ones are dropped
+	AnnounceBuffer = 64
+	// AnnounceMilestone is the energy step between milestone announcements
+	AnnounceMilestone = 1000
+	// AnnounceFlushTimeout bounds the wait for queued lines on shutdown
+	AnnounceFlushTimeout = 200 * time.Millisecond
+)
@@ -15,3 +15,9 @@ const (
 
 	MouseAPMSampleInterval = 150 * time.Millisecond
 )
+
+// Input meter: keystroke rate and input-to-render latency for the :set hud display
+const (
+	KPMWindowSeconds = 60  // Rolling window, keystrokes within it are the KPM
+	LatencySmoothing = 0.2 // EWMA weight of each new latency measurement
+)
@@ -0,0 +1,34 @@
+package parameter
+
+import "time"
+
+// Attract Mode
+const (
+	// AttractIdleDelay is the untouched time at startup before the demo bot takes over
+	AttractIdleDelay = 30 * time.Second
+
+	// AttractInjectBuffer is the injector capacity; the bot never queues more than one key ahead
+	AttractInjectBuffer = 16
+)
+
+// AttractBanner is the status line shown while the demo bot plays
+const AttractBanner = "DEMO - press any key to play"
+
+// Bot Runner
+const (
+	// BotRetryDelay is the wait before an agent observes again after an empty plan
+	BotRetryDelay = 500 * time.Millisecond
+
+	// BotGameDuration is the game time a headless game runs by default
+	BotGameDuration = 3 * time.Minute
+
+	// BotFieldWidth and BotFieldHeight size the virtual terminal of headless games
+	BotFieldWidth  = 120
+	BotFieldHeight = 40
+
+	// BotMaxKeysPerTick bounds keys delivered per headless tick, guarding zero-delay plans
+	BotMaxKeysPerTick = 64
+
+	// ReplayTail is the game time a headless replay keeps running after its last event
+	ReplayTail = 2 * time.Second
+)
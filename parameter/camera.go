@@ -15,4 +15,13 @@ const (
 	// CameraEnabled controls whether camera following is active
 	// When false, camera stays at (0,0) regardless of cursor position
 	CameraEnabled = true
-)
\ No newline at end of file
+)
+
+// Fixed field bounds for :set field=WxH
+// Fields larger than the viewport scroll with the camera
+const (
+	FieldMinWidth  = 40
+	FieldMinHeight = 12
+	FieldMaxWidth  = 1000
+	FieldMaxHeight = 500
+)
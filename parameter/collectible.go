@@ -14,6 +14,12 @@ const (
 
 	// GoldJumpCostPercent is the energy cost to jump to gold
 	GoldJumpCostPercent = 10
+
+	// GoldBonusEnergy is the base energy reward for completing gold before it expires
+	GoldBonusEnergy = 100
+
+	// GoldBonusMaxMultiplier scales the bonus at full remaining time, falling linearly to 1x at expiry
+	GoldBonusMaxMultiplier = 5.0
 )
 
 // Nugget System
@@ -107,6 +107,18 @@ const (
 	MissileExplosionRadiusFloat = 6.0
 )
 
+// Seeker Phase
+const (
+	// SeekerLaunchSpeedFloat is the sideways launch velocity before homing bends it onto the target (cells/sec)
+	SeekerLaunchSpeedFloat = 40.0
+
+	// SeekerMaxLifetime is the safety timeout, longer than missiles since targets can be map-wide
+	SeekerMaxLifetime = 5 * time.Second
+
+	// SeekerExplosionRadiusFloat is the impact explosion radius at the cleared sequence
+	SeekerExplosionRadiusFloat = 3.0
+)
+
 // Destruction
 const (
 	// DestructionFlashDuration is how long the destruction flash effect lasts in milliseconds
@@ -115,3 +127,36 @@ const (
 	// FadeoutDuration is how long the fadeout effect lasts
 	FadeoutDuration = 400 * time.Millisecond
 )
+
+// Score Popups
+const (
+	// PopupScoreDuration is the lifetime of a score popup, restarted when a hit merges into it
+	PopupScoreDuration = 800 * time.Millisecond
+
+	// PopupBannerDuration is the lifetime of milestone and line bonus text
+	PopupBannerDuration = 1500 * time.Millisecond
+
+	// PopupCap is maximum concurrent popups, the oldest is replaced when full
+	PopupCap = 32
+
+	// PopupMergeDistance is the column distance on the same row within which hits add to a live score popup
+	PopupMergeDistance = 8
+
+	// PopupComboMinDefault is the streak from which score popups show the combo count
+	PopupComboMinDefault = 5
+
+	// PopupMilestoneDefault is the streak interval announced with a banner
+	PopupMilestoneDefault = 25
+
+	// PopupThresholdMax bounds the configurable combo and milestone thresholds
+	PopupThresholdMax = 1000
+
+	// PopupPerfectLineText is the banner for a practice line typed without errors
+	PopupPerfectLineText = "PERFECT LINE"
+)
+
+// Values the settings overlay cycles through on Enter
+var (
+	PopupComboPresets     = []int{2, 3, 5, 10, 20}
+	PopupMilestonePresets = []int{0, 10, 25, 50, 100}
+)
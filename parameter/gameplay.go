@@ -18,6 +18,21 @@ const (
 
 	EmberDecayInterval = 250 * time.Millisecond
 	EmberDecayAmount   = 1
+
+	// HeatDecayGrace is the time without a heat gain before idle decay starts
+	HeatDecayGrace = 3 * time.Second
+	// HeatDecayInterval and HeatDecayAmount pace idle decay once the grace expires
+	HeatDecayInterval = 500 * time.Millisecond
+	HeatDecayAmount   = 1
+
+	// HeatOverheatWarnRatio is the overheat fraction of HeatMaxOverheat that sounds the warning
+	HeatOverheatWarnRatio = 0.75
+	// HeatBurstLockout is the time after a burst during which heat gains are ignored
+	HeatBurstLockout = 2 * time.Second
+
+	// Heat tiers in percent of HeatMax, higher tiers spawn faster
+	HeatTierWarm = 34
+	HeatTierHot  = 67
 )
 
 // Energy System
@@ -29,6 +44,27 @@ const (
 	ErrorBlinkTimeout = 200 * time.Millisecond
 )
 
+// Assist Options
+const (
+	// AssistSpeedMin/Max bound the game speed multiplier in percent of real time
+	AssistSpeedMin     = 50
+	AssistSpeedMax     = 200
+	AssistSpeedDefault = 100
+
+	// AssistErrorFlashTimeout replaces ErrorBlinkTimeout when extended error flash is enabled
+	AssistErrorFlashTimeout = 800 * time.Millisecond
+
+	// AssistForgiveMaxMs bounds the Backspace window over a typing error, in milliseconds
+	AssistForgiveMaxMs = 2000
+)
+
+// Values the settings overlay cycles through on Enter, any in-range value is accepted by :set
+var (
+	AssistSpeedPresets     = []int{50, 75, 100, 125, 150, 200}
+	AssistErrorHeatPresets = []int{0, 5, 10, 20, 40}
+	AssistForgivePresets   = []int{0, 250, 500, 1000, 2000}
+)
+
 // Glyph Energy
 const (
 	EnergyBaseBlue  = 2
@@ -58,6 +94,28 @@ const (
 	MaxPlacementTries       = 3
 	MinIndentChange         = 2
 	ContentRefreshThreshold = 0.8
+
+	// ContentSeedName keys the content selection seed derived from the session seed
+	ContentSeedName = "content"
+
+	// BlockPlacementTries is the number of random positions tried for a whole multi-row block
+	BlockPlacementTries = 8
+	// SnippetBonusPerChar is energy per character awarded when every glyph of a block is typed
+	SnippetBonusPerChar = 3
+	// BracketPairBonus is energy awarded when both members of a linked bracket pair are typed consecutively
+	BracketPairBonus = 10
+)
+
+// Practice Mode
+const (
+	// PracticeVisibleLines is the number of practice file lines on the field at once
+	PracticeVisibleLines = 3
+	// PracticeColumn is the left margin of practice lines
+	PracticeColumn = 2
+	// PracticeRowStep is the row gap between consecutively revealed lines
+	PracticeRowStep = 2
+	// PracticeSummaryDuration is how long the end-of-file summary stays in the status bar
+	PracticeSummaryDuration = 10 * time.Second
 )
 
 // Spawn Exclusion Zones
@@ -80,6 +138,36 @@ const (
 	SpawnRateFast   = 2.0  // Used when density < low threshold
 	SpawnRateNormal = 1.0  // Used when density is between thresholds
 	SpawnRateSlow   = 0.02 // Used when density > high threshold
+
+	// Heat tier multipliers stacked on the density multiplier
+	SpawnRateHeatWarm = 1.25
+	SpawnRateHeatHot  = 1.5
+)
+
+// Red Corruption
+const (
+	// CorruptionSpreadInterval is the game time between spread steps
+	CorruptionSpreadInterval = 3 * time.Second
+	// CorruptionSpreadPerStep is the number of red glyphs that try to infect a neighbor per step
+	CorruptionSpreadPerStep = 2
+	// CorruptionMaxRatio stops spreading once this fraction of glyphs is red
+	CorruptionMaxRatio = 0.5
+
+	// CorruptionCleanupBonus is energy awarded per red glyph removed by a delete operator
+	CorruptionCleanupBonus = 20
+	// CorruptionPrecisionMultiplier applies when a delete removes only red glyphs
+	CorruptionPrecisionMultiplier = 2
+)
+
+// Edit Commands
+const (
+	// ReplaceHeatCost is the heat spent by r{char} to turn a red glyph into the typed character
+	ReplaceHeatCost = 5
+
+	// JoinBonusPerChar is energy per pulled character when J joins a word of the cursor's color
+	JoinBonusPerChar = 2
+	// JoinMismatchHeatPenalty is the heat lost when J pulls a word of another color
+	JoinMismatchHeatPenalty = 5
 )
 
 // Positions Finding
@@ -0,0 +1,22 @@
+package parameter
+
+import "time"
+
+// Online Leaderboard
+const (
+	// LeaderboardTimeout bounds each leaderboard request; exit waits at most a few of these
+	LeaderboardTimeout = 5 * time.Second
+
+	// LeaderboardTopCount is the number of top scores shown on exit
+	LeaderboardTopCount = 10
+
+	// LeaderboardQueueMax caps offline scores kept for later; the oldest are dropped first
+	LeaderboardQueueMax = 100
+
+	// LeaderboardKeyEnv names the environment variable holding the score signing key
+	// Kept off the command line so it doesn't show in process listings
+	LeaderboardKeyEnv = "VI_FIGHTER_LB_KEY"
+
+	// LeaderboardNameMax bounds the player name length in runes
+	LeaderboardNameMax = 24
+)
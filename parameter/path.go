@@ -11,6 +11,12 @@ const (
 	// KeymapConfigFile is the keymap override filename
 	KeymapConfigFile = "keymap.toml"
 
+	// SettingsConfigFile holds persisted player preferences (assist, accessibility)
+	SettingsConfigFile = "settings.toml"
+
+	// LeaderboardQueueFile holds scores that could not be submitted, retried on the next submit
+	LeaderboardQueueFile = "leaderboard_queue.jsonl"
+
 	// LocalConfigDir is the repo-local fallback config directory
 	LocalConfigDir = "./config"
 
@@ -73,6 +73,8 @@ var (
 	MissileImpactRadius    = vmath.FromFloat(MissileImpactRadiusFloat)
 	MissileImpactRadiusSq  = vmath.Mul(MissileImpactRadius, MissileImpactRadius)
 	MissileExplosionRadius = vmath.FromFloat(MissileExplosionRadiusFloat)
+	SeekerLaunchSpeed      = vmath.FromFloat(SeekerLaunchSpeedFloat)
+	SeekerExplosionRadius  = vmath.FromFloat(SeekerExplosionRadiusFloat)
 )
 
 // Loot physics
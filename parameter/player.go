@@ -41,6 +41,8 @@ const (
 	WeaponCooldownRod       = 500 * time.Millisecond
 	WeaponCooldownLauncher  = 1000 * time.Millisecond
 	WeaponCooldownDisruptor = 2000 * time.Millisecond
+	// WeaponCooldownSeeker is the launcher cooldown after a seeker, shared with launcher volleys
+	WeaponCooldownSeeker = 3000 * time.Millisecond
 )
 
 // Weapon Max Charges — component owns Charges storage, parameter owns the cap table
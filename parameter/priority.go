@@ -13,6 +13,7 @@ const (
 	PriorityComposite // After boost, before spawning systems (position sync)
 	PriorityWall      // After composite
 	PriorityGlyph
+	PriorityPractice // After Glyph, replaces random spawning in practice mode
 	PriorityNugget
 	PriorityGold
 	PriorityCleaner
@@ -30,12 +31,14 @@ const (
 	PriorityStorm       // After Swarm
 	PriorityPylon       // After Storm
 	PriorityTower       // Before Eye
+	PriorityTurret      // After Tower, fires at eyes before they move
 
 	PriorityGateway // After Tower, before Eye — spawns eyes for the tick
 	PriorityEye     // After Gateway
 	PriorityCombat
 	PriorityLoot // After enemy entities and combat
 	PriorityDecay
+	PriorityCorruption // After decay, spreads red created this tick next step
 	PriorityBlossom
 	PriorityLightning // After Quasar
 	PriorityMissile   // After Weapon
@@ -46,9 +49,12 @@ const (
 	PriorityMarker       // Before splash, after game logic
 	PrioritySplash       // After game logic, before rendering
 	PriorityMotionMarker // After game logic and splash, before rendering
+	PriorityPopup        // After game logic, ages floating score text
 	PriorityDeath        // After game logic, before TimeKeeper
 	PriorityTimekeeper   // After game logic
 	PriorityAdaptation   // Before genetic
 	PriorityGenetic      // After death and timekeeper, observes entity lifecycle
+	PriorityTimeline     // After game logic, samples settled player state
+	PriorityAnnounce     // After game logic, reports settled state changes
 	PriorityDiagnostics  // After all others, telemetry collection
 )
\ No newline at end of file
@@ -0,0 +1,78 @@
+package parameter
+
+// SpawnPattern selects how content lines are laid out on the field
+type SpawnPattern uint8
+
+const (
+	SpawnPatternRandom   SpawnPattern = iota // Each line at an independent random position
+	SpawnPatternRain                         // Words trickle down a column
+	SpawnPatternSentence                     // Lines joined into one sentence wrapped across rows
+	SpawnPatternSpiral                       // Lines wound outward around a center
+	SpawnPatternCluster                      // Lines burst around one point
+	SpawnPatternMixed                        // One of the above picked per wave
+	spawnPatternCount
+)
+
+// SpawnPatternNames maps SpawnPattern to its option name
+var SpawnPatternNames = [spawnPatternCount]string{"random", "rain", "sentence", "spiral", "cluster", "mixed"}
+
+// String returns the option name of the pattern
+func (p SpawnPattern) String() string {
+	if p >= spawnPatternCount {
+		return SpawnPatternNames[SpawnPatternRandom]
+	}
+	return SpawnPatternNames[p]
+}
+
+// ParseSpawnPattern resolves a pattern name, "" resolves to SpawnPatternRandom
+func ParseSpawnPattern(name string) (SpawnPattern, bool) {
+	if name == "" {
+		return SpawnPatternRandom, true
+	}
+	for i, n := range SpawnPatternNames {
+		if n == name {
+			return SpawnPattern(i), true
+		}
+	}
+	return SpawnPatternRandom, false
+}
+
+// Spawn placement on fields larger than the viewport
+const (
+	// SpawnNearViewPercent is the share of placements drawn from around the viewport, the rest use the whole map
+	SpawnNearViewPercent = 75
+	// SpawnViewMarginX and SpawnViewMarginY extend the near-view region past the viewport edges
+	SpawnViewMarginX = 20
+	SpawnViewMarginY = 6
+)
+
+// Spawn pattern layout
+const (
+	// SpawnNoiseScale converts cells to noise units, larger values give busier distributions
+	SpawnNoiseScale = 0.08
+	// SpawnNoiseOctaves is the fractal depth of the anchor noise
+	SpawnNoiseOctaves = 3
+	// SpawnNoiseWaveDrift is the noise-space offset between waves, so consecutive anchors wander
+	SpawnNoiseWaveDrift = 0.7
+	// SpawnAnchorCandidates is the number of sampled anchors, the highest noise value wins
+	SpawnAnchorCandidates = 12
+
+	// SpawnRainMaxWord caps a trickle fragment so columns stay narrow
+	SpawnRainMaxWord = 12
+
+	// SpawnSentenceWidth is the wrap width of a sentence, capped by the field width
+	SpawnSentenceWidth = 60
+
+	// SpawnSpiralRadiusStep is the radius growth in rows per line
+	SpawnSpiralRadiusStep = 1.5
+	// SpawnSpiralAngleStep is the angle advance in radians per line, the golden angle
+	SpawnSpiralAngleStep = 2.39996
+	// SpawnSpiralAspect widens horizontal offsets to cancel the cell aspect ratio
+	SpawnSpiralAspect = 2.0
+
+	// SpawnClusterRadiusX and SpawnClusterRadiusY bound the burst around its center
+	SpawnClusterRadiusX = 14
+	SpawnClusterRadiusY = 5
+	// SpawnClusterTries is the number of offsets tried per line before falling back
+	SpawnClusterTries = 6
+)
@@ -2,7 +2,6 @@ package parameter
 
 // Splash Entity
 const (
-	SplashCharWidth  = 12
 	SplashCharHeight = 12
 	SplashMaxLength  = 8
 
@@ -14,4 +13,4 @@ const (
 
 	// SplashCollisionPadding is the cell padding between different splashes to prevent overcrowding
 	SplashCollisionPadding = 2
-)
\ No newline at end of file
+)
@@ -0,0 +1,28 @@
+package parameter
+
+import "time"
+
+// Turret Entity
+const (
+	// TurretCost is the energy spent to place a turret
+	TurretCost = 500
+
+	// TurretWordOffset is the column gap from the turret to its bound word
+	TurretWordOffset = 2
+
+	// TurretWordMinLen and TurretWordMaxLen bound the words picked from content
+	TurretWordMinLen = 3
+	TurretWordMaxLen = 8
+
+	// TurretWordAttempts is the number of random content lines tried for a word
+	TurretWordAttempts = 20
+
+	// TurretMissileCount is the missiles fired per completed word
+	TurretMissileCount = 2
+
+	// TurretRearmDelay is the pause after firing or losing a word before a new word is bound
+	TurretRearmDelay = 1500 * time.Millisecond
+)
+
+// TurretFallbackWords are bound when content yields no word in range
+var TurretFallbackWords = []string{"fire", "volley", "launch", "strike", "salvo"}
@@ -12,6 +12,14 @@ const (
 
 	// LeftMargin (1 left padding + 1 digit + 1 right padding)
 	LeftMargin = 3
+
+	// MinTerminalWidth and MinTerminalHeight are the smallest terminal the game runs in;
+	// below either the game pauses behind a size notice
+	MinTerminalWidth  = 40
+	MinTerminalHeight = 12
+
+	// ResizeRemapSearchRows is how far a displaced sequence searches for free rows on shrink
+	ResizeRemapSearchRows = 8
 )
 
 // Status Bar & Modes
@@ -52,6 +60,15 @@ const (
 	OverlayPaddingY = 1
 )
 
+// Session Timeline
+const (
+	// TimelineSampleInterval is the game time between timeline samples
+	TimelineSampleInterval = time.Second
+
+	// TimelineMaxSamples caps a session timeline, four hours at one sample per second
+	TimelineMaxSamples = 4 * 60 * 60
+)
+
 // Splash Layout
 const (
 	// SplashMinDistance is the minimum distance from cursor for magnifier placement
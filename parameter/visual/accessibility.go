@@ -0,0 +1,116 @@
+package visual
+
+import (
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+)
+
+// CVDMode selects a color-vision-deficiency glyph palette and shape cues
+type CVDMode uint8
+
+const (
+	CVDOff    CVDMode = iota // Default hue palette, no cues
+	CVDProtan                // Red-weak: yellow/sky/purple palette
+	CVDDeutan                // Green-weak: orange/sky/purple palette
+	CVDTritan                // Blue-weak: teal/pink/vermillion palette
+	CVDMono                  // Achromatopsia: luminance steps only
+	cvdModeCount
+)
+
+// CVDModeNames maps CVDMode to its command/flag name
+var CVDModeNames = [cvdModeCount]string{"off", "protan", "deutan", "tritan", "mono"}
+
+// String returns the command/flag name of the mode
+func (m CVDMode) String() string {
+	if m >= cvdModeCount {
+		return CVDModeNames[CVDOff]
+	}
+	return CVDModeNames[m]
+}
+
+// ParseCVDMode resolves a mode name, "" resolves to CVDOff
+func ParseCVDMode(name string) (CVDMode, bool) {
+	if name == "" {
+		return CVDOff, true
+	}
+	for i, n := range CVDModeNames {
+		if n == name {
+			return CVDMode(i), true
+		}
+	}
+	return CVDOff, false
+}
+
+// Next returns the following mode, wrapping to CVDOff
+func (m CVDMode) Next() CVDMode {
+	return (m + 1) % cvdModeCount
+}
+
+// Accessibility cue parameters
+const (
+	// CVDTickAlpha is the blend of the type's dark level over the background for tick cells
+	CVDTickAlpha = 0.35
+	// cvdDarkScale and cvdBrightLift derive dark/bright levels from a palette base
+	cvdDarkScale  = 0.55
+	cvdBrightLift = 0.45
+)
+
+// cvdLevels derives dark/normal/bright glyph levels from a single base color
+func cvdLevels(base color.RGB) [3]color.RGB {
+	return [3]color.RGB{
+		color.Scale(base, cvdDarkScale),
+		base,
+		color.Lerp(base, color.White, cvdBrightLift),
+	}
+}
+
+// cvdPalette builds a GlyphColorLUT variant from green/blue/red bases; white and gold are shared
+func cvdPalette(green, blue, red color.RGB) [5][3]color.RGB {
+	return [5][3]color.RGB{
+		cvdLevels(green),
+		cvdLevels(blue),
+		cvdLevels(red),
+		GlyphColorLUT[3],
+		GlyphColorLUT[4],
+	}
+}
+
+// CVDGlyphColorLUT maps [CVDMode][GlyphType][GlyphLevel] to RGB
+// Bases follow the Okabe-Ito set, chosen to separate along the axis each deficiency retains
+var CVDGlyphColorLUT = [cvdModeCount][5][3]color.RGB{
+	GlyphColorLUT,
+	DeriveGlyphPalette(RgbBackground, color.RGB{R: 240, G: 228, B: 66}, color.RGB{R: 86, G: 180, B: 233}, color.RGB{R: 204, G: 121, B: 167}),
+	DeriveGlyphPalette(RgbBackground, color.RGB{R: 230, G: 159, B: 0}, color.RGB{R: 86, G: 180, B: 233}, color.RGB{R: 204, G: 121, B: 167}),
+	DeriveGlyphPalette(RgbBackground, color.RGB{R: 0, G: 158, B: 115}, color.RGB{R: 204, G: 121, B: 167}, color.RGB{R: 213, G: 94, B: 0}),
+	DeriveGlyphPalette(RgbBackground, color.RGB{R: 215, G: 215, B: 215}, color.RGB{R: 160, G: 160, B: 160}, color.RGB{R: 120, G: 120, B: 120}),
+}
+
+// GlyphCue is the non-color marker carried by a glyph type in accessibility modes
+type GlyphCue struct {
+	Attr terminal.Attr // Attribute added to the glyph foreground
+	Tick bool          // Background tick behind the glyph
+}
+
+// GlyphCueLUT gives each glyph type a distinct shape cue, indexed by GlyphType
+// Green plain, blue ticked, red (negative) underlined, gold (bonus) bold
+var GlyphCueLUT = [5]GlyphCue{
+	{Attr: terminal.AttrNone},
+	{Attr: terminal.AttrNone, Tick: true},
+	{Attr: terminal.AttrUnderline},
+	{Attr: terminal.AttrNone},
+	{Attr: terminal.AttrBold},
+}
+
+// cvdTicks precomputes tick backgrounds from each palette's dark level
+func cvdTicks() [cvdModeCount][5]color.RGB {
+	var ticks [cvdModeCount][5]color.RGB
+	for m := range ticks {
+		for t := range ticks[m] {
+			ticks[m][t] = color.Blend(RgbBackground, CVDGlyphColorLUT[m][t][0], CVDTickAlpha)
+		}
+	}
+	return ticks
+}
+
+// CVDTickLUT maps [CVDMode][GlyphType] to the opaque tick background
+var CVDTickLUT = cvdTicks()
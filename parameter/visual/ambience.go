@@ -0,0 +1,43 @@
+package visual
+
+import (
+	"time"
+
+	"github.com/lixenwraith/color"
+)
+
+// Ambience layer configuration, background effects behind the playfield (TrueColor only)
+const (
+	// AmbienceStarDensity is stars per 1000 viewport cells, capped at AmbienceStarMax
+	AmbienceStarDensity = 4
+	AmbienceStarMax     = 96
+	// AmbienceStarDrift is the leftward drift of the nearest star layer in cells/sec
+	AmbienceStarDrift = 0.8
+	// AmbienceStarBrightness is the peak channel value added to the background
+	AmbienceStarBrightness = 48
+	AmbienceStarTwinkle    = 2.5 // rad/sec
+
+	// AmbiencePlasmaHeatMin is the heat percent where plasma starts to show
+	AmbiencePlasmaHeatMin = 40
+	// AmbiencePlasmaMaxAlpha is the plasma blend over the background at full heat
+	AmbiencePlasmaMaxAlpha = 0.12
+
+	// AmbienceAuraRadiusX/Y size the boss aura ellipse in cells
+	AmbienceAuraRadiusX = 18.0
+	AmbienceAuraRadiusY = 8.0
+	AmbienceAuraAlpha   = 0.18
+	AmbienceAuraPulseHz = 0.6
+
+	// AmbienceFrameBudget is the render time above which plasma resolution drops
+	AmbienceFrameBudget = 1 * time.Millisecond
+	// AmbienceMaxStride is the coarsest plasma block size in cells
+	AmbienceMaxStride = 4
+)
+
+// Ambience colors
+var (
+	AmbiencePlasmaCool = color.RGB{R: 90, G: 20, B: 60}
+	AmbiencePlasmaHot  = color.RGB{R: 200, G: 80, B: 20}
+	AmbienceAuraQuasar = RgbDrain
+	AmbienceAuraStorm  = RgbElectricViolet
+)
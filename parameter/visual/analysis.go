@@ -0,0 +1,23 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// AnalysisChartRunes are eighth-block column fills for analysis charts, index 0 is empty
+var AnalysisChartRunes = [9]rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Analysis chart markers
+const (
+	AnalysisBestRune  = '▲'
+	AnalysisWorstRune = '▼'
+)
+
+// AnalysisMinChartHeight is the smallest chart, title and marker rows plus one body row
+const AnalysisMinChartHeight = 3
+
+// Analysis colors
+var (
+	RgbAnalysisChart = color.PastelGreen
+	RgbAnalysisBest  = color.BrightGreen
+	RgbAnalysisWorst = color.Red
+	RgbAnalysisAxis  = color.IronGray
+)
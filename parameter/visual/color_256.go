@@ -52,6 +52,9 @@ const (
 	Missile256Body  = color.P256Gold   // (5,4,0)
 	Missile256Base  = color.P256Orange // (5,2,0)
 
+	Missile256SeekerTrail = color.P256Teal
+	Missile256SeekerBody  = color.P256Cyan
+
 	// Swarm charge line
 	SwarmChargeLine256Palette = color.P256Orchid // (4,2,4)
 
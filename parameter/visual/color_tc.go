@@ -64,6 +64,9 @@ var (
 	RgbCursorNormal   = color.Orange
 	RgbCursorInsert   = color.White
 
+	// CursorHaloIntensity scales the cursor color for the large-cursor assist halo
+	CursorHaloIntensity = 0.35
+
 	// Boost glow effect
 	RgbBoostGlow = color.HotPink
 
@@ -137,6 +140,11 @@ var (
 	RgbMissileChildTrailStart = color.Bronze
 	RgbMissileChildTrailEnd   = color.Sienna
 
+	// Seeker: Cyan, set apart from launcher volleys
+	RgbMissileSeekerBody       = color.Cyan
+	RgbMissileSeekerTrailStart = color.Teal
+	RgbMissileSeekerTrailEnd   = color.SteelBlue
+
 	// Missile impact explosion (warm palette)
 	RgbMissileExplosionCore = color.Ivory
 	RgbMissileExplosionMid  = color.WarmOrange
@@ -168,6 +176,7 @@ var (
 	RgbOverlayValue     = color.PastelGreen
 	RgbOverlayHint      = color.Gray
 	RgbOverlaySeparator = color.IronGray
+	RgbOverlaySelectBg  = color.NavyBlue
 
 	// Status bar auxiliary colors
 	RgbColorModeIndicator = color.LightGray
@@ -0,0 +1,39 @@
+package visual
+
+// DitherMode selects how RGB backgrounds are reduced to the xterm-256 palette
+type DitherMode uint8
+
+const (
+	DitherOff      DitherMode = iota // Nearest palette entry, as the terminal would pick
+	DitherOrdered                    // Fixed 4x4 Bayer pattern between the two nearest entries
+	DitherTemporal                   // Bayer pattern shifted every frame, averaging over time as well
+	ditherModeCount
+)
+
+// DitherModeNames maps DitherMode to its option name
+var DitherModeNames = [ditherModeCount]string{"off", "ordered", "temporal"}
+
+// String returns the option name of the mode
+func (m DitherMode) String() string {
+	if m >= ditherModeCount {
+		return DitherModeNames[DitherOff]
+	}
+	return DitherModeNames[m]
+}
+
+// ParseDitherMode resolves a mode name, "" resolves to DitherOff
+func ParseDitherMode(name string) (DitherMode, bool) {
+	if name == "" {
+		return DitherOff, true
+	}
+	for i, n := range DitherModeNames {
+		if n == name {
+			return DitherMode(i), true
+		}
+	}
+	return DitherOff, false
+}
+
+// DitherTemporalStep shifts the dither thresholds each frame; the golden ratio
+// conjugate spreads consecutive frames evenly over the threshold range
+const DitherTemporalStep = 0.6180339887
@@ -0,0 +1,18 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// Gold countdown ring
+const (
+	// GoldRingWarnRatio is the remaining time fraction below which the ring blinks
+	GoldRingWarnRatio = 0.3
+	// GoldRingBlinkMs is the half-period of the low-time ring blink
+	GoldRingBlinkMs = 150
+	// GoldRingSpentDim scales the ring color for cells whose time has run out
+	GoldRingSpentDim = 0.2
+)
+
+var (
+	RgbGoldRing     = color.Amber
+	RgbGoldRingWarn = color.Red
+)
@@ -33,3 +33,8 @@ const (
 	RainbowLUTRange = RainbowLUTMax - RainbowLUTMin // 180
 )
 
+// HueRamp OKLCh parameters, chroma is reduced per hue where sRGB cannot reach it
+const (
+	HueRampLightness = 0.75
+	HueRampChroma    = 0.17
+)
@@ -0,0 +1,9 @@
+package visual
+
+// Heat bar state display
+const (
+	// HeatWarnBlinkMs is the half-period of the overheat warning blink
+	HeatWarnBlinkMs = 200
+	// HeatLockoutDim scales the bar while heat gains are locked out after a burst
+	HeatLockoutDim = 0.55
+)
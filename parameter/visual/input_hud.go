@@ -0,0 +1,13 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// InputHUDMargin is the cell gap between the input HUD and the game area's top-left corner
+const InputHUDMargin = 1
+
+// Input HUD colors
+var (
+	RgbInputHUDBg    = color.Obsidian
+	RgbInputHUDLabel = color.Silver
+	RgbInputHUDValue = color.PastelGreen
+)
@@ -0,0 +1,36 @@
+package visual
+
+import (
+	"time"
+
+	"github.com/lixenwraith/color"
+)
+
+// Minimap sizing and visibility, shown only when enabled by :set minimap on terminals at least this large
+const (
+	MinimapMinScreenWidth  = 160
+	MinimapMinScreenHeight = 48
+	MinimapWidth           = 40 // Cells, height follows map aspect
+	MinimapMinHeight       = 4
+	MinimapMaxHeight       = 16
+	MinimapMargin          = 1 // Cells between minimap and game area edge
+)
+
+// MinimapUpdateInterval is how often the density grid is rebuilt, cursor and viewport update every frame
+const MinimapUpdateInterval = 250 * time.Millisecond
+
+// Minimap colors
+var (
+	RgbMinimapBg       = color.Obsidian
+	RgbMinimapViewport = color.CoolSilver
+	RgbMinimapCursor   = color.White
+)
+
+// MinimapViewportAlpha is the tint strength of the viewport outline
+const MinimapViewportAlpha = 0.35
+
+// MinimapDensityRunes ramps glyph density per minimap cell, index 0 is empty
+var MinimapDensityRunes = [5]rune{' ', '░', '▒', '▓', '█'}
+
+// MinimapCursorRune marks the cursor position
+const MinimapCursorRune = '◆'
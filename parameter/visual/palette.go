@@ -0,0 +1,119 @@
+package visual
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+)
+
+// Sequence palette validation, in WCAG contrast ratios (1 = identical, 21 = black on white)
+const (
+	// PaletteMinContrastDark/Normal/Bright are the minimum glyph-to-background ratios per level
+	PaletteMinContrastDark   = 1.6
+	PaletteMinContrastNormal = 3.0
+	PaletteMinContrastBright = 4.0
+	// PaletteMinLevelStep is the minimum ratio between adjacent levels of one type
+	PaletteMinLevelStep = 1.1
+	// PaletteMinTypeDistance is the minimum redmean distance between sequence types at one level
+	PaletteMinTypeDistance = 120
+	// paletteAdjustStep is the lerp step away from the background per adjustment round
+	paletteAdjustStep = 0.05
+)
+
+// paletteMinContrast indexes the background contrast floor by GlyphLevel
+var paletteMinContrast = [3]float64{PaletteMinContrastDark, PaletteMinContrastNormal, PaletteMinContrastBright}
+
+// paletteSequenceTypes is the number of leading GlyphTypes with distinct levels (green, blue, red)
+const paletteSequenceTypes = 3
+
+// Luminance returns the WCAG relative luminance of c in [0, 1]
+func Luminance(c color.RGB) float64 {
+	return 0.2126*linearChannel(c.R) + 0.7152*linearChannel(c.G) + 0.0722*linearChannel(c.B)
+}
+
+func linearChannel(v uint8) float64 {
+	s := float64(v) / 255
+	if s <= 0.04045 {
+		return s / 12.92
+	}
+	return math.Pow((s+0.055)/1.055, 2.4)
+}
+
+// Contrast returns the WCAG contrast ratio of a and b in [1, 21]
+func Contrast(a, b color.RGB) float64 {
+	la, lb := Luminance(a), Luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// DeriveGlyphPalette computes the glyph palette for a theme from its background and the
+// normal-level green, blue, and red anchors; dark and bright levels are derived from each
+// anchor, and the result is fitted to the background
+func DeriveGlyphPalette(background, green, blue, red color.RGB) [5][3]color.RGB {
+	lut := cvdPalette(green, blue, red)
+	FitGlyphPalette(&lut, background)
+	return lut
+}
+
+// FitGlyphPalette adjusts lut in place until every glyph meets its level's contrast floor
+// against background and sequence levels step apart, moving colors away from the background
+// Returns the number of colors changed; the stock palettes need none
+func FitGlyphPalette(lut *[5][3]color.RGB, background color.RGB) int {
+	away := color.White
+	if Luminance(background) > 0.18 {
+		away = color.Black
+	}
+
+	changed := 0
+	for t := range lut {
+		floor := 0.0
+		for l := range lut[t] {
+			need := paletteMinContrast[l]
+			if t < paletteSequenceTypes && l > 0 {
+				need = max(need, floor*PaletteMinLevelStep)
+			}
+			c := lut[t][l]
+			for step := 1; Contrast(c, background) < need && float64(step)*paletteAdjustStep <= 1; step++ {
+				c = color.Lerp(lut[t][l], away, float64(step)*paletteAdjustStep)
+			}
+			if c != lut[t][l] {
+				lut[t][l] = c
+				changed++
+			}
+			floor = Contrast(c, background)
+		}
+	}
+	return changed
+}
+
+// PaletteIssue is a constraint the palette still fails after fitting
+type PaletteIssue struct {
+	Type, Level int
+	Other       int     // Colliding type for distance issues, -1 for contrast issues
+	Value       float64 // Measured contrast ratio or redmean distance
+}
+
+// ValidateGlyphPalette reports glyphs below their contrast floor and sequence types too close
+// to tell apart at the same level
+func ValidateGlyphPalette(lut *[5][3]color.RGB, background color.RGB) []PaletteIssue {
+	var issues []PaletteIssue
+	for t := range lut {
+		for l := range lut[t] {
+			if c := Contrast(lut[t][l], background); c < paletteMinContrast[l] {
+				issues = append(issues, PaletteIssue{Type: t, Level: l, Other: -1, Value: c})
+			}
+		}
+	}
+	for l := range 3 {
+		for a := 0; a < paletteSequenceTypes; a++ {
+			for b := a + 1; b < paletteSequenceTypes; b++ {
+				if d := color.RedmeanDistance(lut[a][l], lut[b][l]); d < PaletteMinTypeDistance {
+					issues = append(issues, PaletteIssue{Type: a, Level: l, Other: b, Value: float64(d)})
+				}
+			}
+		}
+	}
+	return issues
+}
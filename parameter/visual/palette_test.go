@@ -0,0 +1,73 @@
+package visual
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lixenwraith/color"
+)
+
+func TestStockPalettesFit(t *testing.T) {
+	for m, lut := range CVDGlyphColorLUT {
+		fitted := lut
+		if n := FitGlyphPalette(&fitted, RgbBackground); n != 0 {
+			t.Errorf("%v: fit changed %d colors: %v -> %v", CVDMode(m), n, lut, fitted)
+		}
+		if issues := ValidateGlyphPalette(&lut, RgbBackground); len(issues) != 0 {
+			t.Errorf("%v: %+v", CVDMode(m), issues)
+		}
+	}
+}
+
+func TestDeriveGlyphPaletteLightBackground(t *testing.T) {
+	bg := color.RGB{R: 245, G: 245, B: 240}
+	lut := DeriveGlyphPalette(bg, RgbGlyphGreenNormal, RgbGlyphBlueNormal, RgbGlyphRedNormal)
+
+	if issues := ValidateGlyphPalette(&lut, bg); len(issues) != 0 {
+		t.Fatalf("issues after fit: %+v", issues)
+	}
+	for typ := range paletteSequenceTypes {
+		for l := 1; l < 3; l++ {
+			prev, cur := Contrast(lut[typ][l-1], bg), Contrast(lut[typ][l], bg)
+			if cur < prev*PaletteMinLevelStep {
+				t.Errorf("type %d level %d: contrast %.2f does not step from %.2f", typ, l, cur, prev)
+			}
+		}
+	}
+}
+
+func TestContrast(t *testing.T) {
+	if c := Contrast(color.Black, color.White); c < 20.9 || c > 21.1 {
+		t.Errorf("black/white contrast = %.2f, want 21", c)
+	}
+	if c := Contrast(RgbBackground, RgbBackground); c != 1 {
+		t.Errorf("self contrast = %.2f, want 1", c)
+	}
+}
+
+func TestSequenceThemeRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "theme.toml")
+	want := SequenceTheme{Name: "test", Green: "#33cc66", Blue: "#3388ee", Red: "#ee4433"}
+	if err := SaveSequenceTheme(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadSequenceTheme(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("loaded %+v, want %+v", got, want)
+	}
+
+	lut, err := got.Palette()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues := ValidateGlyphPalette(&lut, RgbBackground); len(issues) != 0 {
+		t.Errorf("derived palette issues: %+v", issues)
+	}
+
+	if _, err := (SequenceTheme{Green: "green"}).Palette(); err == nil {
+		t.Error("invalid anchor accepted")
+	}
+}
@@ -0,0 +1,17 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// Score popups
+const (
+	// PopupRise is the rows a popup climbs over its lifetime
+	PopupRise = 3.0
+	// PopupFadeStart is the lifetime fraction after which a popup fades out
+	PopupFadeStart = 0.5
+)
+
+var (
+	RgbPopupScore   = color.Gold
+	RgbPopupPenalty = color.Coral
+	RgbPopupBanner  = color.VibrantCyan
+)
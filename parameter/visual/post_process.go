@@ -29,4 +29,15 @@ const (
 	OcclusionDimEnabled = true
 	OcclusionDimFactor  = 0.8 // Bg intensity multiplier under foreground chars
 	OcclusionDimMask    = MaskTransient | MaskGlyph
-)
\ No newline at end of file
+)
+
+// CRT filter configuration, applied at flush in TrueColor mode
+const (
+	CRTScanlineFactor = 0.78 // Intensity multiplier on odd rows
+	CRTBleed          = 0.18 // Background blend from the left neighbor
+	CRTGlow           = 0.06 // Left neighbor's glyph color bleeding into background
+	CRTGamma          = 1.15 // Phosphor response curve, >1 deepens shadows
+)
+
+// CRTPhosphorGain is the per-channel gain of the phosphor curve, slight green cast
+var CRTPhosphorGain = [3]float64{0.96, 1.0, 0.92}
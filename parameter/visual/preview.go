@@ -0,0 +1,20 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// Sequence preview panel sizing, shown when enabled by :set preview and the game area fits it
+const (
+	PreviewWidth      = 34 // Cells including padding
+	PreviewHeight     = 4  // Rows: text, type, rate, gain
+	PreviewMargin     = 1  // Cells between panel and game area's bottom-right corner
+	PreviewSearchRows = 3  // Rows above and below the cursor searched for the nearest sequence
+	PreviewMaxRun     = 64 // Glyphs read per sequence
+)
+
+// Preview panel colors
+var (
+	RgbPreviewBg      = color.Obsidian
+	RgbPreviewLabel   = color.Silver
+	RgbPreviewValue   = color.PastelGreen
+	RgbPreviewPenalty = color.Coral
+)
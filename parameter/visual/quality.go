@@ -0,0 +1,37 @@
+package visual
+
+// Quality is the render quality hint, lowered automatically when terminal writes back up (e.g. over SSH)
+type Quality uint8
+
+const (
+	QualityFull    Quality = iota
+	QualityReduced         // Ambience, trail, and CRT off; every other frame flushed
+	QualityMinimal         // As reduced, one frame in QualityMinimalStride flushed
+)
+
+var qualityNames = [...]string{"full", "reduced", "minimal"}
+
+// String returns the quality name
+func (q Quality) String() string {
+	if int(q) < len(qualityNames) {
+		return qualityNames[q]
+	}
+	return "unknown"
+}
+
+// Adaptive quality, driven by the smoothed duration of the blocking terminal flush
+const (
+	// QualityFlushSlowMs degrades one level when the smoothed flush time stays above it
+	QualityFlushSlowMs = 8.0
+	// QualityFlushFastMs restores one level when the smoothed flush time stays below it
+	QualityFlushFastMs = 2.0
+	// QualityEMAAlpha is the smoothing weight of the latest flush sample
+	QualityEMAAlpha = 0.2
+	// QualityDegradeFrames is the number of consecutive slow flushes before degrading
+	QualityDegradeFrames = 15
+	// QualityRecoverFrames is the number of consecutive fast flushes before recovering, longer to avoid flapping
+	QualityRecoverFrames = 120
+	// QualityReducedStride and QualityMinimalStride are frames per flush, skipped frames coalesce into the next
+	QualityReducedStride = 2
+	QualityMinimalStride = 4
+)
@@ -0,0 +1,85 @@
+package visual
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/toml"
+)
+
+// SequenceTheme is a glyph palette file: the normal-level anchors of the three sequence types
+// as "#rrggbb"; dark and bright levels and contrast are derived against RgbBackground
+type SequenceTheme struct {
+	Name  string `toml:"name"`
+	Green string `toml:"green"`
+	Blue  string `toml:"blue"`
+	Red   string `toml:"red"`
+}
+
+// DefaultSequenceTheme returns the anchors of the stock palette
+func DefaultSequenceTheme() SequenceTheme {
+	return SequenceTheme{
+		Name:  "default",
+		Green: GlyphColorLUT[0][1].Hex(),
+		Blue:  GlyphColorLUT[1][1].Hex(),
+		Red:   GlyphColorLUT[2][1].Hex(),
+	}
+}
+
+// Anchors parses the three anchor colors
+func (t SequenceTheme) Anchors() (green, blue, red color.RGB, err error) {
+	if green, err = color.ParseHex(t.Green); err != nil {
+		return green, blue, red, fmt.Errorf("green %q: %w", t.Green, err)
+	}
+	if blue, err = color.ParseHex(t.Blue); err != nil {
+		return green, blue, red, fmt.Errorf("blue %q: %w", t.Blue, err)
+	}
+	if red, err = color.ParseHex(t.Red); err != nil {
+		return green, blue, red, fmt.Errorf("red %q: %w", t.Red, err)
+	}
+	return green, blue, red, nil
+}
+
+// Palette derives and fits the glyph palette from the anchors
+func (t SequenceTheme) Palette() ([5][3]color.RGB, error) {
+	green, blue, red, err := t.Anchors()
+	if err != nil {
+		return [5][3]color.RGB{}, err
+	}
+	return DeriveGlyphPalette(RgbBackground, green, blue, red), nil
+}
+
+// LoadSequenceTheme reads a theme file
+func LoadSequenceTheme(path string) (SequenceTheme, error) {
+	var t SequenceTheme
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return t, err
+	}
+	if err := toml.Unmarshal(data, &t); err != nil {
+		return t, err
+	}
+	if _, _, _, err := t.Anchors(); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// SaveSequenceTheme writes a theme file
+func SaveSequenceTheme(path string, t SequenceTheme) error {
+	data, err := toml.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// ApplySequenceTheme replaces the default (non-CVD) glyph palette and its tick backgrounds
+// Call before rendering starts; the palettes are read without locking
+func ApplySequenceTheme(lut [5][3]color.RGB) {
+	CVDGlyphColorLUT[CVDOff] = lut
+	for t := range CVDTickLUT[CVDOff] {
+		CVDTickLUT[CVDOff][t] = color.Blend(RgbBackground, lut[t][0], CVDTickAlpha)
+	}
+}
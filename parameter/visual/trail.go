@@ -0,0 +1,74 @@
+package visual
+
+import "github.com/lixenwraith/color"
+
+// TrailStyle selects the cursor trail particle look
+type TrailStyle uint8
+
+const (
+	TrailOff     TrailStyle = iota // No trail
+	TrailComet                     // Warm head fading to a cool tail
+	TrailRainbow                   // Hue ramp along the path
+	TrailSparks                    // Drifting sparks scattered from the path
+	trailStyleCount
+)
+
+// TrailStyleNames maps TrailStyle to its option name
+var TrailStyleNames = [trailStyleCount]string{"off", "comet", "rainbow", "sparks"}
+
+// String returns the option name of the style
+func (s TrailStyle) String() string {
+	if s >= trailStyleCount {
+		return TrailStyleNames[TrailOff]
+	}
+	return TrailStyleNames[s]
+}
+
+// ParseTrailStyle resolves a style name, "" resolves to TrailOff
+func ParseTrailStyle(name string) (TrailStyle, bool) {
+	if name == "" {
+		return TrailOff, true
+	}
+	for i, n := range TrailStyleNames {
+		if n == name {
+			return TrailStyle(i), true
+		}
+	}
+	return TrailOff, false
+}
+
+// Cursor trail particles
+const (
+	// TrailMaxParticles caps the live particle pool, spawns are dropped when full
+	TrailMaxParticles = 512
+	// TrailMaxSteps caps path samples per move so long jumps stay cheap
+	TrailMaxSteps = 48
+	// TrailIntensityDistance is the move length in cells that reaches full intensity
+	TrailIntensityDistance = 12.0
+	// TrailIntensityMin is the intensity of a single-cell move
+	TrailIntensityMin = 0.25
+	// TrailMaxAlpha is the background blend of a fresh particle at full intensity
+	TrailMaxAlpha = 0.55
+
+	// TrailLife is the lifetime in seconds of path particles, the head lingers longest
+	TrailLife = 0.35
+	// TrailRainbowHueStep advances the hue ramp per path cell (0-255 ramp)
+	TrailRainbowHueStep = 9
+
+	// TrailSparkPerCell is sparks spawned per path cell at full intensity
+	TrailSparkPerCell = 2
+	// TrailSparkSpeedMin/Max bound spark launch speed in cells/sec
+	TrailSparkSpeedMin = 4.0
+	TrailSparkSpeedMax = 12.0
+	// TrailSparkDrag is the velocity decay rate of sparks (1/sec)
+	TrailSparkDrag = 5.0
+	// TrailSparkLife is the lifetime in seconds of a spark
+	TrailSparkLife = 0.5
+)
+
+var (
+	RgbTrailCometHead = color.LemonYellow
+	RgbTrailCometTail = color.DarkAmber
+	RgbTrailSparkHot  = color.White
+	RgbTrailSparkCool = color.Amber
+)
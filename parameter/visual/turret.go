@@ -0,0 +1,15 @@
+package visual
+
+import (
+	"github.com/lixenwraith/color"
+)
+
+// TurretChar marks a turret cell
+const TurretChar = '⊕' // U+2295 Circled Plus
+
+// Turret state colors
+var (
+	RgbTurretArmed  = color.Cyan      // Word bound, waiting to be typed
+	RgbTurretPrimed = color.Gold      // Word typed, waiting for a target
+	RgbTurretRearm  = color.SlateGray // Between words
+)
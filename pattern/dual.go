@@ -3,11 +3,11 @@ package pattern
 import (
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
-	"github.com/lixenwraith/vi-fighter/cmd/ascimage/ascimage"
+	"github.com/lixenwraith/vi-fighter/asset/vfimg"
 )
 
-// FromDualModeImage converts dual-mode image to pattern using specified color mode
-func FromDualModeImage(img *ascimage.DualModeImage, colorMode terminal.ColorMode) PatternResult {
+// FromDualModeImage converts a .vfimg image to pattern using specified color mode
+func FromDualModeImage(img *vfimg.Image, colorMode terminal.ColorMode) PatternResult {
 	if img == nil || len(img.Cells) == 0 {
 		return PatternResult{}
 	}
@@ -62,7 +62,7 @@ func FromDualModeImage(img *ascimage.DualModeImage, colorMode terminal.ColorMode
 
 // LoadDualModePattern loads a .vfimg file and converts to pattern
 func LoadDualModePattern(path string, colorMode terminal.ColorMode) (PatternResult, error) {
-	img, err := ascimage.LoadDualMode(path)
+	img, err := vfimg.LoadFile(path)
 	if err != nil {
 		return PatternResult{}, err
 	}
@@ -55,9 +55,9 @@ func ApplyCollision(
 	// Apply based on mode
 	switch profile.Mode {
 	case ImpulseAdditive:
-		ApplyImpulse(k, impulseX, impulseY)
+		k.ApplyImpulse(impulseX, impulseY)
 	case ImpulseOverride:
-		SetImpulse(k, impulseX, impulseY)
+		k.SetVelocity(impulseX, impulseY)
 	}
 
 }
@@ -92,9 +92,9 @@ func ApplyOffsetCollision(
 	// Apply based on mode
 	switch profile.Mode {
 	case ImpulseAdditive:
-		ApplyImpulse(k, impulseX, impulseY)
+		k.ApplyImpulse(impulseX, impulseY)
 	case ImpulseOverride:
-		SetImpulse(k, impulseX, impulseY)
+		k.SetVelocity(impulseX, impulseY)
 	}
 }
 
@@ -5,27 +5,6 @@ import (
 	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
-// Integrate performs physics integration: v = v + a*dt; p = p + v*dt
-func Integrate(k *core.Kinetic, dt int64) (x, y int) {
-	k.VelX += vmath.Mul(k.AccelX, dt)
-	k.VelY += vmath.Mul(k.AccelY, dt)
-	k.PreciseX += vmath.Mul(k.VelX, dt)
-	k.PreciseY += vmath.Mul(k.VelY, dt)
-	return vmath.ToInt(k.PreciseX), vmath.ToInt(k.PreciseY)
-}
-
-// ApplyImpulse adds velocity delta (momentum transfer)
-func ApplyImpulse(k *core.Kinetic, vx, vy int64) {
-	k.VelX += vx
-	k.VelY += vy
-}
-
-// SetImpulse overrides velocity (hard redirect/stun)
-func SetImpulse(k *core.Kinetic, vx, vy int64) {
-	k.VelX = vx
-	k.VelY = vy
-}
-
 // ReflectBoundsX handles horizontal boundary collision, returns true if reflection occurred
 // Clamps to centered position within valid cell range [minX, maxX)
 func ReflectBoundsX(k *core.Kinetic, minX, maxX int) bool {
@@ -66,13 +45,3 @@ func ReflectBounds(k *core.Kinetic, width, height int) bool {
 	ry := ReflectBoundsY(k, 0, height)
 	return rx || ry
 }
-
-// GridPos returns current integer grid position
-func GridPos(k *core.Kinetic) (x, y int) {
-	return vmath.ToInt(k.PreciseX), vmath.ToInt(k.PreciseY)
-}
-
-// SetGridPos sets precise position from integer grid coordinates (centered)
-func SetGridPos(k *core.Kinetic, x, y int) {
-	k.PreciseX, k.PreciseY = vmath.CenteredFromGrid(x, y)
-}
\ No newline at end of file
@@ -0,0 +1,138 @@
+package physics
+
+import (
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// WallHit describes the first blocked cell along a swept path
+type WallHit struct {
+	X, Y             int // Blocked cell
+	FreeX, FreeY     int // Last unblocked cell before the hit, equals X, Y when the start is blocked
+	NormalX, NormalY int // Face normal pointing back along the path (-1, 0, 1), zero when the start is blocked
+}
+
+// SweepWalls traverses every cell from (x1, y1) to (x2, y2) in Q32.32 and reports the first blocked one
+// Uses supercover traversal so fast movers cannot tunnel through single-cell walls
+func SweepWalls(x1, y1, x2, y2 int64, blocked WallQueryFunc) (WallHit, bool) {
+	t := vmath.NewGridTraverser(x1, y1, x2, y2)
+	first := true
+	var freeX, freeY int
+
+	for t.Next() {
+		x, y := t.Pos()
+		if blocked(x, y) {
+			hit := WallHit{X: x, Y: y, FreeX: x, FreeY: y}
+			if !first {
+				hit.FreeX, hit.FreeY = freeX, freeY
+				hit.NormalX, hit.NormalY = freeX-x, freeY-y
+			}
+			return hit, true
+		}
+		freeX, freeY = x, y
+		first = false
+	}
+	return WallHit{}, false
+}
+
+// ReflectOffWall moves k to the center of the free cell and reflects velocity off the hit face
+// Diagonal normals (corner hits) reflect both axes; a blocked start reverses velocity
+// restitution: velocity retained after bounce (Scale = elastic)
+func ReflectOffWall(k *core.Kinetic, hit WallHit, restitution int64) {
+	k.PreciseX, k.PreciseY = vmath.CenteredFromGrid(hit.FreeX, hit.FreeY)
+
+	nx, ny := hit.NormalX, hit.NormalY
+	if nx == 0 && ny == 0 {
+		k.VelX = -vmath.Mul(k.VelX, restitution)
+		k.VelY = -vmath.Mul(k.VelY, restitution)
+		return
+	}
+	// Only flip components moving into the face so grazing hits do not reverse
+	if nx != 0 && (k.VelX > 0) != (nx > 0) {
+		k.VelX = -vmath.Mul(k.VelX, restitution)
+	}
+	if ny != 0 && (k.VelY > 0) != (ny > 0) {
+		k.VelY = -vmath.Mul(k.VelY, restitution)
+	}
+}
+
+// AvoidWalls steers k away from walls ahead using three feelers along its velocity
+// The center feeler reaches lookahead (Q32.32 cells), the side feelers ~27° off it reach 3/4 of that
+// Push grows as the nearest hit closes in, up to accel (Q32.32 cells/sec²) at contact
+// Returns the push strength (Scale at contact, 0 when clear) so callers can yield other steering
+func AvoidWalls(k *core.Kinetic, lookahead, accel, dt int64, blocked WallQueryFunc) int64 {
+	dirX, dirY := vmath.Normalize2D(k.VelX, k.VelY)
+	if dirX == 0 && dirY == 0 {
+		return 0
+	}
+	perpX, perpY := vmath.Perpendicular(dirX, dirY)
+
+	sideLen := lookahead * 3 / 4
+	center, centerHit := feelerProximity(k, dirX, dirY, lookahead, blocked)
+	minus, _ := feelerProximity(k, dirX-perpX/2, dirY-perpY/2, sideLen, blocked)
+	plus, _ := feelerProximity(k, dirX+perpX/2, dirY+perpY/2, sideLen, blocked)
+
+	strength := max(center, minus, plus)
+	if strength == 0 {
+		return 0
+	}
+
+	// A wall dead ahead turns toward its nearest end, otherwise toward the clearer feeler
+	var side int64
+	if center > 0 {
+		side = gapSide(centerHit, perpX, perpY, vmath.ToInt(lookahead), blocked)
+	}
+	if side == 0 {
+		side = minus - plus
+	}
+	if side == 0 {
+		side = vmath.Scale
+	}
+	side = vmath.Sign(side)
+
+	push := vmath.Mul(accel, strength)
+	k.VelX += vmath.Mul(vmath.Mul(perpX, side), vmath.Mul(push, dt))
+	k.VelY += vmath.Mul(vmath.Mul(perpY, side), vmath.Mul(push, dt))
+
+	// Brake into a wall dead ahead so the turn has room to complete
+	brake := vmath.Mul(accel, center) / 2
+	k.VelX -= vmath.Mul(dirX, vmath.Mul(brake, dt))
+	k.VelY -= vmath.Mul(dirY, vmath.Mul(brake, dt))
+	return strength
+}
+
+// feelerProximity casts a feeler of length along (dirX, dirY) from k and returns
+// Scale at contact falling to 0 at the tip, 0 when clear
+func feelerProximity(k *core.Kinetic, dirX, dirY, length int64, blocked WallQueryFunc) (int64, WallHit) {
+	endX := k.PreciseX + vmath.Mul(dirX, length)
+	endY := k.PreciseY + vmath.Mul(dirY, length)
+	hit, ok := SweepWalls(k.PreciseX, k.PreciseY, endX, endY, blocked)
+	if !ok || length <= 0 {
+		return 0, hit
+	}
+
+	cx, cy := vmath.CenteredFromGrid(hit.X, hit.Y)
+	dist := vmath.Magnitude(cx-k.PreciseX, cy-k.PreciseY)
+	if dist >= length {
+		return 0, hit
+	}
+	return vmath.Scale - vmath.Div(dist, length), hit
+}
+
+// gapSide scans along the hit face up to reach cells each way for the nearest opening
+// Returns a value whose sign is the perpendicular side of the opening, 0 if none is in reach
+func gapSide(hit WallHit, perpX, perpY int64, reach int, blocked WallQueryFunc) int64 {
+	// Scan across the face: along Y for a vertical face, along X otherwise
+	ax, ay := 1, 0
+	if hit.NormalX != 0 {
+		ax, ay = 0, 1
+	}
+	for d := 1; d <= reach; d++ {
+		for _, s := range [2]int{d, -d} {
+			if !blocked(hit.X+ax*s, hit.Y+ay*s) {
+				return int64(ax*s)*perpX + int64(ay*s)*perpY
+			}
+		}
+	}
+	return 0
+}
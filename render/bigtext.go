@@ -0,0 +1,30 @@
+package render
+
+import (
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/asset"
+)
+
+// BigText draws text in the splash font as background color at map coordinates, one cell per pixel
+// Glyphs are placed by asset.SplashGlyph metrics, so proportional entries tighten the spacing
+// Returns the total pen advance
+func BigText(ctx *RenderContext, buf *RenderBuffer, mapX, mapY int, text []rune, bg color.RGB) int {
+	pen := mapX
+	for _, r := range text {
+		bitmap, m := asset.SplashGlyph(r)
+		originX := pen + int(m.Bearing)
+		for row, bits := range bitmap {
+			for col := range asset.SplashGlyphWidth {
+				// MSB-first: bit 15 = column 0
+				if bits&(1<<(15-col)) == 0 {
+					continue
+				}
+				if screenX, screenY, visible := ctx.MapToScreen(originX+col, mapY+row); visible {
+					buf.SetBgOnly(screenX, screenY, bg)
+				}
+			}
+		}
+		pen += int(m.Advance)
+	}
+	return pen - mapX
+}
@@ -0,0 +1,35 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset"
+)
+
+// Zero metrics keep the monospaced grid; an entry moves both the ink and the pen
+func TestBigTextMetrics(t *testing.T) {
+	ctx := RenderContext{ViewportWidth: 40, ViewportHeight: 12}
+	inked := func(b *RenderBuffer, x int) bool { return b.cells[1*40+x].Bg == color.White }
+
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 40, 12)
+	if w := BigText(&ctx, b, 0, 0, []rune("!!"), color.White); w != 2*asset.SplashGlyphWidth {
+		t.Fatalf("monospaced width = %d", w)
+	}
+	if !inked(b, 5) || !inked(b, 17) || inked(b, 9) {
+		t.Error("monospaced '!' not at columns 5 and 17")
+	}
+
+	saved := asset.SplashFontMetrics['!'-32]
+	defer func() { asset.SplashFontMetrics['!'-32] = saved }()
+	asset.SplashFontMetrics['!'-32] = asset.GlyphMetrics{Bearing: -4, Advance: 4}
+
+	b = NewRenderBuffer(terminal.ColorModeTrueColor, 40, 12)
+	if w := BigText(&ctx, b, 0, 0, []rune("!!"), color.White); w != 8 {
+		t.Fatalf("proportional width = %d", w)
+	}
+	if !inked(b, 1) || !inked(b, 5) || inked(b, 17) {
+		t.Error("proportional '!' not at columns 1 and 5")
+	}
+}
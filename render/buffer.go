@@ -3,6 +3,7 @@ package render
 import (
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/core"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
 )
 
@@ -24,6 +25,20 @@ type RenderBuffer struct {
 	height       int
 	bgOverlay    backgroundOverlay
 	finalizeFunc func(*RenderBuffer)
+	crt          bool
+	dither       visual.DitherMode
+	ditherFrame  uint64
+
+	// Damage tracking: per-row [min, max) spans drawn this frame and last frame
+	// Cells outside both spans still hold last frame's finalized empty cell
+	damage      bool
+	drawn       []bool
+	spanMin     []int
+	spanMax     []int
+	prevMin     []int
+	prevMax     []int
+	fullDamage  bool
+	lastEmptyBg color.RGB
 }
 
 // NewRenderBuffer creates a buffer with the specified dimensions
@@ -34,10 +49,13 @@ func NewRenderBuffer(colorMode terminal.ColorMode, width, height int) *RenderBuf
 		cells:       make([]terminal.Cell, size),
 		touched:     make([]bool, size),
 		masks:       make([]uint8, size),
+		drawn:       make([]bool, size),
 		currentMask: visual.MaskNone,
 		width:       width,
 		height:      height,
+		fullDamage:  true,
 	}
+	b.resizeSpans()
 	if colorMode == terminal.ColorModeTrueColor && visual.OcclusionDimEnabled {
 		b.finalizeFunc = finalizeTrueColorOcclusion
 	} else {
@@ -53,27 +71,108 @@ func (b *RenderBuffer) Resize(width, height int) {
 		b.cells = make([]terminal.Cell, size)
 		b.touched = make([]bool, size)
 		b.masks = make([]uint8, size)
+		b.drawn = make([]bool, size)
 	} else {
 		b.cells = b.cells[:size]
 		b.touched = b.touched[:size]
 		b.masks = b.masks[:size]
+		b.drawn = b.drawn[:size]
 	}
 	b.width = width
 	b.height = height
+	b.resizeSpans()
+	b.Invalidate()
 	b.Clear()
 }
 
+// resizeSpans sizes the per-row damage spans to the buffer height
+func (b *RenderBuffer) resizeSpans() {
+	if cap(b.spanMin) < b.height {
+		b.spanMin = make([]int, b.height)
+		b.spanMax = make([]int, b.height)
+		b.prevMin = make([]int, b.height)
+		b.prevMax = make([]int, b.height)
+	}
+	b.spanMin = b.spanMin[:b.height]
+	b.spanMax = b.spanMax[:b.height]
+	b.prevMin = b.prevMin[:b.height]
+	b.prevMax = b.prevMax[:b.height]
+	resetSpans(b.spanMin, b.spanMax, b.width)
+	resetSpans(b.prevMin, b.prevMax, b.width)
+}
+
+// resetSpans marks every row empty
+func resetSpans(mins, maxs []int, width int) {
+	for y := range mins {
+		mins[y] = width
+		maxs[y] = 0
+	}
+}
+
+// SetDamageTracking enables per-row damage tracking; off restores full clear and finalize each frame
+func (b *RenderBuffer) SetDamageTracking(enabled bool) {
+	if b.damage != enabled {
+		b.damage = enabled
+		b.Invalidate()
+	}
+}
+
+// Invalidate forces the next frame to clear and finalize every cell
+func (b *RenderBuffer) Invalidate() {
+	b.fullDamage = true
+}
+
 // Clear resets all cells to empty and zero-initializes metadata
+// With damage tracking only last frame's spans are reset; cells are zeroed lazily on first draw
 func (b *RenderBuffer) Clear() {
+	b.currentMask = visual.MaskNone
+	b.bgOverlay = backgroundOverlay{}
 	if len(b.cells) == 0 {
 		return
 	}
 
-	clear(b.cells)
-	clear(b.touched)
-	clear(b.masks)
-	b.currentMask = visual.MaskNone
-	b.bgOverlay = backgroundOverlay{}
+	// CRT and temporal dither rewrite every cell after finalize, so clean cells cannot be trusted across frames
+	if !b.damage || b.crt || b.dither == visual.DitherTemporal {
+		b.fullDamage = true
+	}
+
+	if b.fullDamage {
+		clear(b.cells)
+		clear(b.touched)
+		clear(b.masks)
+		clear(b.drawn)
+		resetSpans(b.spanMin, b.spanMax, b.width)
+		resetSpans(b.prevMin, b.prevMax, b.width)
+		return
+	}
+
+	for y := range b.height {
+		lo, hi := b.spanMin[y], b.spanMax[y]
+		if lo < hi {
+			row := y * b.width
+			clear(b.touched[row+lo : row+hi])
+			clear(b.masks[row+lo : row+hi])
+			clear(b.drawn[row+lo : row+hi])
+		}
+	}
+	b.spanMin, b.prevMin = b.prevMin, b.spanMin
+	b.spanMax, b.prevMax = b.prevMax, b.spanMax
+	resetSpans(b.spanMin, b.spanMax, b.width)
+}
+
+// mark records a draw at idx, zeroing the cell on its first draw this frame
+func (b *RenderBuffer) mark(x, y, idx int) {
+	if b.drawn[idx] {
+		return
+	}
+	b.drawn[idx] = true
+	b.cells[idx] = terminal.Cell{}
+	if x < b.spanMin[y] {
+		b.spanMin[y] = x
+	}
+	if x >= b.spanMax[y] {
+		b.spanMax[y] = x + 1
+	}
 }
 
 // SetWriteMask sets the mask for subsequent draw operations
@@ -91,9 +190,25 @@ func (b *RenderBuffer) SetBackgroundOverlay(c color.RGB, intensity float64) {
 	}
 }
 
+// SetDither selects the 256-color background dither applied at flush, persists across Clear
+// Ignored in TrueColor mode
+func (b *RenderBuffer) SetDither(mode visual.DitherMode) {
+	b.dither = mode
+}
+
+// SetCRT enables the CRT post effect applied at flush, persists across Clear
+func (b *RenderBuffer) SetCRT(enabled bool) {
+	b.crt = enabled
+}
+
+// Bounds returns the buffer as a rectangle at the origin, for clipping
+func (b *RenderBuffer) Bounds() core.Rect {
+	return core.Rect{Width: b.width, Height: b.height}
+}
+
 // inBounds returns true if coordinates are within buffer
 func (b *RenderBuffer) inBounds(x, y int) bool {
-	return x >= 0 && x < b.width && y >= 0 && y < b.height
+	return b.Bounds().Contains(x, y)
 }
 
 // === COMPOSITOR API ===
@@ -104,6 +219,7 @@ func (b *RenderBuffer) Set(x, y int, mainRune rune, fg, bg color.RGB, mode Blend
 		return
 	}
 	idx := y*b.width + x
+	b.mark(x, y, idx)
 	dst := &b.cells[idx]
 
 	op := uint8(mode) & 0x0F
@@ -173,6 +289,7 @@ func (b *RenderBuffer) SetFgOnly(x, y int, r rune, fg color.RGB, attrs terminal.
 		return
 	}
 	idx := y*b.width + x
+	b.mark(x, y, idx)
 	dst := &b.cells[idx]
 
 	dst.Rune = r
@@ -188,6 +305,7 @@ func (b *RenderBuffer) SetBgOnly(x, y int, bg color.RGB) {
 		return
 	}
 	idx := y*b.width + x
+	b.mark(x, y, idx)
 
 	b.cells[idx].Bg = bg
 	b.touched[idx] = true
@@ -200,6 +318,7 @@ func (b *RenderBuffer) SetWithBg(x, y int, r rune, fg, bg color.RGB) {
 		return
 	}
 	idx := y*b.width + x
+	b.mark(x, y, idx)
 	dst := &b.cells[idx]
 
 	dst.Rune = r
@@ -218,6 +337,7 @@ func (b *RenderBuffer) SetBg256(x, y int, paletteIdx uint8) {
 		return
 	}
 	idx := y*b.width + x
+	b.mark(x, y, idx)
 	b.cells[idx].Bg = color.RGB{R: paletteIdx, G: 0, B: 0}
 	// Preserve fg-related attrs, add bg256
 	b.cells[idx].Attrs = (b.cells[idx].Attrs & terminal.AttrFg256) | terminal.AttrBg256
@@ -296,45 +416,84 @@ func (b *RenderBuffer) MutateGrayscale(intensity float64, targetMask, excludeMas
 // finalize delegates to the appropriate implementation selected at init
 func (b *RenderBuffer) finalize() {
 	b.finalizeFunc(b)
+	b.fullDamage = false
 }
 
-// finalizeTrueColorOcclusion handles untouched backgrounds and occlusion dimming
-func finalizeTrueColorOcclusion(b *RenderBuffer) {
-	// Pre-compute untouched background once
-	untouchedBg := visual.RgbBackground
+// untouchedBg returns the background of cells no renderer wrote a background to
+// A change from last frame invalidates every clean cell
+func (b *RenderBuffer) untouchedBg() color.RGB {
+	bg := visual.RgbBackground
 	if b.bgOverlay.active {
-		untouchedBg = color.Scale(b.bgOverlay.bgColor, b.bgOverlay.intensity)
+		bg = color.Scale(b.bgOverlay.bgColor, b.bgOverlay.intensity)
 	}
+	if bg != b.lastEmptyBg {
+		b.lastEmptyBg = bg
+		b.fullDamage = true
+	}
+	return bg
+}
 
-	for i := range b.cells {
-		if !b.touched[i] {
-			b.cells[i].Bg = untouchedBg
-			continue
-		}
-		// Occlusion dimming for touched cells with foreground
-		// if b.cells[i].Rune != 0 && b.masks[i]&visual.OcclusionDimMask != 0 && b.masks[i]&visual.MaskUI == 0 {
-		if b.cells[i].Rune != 0 && b.masks[i]&visual.OcclusionDimMask != 0 {
-			b.cells[i].Bg = color.Scale(b.cells[i].Bg, visual.OcclusionDimFactor)
+// forDamaged calls fn for each row range needing finalize: all cells on full damage,
+// else the union of this and last frame's spans
+func (b *RenderBuffer) forDamaged(fn func(start, end int)) {
+	if b.fullDamage {
+		fn(0, len(b.cells))
+		return
+	}
+	for y := range b.height {
+		lo := min(b.spanMin[y], b.prevMin[y])
+		hi := max(b.spanMax[y], b.prevMax[y])
+		if lo < hi {
+			fn(y*b.width+lo, y*b.width+hi)
 		}
 	}
 }
 
+// finalizeTrueColorOcclusion handles untouched backgrounds and occlusion dimming
+func finalizeTrueColorOcclusion(b *RenderBuffer) {
+	// Pre-compute untouched background once
+	untouchedBg := b.untouchedBg()
+
+	b.forDamaged(func(start, end int) {
+		for i := start; i < end; i++ {
+			if !b.drawn[i] {
+				b.cells[i] = terminal.Cell{Bg: untouchedBg}
+				continue
+			}
+			if !b.touched[i] {
+				b.cells[i].Bg = untouchedBg
+				continue
+			}
+			// Occlusion dimming for touched cells with foreground
+			// if b.cells[i].Rune != 0 && b.masks[i]&visual.OcclusionDimMask != 0 && b.masks[i]&visual.MaskUI == 0 {
+			if b.cells[i].Rune != 0 && b.masks[i]&visual.OcclusionDimMask != 0 {
+				b.cells[i].Bg = color.Scale(b.cells[i].Bg, visual.OcclusionDimFactor)
+			}
+		}
+	})
+}
+
 // finalizeSimple handles untouched backgrounds only (256-color or no occlusion)
 func finalizeSimple(b *RenderBuffer) {
-	untouchedBg := visual.RgbBackground
-	if b.bgOverlay.active {
-		untouchedBg = color.Scale(b.bgOverlay.bgColor, b.bgOverlay.intensity)
-	}
-
-	for i := range b.cells {
-		if !b.touched[i] {
-			b.cells[i].Bg = untouchedBg
+	untouchedBg := b.untouchedBg()
+
+	b.forDamaged(func(start, end int) {
+		for i := start; i < end; i++ {
+			if !b.drawn[i] {
+				b.cells[i] = terminal.Cell{Bg: untouchedBg}
+			} else if !b.touched[i] {
+				b.cells[i].Bg = untouchedBg
+			}
 		}
-	}
+	})
 }
 
 // FlushToTerminal writes render buffer to terminal
 func (b *RenderBuffer) FlushToTerminal(term terminal.Terminal) {
 	b.finalize()
+	if b.crt {
+		b.applyCRT()
+	}
+	b.applyDither()
 	term.Flush(b.cells, b.width, b.height)
 }
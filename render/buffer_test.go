@@ -0,0 +1,65 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// Damage tracking must produce the same finalized frame as a full clear and finalize
+func TestDamageTracking_MatchesFullRedraw(t *testing.T) {
+	const w, h = 40, 12
+	for _, mode := range []terminal.ColorMode{terminal.ColorModeTrueColor, terminal.ColorMode256} {
+		full := NewRenderBuffer(mode, w, h)
+		tracked := NewRenderBuffer(mode, w, h)
+		tracked.SetDamageTracking(true)
+		rng := vmath.NewFastRand(42)
+
+		for frame := range 60 {
+			// Same random draw list for both buffers
+			type op struct {
+				x, y, kind int
+				c          color.RGB
+			}
+			ops := make([]op, rng.Intn(30))
+			for i := range ops {
+				ops[i] = op{
+					x:    rng.Intn(w),
+					y:    rng.Intn(h),
+					kind: rng.Intn(4),
+					c:    color.RGB{R: uint8(rng.Intn(256)), G: uint8(rng.Intn(256)), B: uint8(rng.Intn(256))},
+				}
+			}
+			overlay := frame%17 == 5
+
+			for _, b := range []*RenderBuffer{full, tracked} {
+				b.Clear()
+				if overlay {
+					b.SetBackgroundOverlay(color.White, 0.5)
+				}
+				for _, o := range ops {
+					switch o.kind {
+					case 0:
+						b.SetFgOnly(o.x, o.y, 'a', o.c, terminal.AttrNone)
+					case 1:
+						b.SetBgOnly(o.x, o.y, o.c)
+					case 2:
+						b.SetWithBg(o.x, o.y, 'b', o.c, o.c)
+					case 3:
+						b.Set(o.x, o.y, 0, o.c, o.c, BlendAlphaBg, 0.5, terminal.AttrNone)
+					}
+				}
+				b.finalize()
+			}
+
+			for i := range full.cells {
+				if full.cells[i] != tracked.cells[i] {
+					t.Fatalf("mode %v frame %d cell (%d,%d): full %+v, tracked %+v",
+						mode, frame, i%w, i/w, full.cells[i], tracked.cells[i])
+				}
+			}
+		}
+	}
+}
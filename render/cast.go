@@ -0,0 +1,176 @@
+package render
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+)
+
+// castStyle is the SGR state a cast frame has set, colors already resolved
+type castStyle struct {
+	fg, bg color.RGB
+	attrs  terminal.Attr
+}
+
+// castAttrs are the attributes kept in casts; reverse, dim, and palette flags are folded into colors
+const castAttrs = terminal.AttrBold | terminal.AttrItalic | terminal.AttrUnderline | terminal.AttrBlink
+
+// CastWriter encodes frames as an asciinema v2 cast: a JSON header line, then one
+// [seconds, "o", data] event per frame drawing only the cells that changed
+// Size changes are written as "r" events followed by a full redraw
+type CastWriter struct {
+	w *bufio.Writer
+
+	prev  Screenshot
+	style castStyle
+	buf   []byte
+}
+
+// NewCastWriter writes the cast header for a width×height screen
+func NewCastWriter(w io.Writer, width, height int) (*CastWriter, error) {
+	c := &CastWriter{w: bufio.NewWriter(w)}
+	header, err := json.Marshal(struct {
+		Version int               `json:"version"`
+		Width   int               `json:"width"`
+		Height  int               `json:"height"`
+		Env     map[string]string `json:"env"`
+	}{2, width, height, map[string]string{"TERM": "xterm-256color"}})
+	if err != nil {
+		return nil, err
+	}
+	c.w.Write(header)
+	c.w.WriteByte('\n')
+	c.prev.Width, c.prev.Height = width, height
+	return c, nil
+}
+
+// Frame writes the cells of shot that differ from the last frame, at offset at from the
+// start of the cast; unchanged frames write nothing
+func (c *CastWriter) Frame(at time.Duration, shot Screenshot) error {
+	full := c.prev.Cells == nil
+	if shot.Width != c.prev.Width || shot.Height != c.prev.Height {
+		if err := c.event(at, "r", fmt.Sprintf("%dx%d", shot.Width, shot.Height)); err != nil {
+			return err
+		}
+		full = true
+	}
+
+	// A full redraw clears to the terminal default, so the first cell always sets its style
+	c.buf = c.buf[:0]
+	styled := !full
+	if full {
+		c.buf = append(c.buf, "\x1b[?25l\x1b[0m\x1b[2J"...)
+	}
+	curX, curY := -1, -1
+	for y := range shot.Height {
+		for x := range shot.Width {
+			i := y*shot.Width + x
+			cell := shot.Cells[i]
+			if !full && cell == c.prev.Cells[i] {
+				continue
+			}
+			if x != curX || y != curY {
+				c.buf = fmt.Appendf(c.buf, "\x1b[%d;%dH", y+1, x+1)
+			}
+			fg, bg := shotColors(cell)
+			if style := (castStyle{fg, bg, cell.Attrs & castAttrs}); !styled || style != c.style {
+				c.buf = appendSGR(c.buf, style)
+				c.style = style
+				styled = true
+			}
+			r := cell.Rune
+			if r <= ' ' {
+				r = ' '
+			}
+			c.buf = append(c.buf, string(r)...)
+			curX, curY = x+1, y
+		}
+	}
+
+	c.prev = NewScreenshot(shot.Cells, shot.Width, shot.Height)
+	if len(c.buf) == 0 {
+		return nil
+	}
+	return c.event(at, "o", string(c.buf))
+}
+
+// appendSGR resets attributes, then sets style
+func appendSGR(buf []byte, s castStyle) []byte {
+	buf = append(buf, "\x1b[0"...)
+	for _, a := range [...]struct {
+		attr terminal.Attr
+		code string
+	}{{terminal.AttrBold, ";1"}, {terminal.AttrItalic, ";3"}, {terminal.AttrUnderline, ";4"}, {terminal.AttrBlink, ";5"}} {
+		if s.attrs&a.attr != 0 {
+			buf = append(buf, a.code...)
+		}
+	}
+	buf = fmt.Appendf(buf, ";38;2;%d;%d;%d;48;2;%d;%d;%dm", s.fg.R, s.fg.G, s.fg.B, s.bg.R, s.bg.G, s.bg.B)
+	return buf
+}
+
+// event writes one [time, code, data] line; the buffered writer keeps the first error
+func (c *CastWriter) event(at time.Duration, code, data string) error {
+	quoted, _ := json.Marshal(data)
+	c.w.WriteByte('[')
+	c.w.WriteString(strconv.FormatFloat(at.Seconds(), 'f', 6, 64))
+	c.w.WriteString(`, "` + code + `", `)
+	c.w.Write(quoted)
+	_, err := c.w.WriteString("]\n")
+	return err
+}
+
+// Flush writes buffered events
+func (c *CastWriter) Flush() error {
+	return c.w.Flush()
+}
+
+// CastTerminal wraps a terminal and tees every flush into an asciinema cast, timed by the
+// wall clock from creation, while still driving the wrapped terminal
+type CastTerminal struct {
+	terminal.Terminal
+
+	mu    sync.Mutex
+	cast  *CastWriter
+	start time.Time
+	err   error // First write error; recording stops, the terminal keeps running
+}
+
+// NewCastTerminal wraps term, writing the cast header for its current size to w
+func NewCastTerminal(term terminal.Terminal, w io.Writer) (*CastTerminal, error) {
+	width, height := term.Size()
+	cast, err := NewCastWriter(w, width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &CastTerminal{Terminal: term, cast: cast, start: time.Now()}, nil
+}
+
+// Flush records the frame, then forwards it
+func (t *CastTerminal) Flush(cells []terminal.Cell, width, height int) {
+	t.mu.Lock()
+	if t.err == nil {
+		t.err = t.cast.Frame(time.Since(t.start), Screenshot{Cells: cells[:width*height], Width: width, Height: height})
+	}
+	t.mu.Unlock()
+
+	t.Terminal.Flush(cells, width, height)
+}
+
+// Close writes buffered events and reports the first recording error
+// Closing the underlying writer is left to the caller
+func (t *CastTerminal) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		return t.err
+	}
+	return t.cast.Flush()
+}
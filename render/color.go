@@ -12,10 +12,7 @@ var HeatGradientLUT [256]color.RGB
 
 func init() {
 	// Pre-calculate heat gradient
-	for i := range 256 {
-		progress := float64(i) / 255.0
-		HeatGradientLUT[i] = calculateHeatColor(progress)
-	}
+	heatGradient.Fill(HeatGradientLUT[:])
 }
 
 // LerpRGBFixed delegates to color.LerpFixed; retains vmath-typed signature for callers
@@ -34,60 +31,28 @@ func RainbowIndexColor(index, total int64, fallback color.RGB) color.RGB {
 	return HeatGradientLUT[lutIdx]
 }
 
-// calculateHeatColor returns the color for a given position in the heat meter gradient
-// Progress is 0.0 to 1.0, representing position from start to end
-// Only used for LUT generation
-func calculateHeatColor(progress float64) color.RGB {
-	if progress < 0.0 {
-		progress = 0.0
-	}
-	if progress > 1.0 {
-		progress = 1.0
-	}
+// HueRamp returns the color at hue on a 0-255 ramp, wrapping
+// Hues share one OKLCh lightness and chroma so the ramp has no bright or dark bands
+func HueRamp(hue int) color.RGB {
+	return hueRampLUT[hue&0xFF]
+}
 
-	// Rainbow gradient: deep red → orange → yellow → green → cyan → blue → purple/pink
-	switch {
-	case progress < visual.GradientSeg1: // Red to Orange
-		t := progress / visual.GradientSeg1
-		return color.RGB{
-			R: uint8(float64(visual.GradientDeepRed.R) + (float64(visual.GradientOrange.R)-float64(visual.GradientDeepRed.R))*t),
-			G: uint8(float64(visual.GradientDeepRed.G) + (float64(visual.GradientOrange.G)-float64(visual.GradientDeepRed.G))*t),
-			B: uint8(float64(visual.GradientDeepRed.B) + (float64(visual.GradientOrange.B)-float64(visual.GradientDeepRed.B))*t),
-		}
-	case progress < visual.GradientSeg2: // Orange to Yellow
-		t := (progress - visual.GradientSeg1) / (visual.GradientSeg2 - visual.GradientSeg1)
-		return color.RGB{
-			R: uint8(float64(visual.GradientOrange.R) + (float64(visual.GradientYellow.R)-float64(visual.GradientOrange.R))*t),
-			G: uint8(float64(visual.GradientOrange.G) + (float64(visual.GradientYellow.G)-float64(visual.GradientOrange.G))*t),
-			B: uint8(float64(visual.GradientOrange.B) + (float64(visual.GradientYellow.B)-float64(visual.GradientOrange.B))*t),
-		}
-	case progress < visual.GradientSeg3: // Yellow to Green
-		t := (progress - visual.GradientSeg2) / (visual.GradientSeg3 - visual.GradientSeg2)
-		return color.RGB{
-			R: uint8(float64(visual.GradientYellow.R) + (float64(visual.GradientGreen.R)-float64(visual.GradientYellow.R))*t),
-			G: uint8(float64(visual.GradientYellow.G) + (float64(visual.GradientGreen.G)-float64(visual.GradientYellow.G))*t),
-			B: uint8(float64(visual.GradientYellow.B) + (float64(visual.GradientGreen.B)-float64(visual.GradientYellow.B))*t),
-		}
-	case progress < visual.GradientSeg4: // Green to Cyan
-		t := (progress - visual.GradientSeg3) / (visual.GradientSeg4 - visual.GradientSeg3)
-		return color.RGB{
-			R: uint8(float64(visual.GradientGreen.R) + (float64(visual.GradientCyan.R)-float64(visual.GradientGreen.R))*t),
-			G: uint8(float64(visual.GradientGreen.G) + (float64(visual.GradientCyan.G)-float64(visual.GradientGreen.G))*t),
-			B: uint8(float64(visual.GradientGreen.B) + (float64(visual.GradientCyan.B)-float64(visual.GradientGreen.B))*t),
-		}
-	case progress < visual.GradientSeg5: // Cyan to Blue
-		t := (progress - visual.GradientSeg4) / (visual.GradientSeg5 - visual.GradientSeg4)
-		return color.RGB{
-			R: uint8(float64(visual.GradientCyan.R) + (float64(visual.GradientBlue.R)-float64(visual.GradientCyan.R))*t),
-			G: uint8(float64(visual.GradientCyan.G) + (float64(visual.GradientBlue.G)-float64(visual.GradientCyan.G))*t),
-			B: uint8(float64(visual.GradientCyan.B) + (float64(visual.GradientBlue.B)-float64(visual.GradientCyan.B))*t),
-		}
-	default: // Blue to Purple/Pink
-		t := (progress - visual.GradientSeg5) / (1.0 - visual.GradientSeg5)
-		return color.RGB{
-			R: uint8(float64(visual.GradientBlue.R) + (float64(visual.GradientPurple.R)-float64(visual.GradientBlue.R))*t),
-			G: uint8(float64(visual.GradientBlue.G) + (float64(visual.GradientPurple.G)-float64(visual.GradientBlue.G))*t),
-			B: uint8(float64(visual.GradientBlue.B) + (float64(visual.GradientPurple.B)-float64(visual.GradientBlue.B))*t),
-		}
+// hueRampLUT holds the pre-calculated HueRamp colors
+var hueRampLUT = func() (lut [256]color.RGB) {
+	for i := range lut {
+		lut[i] = OKLCh(visual.HueRampLightness, visual.HueRampChroma, float64(i)*360/256).RGB()
 	}
+	return lut
+}()
+
+// heatGradient is the heat meter rainbow: deep red → orange → yellow → green → cyan → blue → purple/pink
+// Interpolated in OKLab so segments blend without muddy or banded midpoints
+var heatGradient = Gradient{
+	{Pos: 0, Color: visual.GradientDeepRed},
+	{Pos: visual.GradientSeg1, Color: visual.GradientOrange},
+	{Pos: visual.GradientSeg2, Color: visual.GradientYellow},
+	{Pos: visual.GradientSeg3, Color: visual.GradientGreen},
+	{Pos: visual.GradientSeg4, Color: visual.GradientCyan},
+	{Pos: visual.GradientSeg5, Color: visual.GradientBlue},
+	{Pos: 1, Color: visual.GradientPurple},
 }
@@ -0,0 +1,238 @@
+package render
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+)
+
+// === HSV / HSL ===
+
+// HSV is hue in degrees [0, 360), saturation and value in [0, 1]
+type HSV struct {
+	H, S, V float64
+}
+
+// HSL is hue in degrees [0, 360), saturation and lightness in [0, 1]
+type HSL struct {
+	H, S, L float64
+}
+
+// rgbHue returns the hue in degrees and the channel max and min in [0, 1]
+func rgbHue(c color.RGB) (h, maxC, minC float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC = max(r, g, b)
+	minC = min(r, g, b)
+	d := maxC - minC
+	switch {
+	case d == 0:
+		h = 0
+	case maxC == r:
+		h = 60 * math.Mod((g-b)/d, 6)
+	case maxC == g:
+		h = 60 * ((b-r)/d + 2)
+	default:
+		h = 60 * ((r-g)/d + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return h, maxC, minC
+}
+
+// hueRGB builds a color from hue, chroma, and the offset added to every channel
+func hueRGB(h, chroma, m float64) color.RGB {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	hp := h / 60
+	x := chroma * (1 - math.Abs(math.Mod(hp, 2)-1))
+	var r, g, b float64
+	switch int(hp) {
+	case 0:
+		r, g, b = chroma, x, 0
+	case 1:
+		r, g, b = x, chroma, 0
+	case 2:
+		r, g, b = 0, chroma, x
+	case 3:
+		r, g, b = 0, x, chroma
+	case 4:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	return color.RGB{R: unitToU8(r + m), G: unitToU8(g + m), B: unitToU8(b + m)}
+}
+
+// ToHSV converts sRGB to HSV
+func ToHSV(c color.RGB) HSV {
+	h, maxC, minC := rgbHue(c)
+	s := 0.0
+	if maxC > 0 {
+		s = (maxC - minC) / maxC
+	}
+	return HSV{H: h, S: s, V: maxC}
+}
+
+// RGB converts HSV to sRGB
+func (c HSV) RGB() color.RGB {
+	chroma := c.V * c.S
+	return hueRGB(c.H, chroma, c.V-chroma)
+}
+
+// ToHSL converts sRGB to HSL
+func ToHSL(c color.RGB) HSL {
+	h, maxC, minC := rgbHue(c)
+	l := (maxC + minC) / 2
+	s := 0.0
+	if d := maxC - minC; d > 0 {
+		s = d / (1 - math.Abs(2*l-1))
+	}
+	return HSL{H: h, S: s, L: l}
+}
+
+// RGB converts HSL to sRGB
+func (c HSL) RGB() color.RGB {
+	chroma := (1 - math.Abs(2*c.L-1)) * c.S
+	return hueRGB(c.H, chroma, c.L-chroma/2)
+}
+
+// === OKLab ===
+
+// OKLab is a perceptual color space: equal steps look equally different, so lerps do not band
+// L is lightness in [0, 1], A and B are roughly in [-0.4, 0.4]
+type OKLab struct {
+	L, A, B float64
+}
+
+// ToOKLab converts sRGB to OKLab
+func ToOKLab(c color.RGB) OKLab {
+	r, g, b := srgbLinear[c.R], srgbLinear[c.G], srgbLinear[c.B]
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return OKLab{
+		L: 0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		A: 1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		B: 0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// linear returns the linear-light sRGB channels, unclamped
+func (c OKLab) linear() (r, g, b float64) {
+	l := c.L + 0.3963377774*c.A + 0.2158037573*c.B
+	m := c.L - 0.1055613458*c.A - 0.0638541728*c.B
+	s := c.L - 0.0894841775*c.A - 1.2914855480*c.B
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return 4.0767416621*l - 3.3077115913*m + 0.2309699292*s,
+		-1.2684380046*l + 2.6097574011*m - 0.3413193965*s,
+		-0.0041960863*l - 0.7034186147*m + 1.7076147010*s
+}
+
+// InGamut reports whether the color is representable in sRGB
+func (c OKLab) InGamut() bool {
+	const eps = 1e-4
+	r, g, b := c.linear()
+	return r >= -eps && r <= 1+eps && g >= -eps && g <= 1+eps && b >= -eps && b <= 1+eps
+}
+
+// RGB converts OKLab to sRGB, clipping out-of-gamut channels
+func (c OKLab) RGB() color.RGB {
+	r, g, b := c.linear()
+	return color.RGB{R: linearToU8(r), G: linearToU8(g), B: linearToU8(b)}
+}
+
+// OKLCh returns the OKLab color at lightness l, chroma, and hue in degrees
+// Chroma is reduced until the color fits sRGB, keeping lightness and hue exact
+func OKLCh(l, chroma, hue float64) OKLab {
+	rad := hue * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	c := OKLab{L: l, A: chroma * cos, B: chroma * sin}
+	if c.InGamut() {
+		return c
+	}
+
+	lo, hi := 0.0, chroma
+	for range 16 {
+		mid := (lo + hi) / 2
+		if (OKLab{L: l, A: mid * cos, B: mid * sin}).InGamut() {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return OKLab{L: l, A: lo * cos, B: lo * sin}
+}
+
+// Lerp interpolates from c to other in OKLab
+func (c OKLab) Lerp(other OKLab, t float64) OKLab {
+	return OKLab{
+		L: c.L + (other.L-c.L)*t,
+		A: c.A + (other.A-c.A)*t,
+		B: c.B + (other.B-c.B)*t,
+	}
+}
+
+// LerpOKLab blends two sRGB colors through OKLab, t in [0, 1]
+func LerpOKLab(a, b color.RGB, t float64) color.RGB {
+	return ToOKLab(a).Lerp(ToOKLab(b), t).RGB()
+}
+
+// === Gradient ===
+
+// GradientStop is a keyframe color at a position in [0, 1]
+type GradientStop struct {
+	Pos   float64
+	Color color.RGB
+}
+
+// Gradient interpolates keyframes in OKLab; stops must be sorted by Pos
+type Gradient []GradientStop
+
+// At returns the color at t, clamped to the first and last stop
+func (g Gradient) At(t float64) color.RGB {
+	if len(g) == 0 {
+		return color.RGB{}
+	}
+	if t <= g[0].Pos {
+		return g[0].Color
+	}
+	for i := 1; i < len(g); i++ {
+		if t < g[i].Pos {
+			a, b := g[i-1], g[i]
+			return LerpOKLab(a.Color, b.Color, (t-a.Pos)/(b.Pos-a.Pos))
+		}
+	}
+	return g[len(g)-1].Color
+}
+
+// Fill samples the gradient evenly into lut, first and last entries land on the end stops
+func (g Gradient) Fill(lut []color.RGB) {
+	n := len(lut)
+	for i := range lut {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		lut[i] = g.At(t)
+	}
+}
+
+// unitToU8 maps [0, 1] to a rounded, clamped byte
+func unitToU8(v float64) uint8 {
+	return uint8(math.Round(max(0, min(1, v)) * 255))
+}
+
+// linearToU8 gamma-encodes a linear-light channel to sRGB
+func linearToU8(v float64) uint8 {
+	v = max(0, min(1, v))
+	if v <= 0.0031308 {
+		return unitToU8(12.92 * v)
+	}
+	return unitToU8(1.055*math.Pow(v, 1/2.4) - 0.055)
+}
@@ -0,0 +1,56 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+)
+
+// near reports whether every channel differs by at most 1, the rounding slack of a float round trip
+func near(a, b color.RGB) bool {
+	d := func(x, y uint8) bool { return x-y <= 1 || y-x <= 1 }
+	return d(a.R, b.R) && d(a.G, b.G) && d(a.B, b.B)
+}
+
+func TestColorSpaceRoundTrip(t *testing.T) {
+	for r := 0; r < 256; r += 51 {
+		for g := 0; g < 256; g += 51 {
+			for b := 0; b < 256; b += 51 {
+				c := color.RGB{R: uint8(r), G: uint8(g), B: uint8(b)}
+				if got := ToHSV(c).RGB(); !near(got, c) {
+					t.Errorf("HSV %v -> %v", c, got)
+				}
+				if got := ToHSL(c).RGB(); !near(got, c) {
+					t.Errorf("HSL %v -> %v", c, got)
+				}
+				if got := ToOKLab(c).RGB(); !near(got, c) {
+					t.Errorf("OKLab %v -> %v", c, got)
+				}
+			}
+		}
+	}
+}
+
+func TestGradientEndpoints(t *testing.T) {
+	g := Gradient{{Pos: 0, Color: color.RGB{R: 255}}, {Pos: 1, Color: color.RGB{B: 255}}}
+	if got := g.At(-1); got != g[0].Color {
+		t.Errorf("At(-1) = %v", got)
+	}
+	if got := g.At(1); got != g[1].Color {
+		t.Errorf("At(1) = %v", got)
+	}
+
+	var lut [8]color.RGB
+	g.Fill(lut[:])
+	if lut[0] != g[0].Color || lut[7] != g[1].Color {
+		t.Errorf("Fill endpoints %v %v", lut[0], lut[7])
+	}
+}
+
+func TestOKLChInGamut(t *testing.T) {
+	for h := 0.0; h < 360; h += 15 {
+		if c := OKLCh(0.75, 0.4, h); !c.InGamut() {
+			t.Errorf("hue %v out of gamut: %+v", h, c)
+		}
+	}
+}
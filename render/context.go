@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 )
 
 // RenderContext provides frame state for renderers, passed by value
@@ -43,6 +44,10 @@ type RenderContext struct {
 	// Screen dimensions (terminal size)
 	ScreenWidth  int
 	ScreenHeight int
+
+	// Quality is the adaptive quality hint, set by the orchestrator each frame
+	// Renderers drop expensive effects below QualityFull
+	Quality visual.Quality
 }
 
 // NewRenderContextFromGame creates a RenderContext from engine.GameContext and TimeResource
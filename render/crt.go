@@ -0,0 +1,75 @@
+package render
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+)
+
+// crtLUT maps [scanline][channel][value] through the phosphor curve and scanline dimming
+var crtLUT = buildCRTLUT()
+
+func buildCRTLUT() *[2][3][256]uint8 {
+	var lut [2][3][256]uint8
+	for line := range lut {
+		scale := 1.0
+		if line == 1 {
+			scale = visual.CRTScanlineFactor
+		}
+		for ch := range lut[line] {
+			gain := visual.CRTPhosphorGain[ch] * scale
+			for v := range lut[line][ch] {
+				out := math.Pow(float64(v)/255.0, visual.CRTGamma) * gain * 255.0
+				lut[line][ch][v] = uint8(min(255, out+0.5))
+			}
+		}
+	}
+	return &lut
+}
+
+// crtCurve maps a color through one scanline's channel curves
+func crtCurve(lut *[3][256]uint8, c color.RGB) color.RGB {
+	return color.RGB{R: lut[0][c.R], G: lut[1][c.G], B: lut[2][c.B]}
+}
+
+// applyCRT runs the CRT filter over finalized cells: horizontal bleed, phosphor curve, scanlines
+// TrueColor only; 256-color cells are skipped to prevent palette index corruption
+func (b *RenderBuffer) applyCRT() {
+	if b.colorMode != terminal.ColorModeTrueColor || b.width == 0 {
+		return
+	}
+
+	for y := 0; y < b.height; y++ {
+		lut := &crtLUT[y&1]
+		row := b.cells[y*b.width : (y+1)*b.width]
+
+		// Bleed reads the left neighbor's pre-filter colors
+		prevBg := row[0].Bg
+		prevGlow := false
+		var prevFg color.RGB
+
+		for x := range row {
+			cell := &row[x]
+			bg, fg := cell.Bg, cell.Fg
+			glyph := cell.Rune != 0 && cell.Rune != ' '
+
+			if cell.Attrs&terminal.AttrBg256 == 0 {
+				if x > 0 {
+					cell.Bg = color.Lerp(cell.Bg, prevBg, visual.CRTBleed)
+					if prevGlow {
+						cell.Bg = color.Lerp(cell.Bg, prevFg, visual.CRTGlow)
+					}
+				}
+				cell.Bg = crtCurve(lut, cell.Bg)
+			}
+			if cell.Attrs&terminal.AttrFg256 == 0 {
+				cell.Fg = crtCurve(lut, cell.Fg)
+			}
+
+			prevBg, prevFg = bg, fg
+			prevGlow = glyph && cell.Attrs&terminal.AttrFg256 == 0
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package render
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+)
+
+// bayer4 is the 4x4 ordered dither matrix as thresholds in (0, 1)
+var bayer4 = [4][4]float64{
+	{0.5 / 16, 8.5 / 16, 2.5 / 16, 10.5 / 16},
+	{12.5 / 16, 4.5 / 16, 14.5 / 16, 6.5 / 16},
+	{3.5 / 16, 11.5 / 16, 1.5 / 16, 9.5 / 16},
+	{15.5 / 16, 7.5 / 16, 13.5 / 16, 5.5 / 16},
+}
+
+// reflectChannel mirrors palette value p through c, clamped to a channel
+func reflectChannel(c, p uint8) uint8 {
+	return uint8(max(0, min(255, 2*int(c)-int(p))))
+}
+
+// ditherIndex returns the xterm-256 entry nearest c or the one on the far side of c,
+// the far one when the fraction of the way c lies toward it exceeds threshold
+func ditherIndex(c color.RGB, threshold float64) uint8 {
+	near := color.RGBTo256(c)
+	p := xterm256Colors[near-16]
+
+	// Mirroring the quantization error through c lands closest to the entry across from p
+	far := color.RGBTo256(color.RGB{R: reflectChannel(c.R, p.R), G: reflectChannel(c.G, p.G), B: reflectChannel(c.B, p.B)})
+	if far == near {
+		return near
+	}
+	q := xterm256Colors[far-16]
+
+	dr, dg, db := float64(q.R)-float64(p.R), float64(q.G)-float64(p.G), float64(q.B)-float64(p.B)
+	t := ((float64(c.R)-float64(p.R))*dr + (float64(c.G)-float64(p.G))*dg + (float64(c.B)-float64(p.B))*db) /
+		(dr*dr + dg*dg + db*db)
+	if t > threshold {
+		return far
+	}
+	return near
+}
+
+// applyDither converts finalized RGB backgrounds to palette indices, alternating between the
+// two nearest entries across cells, and across frames in temporal mode, to show the color between
+// Only backgrounds a renderer wrote are dithered, the flat empty field stays solid
+// 256-color only; foregrounds are left to the terminal since glyph color shimmer reads as noise
+func (b *RenderBuffer) applyDither() {
+	if b.dither == visual.DitherOff || b.colorMode != terminal.ColorMode256 {
+		return
+	}
+
+	var phase float64
+	if b.dither == visual.DitherTemporal {
+		b.ditherFrame++
+		phase = math.Mod(float64(b.ditherFrame)*visual.DitherTemporalStep, 1)
+	}
+
+	for y := range b.height {
+		row := b.cells[y*b.width : (y+1)*b.width]
+		touched := b.touched[y*b.width : (y+1)*b.width]
+		thresholds := &bayer4[y&3]
+		for x := range row {
+			cell := &row[x]
+			if !touched[x] || cell.Attrs&terminal.AttrBg256 != 0 {
+				continue
+			}
+			threshold := thresholds[x&3] + phase
+			if threshold >= 1 {
+				threshold--
+			}
+			cell.Bg = color.RGB{R: ditherIndex(cell.Bg, threshold)}
+			cell.Attrs |= terminal.AttrBg256
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+)
+
+// A 4x4 dither block must average close to the source color, and a palette color stays solid
+func TestDitherIndex_BlockAverage(t *testing.T) {
+	for _, c := range []color.RGB{
+		{R: 13, G: 13, B: 13},   // Between gray ramp steps
+		{R: 115, G: 0, B: 0},    // Between cube levels
+		{R: 200, G: 150, B: 60}, // Off both
+	} {
+		var sum [3]float64
+		for y := range 4 {
+			for x := range 4 {
+				p := xterm256Colors[ditherIndex(c, bayer4[y][x])-16]
+				sum[0] += float64(p.R)
+				sum[1] += float64(p.G)
+				sum[2] += float64(p.B)
+			}
+		}
+		avg := color.RGB{R: uint8(sum[0]/16 + 0.5), G: uint8(sum[1]/16 + 0.5), B: uint8(sum[2]/16 + 0.5)}
+		nearest := xterm256Colors[color.RGBTo256(c)-16]
+		if color.RedmeanDistance(c, avg) >= color.RedmeanDistance(c, nearest) {
+			t.Errorf("%v: dithered average %v no closer than nearest entry %v", c, avg, nearest)
+		}
+	}
+
+	solid := color.RGB{R: 135, G: 175, B: 95}
+	want := color.RGBTo256(solid)
+	for y := range 4 {
+		for x := range 4 {
+			if got := ditherIndex(solid, bayer4[y][x]); got != want {
+				t.Fatalf("palette color dithered to %d at (%d,%d), want %d", got, x, y, want)
+			}
+		}
+	}
+}
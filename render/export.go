@@ -0,0 +1,425 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	stdcolor "image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/asset"
+)
+
+// Screenshot cell size in pixels; the bundled 12×12 font is drawn at double height
+const (
+	ShotCellWidth  = 12
+	ShotCellHeight = 24
+)
+
+// shotFontSize is the SVG font size for ShotCellHeight rows, baseline at shotBaseline
+const (
+	shotFontSize = 20
+	shotBaseline = 18
+)
+
+// Screenshot is a finalized frame of terminal cells that can be rasterized without a terminal
+type Screenshot struct {
+	Cells         []terminal.Cell
+	Width, Height int
+}
+
+// NewScreenshot copies cells so the caller may keep drawing into them
+func NewScreenshot(cells []terminal.Cell, width, height int) Screenshot {
+	return Screenshot{Cells: append([]terminal.Cell(nil), cells[:width*height]...), Width: width, Height: height}
+}
+
+// Crop returns the region at (x, y) of size w×h, clipped to the screenshot
+func (s Screenshot) Crop(x, y, w, h int) Screenshot {
+	x, y = max(x, 0), max(y, 0)
+	w, h = max(min(w, s.Width-x), 0), max(min(h, s.Height-y), 0)
+	out := Screenshot{Cells: make([]terminal.Cell, 0, w*h), Width: w, Height: h}
+	for row := y; row < y+h; row++ {
+		out.Cells = append(out.Cells, s.Cells[row*s.Width+x:row*s.Width+x+w]...)
+	}
+	return out
+}
+
+// Save writes the screenshot as PNG or SVG, chosen by the path extension
+func (s Screenshot) Save(path string) error {
+	var write func(io.Writer) error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png":
+		write = s.WritePNG
+	case ".svg":
+		write = s.WriteSVG
+	default:
+		return fmt.Errorf("screenshot %s: extension must be .png or .svg", path)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// shotColors returns the displayed colors of a cell: palette indices expanded, reverse and dim applied
+func shotColors(c terminal.Cell) (fg, bg color.RGB) {
+	fg, bg = c.Fg, c.Bg
+	if c.Attrs&terminal.AttrFg256 != 0 {
+		fg = xterm256RGB(c.Fg.R)
+	}
+	if c.Attrs&terminal.AttrBg256 != 0 {
+		bg = xterm256RGB(c.Bg.R)
+	}
+	if c.Attrs&terminal.AttrReverse != 0 {
+		fg, bg = bg, fg
+	}
+	if c.Attrs&terminal.AttrDim != 0 {
+		fg = color.Lerp(bg, fg, 0.6)
+	}
+	return fg, bg
+}
+
+// xtermANSI are the xterm defaults for the theme-defined indices 0-15
+var xtermANSI = [16]color.RGB{
+	{R: 0, G: 0, B: 0}, {R: 205, G: 0, B: 0}, {R: 0, G: 205, B: 0}, {R: 205, G: 205, B: 0},
+	{R: 0, G: 0, B: 238}, {R: 205, G: 0, B: 205}, {R: 0, G: 205, B: 205}, {R: 229, G: 229, B: 229},
+	{R: 127, G: 127, B: 127}, {R: 255, G: 0, B: 0}, {R: 0, G: 255, B: 0}, {R: 255, G: 255, B: 0},
+	{R: 92, G: 92, B: 255}, {R: 255, G: 0, B: 255}, {R: 0, G: 255, B: 255}, {R: 255, G: 255, B: 255},
+}
+
+// xterm256RGB maps a 256-color palette index to the color xterm displays for it
+func xterm256RGB(idx uint8) color.RGB {
+	if idx < 16 {
+		return xtermANSI[idx]
+	}
+	return xterm256Colors[idx-16]
+}
+
+// === PNG ===
+
+// WritePNG writes the rasterized screenshot as PNG
+func (s Screenshot) WritePNG(w io.Writer) error {
+	return png.Encode(w, s.Image())
+}
+
+// Image rasterizes the screenshot with the bundled bitmap font
+// ASCII uses asset.SplashFont; block elements, braille, and light box drawing are drawn
+// geometrically, and other runes show as an outlined box
+func (s Screenshot) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, s.Width*ShotCellWidth, s.Height*ShotCellHeight))
+	for y := range s.Height {
+		for x := range s.Width {
+			c := s.Cells[y*s.Width+x]
+			fg, bg := shotColors(c)
+			px, py := x*ShotCellWidth, y*ShotCellHeight
+			fillRect(img, px, py, ShotCellWidth, ShotCellHeight, bg)
+			drawGlyph(img, px, py, c.Rune, fg, bg, c.Attrs&terminal.AttrBold != 0)
+			if c.Attrs&terminal.AttrUnderline != 0 {
+				fillRect(img, px, py+ShotCellHeight-2, ShotCellWidth, 1, fg)
+			}
+		}
+	}
+	return img
+}
+
+func fillRect(img *image.RGBA, x, y, w, h int, c color.RGB) {
+	rgba := stdcolor.RGBA{R: c.R, G: c.G, B: c.B, A: 255}
+	for j := y; j < y+h; j++ {
+		for i := x; i < x+w; i++ {
+			img.SetRGBA(i, j, rgba)
+		}
+	}
+}
+
+// drawGlyph draws r into the cell at (px, py) over an already filled background
+func drawGlyph(img *image.RGBA, px, py int, r rune, fg, bg color.RGB, bold bool) {
+	switch {
+	case r <= ' ':
+	case r <= 126:
+		rows := &asset.SplashFont[r-32]
+		for row, bits := range rows {
+			for col := range ShotCellWidth {
+				if bits&(0x8000>>col) == 0 {
+					continue
+				}
+				fillRect(img, px+col, py+row*2, 1, 2, fg)
+				if bold && col+1 < ShotCellWidth {
+					fillRect(img, px+col+1, py+row*2, 1, 2, fg)
+				}
+			}
+		}
+	case r >= 0x2580 && r <= 0x259F:
+		drawBlock(img, px, py, r, fg, bg)
+	case r >= 0x2800 && r <= 0x28FF:
+		drawBraille(img, px, py, uint8(r-0x2800), fg)
+	default:
+		if arms, ok := boxArms[r]; ok {
+			drawBox(img, px, py, arms, fg)
+			return
+		}
+		// Tofu: outlined box for runes the bundled font lacks
+		fillRect(img, px+2, py+4, ShotCellWidth-4, 1, fg)
+		fillRect(img, px+2, py+ShotCellHeight-5, ShotCellWidth-4, 1, fg)
+		fillRect(img, px+2, py+4, 1, ShotCellHeight-8, fg)
+		fillRect(img, px+ShotCellWidth-3, py+4, 1, ShotCellHeight-8, fg)
+	}
+}
+
+// drawBlock draws U+2580-U+259F: eighth bars, halves, shades, and quadrants
+func drawBlock(img *image.RGBA, px, py int, r rune, fg, bg color.RGB) {
+	const w, h = ShotCellWidth, ShotCellHeight
+	hw, hh := w/2, h/2
+	switch {
+	case r == 0x2580: // Upper half
+		fillRect(img, px, py, w, hh, fg)
+	case r <= 0x2588: // Lower 1/8 to full
+		n := h * int(r-0x2580) / 8
+		fillRect(img, px, py+h-n, w, n, fg)
+	case r <= 0x258F: // Left 7/8 to 1/8
+		n := w * int(0x2590-r) / 8
+		fillRect(img, px, py, n, h, fg)
+	case r == 0x2590: // Right half
+		fillRect(img, px+hw, py, w-hw, h, fg)
+	case r <= 0x2593: // Light, medium, dark shade
+		fillRect(img, px, py, w, h, color.Lerp(bg, fg, float64(r-0x2590)*0.25))
+	case r == 0x2594: // Upper 1/8
+		fillRect(img, px, py, w, h/8, fg)
+	case r == 0x2595: // Right 1/8
+		fillRect(img, px+w-w/8, py, w/8, h, fg)
+	default: // Quadrants
+		quads := [...]uint8{0b0100, 0b1000, 0b0001, 0b1101, 0b1001, 0b0111, 0b1011, 0b0010, 0b0110, 0b1110}
+		q := quads[r-0x2596] // Bits: upper left, upper right, lower left, lower right
+		if q&0b0001 != 0 {
+			fillRect(img, px, py, hw, hh, fg)
+		}
+		if q&0b0010 != 0 {
+			fillRect(img, px+hw, py, w-hw, hh, fg)
+		}
+		if q&0b0100 != 0 {
+			fillRect(img, px, py+hh, hw, h-hh, fg)
+		}
+		if q&0b1000 != 0 {
+			fillRect(img, px+hw, py+hh, w-hw, h-hh, fg)
+		}
+	}
+}
+
+// brailleDots maps braille pattern bits to dot column and row
+var brailleDots = [8][2]int{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {0, 3}, {1, 3}}
+
+func drawBraille(img *image.RGBA, px, py int, bits uint8, fg color.RGB) {
+	for i, d := range brailleDots {
+		if bits&(1<<i) != 0 {
+			fillRect(img, px+3+d[0]*5, py+2+d[1]*6, 2, 3, fg)
+		}
+	}
+}
+
+// Box drawing arms
+const (
+	armLeft uint8 = 1 << iota
+	armRight
+	armUp
+	armDown
+)
+
+// boxArms maps light, heavy, double, and rounded box drawing runes to the arms drawn
+// All weights render as one light stroke
+var boxArms = func() map[rune]uint8 {
+	const (
+		h = armLeft | armRight
+		v = armUp | armDown
+	)
+	return map[rune]uint8{
+		'─': h, '━': h, '═': h, '│': v, '┃': v, '║': v,
+		'┌': armRight | armDown, '┏': armRight | armDown, '╔': armRight | armDown, '╭': armRight | armDown,
+		'┐': armLeft | armDown, '┓': armLeft | armDown, '╗': armLeft | armDown, '╮': armLeft | armDown,
+		'└': armRight | armUp, '┗': armRight | armUp, '╚': armRight | armUp, '╰': armRight | armUp,
+		'┘': armLeft | armUp, '┛': armLeft | armUp, '╝': armLeft | armUp, '╯': armLeft | armUp,
+		'├': v | armRight, '┣': v | armRight, '╠': v | armRight,
+		'┤': v | armLeft, '┫': v | armLeft, '╣': v | armLeft,
+		'┬': h | armDown, '┳': h | armDown, '╦': h | armDown,
+		'┴': h | armUp, '┻': h | armUp, '╩': h | armUp,
+		'┼': h | v, '╋': h | v, '╬': h | v,
+		'╴': armLeft, '╶': armRight, '╵': armUp, '╷': armDown,
+	}
+}()
+
+func drawBox(img *image.RGBA, px, py int, arms uint8, fg color.RGB) {
+	cx, cy := px+ShotCellWidth/2, py+ShotCellHeight/2
+	if arms&armLeft != 0 {
+		fillRect(img, px, cy, cx-px+1, 1, fg)
+	}
+	if arms&armRight != 0 {
+		fillRect(img, cx, cy, px+ShotCellWidth-cx, 1, fg)
+	}
+	if arms&armUp != 0 {
+		fillRect(img, cx, py, 1, cy-py+1, fg)
+	}
+	if arms&armDown != 0 {
+		fillRect(img, cx, cy, 1, py+ShotCellHeight-cy, fg)
+	}
+}
+
+// === SVG ===
+
+// WriteSVG writes the screenshot as rect backgrounds and monospace text runs
+// Runs are stretched to the cell grid, so alignment holds whatever font the viewer picks
+func (s Screenshot) WriteSVG(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		s.Width*ShotCellWidth, s.Height*ShotCellHeight, s.Width*ShotCellWidth, s.Height*ShotCellHeight)
+	fmt.Fprintf(bw, `<g font-family="monospace" font-size="%d" xml:space="preserve">`+"\n", shotFontSize)
+
+	for y := range s.Height {
+		row := s.Cells[y*s.Width : (y+1)*s.Width]
+
+		// Background runs of one color
+		for x := 0; x < s.Width; {
+			_, bg := shotColors(row[x])
+			end := x + 1
+			for end < s.Width {
+				if _, next := shotColors(row[end]); next != bg {
+					break
+				}
+				end++
+			}
+			fmt.Fprintf(bw, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+				x*ShotCellWidth, y*ShotCellHeight, (end-x)*ShotCellWidth, ShotCellHeight, svgColor(bg))
+			x = end
+		}
+
+		// Text runs of one foreground and style, broken at blanks
+		for x := 0; x < s.Width; {
+			if isBlank(row[x].Rune) {
+				x++
+				continue
+			}
+			fg, _ := shotColors(row[x])
+			style := row[x].Attrs & (terminal.AttrBold | terminal.AttrItalic | terminal.AttrUnderline)
+			end := x + 1
+			for end < s.Width && !isBlank(row[end].Rune) && row[end].Attrs&(terminal.AttrBold|terminal.AttrItalic|terminal.AttrUnderline) == style {
+				if next, _ := shotColors(row[end]); next != fg {
+					break
+				}
+				end++
+			}
+
+			var text strings.Builder
+			for _, c := range row[x:end] {
+				text.WriteRune(c.Rune)
+			}
+			fmt.Fprintf(bw, `<text x="%d" y="%d" textLength="%d" lengthAdjust="spacingAndGlyphs" fill="%s"%s>`,
+				x*ShotCellWidth, y*ShotCellHeight+shotBaseline, (end-x)*ShotCellWidth, svgColor(fg), svgStyle(style))
+			_, _ = svgEscaper.WriteString(bw, text.String())
+			bw.WriteString("</text>\n")
+			x = end
+		}
+	}
+
+	bw.WriteString("</g>\n</svg>\n")
+	return bw.Flush()
+}
+
+// isBlank reports runes with nothing to draw; control runes are invalid in XML
+func isBlank(r rune) bool {
+	return r <= ' '
+}
+
+func svgColor(c color.RGB) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func svgStyle(attrs terminal.Attr) string {
+	var b strings.Builder
+	if attrs&terminal.AttrBold != 0 {
+		b.WriteString(` font-weight="bold"`)
+	}
+	if attrs&terminal.AttrItalic != 0 {
+		b.WriteString(` font-style="italic"`)
+	}
+	if attrs&terminal.AttrUnderline != 0 {
+		b.WriteString(` text-decoration="underline"`)
+	}
+	return b.String()
+}
+
+var svgEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// === Capture ===
+
+// CaptureTerminal wraps a terminal and keeps a copy of the last flushed frame
+// Lets the game and sandboxes take screenshots without touching their draw paths
+type CaptureTerminal struct {
+	terminal.Terminal
+
+	mu   sync.Mutex
+	shot Screenshot
+}
+
+// NewCaptureTerminal wraps term
+func NewCaptureTerminal(term terminal.Terminal) *CaptureTerminal {
+	return &CaptureTerminal{Terminal: term}
+}
+
+// Flush copies the frame, then forwards it
+func (t *CaptureTerminal) Flush(cells []terminal.Cell, width, height int) {
+	t.mu.Lock()
+	if cap(t.shot.Cells) < width*height {
+		t.shot.Cells = make([]terminal.Cell, width*height)
+	}
+	t.shot.Cells = t.shot.Cells[:width*height]
+	copy(t.shot.Cells, cells)
+	t.shot.Width, t.shot.Height = width, height
+	t.mu.Unlock()
+
+	t.Terminal.Flush(cells, width, height)
+}
+
+// Screenshot returns a copy of the last flushed frame, empty before the first flush
+func (t *CaptureTerminal) Screenshot() Screenshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return NewScreenshot(t.shot.Cells, t.shot.Width, t.shot.Height)
+}
+
+// headlessTerminal is a terminal.Terminal with no device: fixed size, no input, output dropped
+type headlessTerminal struct {
+	mode          terminal.ColorMode
+	width, height int
+}
+
+// NewHeadlessTerminal returns a capturing terminal with no device behind it, for rendering
+// frames without a tty; PollEvent reports EventClosed
+func NewHeadlessTerminal(mode terminal.ColorMode, width, height int) *CaptureTerminal {
+	return NewCaptureTerminal(&headlessTerminal{mode: mode, width: width, height: height})
+}
+
+func (t *headlessTerminal) Init() error                             { return nil }
+func (t *headlessTerminal) Fini()                                   {}
+func (t *headlessTerminal) Size() (int, int)                        { return t.width, t.height }
+func (t *headlessTerminal) ResizeChan() <-chan terminal.ResizeEvent { return nil }
+func (t *headlessTerminal) ColorMode() terminal.ColorMode           { return t.mode }
+func (t *headlessTerminal) Flush([]terminal.Cell, int, int)         {}
+func (t *headlessTerminal) Clear(color.RGB)                         {}
+func (t *headlessTerminal) SetCursorVisible(bool)                   {}
+func (t *headlessTerminal) MoveCursor(int, int)                     {}
+func (t *headlessTerminal) Sync()                                   {}
+func (t *headlessTerminal) PollEvent() terminal.Event {
+	return terminal.Event{Type: terminal.EventClosed}
+}
+func (t *headlessTerminal) PostEvent(terminal.Event)              {}
+func (t *headlessTerminal) SetMouseMode(terminal.MouseMode) error { return nil }
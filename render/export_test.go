@@ -0,0 +1,86 @@
+package render
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+)
+
+func TestScreenshotExport(t *testing.T) {
+	red := color.RGB{R: 200, G: 10, B: 10}
+	cells := []terminal.Cell{
+		{Rune: '<', Fg: color.White, Bg: red},
+		{Rune: '█', Fg: color.White, Bg: red},
+		{Rune: 'x', Fg: color.RGB{R: 196}, Bg: color.RGB{R: 16}, Attrs: terminal.AttrFg256 | terminal.AttrBg256},
+	}
+	shot := NewScreenshot(cells, 3, 1)
+
+	var buf bytes.Buffer
+	if err := shot.WritePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 3*ShotCellWidth || b.Dy() != ShotCellHeight {
+		t.Fatalf("bounds %v", b)
+	}
+	probe := func(x, y int) color.RGB {
+		r, g, b, _ := img.At(x, y).RGBA()
+		return color.RGB{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8)}
+	}
+	if got := probe(0, 0); got != red {
+		t.Errorf("background = %v, want %v", got, red)
+	}
+	if got := probe(ShotCellWidth+1, 1); got != color.White {
+		t.Errorf("full block = %v, want foreground", got)
+	}
+	if got := probe(2*ShotCellWidth, 0); got != (color.RGB{}) {
+		t.Errorf("palette background = %v, want black", got)
+	}
+
+	buf.Reset()
+	if err := shot.WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	svg := buf.String()
+	for _, want := range []string{`width="24" height="24" fill="#c80a0a"`, `>&lt;█</text>`, `fill="#ff0000">x</text>`} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("svg missing %q", want)
+		}
+	}
+}
+
+func TestCastWriterDiff(t *testing.T) {
+	cells := make([]terminal.Cell, 4*2)
+	for i := range cells {
+		cells[i] = terminal.Cell{Rune: '.', Fg: color.White}
+	}
+
+	var buf bytes.Buffer
+	cast, err := NewCastWriter(&buf, 4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = cast.Frame(0, NewScreenshot(cells, 4, 2))
+	_ = cast.Frame(time.Second, NewScreenshot(cells, 4, 2)) // Unchanged, no event
+	cells[6].Rune = 'x'
+	_ = cast.Frame(2*time.Second, NewScreenshot(cells, 4, 2))
+	if err := cast.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], `{"version":2,"width":4,"height":2`) {
+		t.Fatalf("cast = %q", buf.String())
+	}
+	if want := `[2.000000, "o", "\u001b[2;3Hx"]`; lines[2] != want {
+		t.Errorf("diff event = %s, want %s", lines[2], want)
+	}
+}
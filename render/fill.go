@@ -0,0 +1,200 @@
+package render
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// === Falloff ===
+
+// Falloff shapes intensity over normalized distance t: 1 at the center, 0 at t >= 1
+type Falloff uint8
+
+const (
+	FalloffLinear    Falloff = iota // 1 - t
+	FalloffQuadratic                // (1 - t)², soft glow tail
+	FalloffSmooth                   // Smoothstep, flat center and soft edge
+	FalloffDome                     // 1 - t², sqrt-free from squared distance
+	FalloffDomeSq                   // (1 - t²)², sqrt-free with a sharper edge
+)
+
+// At returns the falloff at normalized distance t
+func (f Falloff) At(t float64) float64 {
+	if t <= 0 {
+		return 1
+	}
+	if t >= 1 {
+		return 0
+	}
+	switch f {
+	case FalloffQuadratic:
+		return (1 - t) * (1 - t)
+	case FalloffSmooth:
+		s := 1 - t
+		return s * s * (3 - 2*s)
+	case FalloffDome:
+		return 1 - t*t
+	case FalloffDomeSq:
+		d := 1 - t*t
+		return d * d
+	default:
+		return 1 - t
+	}
+}
+
+// AtSqFixed returns the Q32.32 falloff from Q32.32 squared normalized distance
+// Dome curves need no square root, the others take one
+func (f Falloff) AtSqFixed(tSq int64) int64 {
+	if tSq <= 0 {
+		return vmath.Scale
+	}
+	if tSq >= vmath.Scale {
+		return 0
+	}
+	switch f {
+	case FalloffDome:
+		return vmath.Scale - tSq
+	case FalloffDomeSq:
+		d := vmath.Scale - tSq
+		return vmath.Mul(d, d)
+	}
+
+	s := vmath.Scale - vmath.Sqrt(tSq)
+	switch f {
+	case FalloffQuadratic:
+		return vmath.Mul(s, s)
+	case FalloffSmooth:
+		return vmath.Mul(vmath.Mul(s, s), 3*vmath.Scale-2*s)
+	default:
+		return s
+	}
+}
+
+// === Gradient Fill ===
+
+// GradientShape selects how FillGradient maps cells onto the gradient
+type GradientShape uint8
+
+const (
+	GradientLinear  GradientShape = iota // Across the rectangle along Angle
+	GradientRadial                       // Outward from the center over Radius
+	GradientCorners                      // Bilinear between the four corner colors
+)
+
+// gradientLUTSize is the stack LUT resolution sampled from Stops per fill
+const gradientLUTSize = 64
+
+// GradientFill describes a background gradient for FillGradient
+type GradientFill struct {
+	Shape GradientShape
+	Stops Gradient // Linear and radial color ramp
+
+	// Linear direction in radians, 0 runs left to right and π/2 top to bottom, aspect-corrected
+	Angle float64
+
+	// Radial center in buffer cells and radius in rows, widened by the cell aspect across columns
+	CX, CY int
+	Radius float64
+	// Falloff fades radial alpha from the center; Outward reverses it for vignettes,
+	// which then also cover cells past the radius at full alpha
+	Falloff Falloff
+	Outward bool
+
+	// Corner colors top-left, top-right, bottom-left, bottom-right, blended in OKLab
+	Corners [4]color.RGB
+
+	// Blend into the background; foreground and runes are never touched
+	Mode  BlendMode
+	Alpha float64
+}
+
+// FillGradient paints the gradient into the backgrounds of the rectangle at x,y, clipped to the buffer
+// Linear and radial fills sample Stops through a stack LUT, so the fill does not allocate
+func (b *RenderBuffer) FillGradient(x, y, w, h int, f *GradientFill) {
+	clip := core.Rect{X: x, Y: y, Width: w, Height: h}.Intersect(b.Bounds())
+	if clip.Empty() || f.Alpha <= 0 {
+		return
+	}
+	x0, y0, x1, y1 := clip.X, clip.Y, clip.Right(), clip.Bottom()
+	mode := BlendMode(uint8(f.Mode)&0x0F | flagBg)
+
+	if f.Shape == GradientCorners {
+		b.fillCorners(x, y, w, h, x0, y0, x1, y1, mode, f)
+		return
+	}
+
+	if len(f.Stops) == 0 {
+		return
+	}
+	var lut [gradientLUTSize]color.RGB
+	f.Stops.Fill(lut[:])
+	sample := func(t float64) color.RGB {
+		return lut[int(max(0, min(1, t))*(gradientLUTSize-1)+0.5)]
+	}
+
+	switch f.Shape {
+	case GradientLinear:
+		// Project cell centers in square units onto the direction, normalized over the rectangle corners
+		dx, dy := math.Cos(f.Angle), math.Sin(f.Angle)*cellAspect
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, c := range [4][2]float64{{0, 0}, {float64(w - 1), 0}, {0, float64(h - 1)}, {float64(w - 1), float64(h - 1)}} {
+			p := c[0]*dx + c[1]*dy
+			lo, hi = min(lo, p), max(hi, p)
+		}
+		span := hi - lo
+		if span <= 0 {
+			span = 1
+		}
+		for cy := y0; cy < y1; cy++ {
+			for cx := x0; cx < x1; cx++ {
+				t := (float64(cx-x)*dx + float64(cy-y)*dy - lo) / span
+				b.Set(cx, cy, 0, color.RGB{}, sample(t), mode, f.Alpha, 0)
+			}
+		}
+
+	case GradientRadial:
+		if f.Radius <= 0 {
+			return
+		}
+		rx, ry := f.Radius*cellAspect, f.Radius
+		if !f.Outward {
+			// Only the ellipse's bounding box can be covered
+			x0, x1 = max(x0, f.CX-int(rx)), min(x1, f.CX+int(rx)+1)
+			y0, y1 = max(y0, f.CY-int(ry)), min(y1, f.CY+int(ry)+1)
+		}
+		for cy := y0; cy < y1; cy++ {
+			ny := float64(cy-f.CY) / ry
+			for cx := x0; cx < x1; cx++ {
+				nx := float64(cx-f.CX) / rx
+				t := math.Sqrt(nx*nx + ny*ny)
+				k := f.Falloff.At(t)
+				if f.Outward {
+					k = 1 - k
+				}
+				if k <= 0 {
+					continue
+				}
+				b.Set(cx, cy, 0, color.RGB{}, sample(t), mode, f.Alpha*k, 0)
+			}
+		}
+	}
+}
+
+// fillCorners blends the four corner colors bilinearly across the full rectangle, drawing the clipped part
+func (b *RenderBuffer) fillCorners(x, y, w, h, x0, y0, x1, y1 int, mode BlendMode, f *GradientFill) {
+	tl, tr := ToOKLab(f.Corners[0]), ToOKLab(f.Corners[1])
+	bl, br := ToOKLab(f.Corners[2]), ToOKLab(f.Corners[3])
+	sx, sy := float64(max(1, w-1)), float64(max(1, h-1))
+
+	for cy := y0; cy < y1; cy++ {
+		v := float64(cy-y) / sy
+		left, right := tl.Lerp(bl, v), tr.Lerp(br, v)
+		for cx := x0; cx < x1; cx++ {
+			c := left.Lerp(right, float64(cx-x)/sx).RGB()
+			b.Set(cx, cy, 0, color.RGB{}, c, mode, f.Alpha, 0)
+		}
+	}
+}
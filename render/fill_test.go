@@ -0,0 +1,53 @@
+package render
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+func TestFalloff_FixedMatchesFloat(t *testing.T) {
+	for _, f := range []Falloff{FalloffLinear, FalloffQuadratic, FalloffSmooth, FalloffDome, FalloffDomeSq} {
+		for i := 0; i <= 20; i++ {
+			d := float64(i) / 20
+			want := f.At(d)
+			got := vmath.ToFloat(f.AtSqFixed(vmath.FromFloat(d * d)))
+			if math.Abs(got-want) > 1e-4 {
+				t.Errorf("falloff %d at %.2f: fixed %.5f, float %.5f", f, d, got, want)
+			}
+		}
+	}
+}
+
+func TestFillGradient_LinearEndpoints(t *testing.T) {
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 20, 4)
+	b.FillGradient(0, 0, 20, 4, &GradientFill{
+		Shape: GradientLinear,
+		Stops: Gradient{{Pos: 0, Color: color.Black}, {Pos: 1, Color: color.White}},
+		Mode:  BlendReplace,
+		Alpha: 1,
+	})
+	if got := b.cells[0].Bg; got != color.Black {
+		t.Errorf("left edge %v, want black", got)
+	}
+	if got := b.cells[19].Bg; got != color.White {
+		t.Errorf("right edge %v, want white", got)
+	}
+	if b.cells[0].Bg != b.cells[3*20].Bg {
+		t.Error("horizontal gradient varies down a column")
+	}
+}
+
+func TestFillGradient_CornersAndClip(t *testing.T) {
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 10, 5)
+	corners := [4]color.RGB{color.Red, color.BrightGreen, color.Blue, color.White}
+	// Rectangle hangs off every edge; visible corners are interior samples
+	b.FillGradient(-5, -2, 20, 9, &GradientFill{Shape: GradientCorners, Corners: corners, Mode: BlendReplace, Alpha: 1})
+	b.FillGradient(0, 0, 10, 5, &GradientFill{Shape: GradientCorners, Corners: corners, Mode: BlendReplace, Alpha: 1})
+	if b.cells[0].Bg != color.Red || b.cells[9].Bg != color.BrightGreen || b.cells[40].Bg != color.Blue || b.cells[49].Bg != color.White {
+		t.Errorf("corners %v %v %v %v", b.cells[0].Bg, b.cells[9].Bg, b.cells[40].Bg, b.cells[49].Bg)
+	}
+}
@@ -1,8 +1,13 @@
 package render
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/status"
 )
 
 type rendererEntry struct {
@@ -17,13 +22,21 @@ type RenderOrchestrator struct {
 	buffer    *RenderBuffer
 	renderers []rendererEntry
 	regCount  int
+
+	quality     qualityGovernor
+	statQuality *atomic.Int64
+	statFlushMs *status.AtomicFloat
+
+	onFlush func(end time.Time)
 }
 
 // NewRenderOrchestrator creates an orchestrator with the given terminal and dimensions
 func NewRenderOrchestrator(term terminal.Terminal, width, height int) *RenderOrchestrator {
+	buffer := NewRenderBuffer(term.ColorMode(), width, height)
+	buffer.SetDamageTracking(true)
 	return &RenderOrchestrator{
 		term:      term,
-		buffer:    NewRenderBuffer(term.ColorMode(), width, height),
+		buffer:    buffer,
 		renderers: make([]rendererEntry, 0, 32),
 	}
 }
@@ -51,6 +64,11 @@ func (o *RenderOrchestrator) Register(r SystemRenderer, priority RenderPriority)
 	o.renderers[pos] = entry
 }
 
+// SetFlushHook registers fn to run after each completed terminal flush with its end time
+func (o *RenderOrchestrator) SetFlushHook(fn func(end time.Time)) {
+	o.onFlush = fn
+}
+
 // Resize updates buffer dimensions and syncs terminal
 func (o *RenderOrchestrator) Resize(width, height int) {
 	o.buffer.Resize(width, height)
@@ -58,11 +76,29 @@ func (o *RenderOrchestrator) Resize(width, height int) {
 }
 
 // RenderFrame executes the render pipeline: clear, render all, flush, show
+// Under output backpressure frames are coalesced: a skipped frame's changes land in the next flushed diff
 func (o *RenderOrchestrator) RenderFrame(ctx RenderContext, world *engine.World) {
+	if o.quality.skip() {
+		return
+	}
+	ctx.Quality = o.quality.level
+
 	// Buffer is orchestrator-owned; no lock needed for clear
 	o.buffer.Clear()
 
 	world.Lock()
+	if o.statQuality == nil {
+		o.statQuality = world.Resources.Status.Ints.Get("render.quality")
+		o.statFlushMs = world.Resources.Status.Floats.Get("render.flush_ms")
+	}
+	o.statQuality.Store(int64(o.quality.level))
+	o.statFlushMs.Set(o.quality.flushMs)
+	o.buffer.SetCRT(world.Resources.Config.CRT && ctx.Quality == visual.QualityFull)
+	if ctx.Quality == visual.QualityFull {
+		o.buffer.SetDither(world.Resources.Config.Dither)
+	} else {
+		o.buffer.SetDither(visual.DitherOff) // Dithered cells churn the diff on every change
+	}
 	for _, entry := range o.renderers {
 		// Skip if renderer implements VisibilityToggle and is not visible
 		if vt, ok := entry.renderer.(VisibilityToggle); ok && !vt.IsVisible() {
@@ -73,5 +109,12 @@ func (o *RenderOrchestrator) RenderFrame(ctx RenderContext, world *engine.World)
 	world.Unlock()
 
 	// Terminal I/O outside the world lock: stalled terminal write mustn't block evel loop
+	start := time.Now()
 	o.buffer.FlushToTerminal(o.term)
+	end := time.Now()
+	o.quality.observe(end.Sub(start))
+
+	if o.onFlush != nil {
+		o.onFlush(end)
+	}
 }
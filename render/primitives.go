@@ -0,0 +1,216 @@
+package render
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/core"
+)
+
+// cellAspect is the on-screen height:width ratio of a terminal cell, circles widen horizontally by it
+const cellAspect = 2
+
+// PolygonMaxVertices caps filled polygons, scanline crossings are kept on the stack
+const PolygonMaxVertices = 64
+
+// Brush is the cell write applied by primitives to each covered cell, fields as passed to Set
+// Every primitive writes each cell at most once per call, so alpha blends do not stack
+type Brush struct {
+	Rune  rune
+	Fg    color.RGB
+	Bg    color.RGB
+	Mode  BlendMode
+	Alpha float64
+	Attrs terminal.Attr
+}
+
+func (b *RenderBuffer) plot(x, y int, br *Brush) {
+	b.Set(x, y, br.Rune, br.Fg, br.Bg, br.Mode, br.Alpha, br.Attrs)
+}
+
+// hspan writes cells x0..x1 inclusive on row y, clipped to the buffer
+func (b *RenderBuffer) hspan(x0, x1, y int, br *Brush) {
+	if y < 0 || y >= b.height {
+		return
+	}
+	for x := max(x0, 0); x <= min(x1, b.width-1); x++ {
+		b.plot(x, y, br)
+	}
+}
+
+// Line draws a Bresenham line from x0,y0 to x1,y1 inclusive
+// Thickness above 1 widens across the major axis, centered on the line
+func (b *RenderBuffer) Line(x0, y0, x1, y1, thickness int, br Brush) {
+	b.line(x0, y0, x1, y1, thickness, false, &br)
+}
+
+// line draws a segment, skipStart omits the first cell so joined segments do not overdraw their shared vertex
+func (b *RenderBuffer) line(x0, y0, x1, y1, thickness int, skipStart bool, br *Brush) {
+	thickness = max(1, thickness)
+	pad := thickness / 2
+	if !core.RectFromCorners(x0, y0, x1, y1).Grow(pad).Intersects(b.Bounds()) {
+		return
+	}
+
+	dx, dy := x1-x0, y1-y0
+	absDx, absDy := dx, dy
+	if absDx < 0 {
+		absDx = -absDx
+	}
+	if absDy < 0 {
+		absDy = -absDy
+	}
+	stepX, stepY := 1, 1
+	if dx < 0 {
+		stepX = -1
+	}
+	if dy < 0 {
+		stepY = -1
+	}
+	xMajor := absDx >= absDy
+
+	err := absDx - absDy
+	x, y := x0, y0
+	for {
+		if !skipStart || x != x0 || y != y0 {
+			for o := -pad; o < thickness-pad; o++ {
+				if xMajor {
+					b.plot(x, y+o, br)
+				} else {
+					b.plot(x+o, y, br)
+				}
+			}
+		}
+		if x == x1 && y == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 > -absDy {
+			err -= absDy
+			x += stepX
+		}
+		if e2 < absDx {
+			err += absDx
+			y += stepY
+		}
+	}
+}
+
+// Polyline draws connected segments through points, shared vertices are written once
+func (b *RenderBuffer) Polyline(points []core.Point, thickness int, br Brush) {
+	if len(points) == 1 {
+		b.line(points[0].X, points[0].Y, points[0].X, points[0].Y, thickness, false, &br)
+	}
+	for i := 1; i < len(points); i++ {
+		b.line(points[i-1].X, points[i-1].Y, points[i].X, points[i].Y, thickness, i > 1, &br)
+	}
+}
+
+// Circle draws an aspect-corrected circle, radius in rows and widened by the cell aspect across columns
+func (b *RenderBuffer) Circle(cx, cy, radius int, filled bool, br Brush) {
+	b.ellipse(cx, cy, radius*cellAspect, radius, filled, &br)
+}
+
+// Ellipse draws an axis-aligned ellipse with radii in cells, outline or filled
+func (b *RenderBuffer) Ellipse(cx, cy, rx, ry int, filled bool, br Brush) {
+	b.ellipse(cx, cy, rx, ry, filled, &br)
+}
+
+func (b *RenderBuffer) ellipse(cx, cy, rx, ry int, filled bool, br *Brush) {
+	if rx < 0 || ry < 0 || !core.RectFromCorners(cx-rx, cy-ry, cx+rx, cy+ry).Intersects(b.Bounds()) {
+		return
+	}
+
+	// halfWidth is the column extent of row dy, -1 past the top
+	halfWidth := func(dy int) int {
+		if dy > ry {
+			return -1
+		}
+		if ry == 0 {
+			return rx
+		}
+		t := float64(dy) / float64(ry)
+		return int(math.Floor(float64(rx)*math.Sqrt(1-t*t) + 0.5))
+	}
+
+	for dy := 0; dy <= ry; dy++ {
+		hw := halfWidth(dy)
+		if filled {
+			b.hspan(cx-hw, cx+hw, cy+dy, br)
+			if dy > 0 {
+				b.hspan(cx-hw, cx+hw, cy-dy, br)
+			}
+			continue
+		}
+
+		// Outline row covers the columns not already reached by the row above it, keeping steep sides connected
+		start := min(halfWidth(dy+1)+1, hw)
+		for dx := start; dx <= hw; dx++ {
+			b.plot(cx+dx, cy+dy, br)
+			if dx > 0 {
+				b.plot(cx-dx, cy+dy, br)
+			}
+			if dy > 0 {
+				b.plot(cx+dx, cy-dy, br)
+				if dx > 0 {
+					b.plot(cx-dx, cy-dy, br)
+				}
+			}
+		}
+	}
+}
+
+// Polygon draws a closed polygon, outlined through its vertex cells or filled by even-odd scanline
+// Filled, vertices are cell corners and cells whose centers lie inside are covered,
+// so polygons sharing an edge tile without overlap; beyond PolygonMaxVertices the outline is drawn
+func (b *RenderBuffer) Polygon(points []core.Point, filled bool, br Brush) {
+	n := len(points)
+	if n == 0 {
+		return
+	}
+	if !filled || n < 3 || n > PolygonMaxVertices {
+		b.Polyline(points, 1, br)
+		if n > 2 {
+			b.line(points[n-1].X, points[n-1].Y, points[0].X, points[0].Y, 1, true, &br)
+		}
+		return
+	}
+
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points[1:] {
+		minY = min(minY, p.Y)
+		maxY = max(maxY, p.Y)
+	}
+	minY = max(minY, 0)
+	maxY = min(maxY, b.height-1)
+
+	var crossings [PolygonMaxVertices]float64
+	for y := minY; y <= maxY; y++ {
+		sy := float64(y) + 0.5
+		count := 0
+		for i := range n {
+			p, q := points[i], points[(i+1)%n]
+			py, qy := float64(p.Y), float64(q.Y)
+			if (py <= sy) == (qy <= sy) {
+				continue
+			}
+			crossings[count] = float64(p.X) + (sy-py)*float64(q.X-p.X)/(qy-py)
+			count++
+		}
+
+		// Insertion sort, crossing counts are small
+		for i := 1; i < count; i++ {
+			for j := i; j > 0 && crossings[j] < crossings[j-1]; j-- {
+				crossings[j], crossings[j-1] = crossings[j-1], crossings[j]
+			}
+		}
+
+		// Cells whose centers fall between crossing pairs
+		for i := 0; i+1 < count; i += 2 {
+			x0 := int(math.Ceil(crossings[i] - 0.5))
+			x1 := int(math.Ceil(crossings[i+1]-0.5)) - 1
+			b.hspan(x0, x1, y, &br)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/core"
+)
+
+// countingBrush writes '#' so covered cells can be read back
+var countingBrush = Brush{Rune: '#', Fg: color.White, Mode: BlendReplace, Alpha: 1}
+
+func covered(b *RenderBuffer) map[core.Point]bool {
+	cells := make(map[core.Point]bool)
+	for i, c := range b.cells {
+		if c.Rune == '#' {
+			cells[core.Point{X: i % b.width, Y: i / b.width}] = true
+		}
+	}
+	return cells
+}
+
+func TestLine_EndpointsAndContinuity(t *testing.T) {
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 20, 10)
+	b.Line(1, 1, 15, 7, 1, countingBrush)
+	cells := covered(b)
+	if !cells[core.Point{X: 1, Y: 1}] || !cells[core.Point{X: 15, Y: 7}] {
+		t.Fatal("line is missing an endpoint")
+	}
+	// x-major line: exactly one cell per column
+	if len(cells) != 15 {
+		t.Errorf("got %d cells, want 15", len(cells))
+	}
+}
+
+func TestPolyline_SharedVertexWrittenOnce(t *testing.T) {
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 10, 10)
+	b.Clear()
+	br := Brush{Bg: color.White, Mode: BlendAlphaBg, Alpha: 0.25}
+	b.Polyline([]core.Point{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 5, Y: 5}}, 1, br)
+	if got, want := b.cells[5].Bg, b.cells[4].Bg; got != want {
+		t.Errorf("shared vertex blended twice: %v vs %v", got, want)
+	}
+}
+
+func TestEllipse_OutlineSymmetricAndInsideFill(t *testing.T) {
+	outline := NewRenderBuffer(terminal.ColorModeTrueColor, 40, 20)
+	outline.Ellipse(20, 10, 12, 6, false, countingBrush)
+	fill := NewRenderBuffer(terminal.ColorModeTrueColor, 40, 20)
+	fill.Ellipse(20, 10, 12, 6, true, countingBrush)
+
+	ring, disc := covered(outline), covered(fill)
+	for p := range ring {
+		if !ring[core.Point{X: 40 - p.X, Y: 20 - p.Y}] {
+			t.Errorf("outline not symmetric at %v", p)
+		}
+		if !disc[p] {
+			t.Errorf("outline cell %v outside fill", p)
+		}
+	}
+	for _, p := range []core.Point{{X: 8, Y: 10}, {X: 32, Y: 10}, {X: 20, Y: 4}, {X: 20, Y: 16}} {
+		if !ring[p] {
+			t.Errorf("outline missing extreme %v", p)
+		}
+	}
+}
+
+func TestPolygon_FillCoversCellCenters(t *testing.T) {
+	b := NewRenderBuffer(terminal.ColorModeTrueColor, 20, 10)
+	b.Polygon([]core.Point{{X: 2, Y: 1}, {X: 8, Y: 1}, {X: 8, Y: 5}, {X: 2, Y: 5}}, true, countingBrush)
+	if got := len(covered(b)); got != 6*4 {
+		t.Errorf("rectangle fill covered %d cells, want 24", got)
+	}
+
+	// Off-buffer geometry is clipped without panicking
+	b.Polygon([]core.Point{{X: -50, Y: -50}, {X: 100, Y: -40}, {X: 30, Y: 80}}, true, countingBrush)
+	b.Line(-100, -100, 200, 300, 3, countingBrush)
+	b.Circle(-500, 5, 40, true, countingBrush)
+}
@@ -9,6 +9,7 @@ const (
 	PriorityBackground RenderPriority = iota
 	PriorityGrid
 	PriorityPing
+	PriorityTrail
 
 	// === Environment ===
 	PriorityWall
@@ -27,6 +28,7 @@ const (
 	// Foreground species with depth, rendered last
 	PriorityPylon
 	PriorityTower
+	PriorityTurret
 	PriorityStorm
 	PriorityEye
 	PrioritySnake
@@ -55,6 +57,7 @@ const (
 	PriorityFadeout
 	PriorityExplosion
 	PrioritySpirit
+	PriorityPopup
 
 	// === Overlays ===
 	PrioritySplash
@@ -68,6 +71,9 @@ const (
 	// === UI Layer ===
 	PriorityHeat
 	PriorityIndicator
+	PriorityMinimap
+	PriorityPreview
+	PriorityInputHUD
 	PriorityStatusBar
 	PriorityCursor
 
@@ -75,5 +81,6 @@ const (
 	PriorityOverlay
 	PriorityFlowField
 	PriorityDebug
+	PrioritySizeGuard // Covers everything while the terminal is too small
 )
 
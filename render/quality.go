@@ -0,0 +1,54 @@
+package render
+
+import (
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+)
+
+// qualityGovernor adapts render quality to terminal write backpressure
+// A blocking flush that keeps taking long means the output link cannot keep up,
+// so frames are coalesced and expensive effects dropped until it recovers
+type qualityGovernor struct {
+	level   visual.Quality
+	flushMs float64 // Smoothed flush duration
+	slow    int     // Consecutive slow flushes
+	fast    int     // Consecutive fast flushes
+	frame   int
+}
+
+// skip reports whether this frame should be coalesced into the next flushed one
+func (g *qualityGovernor) skip() bool {
+	g.frame++
+	switch g.level {
+	case visual.QualityReduced:
+		return g.frame%visual.QualityReducedStride != 0
+	case visual.QualityMinimal:
+		return g.frame%visual.QualityMinimalStride != 0
+	default:
+		return false
+	}
+}
+
+// observe feeds one flush duration and moves the level with hysteresis
+func (g *qualityGovernor) observe(d time.Duration) {
+	ms := float64(d.Microseconds()) / 1000
+	g.flushMs += visual.QualityEMAAlpha * (ms - g.flushMs)
+
+	switch {
+	case g.flushMs > visual.QualityFlushSlowMs:
+		g.fast = 0
+		if g.slow++; g.slow >= visual.QualityDegradeFrames && g.level < visual.QualityMinimal {
+			g.level++
+			g.slow = 0
+		}
+	case g.flushMs < visual.QualityFlushFastMs:
+		g.slow = 0
+		if g.fast++; g.fast >= visual.QualityRecoverFrames && g.level > visual.QualityFull {
+			g.level--
+			g.fast = 0
+		}
+	default:
+		g.slow, g.fast = 0, 0
+	}
+}
@@ -0,0 +1,420 @@
+package render
+
+import (
+	"image"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/lixenwraith/color"
+)
+
+// Metric selects the color distance used for palette lookup
+type Metric uint8
+
+const (
+	MetricRedmean   Metric = iota // Weighted RGB, fast, matches color.RGBTo256
+	MetricCIEDE2000               // Perceptual distance in CIELAB, slower LUT build
+)
+
+// String returns the metric name as accepted by ParseMetric
+func (m Metric) String() string {
+	if m == MetricCIEDE2000 {
+		return "ciede2000"
+	}
+	return "redmean"
+}
+
+// ParseMetric maps a name to a metric, false for unknown names
+func ParseMetric(s string) (Metric, bool) {
+	switch s {
+	case "redmean", "rgb":
+		return MetricRedmean, true
+	case "ciede2000", "de2000", "lab":
+		return MetricCIEDE2000, true
+	}
+	return MetricRedmean, false
+}
+
+// Distance returns the difference between a and b, comparable only within one metric
+func (m Metric) Distance(a, b color.RGB) float64 {
+	if m == MetricCIEDE2000 {
+		return DeltaE2000(ToLab(a), ToLab(b))
+	}
+	return float64(color.RedmeanDistance(a, b))
+}
+
+// === CIELAB ===
+
+// Lab is a CIELAB color under the D65 white point
+type Lab struct {
+	L, A, B float64
+}
+
+// srgbLinear maps an 8-bit sRGB channel to linear light
+var srgbLinear = func() (t [256]float64) {
+	for i := range t {
+		v := float64(i) / 255
+		if v <= 0.04045 {
+			t[i] = v / 12.92
+		} else {
+			t[i] = math.Pow((v+0.055)/1.055, 2.4)
+		}
+	}
+	return t
+}()
+
+// ToLab converts sRGB to CIELAB
+func ToLab(c color.RGB) Lab {
+	r, g, b := srgbLinear[c.R], srgbLinear[c.G], srgbLinear[c.B]
+	// sRGB → XYZ, normalized by D65 white
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*b) / 0.95047
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*b) / 1.08883
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return Lab{L: 116*fy - 16, A: 500 * (fx - fy), B: 200 * (fy - fz)}
+}
+
+func labF(t float64) float64 {
+	const eps = 216.0 / 24389.0
+	const kappa = 24389.0 / 27.0
+	if t > eps {
+		return math.Cbrt(t)
+	}
+	return (kappa*t + 16) / 116
+}
+
+// DeltaE2000 returns the CIEDE2000 color difference with unit weights
+func DeltaE2000(c1, c2 Lab) float64 {
+	const deg = math.Pi / 180
+
+	cab1 := math.Hypot(c1.A, c1.B)
+	cab2 := math.Hypot(c2.A, c2.B)
+	cabMean7 := math.Pow((cab1+cab2)/2, 7)
+	g := 0.5 * (1 - math.Sqrt(cabMean7/(cabMean7+6103515625))) // 25^7
+
+	a1 := (1 + g) * c1.A
+	a2 := (1 + g) * c2.A
+	cp1 := math.Hypot(a1, c1.B)
+	cp2 := math.Hypot(a2, c2.B)
+	hp1 := hueAngle(c1.B, a1)
+	hp2 := hueAngle(c2.B, a2)
+
+	dL := c2.L - c1.L
+	dC := cp2 - cp1
+	var dh float64
+	if cp1*cp2 != 0 {
+		dh = hp2 - hp1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(cp1*cp2) * math.Sin(dh/2*deg)
+
+	lMean := (c1.L + c2.L) / 2
+	cMean := (cp1 + cp2) / 2
+	hMean := hp1 + hp2
+	if cp1*cp2 != 0 {
+		if math.Abs(hp1-hp2) > 180 {
+			if hMean < 360 {
+				hMean += 360
+			} else {
+				hMean -= 360
+			}
+		}
+		hMean /= 2
+	}
+
+	t := 1 - 0.17*math.Cos((hMean-30)*deg) + 0.24*math.Cos(2*hMean*deg) +
+		0.32*math.Cos((3*hMean+6)*deg) - 0.20*math.Cos((4*hMean-63)*deg)
+	dTheta := 30 * math.Exp(-math.Pow((hMean-275)/25, 2))
+	cMean7 := math.Pow(cMean, 7)
+	rc := 2 * math.Sqrt(cMean7/(cMean7+6103515625))
+	l50 := (lMean - 50) * (lMean - 50)
+	sl := 1 + 0.015*l50/math.Sqrt(20+l50)
+	sc := 1 + 0.045*cMean
+	sh := 1 + 0.015*cMean*t
+	rt := -math.Sin(2*dTheta*deg) * rc
+
+	tl, tc, th := dL/sl, dC/sc, dH/sh
+	return math.Sqrt(tl*tl + tc*tc + th*th + rt*tc*th)
+}
+
+// hueAngle returns atan2(b, a) in degrees within [0, 360)
+func hueAngle(b, a float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// === Palette lookup ===
+
+// Palette LUT resolution: 5 bits per channel, 32K entries
+// Coarser than color's 6-bit xterm LUT so CIEDE2000 builds stay fast
+const (
+	paletteLUTBits = 5
+	paletteLUTSize = 1 << (3 * paletteLUTBits)
+	paletteLUTMax  = 256 // Entries addressable by the uint8 LUT
+	labShortlist   = 8   // Euclidean Lab candidates re-ranked by CIEDE2000
+)
+
+// Palette is an ordered color set with a lazily built nearest-index LUT
+type Palette struct {
+	Colors []color.RGB
+	Metric Metric
+
+	labs    []Lab
+	lut     *[paletteLUTSize]uint8
+	lutOnce sync.Once
+}
+
+// NewPalette creates a palette, colors beyond 256 are dropped
+func NewPalette(colors []color.RGB, metric Metric) *Palette {
+	if len(colors) > paletteLUTMax {
+		colors = colors[:paletteLUTMax]
+	}
+	p := &Palette{Colors: colors, Metric: metric}
+	if metric == MetricCIEDE2000 {
+		p.labs = make([]Lab, len(colors))
+		for i, c := range colors {
+			p.labs[i] = ToLab(c)
+		}
+	}
+	return p
+}
+
+// Nearest returns the palette index closest to c via the cached LUT
+// The first call builds the LUT; safe for concurrent use
+func (p *Palette) Nearest(c color.RGB) int {
+	if len(p.Colors) == 0 {
+		return 0
+	}
+	p.lutOnce.Do(p.buildLUT)
+	const shift = 8 - paletteLUTBits
+	return int(p.lut[int(c.R>>shift)<<(2*paletteLUTBits)|int(c.G>>shift)<<paletteLUTBits|int(c.B>>shift)])
+}
+
+// NearestExact searches every entry without the LUT, for one-off lookups
+func (p *Palette) NearestExact(c color.RGB) int {
+	if p.Metric == MetricCIEDE2000 {
+		return p.nearestLab(ToLab(c))
+	}
+	best, bestD := 0, math.MaxInt
+	for i, pc := range p.Colors {
+		if d := color.RedmeanDistance(c, pc); d < bestD {
+			best, bestD = i, d
+		}
+	}
+	return best
+}
+
+// nearestLab shortlists by Euclidean Lab distance then re-ranks with CIEDE2000,
+// which avoids the full metric against every entry at a negligible accuracy cost
+func (p *Palette) nearestLab(lab Lab) int {
+	var short [labShortlist]int
+	var shortD [labShortlist]float64
+	n := 0
+	for i, pl := range p.labs {
+		dl, da, db := lab.L-pl.L, lab.A-pl.A, lab.B-pl.B
+		d := dl*dl + da*da + db*db
+		// Insertion into the sorted shortlist
+		j := min(n, labShortlist-1)
+		if n == labShortlist && d >= shortD[j] {
+			continue
+		}
+		for j > 0 && shortD[j-1] > d {
+			short[j], shortD[j] = short[j-1], shortD[j-1]
+			j--
+		}
+		short[j], shortD[j] = i, d
+		n = min(n+1, labShortlist)
+	}
+
+	best, bestD := short[0], math.Inf(1)
+	for _, i := range short[:n] {
+		if d := DeltaE2000(lab, p.labs[i]); d < bestD {
+			best, bestD = i, d
+		}
+	}
+	return best
+}
+
+func (p *Palette) buildLUT() {
+	const shift = 8 - paletteLUTBits
+	const half = 1 << (shift - 1)
+	const side = 1 << paletteLUTBits
+	lut := new([paletteLUTSize]uint8)
+	for r := range side {
+		for g := range side {
+			for b := range side {
+				// Sample bucket centers
+				c := color.RGB{R: uint8(r<<shift | half), G: uint8(g<<shift | half), B: uint8(b<<shift | half)}
+				lut[r<<(2*paletteLUTBits)|g<<paletteLUTBits|b] = uint8(p.NearestExact(c))
+			}
+		}
+	}
+	p.lut = lut
+}
+
+// === xterm-256 ===
+
+// xterm256Colors is the fixed part of the palette, indices 16-255
+// Indices 0-15 are theme-defined by the terminal and never targeted
+var xterm256Colors = func() []color.RGB {
+	colors := make([]color.RGB, 0, 240)
+	for i := range 216 {
+		r, g, b := color.CubeRGB256(uint8(16 + i))
+		colors = append(colors, color.RGB{R: cubeLevel(r), G: cubeLevel(g), B: cubeLevel(b)})
+	}
+	for i := range 24 {
+		v := uint8(8 + i*10)
+		colors = append(colors, color.RGB{R: v, G: v, B: v})
+	}
+	return colors
+}()
+
+func cubeLevel(step uint8) uint8 {
+	if step == 0 {
+		return 0
+	}
+	return 55 + step*40
+}
+
+var xterm256Lab = sync.OnceValue(func() *Palette {
+	return NewPalette(xterm256Colors, MetricCIEDE2000)
+})
+
+// Quantize256 returns the nearest xterm-256 index for c under metric
+// Redmean uses the shared color LUT; CIEDE2000 builds its own on first call
+func Quantize256(c color.RGB, metric Metric) uint8 {
+	if metric == MetricCIEDE2000 {
+		return uint8(16 + xterm256Lab().Nearest(c))
+	}
+	return color.RGBTo256(c)
+}
+
+// === Palette extraction ===
+
+// medianCutSamples caps pixels considered by MedianCut, larger images are strided
+const medianCutSamples = 1 << 16
+
+// colorBox is a median-cut bucket, a contiguous range of the sample slice
+type colorBox struct {
+	pixels []color.RGB
+	axis   int // Channel with the widest range: 0 R, 1 G, 2 B
+	span   int
+}
+
+func newColorBox(pixels []color.RGB) colorBox {
+	lo := [3]int{255, 255, 255}
+	hi := [3]int{}
+	for _, c := range pixels {
+		for ch, v := range [3]int{int(c.R), int(c.G), int(c.B)} {
+			lo[ch] = min(lo[ch], v)
+			hi[ch] = max(hi[ch], v)
+		}
+	}
+	box := colorBox{pixels: pixels}
+	for ch := range 3 {
+		if hi[ch]-lo[ch] > box.span {
+			box.axis, box.span = ch, hi[ch]-lo[ch]
+		}
+	}
+	return box
+}
+
+func (b colorBox) mean() color.RGB {
+	var r, g, bl int
+	for _, c := range b.pixels {
+		r += int(c.R)
+		g += int(c.G)
+		bl += int(c.B)
+	}
+	n := len(b.pixels)
+	return color.RGB{R: uint8((r + n/2) / n), G: uint8((g + n/2) / n), B: uint8((bl + n/2) / n)}
+}
+
+// MedianCut extracts up to n representative colors from img, most common first
+// Fully transparent pixels are ignored
+func MedianCut(img image.Image, n int) []color.RGB {
+	bounds := img.Bounds()
+	total := bounds.Dx() * bounds.Dy()
+	if total == 0 || n <= 0 {
+		return nil
+	}
+	stride := max(1, total/medianCutSamples)
+
+	pixels := make([]color.RGB, 0, min(total, medianCutSamples))
+	for i := 0; i < total; i += stride {
+		x := bounds.Min.X + i%bounds.Dx()
+		y := bounds.Min.Y + i/bounds.Dx()
+		px := img.At(x, y)
+		if _, _, _, a := px.RGBA(); a == 0 {
+			continue
+		}
+		pixels = append(pixels, color.From(px))
+	}
+	return MedianCutColors(pixels, n)
+}
+
+// MedianCutColors splits samples into up to n boxes by repeatedly halving the
+// box with the widest channel range at its median; samples is reordered
+func MedianCutColors(samples []color.RGB, n int) []color.RGB {
+	if len(samples) == 0 || n <= 0 {
+		return nil
+	}
+
+	boxes := []colorBox{newColorBox(samples)}
+	for len(boxes) < n {
+		// Split the widest box, weighted by population so large flat areas still divide
+		pick, pickScore := -1, 0
+		for i, b := range boxes {
+			if len(b.pixels) < 2 || b.span == 0 {
+				continue
+			}
+			if score := b.span * len(b.pixels); score > pickScore {
+				pick, pickScore = i, score
+			}
+		}
+		if pick < 0 {
+			break
+		}
+
+		b := boxes[pick]
+		axis := b.axis
+		sort.Slice(b.pixels, func(i, j int) bool {
+			return channel(b.pixels[i], axis) < channel(b.pixels[j], axis)
+		})
+		mid := len(b.pixels) / 2
+		boxes[pick] = newColorBox(b.pixels[:mid])
+		boxes = append(boxes, newColorBox(b.pixels[mid:]))
+	}
+
+	sort.SliceStable(boxes, func(i, j int) bool {
+		return len(boxes[i].pixels) > len(boxes[j].pixels)
+	})
+	colors := make([]color.RGB, len(boxes))
+	for i, b := range boxes {
+		colors[i] = b.mean()
+	}
+	return colors
+}
+
+func channel(c color.RGB, axis int) uint8 {
+	switch axis {
+	case 0:
+		return c.R
+	case 1:
+		return c.G
+	default:
+		return c.B
+	}
+}
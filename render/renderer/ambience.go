@@ -0,0 +1,235 @@
+package renderer
+
+import (
+	"math"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// ambienceStar is a background star in viewport space, depth scales drift and brightness
+type ambienceStar struct {
+	x, y  float64
+	depth float64 // (0, 1], 1 = nearest
+	phase float64
+}
+
+// AmbienceRenderer draws low-intensity background effects behind the playfield:
+// drifting stars, heat-driven plasma, and boss auras
+// Composites into a viewport-sized field first so effects layer without reading the buffer
+type AmbienceRenderer struct {
+	gameCtx *engine.GameContext
+
+	elapsed float64 // Game seconds, frozen while paused
+
+	stars          []ambienceStar
+	starW, starH   int
+	rng            *vmath.FastRand
+	field          []color.RGB
+	mark           []bool
+	stride         int // Plasma block size, adapted to AmbienceFrameBudget
+	fieldW, fieldH int
+}
+
+// NewAmbienceRenderer creates the background ambience renderer
+func NewAmbienceRenderer(gameCtx *engine.GameContext) *AmbienceRenderer {
+	return &AmbienceRenderer{
+		gameCtx: gameCtx,
+		rng:     vmath.NewFastRand(0xA4B1E7CE),
+		stride:  1,
+	}
+}
+
+// IsVisible implements render.VisibilityToggle; off when disabled, and in 256-color mode
+// unless dithering smooths its gradients
+func (r *AmbienceRenderer) IsVisible() bool {
+	config := r.gameCtx.World.Resources.Config
+	return !config.NoAmbience && (config.ColorMode == terminal.ColorModeTrueColor || config.Dither != visual.DitherOff)
+}
+
+// Render composites stars, plasma, and auras into the viewport background
+func (r *AmbienceRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	// Strobe overlays untouched cells only; yield so the flash stays full-screen
+	// Whole-viewport animation is the largest per-frame diff, first to go on a slow link
+	if r.gameCtx.World.Resources.Transient.Strobe.Active || ctx.Quality != visual.QualityFull {
+		return
+	}
+	start := time.Now()
+
+	vw, vh := ctx.ViewportWidth, ctx.ViewportHeight
+	if vw <= 0 || vh <= 0 {
+		return
+	}
+	if !ctx.IsPaused {
+		r.elapsed += ctx.DeltaTime
+	}
+	r.resize(vw, vh)
+
+	buf.SetWriteMask(visual.MaskAmbience)
+
+	r.fillPlasma(vw, vh)
+	r.drawAuras(ctx)
+	r.drawStars(vw, vh)
+
+	for vy := range vh {
+		for vx := range vw {
+			i := vy*vw + vx
+			if !r.mark[i] {
+				continue
+			}
+			sx, sy := ctx.ViewportToScreen(vx, vy)
+			buf.SetBgOnly(sx, sy, r.field[i])
+		}
+	}
+
+	// Adapt plasma resolution to the frame budget
+	spent := time.Since(start)
+	if spent > visual.AmbienceFrameBudget && r.stride < visual.AmbienceMaxStride {
+		r.stride++
+	} else if spent < visual.AmbienceFrameBudget/4 && r.stride > 1 {
+		r.stride--
+	}
+}
+
+// resize reallocates the field and reseeds stars when the viewport changes
+func (r *AmbienceRenderer) resize(vw, vh int) {
+	size := vw * vh
+	if vw != r.fieldW || vh != r.fieldH {
+		if cap(r.field) < size {
+			r.field = make([]color.RGB, size)
+			r.mark = make([]bool, size)
+		}
+		r.field = r.field[:size]
+		r.mark = r.mark[:size]
+		r.fieldW, r.fieldH = vw, vh
+	}
+	clear(r.mark)
+
+	if vw == r.starW && vh == r.starH {
+		return
+	}
+	r.starW, r.starH = vw, vh
+	count := min(visual.AmbienceStarMax, size*visual.AmbienceStarDensity/1000)
+	r.stars = r.stars[:0]
+	for range count {
+		r.stars = append(r.stars, ambienceStar{
+			x:     r.rng.Float64() * float64(vw),
+			y:     r.rng.Float64() * float64(vh),
+			depth: 0.25 + 0.75*r.rng.Float64(),
+			phase: r.rng.Float64() * 2 * math.Pi,
+		})
+	}
+}
+
+// base returns the field color at i, the untouched background if nothing drew there yet
+func (r *AmbienceRenderer) base(i int) color.RGB {
+	if r.mark[i] {
+		return r.field[i]
+	}
+	return visual.RgbBackground
+}
+
+// fillPlasma tints the whole viewport with a slow plasma once heat passes the threshold
+func (r *AmbienceRenderer) fillPlasma(vw, vh int) {
+	heatComp, ok := r.gameCtx.World.Components.Heat.GetComponent(r.gameCtx.World.Resources.Player.Entity)
+	if !ok || heatComp.Current <= visual.AmbiencePlasmaHeatMin {
+		return
+	}
+	alpha := visual.AmbiencePlasmaMaxAlpha * float64(heatComp.Current-visual.AmbiencePlasmaHeatMin) /
+		float64(parameter.HeatMax-visual.AmbiencePlasmaHeatMin)
+
+	t := r.elapsed
+	stride := r.stride
+	for by := 0; by < vh; by += stride {
+		for bx := 0; bx < vw; bx += stride {
+			x, y := float64(bx), float64(by)*2 // Cells are ~2:1, keep blobs round
+			v := math.Sin(x*0.07+t*0.6) +
+				math.Sin(y*0.06-t*0.45) +
+				math.Sin((x+y)*0.04+t*0.3)
+			v = (v + 3) / 6 // [0, 1]
+			c := color.Blend(visual.RgbBackground, color.Lerp(visual.AmbiencePlasmaCool, visual.AmbiencePlasmaHot, v), alpha*v)
+
+			for y := by; y < min(by+stride, vh); y++ {
+				for x := bx; x < min(bx+stride, vw); x++ {
+					i := y*vw + x
+					r.field[i] = c
+					r.mark[i] = true
+				}
+			}
+		}
+	}
+}
+
+// drawAuras blends a pulsing glow around each live boss
+func (r *AmbienceRenderer) drawAuras(ctx render.RenderContext) {
+	world := r.gameCtx.World
+	pulse := 0.7 + 0.3*math.Sin(r.elapsed*visual.AmbienceAuraPulseHz*2*math.Pi)
+
+	for _, e := range world.Components.Quasar.GetAllEntities() {
+		if pos, ok := world.Positions.GetPosition(e); ok {
+			r.drawAura(ctx, pos.X, pos.Y, visual.AmbienceAuraQuasar, pulse)
+		}
+	}
+	for _, e := range world.Components.Storm.GetAllEntities() {
+		stormComp, ok := world.Components.Storm.GetComponent(e)
+		if !ok {
+			continue
+		}
+		for i, circle := range stormComp.Circles {
+			if !stormComp.CirclesAlive[i] {
+				continue
+			}
+			if pos, ok := world.Positions.GetPosition(circle); ok {
+				r.drawAura(ctx, pos.X, pos.Y, visual.AmbienceAuraStorm, pulse)
+			}
+		}
+	}
+}
+
+func (r *AmbienceRenderer) drawAura(ctx render.RenderContext, mapX, mapY int, c color.RGB, pulse float64) {
+	cx, cy, _ := ctx.MapToViewport(mapX, mapY)
+	rx, ry := visual.AmbienceAuraRadiusX, visual.AmbienceAuraRadiusY
+	vw, vh := r.fieldW, r.fieldH
+
+	for vy := max(0, cy-int(ry)); vy <= min(vh-1, cy+int(ry)); vy++ {
+		for vx := max(0, cx-int(rx)); vx <= min(vw-1, cx+int(rx)); vx++ {
+			dx := float64(vx-cx) / rx
+			dy := float64(vy-cy) / ry
+			distSq := dx*dx + dy*dy
+			if distSq >= 1.0 {
+				continue
+			}
+			falloff := render.FalloffQuadratic.At(math.Sqrt(distSq))
+			i := vy*vw + vx
+			r.field[i] = color.Blend(r.base(i), c, visual.AmbienceAuraAlpha*falloff*pulse)
+			r.mark[i] = true
+		}
+	}
+}
+
+// drawStars drifts and twinkles the star field, nearer stars faster and brighter
+func (r *AmbienceRenderer) drawStars(vw, vh int) {
+	for k := range r.stars {
+		s := &r.stars[k]
+		x := math.Mod(s.x-r.elapsed*visual.AmbienceStarDrift*s.depth, float64(vw))
+		if x < 0 {
+			x += float64(vw)
+		}
+		vx, vy := int(x), int(s.y)
+		if vy >= vh {
+			continue
+		}
+
+		twinkle := 0.6 + 0.4*math.Sin(r.elapsed*visual.AmbienceStarTwinkle+s.phase)
+		val := uint8(visual.AmbienceStarBrightness * s.depth * twinkle)
+		i := vy*vw + vx
+		r.field[i] = color.Add(r.base(i), color.RGB{R: val, G: val, B: val}, 1.0)
+		r.mark[i] = true
+	}
+}
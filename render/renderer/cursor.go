@@ -2,6 +2,7 @@ package renderer
 
 import (
 	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/core"
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/parameter"
@@ -77,7 +78,7 @@ func (r *CursorRenderer) Render(ctx render.RenderContext, buf *render.RenderBuff
 	if glyphEntity != 0 {
 		if glyph, ok := r.gameCtx.World.Components.Glyph.GetComponent(glyphEntity); ok {
 			charAtCursor = glyph.Rune
-			fg := visual.GlyphColorLUT[glyph.Type][glyph.Level]
+			fg := visual.CVDGlyphColorLUT[r.gameCtx.World.Resources.Config.Accessibility][glyph.Type][glyph.Level]
 
 			// Cursor background takes the entity's foreground color
 			cursorBgColor = fg
@@ -106,6 +107,17 @@ func (r *CursorRenderer) Render(ctx render.RenderContext, buf *render.RenderBuff
 		charFgColor = visual.RgbBlack
 	}
 
-	// 5. Render
+	// 5. Large-cursor assist: halo on orthogonal neighbors, runes preserved
+	if r.gameCtx.World.Resources.Config.Assist.LargeCursor {
+		halo := color.Scale(cursorBgColor, visual.CursorHaloIntensity)
+		for _, d := range [4][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			// Neighbors clip to the map so the halo never bleeds into margins
+			if hx, hy, ok := ctx.MapToScreen(ctx.CursorX+d[0], ctx.CursorY+d[1]); ok {
+				buf.Set(hx, hy, 0, halo, halo, render.BlendMaxBg, 1.0, terminal.AttrNone)
+			}
+		}
+	}
+
+	// 6. Render
 	buf.SetWithBg(screenX, screenY, charAtCursor, charFgColor, cursorBgColor)
 }
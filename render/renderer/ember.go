@@ -56,16 +56,21 @@ type EmberPainter struct {
 
 // EmberRenderer renders ember effect for entities with active ember state
 type EmberRenderer struct {
-	gameCtx *engine.GameContext
-	painter *EmberPainter
+	gameCtx  *engine.GameContext
+	painter  *EmberPainter
+	dithered *EmberPainter // RGB gradient painter for dithered 256-color output, nil in TrueColor
 }
 
 // NewEmberRenderer creates the ember system renderer
 func NewEmberRenderer(gameCtx *engine.GameContext) *EmberRenderer {
-	return &EmberRenderer{
+	r := &EmberRenderer{
 		gameCtx: gameCtx,
 		painter: NewEmberPainter(gameCtx.World.Resources.Config.ColorMode),
 	}
+	if gameCtx.World.Resources.Config.ColorMode == terminal.ColorMode256 {
+		r.dithered = NewEmberPainter(terminal.ColorModeTrueColor)
+	}
+	return r
 }
 
 // Render draws all active ember effects
@@ -77,6 +82,11 @@ func (r *EmberRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffe
 		return
 	}
 
+	painter := r.painter
+	if r.dithered != nil && r.gameCtx.World.Resources.Config.Dither != visual.DitherOff && ctx.Quality == visual.QualityFull {
+		painter = r.dithered
+	}
+
 	cursorEntity := r.gameCtx.World.Resources.Player.Entity
 
 	for _, entity := range shieldEntities {
@@ -96,7 +106,7 @@ func (r *EmberRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffe
 			skipY = pos.Y
 		}
 
-		r.painter.Paint(buf, ctx, pos.X, pos.Y, heatComp.Current, skipX, skipY)
+		painter.Paint(buf, ctx, pos.X, pos.Y, heatComp.Current, skipX, skipY)
 	}
 }
 
@@ -235,16 +235,12 @@ func (r *ExplosionRenderer) accumulateCenter(ctx render.RenderContext, c *engine
 				continue
 			}
 
-			// Falloff calculation differs by type
-			var distFalloff int64
+			// Falloff differs by type: sharper edge for missiles, softer and more diffuse for dust
+			falloff := render.FalloffDome
 			if c.Type == event.ExplosionTypeMissile {
-				// Quadratic falloff for sharper edge (sharper edge)
-				linearFalloff := vmath.Scale - vmath.Div(distSq, radiusSq)
-				distFalloff = vmath.Mul(linearFalloff, linearFalloff)
-			} else {
-				// Linear falloff for dust (softer, more diffuse)
-				distFalloff = vmath.Scale - vmath.Div(distSq, radiusSq)
+				falloff = render.FalloffDomeSq
 			}
+			distFalloff := falloff.AtSqFixed(vmath.Div(distSq, radiusSq))
 
 			cellIntensity := vmath.Mul(vmath.Mul(c.Intensity, timeDecay), distFalloff)
 			accBuffer[rowOffset+vx] += cellIntensity
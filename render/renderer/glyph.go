@@ -24,6 +24,9 @@ func NewGlyphRenderer(gameCtx *engine.GameContext) *GlyphRenderer {
 func (r *GlyphRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
 	buf.SetWriteMask(visual.MaskGlyph)
 
+	cvd := r.gameCtx.World.Resources.Config.Accessibility
+	palette := &visual.CVDGlyphColorLUT[cvd]
+
 	entities := r.gameCtx.World.Components.Glyph.GetAllEntities()
 	for _, entity := range entities {
 		glyph, ok := r.gameCtx.World.Components.Glyph.GetComponent(entity)
@@ -46,8 +49,24 @@ func (r *GlyphRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffe
 			continue
 		}
 
-		fg := visual.GlyphColorLUT[glyph.Type][glyph.Level]
+		fg := palette[glyph.Type][glyph.Level]
+
+		// Linked bracket pairs are underlined as '%' targets
+		attr := terminal.AttrNone
+		if r.gameCtx.World.Components.Bracket.HasEntity(entity) {
+			attr = terminal.AttrUnderline
+		}
 
-		buf.SetFgOnly(screenX, screenY, glyph.Rune, fg, terminal.AttrNone)
+		if cvd == visual.CVDOff {
+			buf.SetFgOnly(screenX, screenY, glyph.Rune, fg, attr)
+			continue
+		}
+
+		// Accessibility cues carry type meaning independent of hue
+		cue := visual.GlyphCueLUT[glyph.Type]
+		buf.SetFgOnly(screenX, screenY, glyph.Rune, fg, cue.Attr|attr)
+		if cue.Tick {
+			buf.Set(screenX, screenY, 0, fg, visual.CVDTickLUT[cvd][glyph.Type], render.BlendMaxBg, 1.0, terminal.AttrNone)
+		}
 	}
 }
\ No newline at end of file
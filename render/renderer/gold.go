@@ -1,7 +1,11 @@
 package renderer
 
 import (
+	"math"
+
+	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/render"
@@ -29,12 +33,19 @@ func (r *GoldRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer
 
 	buf.SetWriteMask(visual.MaskComposite)
 
+	attr := terminal.AttrNone
+	if r.gameCtx.World.Resources.Config.Accessibility != visual.CVDOff {
+		attr = visual.GlyphCueLUT[component.GlyphGold].Attr
+	}
+
 	for _, anchor := range headers {
 		header, ok := r.gameCtx.World.Components.Header.GetComponent(anchor)
 		if !ok || header.Behavior != component.BehaviorGold {
 			continue
 		}
 
+		r.renderRing(ctx, buf, anchor, len(header.MemberEntries))
+
 		for _, member := range header.MemberEntries {
 			if member.Entity == 0 {
 				continue
@@ -55,7 +66,66 @@ func (r *GoldRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer
 				continue
 			}
 
-			buf.SetFgOnly(screenX, screenY, glyph.Rune, visual.RgbGlyphGold, terminal.AttrNone)
+			buf.SetFgOnly(screenX, screenY, glyph.Rune, visual.RgbGlyphGold, attr)
+		}
+	}
+}
+
+// renderRing tints a one-cell border around the sequence, lit cells deplete clockwise as the timer runs out
+func (r *GoldRenderer) renderRing(ctx render.RenderContext, buf *render.RenderBuffer, anchor core.Entity, length int) {
+	ratio, ok := r.timerRatio(anchor)
+	if !ok {
+		return
+	}
+	pos, ok := r.gameCtx.World.Positions.GetPosition(anchor)
+	if !ok {
+		return
+	}
+
+	lit := visual.RgbGoldRing
+	if ratio < visual.GoldRingWarnRatio && (ctx.GameTime.UnixMilli()/visual.GoldRingBlinkMs)%2 == 0 {
+		lit = visual.RgbGoldRingWarn
+	}
+	spent := color.Scale(visual.RgbGoldRing, visual.GoldRingSpentDim)
+
+	// Perimeter of the box (x-1, y-1)..(x+length, y+1), walked clockwise from top-left
+	w := length + 2
+	perimeter := 2*w + 2
+	litCount := int(math.Ceil(ratio * float64(perimeter)))
+
+	for i := range perimeter {
+		var dx, dy int
+		switch {
+		case i < w:
+			dx, dy = i, 0
+		case i == w:
+			dx, dy = w-1, 1
+		case i < 2*w+1:
+			dx, dy = w-1-(i-w-1), 2
+		default:
+			dx, dy = 0, 1
+		}
+
+		screenX, screenY, visible := ctx.MapToScreen(pos.X-1+dx, pos.Y-1+dy)
+		if !visible {
+			continue
+		}
+		if i < litCount {
+			buf.SetBgOnly(screenX, screenY, lit)
+		} else {
+			buf.SetBgOnly(screenX, screenY, spent)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// timerRatio returns the remaining fraction of the gold timer splash anchored to the header
+func (r *GoldRenderer) timerRatio(anchor core.Entity) (float64, bool) {
+	for _, entity := range r.gameCtx.World.Components.Splash.GetAllEntities() {
+		splash, ok := r.gameCtx.World.Components.Splash.GetComponent(entity)
+		if !ok || splash.Slot != component.SlotTimer || splash.AnchorEntity != anchor || splash.Duration <= 0 {
+			continue
+		}
+		return max(0, min(1, float64(splash.Remaining)/float64(splash.Duration))), true
+	}
+	return 0, false
+}
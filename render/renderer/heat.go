@@ -13,6 +13,8 @@ type HeatRenderer struct {
 	gameCtx *engine.GameContext
 
 	burstBlink bool
+	lockout    bool // Post-burst lockout, bar drawn dimmed
+	overheatFg color.RGB
 
 	renderCell heatCellRenderer
 }
@@ -47,6 +49,13 @@ func (r *HeatRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer
 	heat := heatComp.Current
 	overheat := heatComp.Overheat
 	r.burstBlink = heatComp.BurstFlashRemaining > 0
+	r.lockout = heatComp.LockoutRemaining > 0
+
+	// Overheat warning blinks the overheat markers red
+	r.overheatFg = visual.RgbWhite
+	if heatComp.OverheatWarned && (ctx.GameTime.UnixMilli()/visual.HeatWarnBlinkMs)%2 == 0 {
+		r.overheatFg = visual.RgbRed
+	}
 
 	maxX := ctx.ScreenWidth - 1
 	heatFillWidth := (maxX * heat) / 100
@@ -90,18 +99,21 @@ func (r *HeatRenderer) cellTrueColor(buf *render.RenderBuffer, x, width int, fil
 	} else {
 		fillRune = 0
 	}
+	if r.lockout {
+		c = color.Scale(c, visual.HeatLockoutDim)
+	}
 
 	if fillRune == 0 {
 		buf.SetBgOnly(x, 0, c)
 	} else {
-		buf.SetWithBg(x, 0, fillRune, visual.RgbWhite, c)
+		buf.SetWithBg(x, 0, fillRune, r.overheatFg, c)
 	}
 }
 
 // cell256 renders with fixed 10-segment palette colors
 func (r *HeatRenderer) cell256(buf *render.RenderBuffer, x, width int, fillRune rune) {
 	if fillRune != 0 {
-		buf.SetFgOnly(x, 0, fillRune, visual.RgbWhite, terminal.AttrNone)
+		buf.SetFgOnly(x, 0, fillRune, r.overheatFg, terminal.AttrNone)
 	} else {
 		segment := segmentIndex(x, width)
 		buf.SetBg256(x, 0, visual.Heat256LUT[segment])
@@ -0,0 +1,72 @@
+package renderer
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// InputHUDRenderer draws rolling KPM, APM, and input-to-render latency in the top-left of the game area
+// Latency shown is from the previous flush, the frame being drawn has not reached the terminal yet
+type InputHUDRenderer struct {
+	gameCtx *engine.GameContext
+
+	statAPM *atomic.Int64
+}
+
+// NewInputHUDRenderer creates an input HUD renderer
+func NewInputHUDRenderer(gameCtx *engine.GameContext) *InputHUDRenderer {
+	return &InputHUDRenderer{
+		gameCtx: gameCtx,
+		statAPM: gameCtx.World.Resources.Status.Ints.Get("engine.apm"),
+	}
+}
+
+// Render implements SystemRenderer
+func (r *InputHUDRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	if !r.gameCtx.World.Resources.Config.InputHUD {
+		return
+	}
+
+	meter := r.gameCtx.InputMeter
+	smoothed, last := meter.Latency()
+
+	buf.SetWriteMask(visual.MaskUI)
+	x := ctx.GameXOffset + visual.InputHUDMargin
+	y := ctx.GameYOffset + visual.InputHUDMargin
+	maxX := ctx.GameXOffset + ctx.ViewportWidth
+
+	x = r.drawField(buf, x, y, maxX, "KPM", fmt.Sprintf("%d", meter.KPM(r.gameCtx.PausableClock.RealTime())))
+	x = r.drawField(buf, x, y, maxX, "APM", fmt.Sprintf("%d", r.statAPM.Load()))
+	r.drawField(buf, x, y, maxX, "LAT", fmt.Sprintf("%s/%s", formatLatency(smoothed), formatLatency(last)))
+}
+
+// drawField writes " label value " and returns the next free column, clipped at maxX
+func (r *InputHUDRenderer) drawField(buf *render.RenderBuffer, x, y, maxX int, label, value string) int {
+	x = r.drawText(buf, x, y, maxX, " "+label+" ", visual.RgbInputHUDLabel)
+	return r.drawText(buf, x, y, maxX, value+" ", visual.RgbInputHUDValue)
+}
+
+func (r *InputHUDRenderer) drawText(buf *render.RenderBuffer, x, y, maxX int, text string, fg color.RGB) int {
+	for _, ch := range text {
+		if x >= maxX {
+			break
+		}
+		buf.SetWithBg(x, y, ch, fg, visual.RgbInputHUDBg)
+		x++
+	}
+	return x
+}
+
+// formatLatency shows milliseconds with one decimal, dash before the first measurement
+func formatLatency(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
@@ -96,7 +96,7 @@ func (r *MarkerRenderer) renderInvert(ctx render.RenderContext, buf *render.Rend
 				// Check glyph first
 				if glyph, ok := r.gameCtx.World.Components.Glyph.GetComponent(e); ok {
 					char = glyph.Rune
-					fg = visual.GlyphColorLUT[glyph.Type][glyph.Level]
+					fg = visual.CVDGlyphColorLUT[r.gameCtx.World.Resources.Config.Accessibility][glyph.Type][glyph.Level]
 					break
 				}
 
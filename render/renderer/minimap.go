@@ -0,0 +1,154 @@
+package renderer
+
+import (
+	"time"
+
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// minimapGlyphTypes is the number of glyph types counted per cell, matches CVDGlyphColorLUT
+const minimapGlyphTypes = 5
+
+// minimapCell holds per-type glyph counts for one minimap cell
+type minimapCell [minimapGlyphTypes]uint16
+
+// MinimapRenderer draws a downscaled glyph density overview of the map in the
+// top-right corner of the game area, with cursor and viewport marked
+// The density grid is rebuilt at MinimapUpdateInterval; the overlay draws every frame
+type MinimapRenderer struct {
+	gameCtx *engine.GameContext
+
+	cells      []minimapCell
+	width      int
+	height     int
+	mapWidth   int
+	mapHeight  int
+	lastUpdate time.Time
+}
+
+// NewMinimapRenderer creates a minimap renderer
+func NewMinimapRenderer(gameCtx *engine.GameContext) *MinimapRenderer {
+	return &MinimapRenderer{
+		gameCtx: gameCtx,
+	}
+}
+
+// Render implements SystemRenderer
+func (r *MinimapRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	if !r.gameCtx.World.Resources.Config.Minimap ||
+		ctx.ScreenWidth < visual.MinimapMinScreenWidth || ctx.ScreenHeight < visual.MinimapMinScreenHeight ||
+		ctx.MapWidth <= 0 || ctx.MapHeight <= 0 {
+		return
+	}
+
+	w := min(visual.MinimapWidth, ctx.MapWidth)
+	// Map cells are twice as tall as wide on screen, halve rows to keep the aspect
+	h := max(visual.MinimapMinHeight, min(visual.MinimapMaxHeight, w*ctx.MapHeight/ctx.MapWidth/2))
+	h = min(h, ctx.MapHeight)
+
+	resized := w != r.width || h != r.height || ctx.MapWidth != r.mapWidth || ctx.MapHeight != r.mapHeight
+	if resized || ctx.GameTime.Sub(r.lastUpdate) >= visual.MinimapUpdateInterval || ctx.GameTime.Before(r.lastUpdate) {
+		r.rebuild(w, h, ctx.MapWidth, ctx.MapHeight)
+		r.lastUpdate = ctx.GameTime
+	}
+
+	x0 := ctx.GameXOffset + ctx.ViewportWidth - w - visual.MinimapMargin
+	y0 := ctx.GameYOffset + visual.MinimapMargin
+	if x0 < ctx.GameXOffset || y0+h > ctx.GameYOffset+ctx.ViewportHeight {
+		return
+	}
+
+	buf.SetWriteMask(visual.MaskUI)
+	r.drawDensity(buf, x0, y0)
+	r.drawViewport(ctx, buf, x0, y0)
+
+	cx, cy := r.toCell(ctx.CursorX, ctx.CursorY)
+	buf.SetFgOnly(x0+cx, y0+cy, visual.MinimapCursorRune, visual.RgbMinimapCursor, terminal.AttrBold)
+}
+
+// rebuild recounts glyphs per minimap cell
+func (r *MinimapRenderer) rebuild(w, h, mapW, mapH int) {
+	r.width, r.height = w, h
+	r.mapWidth, r.mapHeight = mapW, mapH
+	if cap(r.cells) < w*h {
+		r.cells = make([]minimapCell, w*h)
+	} else {
+		r.cells = r.cells[:w*h]
+		clear(r.cells)
+	}
+
+	world := r.gameCtx.World
+	for _, entity := range world.Components.Glyph.GetAllEntities() {
+		glyph, ok := world.Components.Glyph.GetComponent(entity)
+		if !ok || glyph.Type < 0 || int(glyph.Type) >= minimapGlyphTypes {
+			continue
+		}
+		pos, ok := world.Positions.GetPosition(entity)
+		if !ok || pos.X < 0 || pos.X >= mapW || pos.Y < 0 || pos.Y >= mapH {
+			continue
+		}
+		cx, cy := r.toCell(pos.X, pos.Y)
+		cell := &r.cells[cy*w+cx]
+		if cell[glyph.Type] < ^uint16(0) {
+			cell[glyph.Type]++
+		}
+	}
+}
+
+// toCell maps a map coordinate to its minimap cell
+func (r *MinimapRenderer) toCell(mapX, mapY int) (int, int) {
+	cx := max(0, min(r.width-1, mapX*r.width/r.mapWidth))
+	cy := max(0, min(r.height-1, mapY*r.height/r.mapHeight))
+	return cx, cy
+}
+
+// drawDensity fills the panel, each cell shaded by glyph density in its dominant type's color
+func (r *MinimapRenderer) drawDensity(buf *render.RenderBuffer, x0, y0 int) {
+	palette := &visual.CVDGlyphColorLUT[r.gameCtx.World.Resources.Config.Accessibility]
+
+	// Map area covered by one cell, density is relative to a fully packed cell
+	area := max(1, (r.mapWidth/r.width)*(r.mapHeight/r.height))
+	ramp := len(visual.MinimapDensityRunes) - 1
+
+	for cy := range r.height {
+		for cx := range r.width {
+			cell := &r.cells[cy*r.width+cx]
+			total, dominant := 0, 0
+			for t, n := range cell {
+				total += int(n)
+				if n > cell[dominant] {
+					dominant = t
+				}
+			}
+
+			ch := visual.MinimapDensityRunes[0]
+			fg := visual.RgbMinimapBg
+			if total > 0 {
+				// Any glyph shows at least the first step
+				ch = visual.MinimapDensityRunes[max(1, min(ramp, total*ramp/area))]
+				fg = palette[dominant][component.GlyphBright]
+			}
+			buf.Set(x0+cx, y0+cy, ch, fg, visual.RgbMinimapBg, render.BlendReplace, 1.0, terminal.AttrNone)
+		}
+	}
+}
+
+// drawViewport tints the outline of the visible map region
+func (r *MinimapRenderer) drawViewport(ctx render.RenderContext, buf *render.RenderBuffer, x0, y0 int) {
+	minX, minY, maxX, maxY := ctx.VisibleMapBounds()
+	left, top := r.toCell(minX, minY)
+	right, bottom := r.toCell(maxX, maxY)
+
+	for cy := top; cy <= bottom; cy++ {
+		for cx := left; cx <= right; cx++ {
+			if cy != top && cy != bottom && cx != left && cx != right {
+				continue
+			}
+			buf.Set(x0+cx, y0+cy, 0, visual.RgbMinimapViewport, visual.RgbMinimapViewport, render.BlendAlphaBg, visual.MinimapViewportAlpha, terminal.AttrNone)
+		}
+	}
+}
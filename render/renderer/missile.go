@@ -66,6 +66,9 @@ func (r *MissileRenderer) renderMissileTrueColor(
 	maxAge := parameter.MissileTrailMaxAge
 
 	startCol, endCol := visual.RgbMissileChildTrailStart, visual.RgbMissileChildTrailEnd
+	if missile.Seeker {
+		startCol, endCol = visual.RgbMissileSeekerTrailStart, visual.RgbMissileSeekerTrailEnd
+	}
 
 	prevX, prevY := kinetic.PreciseX, kinetic.PreciseY
 
@@ -119,7 +122,10 @@ func (r *MissileRenderer) renderBody(
 		return
 	}
 
-	var color color.RGB
+	color := visual.RgbMissileChildBody
+	if missile.Seeker {
+		color = visual.RgbMissileSeekerBody
+	}
 	char := r.directionChar(kinetic.VelX, kinetic.VelY)
 
 	if trueColor {
@@ -190,6 +196,11 @@ func (r *MissileRenderer) renderMissile256(
 	// === Trail ===
 	maxAge := parameter.MissileTrailMaxAge
 
+	trailCol, bodyCol := visual.Missile256Trail, visual.Missile256Base
+	if missile.Seeker {
+		trailCol, bodyCol = visual.Missile256SeekerTrail, visual.Missile256SeekerBody
+	}
+
 	for i := range missile.TrailLen {
 		idx := (missile.TrailHead - missile.TrailLen + i + component.TrailCapacity) % component.TrailCapacity
 		pt := &missile.Trail[idx]
@@ -209,7 +220,7 @@ func (r *MissileRenderer) renderMissile256(
 		// Binary visibility for 256-color (no alpha blending)
 		if pt.Age < maxAge/2 {
 			buf.SetFgOnly(screenX, screenY, visual.MissileTrailChar,
-				color.RGB{R: visual.Missile256Trail}, terminal.AttrFg256)
+				color.RGB{R: trailCol}, terminal.AttrFg256)
 		}
 	}
 
@@ -224,5 +235,5 @@ func (r *MissileRenderer) renderMissile256(
 
 	char := r.directionChar(kinetic.VelX, kinetic.VelY)
 
-	buf.SetFgOnly(screenX, screenY, char, color.RGB{R: visual.Missile256Base}, terminal.AttrFg256|terminal.AttrBold)
+	buf.SetFgOnly(screenX, screenY, char, color.RGB{R: bodyCol}, terminal.AttrFg256|terminal.AttrBold)
 }
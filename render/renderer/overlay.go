@@ -1,6 +1,8 @@
 package renderer
 
 import (
+	"fmt"
+
 	"github.com/lixenwraith/color"
 	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/terminal/tui"
@@ -95,12 +97,15 @@ type OverlayRenderer struct {
 	gameCtx *engine.GameContext
 	adapter *TUIAdapter
 	masonry *tui.MasonryState
+
+	lastSelect int // Selection seen last frame; the view follows it when it moves
 }
 
 // NewOverlayRenderer creates a new overlay renderer
 func NewOverlayRenderer(gameCtx *engine.GameContext) *OverlayRenderer {
 	return &OverlayRenderer{
-		gameCtx: gameCtx,
+		gameCtx:    gameCtx,
+		lastSelect: -1,
 	}
 }
 
@@ -162,6 +167,10 @@ func (r *OverlayRenderer) renderContent(outer, content tui.Region, data *core.Ov
 		r.renderAboutContent(outer, content, data)
 		return
 	}
+	if data.Custom && data.Title == "ANALYSIS" {
+		r.renderAnalysisContent(outer, content, data)
+		return
+	}
 
 	padded := content.Sub(
 		parameter.OverlayPaddingX,
@@ -175,6 +184,22 @@ func (r *OverlayRenderer) renderContent(outer, content tui.Region, data *core.Ov
 		return
 	}
 
+	// Selection is a flat index over actionable entries; find each card's first one
+	selected := -1
+	firstAction := make(map[string]int, len(cards))
+	if data.Selectable {
+		selected = r.gameCtx.GetOverlaySelect()
+		n := 0
+		for _, card := range cards {
+			firstAction[card.Title] = n
+			for _, e := range card.Entries {
+				if e.Action != "" {
+					n++
+				}
+			}
+		}
+	}
+
 	// Convert to masonry items
 	items := make([]tui.MasonryItem, len(cards))
 	for i, card := range cards {
@@ -201,12 +226,30 @@ func (r *OverlayRenderer) renderContent(outer, content tui.Region, data *core.Ov
 	})
 
 	// Sync scroll from game context
+	r.masonry.SetViewport(padded.H)
 	r.masonry.Viewport.ScrollTo(r.gameCtx.GetOverlayScroll())
 
+	// Scroll a moved selection into view; page keys may still scroll it away
+	if selected >= 0 && selected != r.lastSelect {
+		if row, ok := selectedRow(r.masonry.Layouts, firstAction, selected); ok {
+			v := r.masonry.Viewport
+			if row < v.Offset {
+				v.ScrollTo(row)
+			} else if row >= v.Offset+padded.H {
+				v.ScrollTo(row - padded.H + 1)
+			}
+		}
+	}
+	r.lastSelect = selected
+
 	// Render visible items
 	padded.Masonry(r.masonry, func(region tui.Region, layout tui.MasonryLayout, contentOffset int) {
 		card := layout.Item.Data.(core.OverlayCard)
-		r.renderCard(region, card, contentOffset, region.H)
+		cardSelected := -1
+		if first, ok := firstAction[card.Title]; ok {
+			cardSelected = selected - first
+		}
+		r.renderCard(region, card, contentOffset, region.H, cardSelected)
 	})
 
 	// Sync clamped scroll back to GameContext to prevent drift
@@ -214,6 +257,9 @@ func (r *OverlayRenderer) renderContent(outer, content tui.Region, data *core.Ov
 
 	// Navigation hints
 	hints := "ESC close · j/k scroll · PgUp/PgDn page"
+	if data.Selectable {
+		hints = "ESC close · j/k select · Enter change · PgUp/PgDn page"
+	}
 	hintsX := (outer.W - tui.RuneLen(hints)) / 2
 	outer.Text(hintsX, outer.H-2, hints, visual.RgbOverlayHint, visual.RgbOverlayBg, terminal.AttrDim)
 
@@ -224,6 +270,24 @@ func (r *OverlayRenderer) renderContent(outer, content tui.Region, data *core.Ov
 	}
 }
 
+// selectedRow returns the content row of the selected actionable entry
+func selectedRow(layouts []tui.MasonryLayout, firstAction map[string]int, selected int) (int, bool) {
+	for _, l := range layouts {
+		card := l.Item.Data.(core.OverlayCard)
+		action := firstAction[card.Title]
+		for i, e := range card.Entries {
+			if e.Action == "" {
+				continue
+			}
+			if action == selected {
+				return l.Y + 1 + i, true // +1 for the top border
+			}
+			action++
+		}
+	}
+	return 0, false
+}
+
 func (r *OverlayRenderer) calculateCardLayouts(cards []core.OverlayCard, availW, availH int) []cardLayout {
 	// Determine column count based on width
 	var cols int
@@ -275,7 +339,8 @@ func (r *OverlayRenderer) calculateCardLayouts(cards []core.OverlayCard, availW,
 	return layouts
 }
 
-func (r *OverlayRenderer) renderCard(region tui.Region, card core.OverlayCard, entryOffset, visibleH int) {
+// renderCard draws one card; selected is the index among the card's actionable entries to highlight, -1 none
+func (r *OverlayRenderer) renderCard(region tui.Region, card core.OverlayCard, entryOffset, visibleH, selected int) {
 	// Draw card frame if top border visible
 	if entryOffset == 0 {
 		region.Box(tui.LineSingle, visual.RgbOverlayBorder)
@@ -303,8 +368,17 @@ func (r *OverlayRenderer) renderCard(region tui.Region, card core.OverlayCard, e
 
 	keyStyle := tui.Style{Fg: visual.RgbOverlayKey, Bg: visual.RgbOverlayBg}
 	valStyle := tui.Style{Fg: visual.RgbOverlayValue, Bg: visual.RgbOverlayBg}
+	selKeyStyle := tui.Style{Fg: visual.RgbOverlayText, Bg: visual.RgbOverlaySelectBg, Attr: terminal.AttrBold}
+	selValStyle := tui.Style{Fg: visual.RgbOverlayValue, Bg: visual.RgbOverlaySelectBg, Attr: terminal.AttrBold}
 
+	action := 0
 	for i, entry := range card.Entries {
+		isSelected := false
+		if entry.Action != "" {
+			isSelected = action == selected
+			action++
+		}
+
 		y := innerY + i
 		if y < 0 {
 			continue
@@ -314,6 +388,11 @@ func (r *OverlayRenderer) renderCard(region tui.Region, card core.OverlayCard, e
 		}
 
 		inner := region.Sub(innerX, y, innerW, 1)
+		if isSelected {
+			inner.Fill(visual.RgbOverlaySelectBg)
+			inner.KeyValue(0, entry.Key, entry.Value, selKeyStyle, selValStyle, ':')
+			continue
+		}
 		inner.KeyValue(0, entry.Key, entry.Value, keyStyle, valStyle, ':')
 	}
 
@@ -410,6 +489,107 @@ func (r *OverlayRenderer) renderAboutInfo(region tui.Region, bg, fg, dimFg, head
 	}
 }
 
+// renderAnalysisContent draws the session summary line above stacked timeline charts
+func (r *OverlayRenderer) renderAnalysisContent(outer, content tui.Region, data *core.OverlayContent) {
+	bg := visual.RgbOverlayBg
+	padX, padY := parameter.OverlayPaddingX, parameter.OverlayPaddingY
+	region := content.Sub(padX, padY, content.W-2*padX, content.H-2*padY-1)
+
+	y := 0
+	for _, card := range data.Cards() {
+		// Summary entries flow left to right, wrapping at region width
+		x := 0
+		for _, e := range card.Entries {
+			w := tui.RuneLen(e.Key) + tui.RuneLen(e.Value) + 2
+			if x > 0 && x+w > region.W {
+				x = 0
+				y++
+			}
+			if y >= region.H {
+				break
+			}
+			region.Text(x, y, e.Key+":", visual.RgbOverlayKey, bg, terminal.AttrNone)
+			region.Text(x+tui.RuneLen(e.Key)+2, y, e.Value, visual.RgbOverlayValue, bg, terminal.AttrNone)
+			x += w + 2
+		}
+		y += 2
+	}
+
+	charts := data.Charts()
+	if len(charts) > 0 {
+		// Drop trailing charts that would not reach the minimum height
+		shown := min(len(charts), max(0, (region.H-y)/visual.AnalysisMinChartHeight))
+		if shown > 0 {
+			chartH := (region.H - y) / shown
+			for i := range shown {
+				r.renderChart(region.Sub(0, y+i*chartH, region.W, chartH), charts[i])
+			}
+		}
+	}
+
+	hints := "ESC close · :analysis file.csv exports"
+	hintsX := (outer.W - tui.RuneLen(hints)) / 2
+	outer.Text(hintsX, outer.H-2, hints, visual.RgbOverlayHint, bg, terminal.AttrDim)
+}
+
+// renderChart draws a series as eighth-block columns resampled to the region width, markers on the bottom row
+// Each column shows the peak of the samples it covers so short spikes stay visible
+func (r *OverlayRenderer) renderChart(region tui.Region, chart core.OverlayChart) {
+	bg := visual.RgbOverlayBg
+	bodyH := region.H - 2
+	n := len(chart.Values)
+	if bodyH < 1 || region.W < 1 || n == 0 {
+		return
+	}
+
+	lo, hi := chart.Values[0], chart.Values[0]
+	for _, v := range chart.Values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	span := hi - lo
+	if span <= 0 {
+		span = 1
+	}
+
+	region.Text(0, 0, chart.Title, visual.RgbOverlayHeader, bg, terminal.AttrBold)
+	rangeLabel := fmt.Sprintf("%.0f…%.0f", lo, hi)
+	region.Text(region.W-tui.RuneLen(rangeLabel), 0, rangeLabel, visual.RgbOverlayHint, bg, terminal.AttrNone)
+
+	w := min(region.W, n)
+	steps := len(visual.AnalysisChartRunes) - 1
+	for x := range w {
+		i0 := x * n / w
+		i1 := max(i0+1, (x+1)*n/w)
+		v := chart.Values[i0]
+		for _, sv := range chart.Values[i0:i1] {
+			v = max(v, sv)
+		}
+		level := int((v-lo)/span*float64(bodyH*steps) + 0.5)
+
+		for row := range bodyH {
+			fill := level - (bodyH-1-row)*steps
+			ch := visual.AnalysisChartRunes[max(0, min(steps, fill))]
+			fg := visual.RgbAnalysisChart
+			if ch == ' ' && row == bodyH-1 {
+				ch, fg = '_', visual.RgbAnalysisAxis
+			}
+			region.Cell(x, 1+row, ch, fg, bg, terminal.AttrNone)
+		}
+	}
+
+	for _, m := range chart.Markers {
+		if m.Index < 0 || m.Index >= n {
+			continue
+		}
+		fg := visual.RgbAnalysisWorst
+		if m.Best {
+			fg = visual.RgbAnalysisBest
+		}
+		region.Cell(m.Index*w/n, region.H-1, m.Rune, fg, bg, terminal.AttrBold)
+	}
+}
+
 var logoPattern = []string{
 	"BBBBBBBBBBBBBBBBBBBBBBBBBB",
 	"BByyBBggggggBBbbbbbbBBvvBB",
@@ -0,0 +1,74 @@
+package renderer
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// popupColors is the text color indexed by engine.PopupKind
+var popupColors = [...]color.RGB{
+	engine.PopupScore:   visual.RgbPopupScore,
+	engine.PopupPenalty: visual.RgbPopupPenalty,
+	engine.PopupBanner:  visual.RgbPopupBanner,
+}
+
+// PopupRenderer draws floating score and combo text rising and fading above hit locations
+type PopupRenderer struct {
+	gameCtx *engine.GameContext
+}
+
+// NewPopupRenderer creates the popup renderer
+func NewPopupRenderer(gameCtx *engine.GameContext) *PopupRenderer {
+	return &PopupRenderer{
+		gameCtx: gameCtx,
+	}
+}
+
+// IsVisible implements render.VisibilityToggle; hidden when popups are off
+func (r *PopupRenderer) IsVisible() bool {
+	return !r.gameCtx.World.Resources.Config.Popups.Off
+}
+
+// Render draws each popup centered above its cell, eased upward and faded over its lifetime
+// Spaces are skipped so the field shows through between words
+func (r *PopupRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	popups := r.gameCtx.World.Resources.Transient.Popups()
+	if len(popups) == 0 {
+		return
+	}
+
+	buf.SetWriteMask(visual.MaskTransient)
+
+	for i := range popups {
+		p := &popups[i]
+		if p.Life <= 0 {
+			continue
+		}
+		t := vmath.ClampF(float64(p.Age)/float64(p.Life), 0, 1)
+
+		rise := int(math.Round(vmath.EaseOutCubicF(t) * visual.PopupRise))
+		alpha := 1.0
+		if t > visual.PopupFadeStart {
+			alpha = 1 - vmath.EaseInQuadF((t-visual.PopupFadeStart)/(1-visual.PopupFadeStart))
+		}
+		fg := color.Scale(popupColors[p.Kind], alpha)
+
+		mapY := p.Y - 1 - rise
+		mapX := p.X - utf8.RuneCountInString(p.Text)/2
+		for _, ch := range p.Text {
+			if ch != ' ' {
+				if screenX, screenY, visible := ctx.MapToScreen(mapX, mapY); visible {
+					buf.SetFgOnly(screenX, screenY, ch, fg, terminal.AttrBold)
+				}
+			}
+			mapX++
+		}
+	}
+}
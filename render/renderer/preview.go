@@ -0,0 +1,195 @@
+package renderer
+
+import (
+	"strconv"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// PreviewRenderer draws a panel in the bottom-right of the game area describing the
+// sequence under or nearest the cursor: its text, type, energy rate, and what is left to gain
+type PreviewRenderer struct {
+	gameCtx *engine.GameContext
+
+	run  []component.GlyphComponent // Reused per frame
+	cell [parameter.MaxEntitiesPerCell]core.Entity
+}
+
+// NewPreviewRenderer creates a sequence preview renderer
+func NewPreviewRenderer(gameCtx *engine.GameContext) *PreviewRenderer {
+	return &PreviewRenderer{
+		gameCtx: gameCtx,
+		run:     make([]component.GlyphComponent, 0, visual.PreviewMaxRun),
+	}
+}
+
+// IsVisible implements render.VisibilityToggle; hidden unless enabled by :set preview
+func (r *PreviewRenderer) IsVisible() bool {
+	return r.gameCtx.World.Resources.Config.Preview
+}
+
+// Render implements SystemRenderer
+func (r *PreviewRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	x0 := ctx.GameXOffset + ctx.ViewportWidth - visual.PreviewWidth - visual.PreviewMargin
+	y0 := ctx.GameYOffset + ctx.ViewportHeight - visual.PreviewHeight - visual.PreviewMargin
+	if x0 < ctx.GameXOffset || y0 < ctx.GameYOffset {
+		return
+	}
+	maxX := x0 + visual.PreviewWidth
+
+	buf.SetWriteMask(visual.MaskUI)
+	for y := y0; y < y0+visual.PreviewHeight; y++ {
+		for x := x0; x < maxX; x++ {
+			buf.SetWithBg(x, y, ' ', visual.RgbPreviewLabel, visual.RgbPreviewBg)
+		}
+	}
+
+	next, found := r.findSequence(ctx.CursorX, ctx.CursorY, ctx.MapWidth, ctx.MapHeight)
+	if !found {
+		r.drawText(buf, x0+1, y0, maxX, "no sequence nearby", visual.RgbPreviewLabel)
+		return
+	}
+
+	// Sequence text in glyph colors, the next character to type underlined; a run too long
+	// for the panel scrolls to keep the next character in view
+	palette := &visual.CVDGlyphColorLUT[r.gameCtx.World.Resources.Config.Accessibility]
+	x := r.drawText(buf, x0+1, y0, maxX, "seq  ", visual.RgbPreviewLabel)
+	avail := maxX - 1 - x
+	from := max(0, min(next-2, len(r.run)-avail))
+	for i := from; i < len(r.run) && i < from+avail; i++ {
+		g := r.run[i]
+		attrs := terminal.AttrNone
+		if i == next {
+			attrs = terminal.AttrUnderline | terminal.AttrBold
+		}
+		buf.Set(x, y0, g.Rune, palette[g.Type][g.Level], visual.RgbPreviewBg, render.BlendReplace, 1, attrs)
+		x++
+	}
+
+	// Type and level, "mixed" once decay has split the run
+	first := r.run[next]
+	typeText := component.GlyphTypeNames[first.Type] + " " + component.GlyphLevelNames[first.Level]
+	for _, g := range r.run[next:] {
+		if g.Type != first.Type || g.Level != first.Level {
+			typeText = "mixed"
+			break
+		}
+	}
+	x = r.drawText(buf, x0+1, y0+1, maxX, "type ", visual.RgbPreviewLabel)
+	r.drawText(buf, x, y0+1, maxX, typeText, palette[first.Type][first.Level])
+
+	heat := 0
+	if h, ok := r.gameCtx.World.Components.Heat.GetComponent(r.gameCtx.World.Resources.Player.Entity); ok {
+		heat = h.Current
+	}
+
+	// Energy per glyph is type base × heat; level only matters for decay
+	base := first.Type.EnergyBase()
+	x = r.drawText(buf, x0+1, y0+2, maxX, "rate ", visual.RgbPreviewLabel)
+	if base == 0 {
+		r.drawText(buf, x, y0+2, maxX, "no energy", visual.RgbPreviewValue)
+	} else {
+		r.drawText(buf, x, y0+2, maxX, "x"+strconv.Itoa(base)+" × heat "+strconv.Itoa(heat)+" = "+signed(base*heat), energyColor(base))
+	}
+
+	total := 0
+	for _, g := range r.run[next:] {
+		total += g.Type.EnergyBase() * heat
+	}
+	x = r.drawText(buf, x0+1, y0+3, maxX, "gain ", visual.RgbPreviewLabel)
+	r.drawText(buf, x, y0+3, maxX, signed(total)+" for "+strconv.Itoa(len(r.run)-next)+" left", energyColor(total))
+}
+
+// findSequence fills run with the glyph run under the cursor, or the nearest one on the
+// cursor row and then rows progressively further away; returns the index of the next
+// glyph to type, the one under the cursor or the run's first
+func (r *PreviewRenderer) findSequence(cursorX, cursorY, mapW, mapH int) (int, bool) {
+	r.run = r.run[:0]
+
+	for d := 0; d <= visual.PreviewSearchRows; d++ {
+		for i, y := range [2]int{cursorY + d, cursorY - d} {
+			if y < 0 || y >= mapH || (d == 0 && i == 1) {
+				continue
+			}
+			for off := 0; off < mapW; off++ {
+				for j, x := range [2]int{cursorX + off, cursorX - off} {
+					if x < 0 || x >= mapW || (off == 0 && j == 1) {
+						continue
+					}
+					if _, ok := r.glyphAt(x, y); ok {
+						return r.collectRun(x, y, cursorX, cursorY), true
+					}
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// collectRun reads the horizontal glyph run containing (x, y) into run
+func (r *PreviewRenderer) collectRun(x, y, cursorX, cursorY int) int {
+	start := x
+	for start > 0 {
+		if _, ok := r.glyphAt(start-1, y); !ok {
+			break
+		}
+		start--
+	}
+	for cx := start; len(r.run) < visual.PreviewMaxRun; cx++ {
+		g, ok := r.glyphAt(cx, y)
+		if !ok {
+			break
+		}
+		r.run = append(r.run, g)
+	}
+	if y == cursorY && cursorX > start && cursorX < start+len(r.run) {
+		return cursorX - start
+	}
+	return 0
+}
+
+// glyphAt returns the glyph at a map cell
+func (r *PreviewRenderer) glyphAt(x, y int) (component.GlyphComponent, bool) {
+	world := r.gameCtx.World
+	n := world.Positions.GetAllEntitiesAtInto(x, y, r.cell[:])
+	for _, e := range r.cell[:n] {
+		if g, ok := world.Components.Glyph.GetComponent(e); ok {
+			return g, true
+		}
+	}
+	return component.GlyphComponent{}, false
+}
+
+func (r *PreviewRenderer) drawText(buf *render.RenderBuffer, x, y, maxX int, text string, fg color.RGB) int {
+	for _, ch := range text {
+		if x >= maxX-1 {
+			break
+		}
+		buf.SetWithBg(x, y, ch, fg, visual.RgbPreviewBg)
+		x++
+	}
+	return x
+}
+
+// signed formats n with an explicit plus sign when positive
+func signed(n int) string {
+	if n > 0 {
+		return "+" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
+
+// energyColor picks the value color by the sign of an energy amount
+func energyColor(n int) color.RGB {
+	if n < 0 {
+		return visual.RgbPreviewPenalty
+	}
+	return visual.RgbPreviewValue
+}
@@ -0,0 +1,60 @@
+package renderer
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// sizeGuardNeed is the second line of the notice, fixed by the minimum dimensions
+var sizeGuardNeed = "need " + strconv.Itoa(parameter.MinTerminalWidth) + "x" + strconv.Itoa(parameter.MinTerminalHeight)
+
+// SizeGuardRenderer blanks the screen with a notice while the terminal is below the minimum size
+type SizeGuardRenderer struct {
+	gameCtx *engine.GameContext
+}
+
+// NewSizeGuardRenderer creates the too-small notice renderer
+func NewSizeGuardRenderer(gameCtx *engine.GameContext) *SizeGuardRenderer {
+	return &SizeGuardRenderer{
+		gameCtx: gameCtx,
+	}
+}
+
+// IsVisible implements render.VisibilityToggle; shown only while the terminal is too small
+func (r *SizeGuardRenderer) IsVisible() bool {
+	return r.gameCtx.TooSmall.Load()
+}
+
+// Render fills the whole terminal and centers the notice with the current size
+func (r *SizeGuardRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	buf.SetWriteMask(visual.MaskUI)
+
+	for y := 0; y < ctx.ScreenHeight; y++ {
+		for x := 0; x < ctx.ScreenWidth; x++ {
+			buf.SetWithBg(x, y, ' ', visual.RgbOverlayText, visual.RgbOverlayBg)
+		}
+	}
+
+	lines := [...]string{
+		"terminal too small",
+		sizeGuardNeed,
+		"have " + strconv.Itoa(ctx.ScreenWidth) + "x" + strconv.Itoa(ctx.ScreenHeight),
+	}
+	top := (ctx.ScreenHeight - len(lines)) / 2
+	for i, line := range lines {
+		fg := visual.RgbOverlayText
+		if i == 0 {
+			fg = visual.RgbOverlayTitle
+		}
+		x := max(0, (ctx.ScreenWidth-utf8.RuneCountInString(line))/2)
+		for _, ch := range line {
+			buf.SetWithBg(x, top+i, ch, fg, visual.RgbOverlayBg)
+			x++
+		}
+	}
+}
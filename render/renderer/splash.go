@@ -4,11 +4,8 @@ import (
 	"math"
 	"strconv"
 
-	"github.com/lixenwraith/color"
-	"github.com/lixenwraith/vi-fighter/asset"
 	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/engine"
-	"github.com/lixenwraith/vi-fighter/parameter"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/render"
 )
@@ -43,18 +40,10 @@ func (r *SplashRenderer) Render(ctx render.RenderContext, buf *render.RenderBuff
 		if splash.Slot == component.SlotTimer {
 			// Timer: render digits from remaining time (ceiling)
 			remainingSec := int(math.Ceil(splash.Remaining.Seconds()))
-
-			digits := strconv.Itoa(remainingSec)
-			for i, d := range digits {
-				charX := anchorX + i*parameter.SplashCharWidth
-				r.renderChar(ctx, buf, d, charX, anchorY, splash.Color)
-			}
+			render.BigText(&ctx, buf, anchorX, anchorY, []rune(strconv.Itoa(remainingSec)), splash.Color)
 		} else {
 			// Transient: render content directly
-			for i := range splash.Length {
-				charX := anchorX + i*parameter.SplashCharWidth
-				r.renderChar(ctx, buf, splash.Content[i], charX, anchorY, splash.Color)
-			}
+			render.BigText(&ctx, buf, anchorX, anchorY, splash.Content[:splash.Length], splash.Color)
 		}
 	}
 }
@@ -75,38 +64,3 @@ func (r *SplashRenderer) resolveAnchor(splashComp *component.SplashComponent) (i
 
 	return baseX, baseY
 }
-
-// renderChar renders a single splash character bitmap
-func (r *SplashRenderer) renderChar(ctx render.RenderContext, buf *render.RenderBuffer, char rune, gameX, gameY int, c color.RGB) {
-	var bitmap [12]uint16
-	// Bounds check and fallback for missing glyphs
-	if char < 32 || char > 126 {
-		bitmap = asset.SplashFontFallback
-	} else {
-		bitmap = asset.SplashFont[char-32]
-	}
-
-	for row := range parameter.SplashCharHeight {
-		// Map coord for this row
-		mapY := gameY + row
-
-		rowBits := bitmap[row]
-		for col := range parameter.SplashCharWidth {
-			// MSB-first: bit 15 = column 0
-			if rowBits&(1<<(15-col)) == 0 {
-				continue
-			}
-
-			// Map coord for this column
-			mapX := gameX + col
-
-			// Transform to screen with visibility check
-			screenX, screenY, visible := ctx.MapToScreen(mapX, mapY)
-			if !visible {
-				continue
-			}
-
-			buf.SetBgOnly(screenX, screenY, c)
-		}
-	}
-}
@@ -7,40 +7,22 @@ import (
 	"unicode/utf8"
 
 	"github.com/lixenwraith/color"
-	"github.com/lixenwraith/terminal"
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/parameter"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/render"
-	"github.com/lixenwraith/vi-fighter/status"
 )
 
 // StatusBarRenderer draws the status bar at the bottom
 type StatusBarRenderer struct {
 	gameCtx *engine.GameContext
 
-	// Color mode (persist throughout runtime)
-	colorMode terminal.ColorMode
-
 	// Sound/Audio indicator
 	statAudioMask *atomic.Int64
 
-	// Cached metric pointers (zero-lock reads)
-	statFPS        *atomic.Int64
-	statAPM        *atomic.Int64
-	statTicks      *atomic.Int64
-	statPhase      *atomic.Int64
-	statDecayTimer *atomic.Int64
-
-	// FSM telemetry
-	statFSMName    *status.AtomicString
-	statFSMElapsed *atomic.Int64
-	statFSMMaxDur  *atomic.Int64
-	statFSMIndex   *atomic.Int64
-	statFSMTotal   *atomic.Int64
-
-	// Energy telemetry
-	statDamageMultiplier *atomic.Int64
+	// Right-side segments bound at creation, in layout order
+	segments   []boundSegment
+	rightItems []StatusItem // Reused per frame
 
 	// Cursor blink state
 	cursorBlinkOn   bool
@@ -54,23 +36,9 @@ func NewStatusBarRenderer(gameCtx *engine.GameContext) *StatusBarRenderer {
 	return &StatusBarRenderer{
 		gameCtx: gameCtx,
 
-		colorMode: gameCtx.World.Resources.Config.ColorMode,
-
 		statAudioMask: statusReg.Ints.Get("audio.mask"),
 
-		statFPS:        statusReg.Ints.Get("engine.fps"),
-		statAPM:        statusReg.Ints.Get("engine.apm"),
-		statTicks:      statusReg.Ints.Get("engine.ticks"),
-		statPhase:      statusReg.Ints.Get("engine.phase"),
-		statDecayTimer: statusReg.Ints.Get("decay.timer"),
-
-		statFSMName:    statusReg.Strings.Get("fsm.state"),
-		statFSMElapsed: statusReg.Ints.Get("fsm.elapsed"),
-		statFSMMaxDur:  statusReg.Ints.Get("fsm.max_duration"),
-		statFSMIndex:   statusReg.Ints.Get("fsm.state_index"),
-		statFSMTotal:   statusReg.Ints.Get("fsm.state_count"),
-
-		statDamageMultiplier: statusReg.Ints.Get("energy.damage_multiplier"),
+		segments: bindStatusSegments(gameCtx),
 	}
 }
 
@@ -97,141 +65,14 @@ func (r *StatusBarRenderer) Render(ctx render.RenderContext, buf *render.RenderB
 	}
 
 	// === BUILD RIGHT-SIDE ITEMS ===
-	type statusItem struct {
-		text string
-		fg   color.RGB
-		bg   color.RGB
-	}
-	var rightItems []statusItem
-
-	// Priority 1: FSM Phase
-	phaseName := r.statFSMName.Load()
-	if phaseName != "" {
-		elapsed := time.Duration(r.statFSMElapsed.Load())
-		maxDur := time.Duration(r.statFSMMaxDur.Load())
-		phaseIdx := r.statFSMIndex.Load()
-		phaseTotal := r.statFSMTotal.Load()
-
-		var timerVal float64
-		if maxDur > 0 {
-			remaining := maxDur - elapsed
-			if remaining < 0 {
-				remaining = 0
-			}
-			timerVal = remaining.Seconds()
-		} else {
-			timerVal = elapsed.Seconds()
+	// Segments are in priority order, so dropping from the end drops the lowest priority
+	rightItems := r.rightItems[:0]
+	for _, seg := range r.segments {
+		if item, ok := seg.render(); ok {
+			rightItems = append(rightItems, item)
 		}
-
-		phaseBg := render.RainbowIndexColor(phaseIdx, phaseTotal, visual.RgbModeNormalBg)
-		rightItems = append(rightItems, statusItem{
-			text: fmt.Sprintf(" %s: %.1fs ", phaseName, timerVal),
-			fg:   visual.RgbBlack,
-			bg:   phaseBg,
-		})
-	}
-
-	// Priority 2: Energy
-	energyComp, _ := r.gameCtx.World.Components.Energy.GetComponent(r.gameCtx.World.Resources.Player.Entity)
-	energyVal := energyComp.Current
-	energyText := fmt.Sprintf(" Energy: %d ", energyVal)
-
-	var energyFg, energyBg color.RGB
-	if energyVal < 0 {
-		energyFg, energyBg = visual.RgbEnergyBg, visual.RgbBlack
-	} else {
-		energyFg, energyBg = visual.RgbBlack, visual.RgbEnergyBg
-	}
-
-	blinkRemaining := energyComp.BlinkRemaining
-	if energyComp.BlinkActive && blinkRemaining > 0 {
-		typeCode := energyComp.BlinkType
-		if typeCode == 0 {
-			energyFg = visual.RgbCursorError
-		} else {
-			var blinkColor color.RGB
-			switch typeCode {
-			case 1:
-				blinkColor = visual.RgbEnergyBlinkBlue
-			case 2:
-				blinkColor = visual.RgbEnergyBlinkGreen
-			case 3:
-				blinkColor = visual.RgbEnergyBlinkRed
-			case 4:
-				blinkColor = visual.RgbGlyphGold
-			default:
-				blinkColor = visual.RgbEnergyBlinkWhite
-			}
-			energyFg, energyBg = visual.RgbBlack, blinkColor
-		}
-	}
-	rightItems = append(rightItems, statusItem{text: energyText, fg: energyFg, bg: energyBg})
-
-	// Priority 3: Damage Multiplier (cycle scaling)
-	dmgMult := r.statDamageMultiplier.Load()
-	if dmgMult > 1 {
-		rightItems = append(rightItems, statusItem{
-			text: fmt.Sprintf(" x%d ", dmgMult),
-			fg:   visual.RgbBlack,
-			bg:   visual.RgbCursorError, // Red background
-		})
-	}
-
-	// Priority 4: Boost (conditional)
-	boost, boostOk := r.gameCtx.World.Components.Boost.GetComponent(r.gameCtx.World.Resources.Player.Entity)
-	if boostOk && boost.Active {
-		remaining := boost.Remaining.Seconds()
-		if remaining < 0 {
-			remaining = 0
-		}
-		rightItems = append(rightItems, statusItem{
-			text: fmt.Sprintf(" Boost: %.1fs ", remaining),
-			fg:   visual.RgbStatusText,
-			bg:   visual.RgbBoostBg,
-		})
-	}
-
-	// Priority 5: Grid (conditional)
-	if ping, ok := r.gameCtx.World.Components.Ping.GetComponent(r.gameCtx.World.Resources.Player.Entity); ok && ping.GridActive {
-		gridRemaining := ping.GridRemaining.Seconds()
-		if gridRemaining < 0 {
-			gridRemaining = 0
-		}
-		rightItems = append(rightItems, statusItem{
-			text: fmt.Sprintf(" Grid: %.1fs ", gridRemaining),
-			fg:   visual.RgbGridTimerFg,
-			bg:   visual.RgbBackground,
-		})
-	}
-
-	// Priority 6-9: Metrics (lowest priority, dropped first)
-	rightItems = append(rightItems, statusItem{
-		text: fmt.Sprintf(" APM: %d ", r.statAPM.Load()),
-		fg:   visual.RgbBlack,
-		bg:   visual.RgbApmBg,
-	})
-	rightItems = append(rightItems, statusItem{
-		text: fmt.Sprintf(" GT: %d ", r.statTicks.Load()),
-		fg:   visual.RgbBlack,
-		bg:   visual.RgbGtBg,
-	})
-	rightItems = append(rightItems, statusItem{
-		text: fmt.Sprintf(" FPS: %d ", r.statFPS.Load()),
-		fg:   visual.RgbBlack,
-		bg:   visual.RgbFpsBg,
-	})
-
-	var colorModeStr string
-	if r.colorMode == terminal.ColorModeTrueColor {
-		colorModeStr = " TC "
-	} else {
-		colorModeStr = " 256 "
 	}
-	rightItems = append(rightItems, statusItem{
-		text: colorModeStr,
-		fg:   visual.RgbBlack,
-		bg:   visual.RgbColorModeIndicator,
-	})
+	r.rightItems = rightItems
 
 	// === RENDER LEFT-SIDE FIXED ELEMENTS ===
 	x := 0
@@ -339,7 +180,7 @@ func (r *StatusBarRenderer) Render(ctx render.RenderContext, buf *render.RenderB
 	// Calculate widths for all right items
 	itemWidths := make([]int, len(rightItems))
 	for i, item := range rightItems {
-		itemWidths[i] = utf8.RuneCountInString(item.text)
+		itemWidths[i] = utf8.RuneCountInString(item.Text)
 	}
 
 	availableTotal := ctx.ScreenWidth - leftEndX
@@ -401,8 +242,8 @@ func (r *StatusBarRenderer) Render(ctx render.RenderContext, buf *render.RenderB
 		startX := ctx.ScreenWidth - rightFitWidth
 		for i := range fitCount {
 			item := rightItems[i]
-			for _, ch := range item.text {
-				buf.SetWithBg(startX, statusY, ch, item.fg, item.bg)
+			for _, ch := range item.Text {
+				buf.SetWithBg(startX, statusY, ch, item.Fg, item.Bg)
 				startX++
 			}
 		}
@@ -0,0 +1,254 @@
+package renderer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// StatusItem is the text and colors a segment shows this frame
+type StatusItem struct {
+	Text   string
+	Fg, Bg color.RGB
+}
+
+// StatusSegmentFunc produces a bound segment's item each frame, under the world lock
+// ok false hides the segment for the frame
+type StatusSegmentFunc func() (item StatusItem, ok bool)
+
+// StatusSegment is a right-side status bar item
+// Segments are laid out left to right by descending Priority, and the lowest priority
+// segments are dropped first when the bar is too narrow, as with tui.BarSection
+type StatusSegment struct {
+	Name     string
+	Priority int // Higher survives truncation; ties keep registration order
+	MinWidth int // Text is padded to at least this many cells so changing values don't shift the bar
+
+	// Bind runs once per status bar to cache per-game state, such as status registry pointers
+	Bind func(ctx *engine.GameContext) StatusSegmentFunc
+}
+
+var (
+	statusSegmentMu sync.RWMutex
+	statusSegments  []StatusSegment
+)
+
+// RegisterStatusSegment adds a segment, or replaces the one with the same name in place
+// Status bars bind the registry when created, so register from init
+func RegisterStatusSegment(s StatusSegment) {
+	statusSegmentMu.Lock()
+	defer statusSegmentMu.Unlock()
+	if i := slices.IndexFunc(statusSegments, func(o StatusSegment) bool { return o.Name == s.Name }); i >= 0 {
+		statusSegments[i] = s
+		return
+	}
+	statusSegments = append(statusSegments, s)
+}
+
+// StatusSegments snapshots the registry in layout order
+func StatusSegments() []StatusSegment {
+	statusSegmentMu.RLock()
+	out := slices.Clone(statusSegments)
+	statusSegmentMu.RUnlock()
+	slices.SortStableFunc(out, func(a, b StatusSegment) int { return b.Priority - a.Priority })
+	return out
+}
+
+// boundSegment is a segment bound to one status bar
+type boundSegment struct {
+	minWidth int
+	item     StatusSegmentFunc
+}
+
+func bindStatusSegments(ctx *engine.GameContext) []boundSegment {
+	segs := StatusSegments()
+	bound := make([]boundSegment, 0, len(segs))
+	for _, s := range segs {
+		if s.Bind != nil {
+			bound = append(bound, boundSegment{minWidth: s.MinWidth, item: s.Bind(ctx)})
+		}
+	}
+	return bound
+}
+
+// render returns the padded item
+func (b boundSegment) render() (StatusItem, bool) {
+	item, ok := b.item()
+	if !ok {
+		return item, false
+	}
+	if pad := b.minWidth - utf8.RuneCountInString(item.Text); pad > 0 {
+		item.Text += strings.Repeat(" ", pad)
+	}
+	return item, true
+}
+
+// Built-in segment priorities
+const (
+	statusPriorityPhase      = 900
+	statusPriorityEnergy     = 800
+	statusPriorityMultiplier = 700
+	statusPriorityBoost      = 600
+	statusPriorityGrid       = 500
+	statusPriorityAPM        = 400
+	statusPriorityTicks      = 300
+	statusPriorityFPS        = 200
+	statusPriorityColorMode  = 100
+)
+
+func init() {
+	RegisterStatusSegment(StatusSegment{Name: "phase", Priority: statusPriorityPhase, Bind: bindPhaseSegment})
+	RegisterStatusSegment(StatusSegment{Name: "energy", Priority: statusPriorityEnergy, Bind: bindEnergySegment})
+	RegisterStatusSegment(StatusSegment{Name: "multiplier", Priority: statusPriorityMultiplier, Bind: bindMultiplierSegment})
+	RegisterStatusSegment(StatusSegment{Name: "boost", Priority: statusPriorityBoost, Bind: bindBoostSegment})
+	RegisterStatusSegment(StatusSegment{Name: "grid", Priority: statusPriorityGrid, Bind: bindGridSegment})
+	RegisterStatusSegment(StatusSegment{Name: "apm", Priority: statusPriorityAPM, Bind: statSegment("engine.apm", " APM: %d ", visual.RgbApmBg)})
+	RegisterStatusSegment(StatusSegment{Name: "ticks", Priority: statusPriorityTicks, Bind: statSegment("engine.ticks", " GT: %d ", visual.RgbGtBg)})
+	RegisterStatusSegment(StatusSegment{Name: "fps", Priority: statusPriorityFPS, Bind: statSegment("engine.fps", " FPS: %d ", visual.RgbFpsBg)})
+	RegisterStatusSegment(StatusSegment{Name: "color_mode", Priority: statusPriorityColorMode, Bind: bindColorModeSegment})
+}
+
+// statSegment binds a segment showing an integer status metric on bg
+func statSegment(key, format string, bg color.RGB) func(*engine.GameContext) StatusSegmentFunc {
+	return func(ctx *engine.GameContext) StatusSegmentFunc {
+		stat := ctx.World.Resources.Status.Ints.Get(key)
+		return func() (StatusItem, bool) {
+			return StatusItem{Text: fmt.Sprintf(format, stat.Load()), Fg: visual.RgbBlack, Bg: bg}, true
+		}
+	}
+}
+
+// bindPhaseSegment shows the FSM state with its countdown, or elapsed time when unbounded
+func bindPhaseSegment(ctx *engine.GameContext) StatusSegmentFunc {
+	statusReg := ctx.World.Resources.Status
+	name := statusReg.Strings.Get("fsm.state")
+	elapsedStat := statusReg.Ints.Get("fsm.elapsed")
+	maxDurStat := statusReg.Ints.Get("fsm.max_duration")
+	index := statusReg.Ints.Get("fsm.state_index")
+	total := statusReg.Ints.Get("fsm.state_count")
+
+	return func() (StatusItem, bool) {
+		phaseName := name.Load()
+		if phaseName == "" {
+			return StatusItem{}, false
+		}
+		elapsed := time.Duration(elapsedStat.Load())
+		maxDur := time.Duration(maxDurStat.Load())
+
+		var timerVal float64
+		if maxDur > 0 {
+			timerVal = max(maxDur-elapsed, 0).Seconds()
+		} else {
+			timerVal = elapsed.Seconds()
+		}
+
+		return StatusItem{
+			Text: fmt.Sprintf(" %s: %.1fs ", phaseName, timerVal),
+			Fg:   visual.RgbBlack,
+			Bg:   render.RainbowIndexColor(index.Load(), total.Load(), visual.RgbModeNormalBg),
+		}, true
+	}
+}
+
+// bindEnergySegment shows player energy, inverted when negative and flashing on typing feedback
+func bindEnergySegment(ctx *engine.GameContext) StatusSegmentFunc {
+	world := ctx.World
+	return func() (StatusItem, bool) {
+		energyComp, _ := world.Components.Energy.GetComponent(world.Resources.Player.Entity)
+		energyVal := energyComp.Current
+		item := StatusItem{Text: fmt.Sprintf(" Energy: %d ", energyVal)}
+
+		if energyVal < 0 {
+			item.Fg, item.Bg = visual.RgbEnergyBg, visual.RgbBlack
+		} else {
+			item.Fg, item.Bg = visual.RgbBlack, visual.RgbEnergyBg
+		}
+
+		if energyComp.BlinkActive && energyComp.BlinkRemaining > 0 {
+			typeCode := energyComp.BlinkType
+			if typeCode == 0 {
+				item.Fg = visual.RgbCursorError
+			} else {
+				var blinkColor color.RGB
+				switch typeCode {
+				case 1:
+					blinkColor = visual.RgbEnergyBlinkBlue
+				case 2:
+					blinkColor = visual.RgbEnergyBlinkGreen
+				case 3:
+					blinkColor = visual.RgbEnergyBlinkRed
+				case 4:
+					blinkColor = visual.RgbGlyphGold
+				default:
+					blinkColor = visual.RgbEnergyBlinkWhite
+				}
+				item.Fg, item.Bg = visual.RgbBlack, blinkColor
+			}
+		}
+		return item, true
+	}
+}
+
+// bindMultiplierSegment shows the cycle damage multiplier once it scales
+func bindMultiplierSegment(ctx *engine.GameContext) StatusSegmentFunc {
+	stat := ctx.World.Resources.Status.Ints.Get("energy.damage_multiplier")
+	return func() (StatusItem, bool) {
+		dmgMult := stat.Load()
+		if dmgMult <= 1 {
+			return StatusItem{}, false
+		}
+		return StatusItem{Text: fmt.Sprintf(" x%d ", dmgMult), Fg: visual.RgbBlack, Bg: visual.RgbCursorError}, true
+	}
+}
+
+// bindBoostSegment shows the remaining boost while active
+func bindBoostSegment(ctx *engine.GameContext) StatusSegmentFunc {
+	world := ctx.World
+	return func() (StatusItem, bool) {
+		boost, ok := world.Components.Boost.GetComponent(world.Resources.Player.Entity)
+		if !ok || !boost.Active {
+			return StatusItem{}, false
+		}
+		return StatusItem{
+			Text: fmt.Sprintf(" Boost: %.1fs ", max(boost.Remaining, 0).Seconds()),
+			Fg:   visual.RgbStatusText,
+			Bg:   visual.RgbBoostBg,
+		}, true
+	}
+}
+
+// bindGridSegment shows the remaining ping grid while active
+func bindGridSegment(ctx *engine.GameContext) StatusSegmentFunc {
+	world := ctx.World
+	return func() (StatusItem, bool) {
+		ping, ok := world.Components.Ping.GetComponent(world.Resources.Player.Entity)
+		if !ok || !ping.GridActive {
+			return StatusItem{}, false
+		}
+		return StatusItem{
+			Text: fmt.Sprintf(" Grid: %.1fs ", max(ping.GridRemaining, 0).Seconds()),
+			Fg:   visual.RgbGridTimerFg,
+			Bg:   visual.RgbBackground,
+		}, true
+	}
+}
+
+// bindColorModeSegment shows the output color mode, fixed for the session
+func bindColorModeSegment(ctx *engine.GameContext) StatusSegmentFunc {
+	text := " 256 "
+	if ctx.World.Resources.Config.ColorMode == terminal.ColorModeTrueColor {
+		text = " TC "
+	}
+	return func() (StatusItem, bool) {
+		return StatusItem{Text: text, Fg: visual.RgbBlack, Bg: visual.RgbColorModeIndicator}, true
+	}
+}
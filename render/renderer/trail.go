@@ -0,0 +1,183 @@
+package renderer
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// trailParticle is a cursor trail particle in map space
+type trailParticle struct {
+	x, y      float64
+	vx, vy    float64
+	age, life float64
+	intensity float64 // Move-length scale of the spawning motion
+	hue       int
+}
+
+// TrailRenderer emits particles along cursor motion and blends them over the ping highlight
+// Particles live in the renderer; they carry no game state
+type TrailRenderer struct {
+	gameCtx *engine.GameContext
+
+	particles []trailParticle
+	rng       *vmath.FastRand
+	style     visual.TrailStyle
+
+	lastX, lastY int
+	hasLast      bool
+	hue          int
+}
+
+// NewTrailRenderer creates the cursor trail renderer
+func NewTrailRenderer(gameCtx *engine.GameContext) *TrailRenderer {
+	return &TrailRenderer{
+		gameCtx:   gameCtx,
+		particles: make([]trailParticle, 0, visual.TrailMaxParticles),
+		rng:       vmath.NewFastRand(0x7A11C0DE),
+	}
+}
+
+// IsVisible implements render.VisibilityToggle; hidden when off or while typing commands
+func (r *TrailRenderer) IsVisible() bool {
+	return r.gameCtx.World.Resources.Config.Trail != visual.TrailOff &&
+		!r.gameCtx.IsSearchMode() && !r.gameCtx.IsCommandMode()
+}
+
+// Render spawns particles for this frame's cursor motion, ages the pool, and draws it
+func (r *TrailRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	// Style switch or re-enable starts a fresh trail instead of streaking from a stale position
+	if style := r.gameCtx.World.Resources.Config.Trail; style != r.style {
+		r.style = style
+		r.particles = r.particles[:0]
+		r.hasLast = false
+	}
+
+	// Degraded output drops the trail, restarting fresh when quality returns
+	if ctx.Quality != visual.QualityFull {
+		r.particles = r.particles[:0]
+		r.hasLast = false
+		return
+	}
+
+	if r.hasLast && (ctx.CursorX != r.lastX || ctx.CursorY != r.lastY) {
+		r.emit(r.lastX, r.lastY, ctx.CursorX, ctx.CursorY)
+	}
+	r.lastX, r.lastY, r.hasLast = ctx.CursorX, ctx.CursorY, true
+
+	if !ctx.IsPaused {
+		r.step(ctx.DeltaTime)
+	}
+	if len(r.particles) == 0 {
+		return
+	}
+
+	buf.SetWriteMask(visual.MaskTransient)
+
+	for i := range r.particles {
+		p := &r.particles[i]
+		screenX, screenY, visible := ctx.MapToScreen(int(math.Round(p.x)), int(math.Round(p.y)))
+		if !visible {
+			continue
+		}
+
+		t := p.age / p.life
+		alpha := visual.TrailMaxAlpha * p.intensity * (1 - t)
+
+		switch r.style {
+		case visual.TrailComet:
+			c := color.Lerp(visual.RgbTrailCometHead, visual.RgbTrailCometTail, t)
+			buf.Set(screenX, screenY, 0, c, c, render.BlendAlphaBg, alpha, terminal.AttrNone)
+		case visual.TrailRainbow:
+			c := render.HueRamp(p.hue)
+			buf.Set(screenX, screenY, 0, c, c, render.BlendAlphaBg, alpha, terminal.AttrNone)
+		case visual.TrailSparks:
+			// Rune is drawn under glyphs, so sparks only show on empty cells
+			c := color.Lerp(visual.RgbTrailSparkHot, visual.RgbTrailSparkCool, t)
+			buf.Set(screenX, screenY, 0, c, c, render.BlendAlphaBg, alpha*0.5, terminal.AttrNone)
+			buf.SetFgOnly(screenX, screenY, sparkRune(t), c, terminal.AttrNone)
+		}
+	}
+}
+
+// emit samples the path between two map cells and spawns particles scaled by move length
+func (r *TrailRenderer) emit(fromX, fromY, toX, toY int) {
+	dx, dy := toX-fromX, toY-fromY
+	dist := max(vmath.IntAbs(dx), vmath.IntAbs(dy))
+	steps := min(dist, visual.TrailMaxSteps)
+	intensity := min(1.0, visual.TrailIntensityMin+float64(dist)/visual.TrailIntensityDistance)
+
+	// Walk from the old position toward the cursor, the cursor cell itself is left to the cursor renderer
+	for k := range steps {
+		f := float64(k) / float64(steps)
+		x := float64(fromX) + float64(dx)*f
+		y := float64(fromY) + float64(dy)*f
+		r.hue = (r.hue + visual.TrailRainbowHueStep) & 0xFF
+
+		switch r.style {
+		case visual.TrailComet, visual.TrailRainbow:
+			// Cells nearer the head live longer so the trail tapers toward the origin
+			r.spawn(trailParticle{
+				x: x, y: y,
+				life:      visual.TrailLife * (0.5 + 0.5*f),
+				intensity: intensity,
+				hue:       r.hue,
+			})
+		case visual.TrailSparks:
+			count := max(1, int(math.Round(visual.TrailSparkPerCell*intensity)))
+			for range count {
+				angle := r.rng.Float64() * 2 * math.Pi
+				speed := visual.TrailSparkSpeedMin + r.rng.Float64()*(visual.TrailSparkSpeedMax-visual.TrailSparkSpeedMin)
+				r.spawn(trailParticle{
+					x: x, y: y,
+					vx:        math.Cos(angle) * speed,
+					vy:        math.Sin(angle) * speed * 0.5, // Cells are ~2:1
+					life:      visual.TrailSparkLife * (0.6 + 0.4*r.rng.Float64()),
+					intensity: intensity,
+				})
+			}
+		}
+	}
+}
+
+// spawn adds a particle, dropped when the pool is full
+func (r *TrailRenderer) spawn(p trailParticle) {
+	if len(r.particles) < visual.TrailMaxParticles {
+		r.particles = append(r.particles, p)
+	}
+}
+
+// step ages and moves particles, compacting out the expired ones in place
+func (r *TrailRenderer) step(dt float64) {
+	drag := math.Exp(-visual.TrailSparkDrag * dt)
+	live := r.particles[:0]
+	for _, p := range r.particles {
+		p.age += dt
+		if p.age >= p.life {
+			continue
+		}
+		p.x += p.vx * dt
+		p.y += p.vy * dt
+		p.vx *= drag
+		p.vy *= drag
+		live = append(live, p)
+	}
+	r.particles = live
+}
+
+// sparkRune fades a spark through smaller glyphs as it ages
+func sparkRune(t float64) rune {
+	switch {
+	case t < 0.3:
+		return '*'
+	case t < 0.7:
+		return '+'
+	default:
+		return '·'
+	}
+}
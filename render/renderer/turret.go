@@ -0,0 +1,53 @@
+package renderer
+
+import (
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// TurretRenderer draws turrets colored by firing state, their words render as glyphs
+type TurretRenderer struct {
+	gameCtx *engine.GameContext
+}
+
+// NewTurretRenderer creates a new turret renderer
+func NewTurretRenderer(gameCtx *engine.GameContext) *TurretRenderer {
+	return &TurretRenderer{
+		gameCtx: gameCtx,
+	}
+}
+
+// Render draws all turret entities
+func (r *TurretRenderer) Render(ctx render.RenderContext, buf *render.RenderBuffer) {
+	turrets := r.gameCtx.World.Components.Turret
+	if turrets.CountEntities() == 0 {
+		return
+	}
+
+	buf.SetWriteMask(visual.MaskComposite)
+
+	turrets.Each(func(entity core.Entity, turretComp *component.TurretComponent) bool {
+		turretPos, ok := r.gameCtx.World.Positions.GetPosition(entity)
+		if !ok {
+			return true
+		}
+		screenX, screenY, visible := ctx.MapToScreen(turretPos.X, turretPos.Y)
+		if !visible {
+			return true
+		}
+
+		fg := visual.RgbTurretRearm
+		switch {
+		case turretComp.Primed:
+			fg = visual.RgbTurretPrimed
+		case turretComp.Word != nil:
+			fg = visual.RgbTurretArmed
+		}
+		buf.SetFgOnly(screenX, screenY, visual.TurretChar, fg, terminal.AttrBold)
+		return true
+	})
+}
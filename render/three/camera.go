@@ -0,0 +1,70 @@
+// Package three draws simple perspective 3D scenes into a render.RenderBuffer: shaded
+// spheres, flat billboards, and point meshes, depth sorted far to near
+package three
+
+import "math"
+
+// Vec3 is a camera-space point; X right, Y down, Z into the screen
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// Add returns a + b
+func (a Vec3) Add(b Vec3) Vec3 {
+	return Vec3{a.X + b.X, a.Y + b.Y, a.Z + b.Z}
+}
+
+// Scale returns v scaled by s
+func (v Vec3) Scale(s float64) Vec3 {
+	return Vec3{v.X * s, v.Y * s, v.Z * s}
+}
+
+// Normalize returns v at unit length, or zero for the zero vector
+func (v Vec3) Normalize() Vec3 {
+	m := math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+	if m == 0 {
+		return Vec3{}
+	}
+	return v.Scale(1 / m)
+}
+
+// Camera maps camera space to screen cells, looking down +Z from -Focal
+type Camera struct {
+	Focal  float64 // Distance from the eye to the Z=0 plane, where scale is 1
+	Zoom   float64 // Cells of screen height per world unit at Z=0, as a fraction of Height
+	Aspect float64 // Cell height:width ratio, X is stretched by it so circles stay round
+	Near   float64 // Minimum eye distance, closer points are clamped rather than inverted
+
+	Width, Height int // Viewport in cells
+}
+
+// NewCamera returns a camera for a width×height viewport with terminal cell aspect
+func NewCamera(width, height int) Camera {
+	return Camera{
+		Focal:  14,
+		Zoom:   0.13,
+		Aspect: 2,
+		Near:   0.5,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// Projection is a point placed on screen, with the perspective factor for sizing
+type Projection struct {
+	X, Y  float64 // Screen cell coordinates, fractional
+	Scale float64 // Cells per world unit vertically at this depth
+	Depth float64 // Camera-space Z, larger is farther
+}
+
+// Project places p on screen
+func (c *Camera) Project(p Vec3) Projection {
+	invZ := c.Focal / max(p.Z+c.Focal, c.Near)
+	scale := invZ * float64(c.Height) * c.Zoom
+	return Projection{
+		X:     float64(c.Width)/2 + p.X*scale*c.Aspect,
+		Y:     float64(c.Height)/2 + p.Y*scale,
+		Scale: scale,
+		Depth: p.Z,
+	}
+}
@@ -0,0 +1,51 @@
+package three
+
+import "math"
+
+// Light is a directional light with a Blinn-Phong specular highlight, viewed along -Z
+type Light struct {
+	Dir       Vec3    // Unit vector toward the light
+	Half      Vec3    // Unit half vector between the light and the viewer
+	Shininess float64 // Specular exponent, higher is a tighter highlight
+	Specular  float64 // Highlight strength, 1 adds full white at the peak
+}
+
+// NewLight returns a light shining from direction (x, y, z), normalized
+func NewLight(x, y, z float64) Light {
+	dir := Vec3{x, y, z}.Normalize()
+	return Light{
+		Dir:       dir,
+		Half:      dir.Add(Vec3{Z: 1}).Normalize(),
+		Shininess: 20,
+		Specular:  0.9,
+	}
+}
+
+// DefaultLight is an upper-left key light in front of the scene
+func DefaultLight() Light {
+	return NewLight(-0.35, -0.55, 0.75)
+}
+
+// Highlight returns the specular term for surface normal n
+func (l *Light) Highlight(n Vec3) float64 {
+	d := n.X*l.Half.X + n.Y*l.Half.Y + n.Z*l.Half.Z
+	if d <= 0 {
+		return 0
+	}
+	return math.Pow(d, l.Shininess) * l.Specular
+}
+
+// Fog dims primitives with depth between Near and Far by up to Fade
+type Fog struct {
+	Near, Far float64
+	Fade      float64
+}
+
+// Brightness returns the depth multiplier at camera-space z
+func (f Fog) Brightness(z float64) float64 {
+	if f.Far <= f.Near {
+		return 1
+	}
+	t := max(0, min(1, (z-f.Near)/(f.Far-f.Near)))
+	return 1 - t*f.Fade
+}
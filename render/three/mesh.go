@@ -0,0 +1,87 @@
+package three
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// Mesh is a point cloud drawn as one glyph per vertex, nearer points brighter
+// Points are in model space, rotated by Yaw about Y then Pitch about X, then moved to Origin
+type Mesh struct {
+	Points     []Vec3
+	Origin     Vec3
+	Yaw, Pitch float64 // Radians
+	Color      color.RGB
+	Rune       rune // Zero draws '•'
+}
+
+// meshDepthRange is the model-space depth span mapped from full to half brightness
+const meshDepthRange = 2.0
+
+func (s *Scene) drawMesh(buf *render.RenderBuffer, m *Mesh) {
+	w, h := s.Camera.Width, s.Camera.Height
+	if w <= 0 || h <= 0 {
+		return
+	}
+	if cap(s.zbuf) < w*h {
+		s.zbuf = make([]float64, w*h)
+	}
+	zbuf := s.zbuf[:w*h]
+	for i := range zbuf {
+		zbuf[i] = math.Inf(1)
+	}
+
+	ch := m.Rune
+	if ch == 0 {
+		ch = '•'
+	}
+	sinY, cosY := math.Sincos(m.Yaw)
+	sinP, cosP := math.Sincos(m.Pitch)
+
+	// Two passes: the first keeps the nearest depth per cell, the second draws only those points
+	// so a back vertex never overwrites a front one regardless of point order
+	for pass := range 2 {
+		for _, pt := range m.Points {
+			x := pt.X*cosY + pt.Z*sinY
+			z := -pt.X*sinY + pt.Z*cosY
+			y := pt.Y*cosP - z*sinP
+			z = pt.Y*sinP + z*cosP
+
+			p := s.Camera.Project(Vec3{x, y, z}.Add(m.Origin))
+			sx, sy := int(math.Floor(p.X)), int(math.Floor(p.Y))
+			if sx < 0 || sx >= w || sy < 0 || sy >= h {
+				continue
+			}
+			idx := sy*w + sx
+
+			if pass == 0 {
+				zbuf[idx] = min(zbuf[idx], p.Depth)
+				continue
+			}
+			if p.Depth != zbuf[idx] {
+				continue
+			}
+			zbuf[idx] = math.Inf(-1) // Drawn, coincident duplicates are skipped
+
+			near := 1 - max(0, min(1, z/meshDepthRange+0.5))*0.5
+			fg := color.Scale(m.Color, near*s.Fog.Brightness(p.Depth))
+			buf.SetFgOnly(sx, sy, ch, fg, terminal.AttrNone)
+		}
+	}
+}
+
+// FibonacciSphere returns n points spread evenly over a sphere of radius r
+func FibonacciSphere(n int, r float64) []Vec3 {
+	points := make([]Vec3, n)
+	golden := math.Pi * (3 - math.Sqrt(5))
+	for i := range n {
+		y := 1 - (float64(i)+0.5)/float64(n)*2
+		ring := math.Sqrt(1 - y*y)
+		sin, cos := math.Sincos(golden * float64(i))
+		points[i] = Vec3{cos * ring * r, y * r, sin * ring * r}
+	}
+	return points
+}
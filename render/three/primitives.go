@@ -0,0 +1,145 @@
+package three
+
+import (
+	"math"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+// Sphere shading constants, in units of the sphere radius
+const (
+	sphereBoost    = 1.3 // Base color gain for a saturated neon look
+	sphereCore     = 0.7 // Radius of the white-hot center
+	sphereEdge     = 0.08
+	sphereGlow     = 1.6 // Halo extent
+	sphereGlowCut  = 2.5 // Squared distance where the halo is dropped
+	sphereGlowFade = 0.6
+	sphereMinCells = 0.4 // Projected radius below which a sphere is skipped
+)
+
+// Sphere is a shaded ball with a bright core, rim light, specular highlight, and additive halo
+type Sphere struct {
+	Center Vec3
+	Radius float64
+	Color  color.RGB
+	Flash  float64 // 0..1 blend toward white
+	Ring   float64 // 0..1 brightness of an outline ring, e.g. for selection
+	Frost  bool    // Recolor to an icy monochrome
+}
+
+// Billboard is a flat disc facing the camera, fading from the center, blended additively
+type Billboard struct {
+	Center Vec3
+	Radius float64
+	Color  color.RGB
+	Alpha  float64 // Center opacity
+}
+
+func (s *Scene) drawSphere(buf *render.RenderBuffer, sp *Sphere) {
+	p := s.Camera.Project(sp.Center)
+	radius := sp.Radius * p.Scale
+	if radius < sphereMinCells {
+		return
+	}
+
+	minX, minY, maxX, maxY := s.bounds(p, radius*sphereGlow)
+	bright := s.Fog.Brightness(p.Depth)
+	baseR := math.Min(255, float64(sp.Color.R)*sphereBoost)
+	baseG := math.Min(255, float64(sp.Color.G)*sphereBoost)
+	baseB := math.Min(255, float64(sp.Color.B)*sphereBoost)
+
+	for sy := minY; sy <= maxY; sy++ {
+		for sx := minX; sx <= maxX; sx++ {
+			nx := (float64(sx) + 0.5 - p.X) / (radius * s.Camera.Aspect)
+			ny := (float64(sy) + 0.5 - p.Y) / radius
+			distSq := nx*nx + ny*ny
+			if distSq > sphereGlowCut {
+				continue
+			}
+			dist := math.Sqrt(distSq)
+
+			var r, g, b float64
+			inside := distSq <= 1
+			if inside {
+				nz := math.Sqrt(1 - distSq)
+				rim := (1 - nz) * (1 - nz) * 0.8
+
+				core := 0.0
+				if dist < sphereCore {
+					core = (1 - dist/sphereCore) * 0.6
+				}
+				white := (core + s.Light.Highlight(Vec3{nx, ny, nz})) * 255
+
+				intensity := (0.4 + rim*0.6) * bright
+				r = baseR*intensity + white
+				g = baseG*intensity + white
+				b = baseB*intensity + white
+			} else {
+				falloff := math.Exp(-(dist-1)*3) * 0.5 * bright
+				r, g, b = baseR*falloff, baseG*falloff, baseB*falloff
+			}
+
+			if sp.Frost {
+				avg := (r + g + b) / 3
+				r, g, b = avg*0.5, avg*0.8+40, avg+60
+			}
+			if sp.Flash > 0 {
+				f := sp.Flash * 0.8
+				r, g, b = r*(1-f)+255*f, g*(1-f)+255*f, b*(1-f)+255*f
+			}
+			if sp.Ring > 0 && distSq > 0.8 && distSq <= 1.2 {
+				r, g, b = r+80*sp.Ring, g+80*sp.Ring, b+40*sp.Ring
+			}
+			c := color.RGB{R: clamp8(r), G: clamp8(g), B: clamp8(b)}
+
+			// Solid body with an antialiased edge; the halo fades out and adds light
+			if inside {
+				alpha := min(1, (1-dist)/sphereEdge)
+				buf.Set(sx, sy, ' ', color.RGB{}, c, render.BlendAlpha, alpha, terminal.AttrNone)
+			} else {
+				alpha := math.Max(0, 1-(dist-1)/sphereGlowFade)
+				buf.Set(sx, sy, ' ', color.RGB{}, c, render.BlendScreen, alpha*0.7, terminal.AttrNone)
+			}
+		}
+	}
+}
+
+func (s *Scene) drawBillboard(buf *render.RenderBuffer, bb *Billboard) {
+	p := s.Camera.Project(bb.Center)
+	radius := bb.Radius * p.Scale
+	c := color.Scale(bb.Color, s.Fog.Brightness(p.Depth))
+
+	// Too small for a disc, light the center cell
+	if radius < 0.5 {
+		x, y := int(p.X), int(p.Y)
+		if x >= 0 && x < s.Camera.Width && y >= 0 && y < s.Camera.Height {
+			buf.Set(x, y, ' ', color.RGB{}, c, render.BlendScreen, bb.Alpha, terminal.AttrNone)
+		}
+		return
+	}
+
+	minX, minY, maxX, maxY := s.bounds(p, radius)
+	for sy := minY; sy <= maxY; sy++ {
+		for sx := minX; sx <= maxX; sx++ {
+			nx := (float64(sx) + 0.5 - p.X) / (radius * s.Camera.Aspect)
+			ny := (float64(sy) + 0.5 - p.Y) / radius
+			distSq := nx*nx + ny*ny
+			if distSq > 1 {
+				continue
+			}
+			buf.Set(sx, sy, ' ', color.RGB{}, c, render.BlendScreen, bb.Alpha*(1-distSq), terminal.AttrNone)
+		}
+	}
+}
+
+func clamp8(v float64) uint8 {
+	if v >= 255 {
+		return 255
+	}
+	if v <= 0 {
+		return 0
+	}
+	return uint8(v)
+}
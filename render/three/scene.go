@@ -0,0 +1,105 @@
+package three
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+type itemKind uint8
+
+const (
+	kindSphere itemKind = iota
+	kindBillboard
+	kindMesh
+)
+
+// item is one queued primitive, index points into the per-kind slice
+type item struct {
+	kind  itemKind
+	index int
+	depth float64
+}
+
+// Scene queues primitives for a frame and draws them far to near (painter's algorithm)
+// Queues are reused across frames; a Scene is not safe for concurrent use
+type Scene struct {
+	Camera Camera
+	Light  Light
+	Fog    Fog
+
+	items      []item
+	spheres    []Sphere
+	billboards []Billboard
+	meshes     []*Mesh
+
+	zbuf []float64 // Per-cell nearest depth within one mesh
+}
+
+// NewScene creates a scene for a width×height viewport with the default light and no fog
+func NewScene(width, height int) *Scene {
+	return &Scene{
+		Camera: NewCamera(width, height),
+		Light:  DefaultLight(),
+	}
+}
+
+// Resize updates the viewport
+func (s *Scene) Resize(width, height int) {
+	s.Camera.Width, s.Camera.Height = width, height
+}
+
+// Sphere queues a shaded sphere
+func (s *Scene) Sphere(sp Sphere) {
+	s.items = append(s.items, item{kind: kindSphere, index: len(s.spheres), depth: sp.Center.Z})
+	s.spheres = append(s.spheres, sp)
+}
+
+// Billboard queues a flat screen-facing disc
+func (s *Scene) Billboard(b Billboard) {
+	s.items = append(s.items, item{kind: kindBillboard, index: len(s.billboards), depth: b.Center.Z})
+	s.billboards = append(s.billboards, b)
+}
+
+// Mesh queues a point mesh, sorted against other primitives by its origin
+// The mesh is read during Draw, not copied
+func (s *Scene) Mesh(m *Mesh) {
+	s.items = append(s.items, item{kind: kindMesh, index: len(s.meshes), depth: m.Origin.Z})
+	s.meshes = append(s.meshes, m)
+}
+
+// Draw renders the queued primitives far to near into buf and empties the queues
+// Equal depths keep submission order
+func (s *Scene) Draw(buf *render.RenderBuffer) {
+	slices.SortStableFunc(s.items, func(a, b item) int {
+		return cmp.Compare(b.depth, a.depth)
+	})
+
+	for _, it := range s.items {
+		switch it.kind {
+		case kindSphere:
+			s.drawSphere(buf, &s.spheres[it.index])
+		case kindBillboard:
+			s.drawBillboard(buf, &s.billboards[it.index])
+		case kindMesh:
+			s.drawMesh(buf, s.meshes[it.index])
+		}
+	}
+
+	s.items = s.items[:0]
+	s.spheres = s.spheres[:0]
+	s.billboards = s.billboards[:0]
+	clear(s.meshes)
+	s.meshes = s.meshes[:0]
+}
+
+// bounds returns the clipped cell rectangle covering a projected ellipse of vertical radius ry
+func (s *Scene) bounds(p Projection, ry float64) (minX, minY, maxX, maxY int) {
+	rx := ry * s.Camera.Aspect
+	minX = max(0, int(p.X-rx-1))
+	maxX = min(s.Camera.Width-1, int(p.X+rx+1))
+	minY = max(0, int(p.Y-ry-1))
+	maxY = min(s.Camera.Height-1, int(p.Y+ry+1))
+	return
+}
@@ -0,0 +1,63 @@
+package three
+
+import (
+	"testing"
+
+	"github.com/lixenwraith/color"
+	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/render"
+)
+
+func TestProjectCenterAndPerspective(t *testing.T) {
+	c := NewCamera(80, 24)
+	if p := c.Project(Vec3{}); p.X != 40 || p.Y != 12 {
+		t.Errorf("origin projects to %v,%v", p.X, p.Y)
+	}
+	near, far := c.Project(Vec3{X: 1, Z: 0}), c.Project(Vec3{X: 1, Z: 10})
+	if far.X >= near.X || far.Scale >= near.Scale {
+		t.Errorf("far point not pulled toward center: near %v far %v", near, far)
+	}
+	if dx, dy := near.X-40, c.Project(Vec3{Y: 1}).Y-12; dx != dy*c.Aspect {
+		t.Errorf("aspect not applied: dx %v dy %v", dx, dy)
+	}
+}
+
+// The nearer sphere is drawn last regardless of submission order
+func TestSceneDepthOrder(t *testing.T) {
+	buf := render.NewRenderBuffer(terminal.ColorModeTrueColor, 80, 24)
+	s := NewScene(80, 24)
+	red := color.RGB{R: 200}
+	blue := color.RGB{B: 200}
+	s.Sphere(Sphere{Center: Vec3{Z: 2}, Radius: 3, Color: red})
+	s.Sphere(Sphere{Center: Vec3{Z: 12}, Radius: 3, Color: blue})
+	s.Draw(buf)
+
+	probe := render.NewRenderBuffer(terminal.ColorModeTrueColor, 80, 24)
+	ps := NewScene(80, 24)
+	ps.Sphere(Sphere{Center: Vec3{Z: 2}, Radius: 3, Color: red})
+	ps.Draw(probe)
+
+	got, want := flushed(buf)[12*80+40].Bg, flushed(probe)[12*80+40].Bg
+	if got != want {
+		t.Errorf("center = %v, want near sphere %v", got, want)
+	}
+	if len(s.items) != 0 || len(s.spheres) != 0 {
+		t.Error("queues not emptied after Draw")
+	}
+}
+
+// captureTerm records the cells of the last Flush
+type captureTerm struct {
+	terminal.Terminal
+	cells []terminal.Cell
+}
+
+func (c *captureTerm) Flush(cells []terminal.Cell, width, height int) {
+	c.cells = append(c.cells[:0], cells...)
+}
+
+func flushed(buf *render.RenderBuffer) []terminal.Cell {
+	term := &captureTerm{}
+	buf.FlushToTerminal(term)
+	return term.cells
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// AnnounceService writes announcement lines to a file or named pipe for screen readers
+// and braille displays; a writer goroutine keeps slow readers off the game tick
+type AnnounceService struct {
+	path  string
+	level parameter.AnnounceLevel
+
+	lines   chan string
+	dropped atomic.Uint64 // Lines lost to a full queue or a failed open
+
+	stopCh chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewAnnounceService creates the service; an empty path or AnnounceOff disables it
+func NewAnnounceService(path string, level parameter.AnnounceLevel) *AnnounceService {
+	return &AnnounceService{
+		path:   path,
+		level:  level,
+		lines:  make(chan string, parameter.AnnounceBuffer),
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *AnnounceService) Name() string           { return "announce" }
+func (s *AnnounceService) Dependencies() []string { return nil }
+
+func (s *AnnounceService) enabled() bool {
+	return s.path != "" && s.level != parameter.AnnounceOff
+}
+
+func (s *AnnounceService) Init() error { return nil }
+
+// Start opens the target in the writer goroutine
+// Opening a named pipe blocks until a reader attaches, which must not hold up startup
+func (s *AnnounceService) Start() error {
+	if !s.enabled() {
+		close(s.done)
+		return nil
+	}
+	go s.run()
+	return nil
+}
+
+func (s *AnnounceService) run() {
+	defer close(s.done)
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		s.dropped.Add(1)
+		return
+	}
+	defer f.Close()
+
+	for {
+		select {
+		case line := <-s.lines:
+			_, _ = f.WriteString(line + "\n")
+		case <-s.stopCh:
+			// Flush what is queued; a reader gone mid-write only loses these lines
+			for {
+				select {
+				case line := <-s.lines:
+					_, _ = f.WriteString(line + "\n")
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Stop flushes queued lines, giving up after AnnounceFlushTimeout
+// A pipe that never got a reader is abandoned
+func (s *AnnounceService) Stop() error {
+	s.once.Do(func() { close(s.stopCh) })
+	select {
+	case <-s.done:
+	case <-time.After(parameter.AnnounceFlushTimeout):
+	}
+	return nil
+}
+
+func (s *AnnounceService) Contribute(r *engine.Resource) {
+	if !s.enabled() {
+		return
+	}
+	r.Announce = &engine.AnnounceResource{Port: s, Level: s.level}
+}
+
+// Announce queues a line without blocking; drops on a full queue
+func (s *AnnounceService) Announce(line string) {
+	select {
+	case s.lines <- line:
+	default:
+		s.dropped.Add(1)
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,8 +13,10 @@ import (
 )
 
 type ContentService struct {
-	manager     *content.ContentManager
-	contentPath string
+	manager      *content.ContentManager
+	contentPath  string
+	practicePath string
+	practice     []string
 
 	content    atomic.Pointer[core.PreparedContent]
 	consumed   atomic.Int64
@@ -21,14 +24,16 @@ type ContentService struct {
 	generation atomic.Int64
 
 	refreshing atomic.Bool
+	inline     bool // Refresh on the consuming goroutine, see SetInlineRefresh
 	stopCh     chan struct{}
 	wg         sync.WaitGroup
 }
 
-func NewContentService(path string) *ContentService {
+func NewContentService(path, practicePath string) *ContentService {
 	return &ContentService{
-		contentPath: path,
-		stopCh:      make(chan struct{}),
+		contentPath:  path,
+		practicePath: practicePath,
+		stopCh:       make(chan struct{}),
 	}
 }
 
@@ -43,6 +48,15 @@ func (s *ContentService) Init() error {
 	_ = s.manager.DiscoverContentFiles()
 	_ = s.manager.PreValidateAllContent()
 	s.loadContent()
+
+	// Practice file is explicit user input, unlike discovery it fails startup
+	if s.practicePath != "" {
+		lines, err := s.manager.LoadPracticeLines(s.practicePath)
+		if err != nil {
+			return fmt.Errorf("practice file: %w", err)
+		}
+		s.practice = lines
+	}
 	return nil
 }
 
@@ -62,15 +76,38 @@ func (s *ContentService) CurrentContent() *core.PreparedContent {
 	return s.content.Load()
 }
 
+// PracticeLines returns the practice file lines in order, nil when not in practice mode
+func (s *ContentService) PracticeLines() []string {
+	return s.practice
+}
+
+// Reseed restarts content selection from seed and reloads the current block set
+// Call after Init and before the first tick, so a seeded session draws the same content
+func (s *ContentService) Reseed(seed uint64) {
+	s.manager.Reseed(seed)
+	s.loadContent()
+}
+
+// SetInlineRefresh loads refreshed content synchronously inside NotifyConsumed
+// Headless drivers use it so content switches at the same tick on every run
+func (s *ContentService) SetInlineRefresh(inline bool) {
+	s.inline = inline
+}
+
 func (s *ContentService) NotifyConsumed(count int) {
 	newConsumed := s.consumed.Add(int64(count))
 	total := s.total.Load()
 	if total == 0 {
 		return
 	}
-	if float64(newConsumed)/float64(total) >= parameter.ContentRefreshThreshold && !s.refreshing.Load() {
-		s.triggerRefresh()
+	if float64(newConsumed)/float64(total) < parameter.ContentRefreshThreshold || s.refreshing.Load() {
+		return
+	}
+	if s.inline {
+		s.refreshContent()
+		return
 	}
+	s.triggerRefresh()
 }
 
 func (s *ContentService) loadContent() {
@@ -100,17 +137,22 @@ func (s *ContentService) triggerRefresh() {
 			return
 		default:
 		}
-		lines, _, err := s.manager.SelectRandomBlockWithValidation()
-		if err != nil || len(lines) == 0 {
-			return
-		}
-		blocks := s.groupIntoBlocks(lines)
-		s.content.Store(&core.PreparedContent{Blocks: blocks, Generation: s.generation.Add(1)})
-		s.total.Store(int64(len(blocks)))
-		s.consumed.Store(0)
+		s.refreshContent()
 	})
 }
 
+// refreshContent swaps in a new block set, keeping the current one on failure
+func (s *ContentService) refreshContent() {
+	lines, _, err := s.manager.SelectRandomBlockWithValidation()
+	if err != nil || len(lines) == 0 {
+		return
+	}
+	blocks := s.groupIntoBlocks(lines)
+	s.content.Store(&core.PreparedContent{Blocks: blocks, Generation: s.generation.Add(1)})
+	s.total.Store(int64(len(blocks)))
+	s.consumed.Store(0)
+}
+
 func (s *ContentService) groupIntoBlocks(lines []string) []core.CodeBlock {
 	// Grouping logic identical to original content_service.go
 	if len(lines) == 0 {
@@ -5,14 +5,23 @@ import (
 	"os"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/lixenwraith/terminal"
+	"github.com/lixenwraith/vi-fighter/input"
 )
 
+// InputEvent is a polled terminal event stamped as it leaves the parser
+type InputEvent struct {
+	terminal.Event
+	At time.Time
+}
+
 type TerminalService struct {
 	term      terminal.Terminal
+	source    input.Source // term wrapped by input middleware
 	colorMode terminal.ColorMode
-	eventCh   chan terminal.Event
+	eventCh   chan InputEvent
 	stopCh    chan struct{}
 	doneCh    chan struct{}
 	mu        sync.Mutex
@@ -23,7 +32,7 @@ type TerminalService struct {
 func NewTerminalService(colorMode terminal.ColorMode) *TerminalService {
 	return &TerminalService{
 		colorMode: colorMode,
-		eventCh:   make(chan terminal.Event, 256),
+		eventCh:   make(chan InputEvent, 256),
 		stopCh:    make(chan struct{}),
 		doneCh:    make(chan struct{}),
 	}
@@ -37,9 +46,15 @@ func (s *TerminalService) Init() error {
 	if err := s.term.Init(); err != nil {
 		return fmt.Errorf("terminal init: %w", err)
 	}
+	s.source = s.term
 	return nil
 }
 
+// Use wraps the polled event source with middleware; call after Init and before Start
+func (s *TerminalService) Use(mws ...input.Middleware) {
+	s.source = input.Chain(s.source, mws...)
+}
+
 func (s *TerminalService) Start() error {
 	s.mu.Lock()
 	if s.running {
@@ -73,12 +88,13 @@ func (s *TerminalService) pollLoop() {
 			return
 		default:
 		}
-		ev := s.term.PollEvent()
+		ev := s.source.PollEvent()
+		at := time.Now()
 		if ev.Type == terminal.EventClosed || ev.Type == terminal.EventError {
 			return
 		}
 		select {
-		case s.eventCh <- ev:
+		case s.eventCh <- InputEvent{Event: ev, At: at}:
 		case <-s.stopCh:
 			return
 		}
@@ -109,5 +125,5 @@ func (s *TerminalService) Stop() error {
 	return nil
 }
 
-func (s *TerminalService) Terminal() terminal.Terminal   { return s.term }
-func (s *TerminalService) Events() <-chan terminal.Event { return s.eventCh }
+func (s *TerminalService) Terminal() terminal.Terminal { return s.term }
+func (s *TerminalService) Events() <-chan InputEvent   { return s.eventCh }
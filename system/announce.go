@@ -0,0 +1,171 @@
+package system
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// announceModeNames are the spoken mode names, indexed by core.GameMode
+var announceModeNames = [...]string{"normal", "visual", "insert", "search", "command", "overlay"}
+
+// AnnounceSystem reports important state changes as plain text lines to the announcement
+// channel, for screen readers and braille displays; inert without Resources.Announce
+type AnnounceSystem struct {
+	world *engine.World
+
+	// Last reported values, polled each tick
+	milestone int64 // Highest energy step reached
+	errors    int64
+
+	statErrors *atomic.Int64 // Owned by TypingSystem
+
+	enabled bool
+}
+
+// NewAnnounceSystem creates a new announcement system
+func NewAnnounceSystem(world *engine.World) engine.System {
+	s := &AnnounceSystem{
+		world: world,
+	}
+
+	s.statErrors = world.Resources.Status.Ints.Get("typing.errors")
+
+	s.Init()
+	return s
+}
+
+// Init resets the reported baselines
+func (s *AnnounceSystem) Init() {
+	s.milestone = 0
+	s.errors = s.statErrors.Load()
+	s.enabled = true
+}
+
+// Name returns system's name
+func (s *AnnounceSystem) Name() string {
+	return "announce"
+}
+
+// Priority returns the system's priority
+func (s *AnnounceSystem) Priority() int {
+	return parameter.PriorityAnnounce
+}
+
+// EventTypes returns the event types AnnounceSystem handles
+func (s *AnnounceSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventModeChanged,
+		event.EventGamePauseChanged,
+		event.EventEnergyCrossedZero,
+		event.EventGoldSpawned,
+		event.EventGoldCompleted,
+		event.EventGoldTimeout,
+		event.EventDecayWave,
+		event.EventBlossomWave,
+		event.EventQuasarSpawned,
+		event.EventSwarmSpawned,
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+// HandleEvent announces discrete state changes
+func (s *AnnounceSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		s.say(parameter.AnnounceLow, "new game")
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+		return
+	}
+
+	switch ev.Type {
+	case event.EventModeChanged:
+		if payload, ok := ev.Payload.(*event.ModeChangedPayload); ok {
+			s.say(parameter.AnnounceNormal, "mode "+announceModeName(payload.Mode))
+		}
+	case event.EventGamePauseChanged:
+		if payload, ok := ev.Payload.(*event.GamePausePayload); ok {
+			if payload.Paused {
+				s.say(parameter.AnnounceLow, "paused")
+			} else {
+				s.say(parameter.AnnounceLow, "resumed")
+			}
+		}
+	case event.EventEnergyCrossedZero:
+		if s.energy() < 0 {
+			s.say(parameter.AnnounceLow, "energy negative")
+		} else {
+			s.say(parameter.AnnounceLow, "energy positive")
+		}
+	case event.EventGoldSpawned:
+		s.say(parameter.AnnounceNormal, "gold sequence")
+	case event.EventGoldCompleted:
+		s.say(parameter.AnnounceNormal, "gold complete")
+	case event.EventGoldTimeout:
+		s.say(parameter.AnnounceNormal, "gold expired")
+	case event.EventDecayWave:
+		s.say(parameter.AnnounceLow, "decay wave")
+	case event.EventBlossomWave:
+		s.say(parameter.AnnounceLow, "blossom wave")
+	case event.EventQuasarSpawned:
+		s.say(parameter.AnnounceLow, "quasar")
+	case event.EventSwarmSpawned:
+		s.say(parameter.AnnounceLow, "swarm")
+	}
+}
+
+// Update announces energy milestones and typing errors, which have no events of their own
+func (s *AnnounceSystem) Update() {
+	if !s.enabled || s.world.Resources.Announce == nil {
+		return
+	}
+
+	// Only a new high step is news; losses are covered by the zero crossing
+	if milestone := s.energy() / parameter.AnnounceMilestone; milestone > s.milestone {
+		s.say(parameter.AnnounceNormal, "energy "+strconv.FormatInt(milestone*parameter.AnnounceMilestone, 10))
+		s.milestone = milestone
+	}
+
+	if errors := s.statErrors.Load(); errors != s.errors {
+		if errors > s.errors {
+			s.say(parameter.AnnounceVerbose, "error")
+		}
+		s.errors = errors
+	}
+}
+
+// say sends a line when the channel is open at level or above
+func (s *AnnounceSystem) say(level parameter.AnnounceLevel, line string) {
+	ann := s.world.Resources.Announce
+	if !s.enabled || ann == nil || ann.Level < level {
+		return
+	}
+	ann.Port.Announce(line)
+}
+
+func (s *AnnounceSystem) energy() int64 {
+	if ec, ok := s.world.Components.Energy.GetComponent(s.world.Resources.Player.Entity); ok {
+		return ec.Current
+	}
+	return 0
+}
+
+func announceModeName(mode core.GameMode) string {
+	if int(mode) < len(announceModeNames) {
+		return announceModeNames[mode]
+	}
+	return "unknown"
+}
@@ -2,7 +2,6 @@ package system
 
 import (
 	"sync/atomic"
-	"time"
 
 	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/core"
@@ -10,7 +9,6 @@ import (
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
-	"github.com/lixenwraith/vi-fighter/physics"
 	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
@@ -48,7 +46,7 @@ func NewBlossomSystem(world *engine.World) engine.System {
 
 // Init resets session state for new game
 func (s *BlossomSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(time.Now().UnixNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 	clear(s.blossomedThisFrame)
 	clear(s.processedGridCells)
 	s.statCount.Store(0)
@@ -215,7 +213,7 @@ func (s *BlossomSystem) updateBlossomEntities() {
 
 		oldX, oldY := kineticComp.PreciseX, kineticComp.PreciseY
 		// Physics Integration (Fixed Point)
-		curX, curY := physics.Integrate(&kineticComp.Kinetic, dtFixed)
+		curX, curY := kineticComp.Step(dtFixed)
 
 		destroyBlossom := false
 		// Swept Traversal: Check every grid cell intersected by the movement vector
@@ -105,8 +105,7 @@ func (s *BulletSystem) Update() {
 		}
 
 		prevX, prevY := kinetic.PreciseX, kinetic.PreciseY
-		kinetic.PreciseX += vmath.Mul(kinetic.VelX, dtFixed)
-		kinetic.PreciseY += vmath.Mul(kinetic.VelY, dtFixed)
+		kinetic.Move(dtFixed)
 
 		destroyed := s.traverseAndCollide(
 			&bullet, prevX, prevY, kinetic.PreciseX, kinetic.PreciseY,
@@ -77,21 +77,7 @@ func (s *CameraSystem) updateCamera(cursorX, cursorY int) {
 	cursorVY := cursorY - config.CameraY
 
 	// Dead zone boundaries (viewport-relative)
-	marginX := parameter.CameraDeadZoneMarginX
-	marginY := parameter.CameraDeadZoneMarginY
-
-	// Clamp margins to half viewport to ensure dead zone exists
-	if marginX > config.ViewportWidth/2 {
-		marginX = config.ViewportWidth / 2
-	}
-	if marginY > config.ViewportHeight/2 {
-		marginY = config.ViewportHeight / 2
-	}
-
-	deadZoneLeft := marginX
-	deadZoneRight := config.ViewportWidth - marginX - 1
-	deadZoneTop := marginY
-	deadZoneBottom := config.ViewportHeight - marginY - 1
+	deadZoneLeft, deadZoneTop, deadZoneRight, deadZoneBottom := config.CameraDeadZone()
 
 	// Calculate camera shift needed to bring cursor into dead zone
 	var shiftX, shiftY int
@@ -124,20 +110,9 @@ func (s *CameraSystem) updateCamera(cursorX, cursorY int) {
 		newCameraY := config.CameraY + shiftY
 
 		// Clamp to valid range
-		maxCameraX := config.MapWidth - config.ViewportWidth
-		maxCameraY := config.MapHeight - config.ViewportHeight
-
-		if newCameraX < 0 {
-			newCameraX = 0
-		} else if newCameraX > maxCameraX {
-			newCameraX = maxCameraX
-		}
-
-		if newCameraY < 0 {
-			newCameraY = 0
-		} else if newCameraY > maxCameraY {
-			newCameraY = maxCameraY
-		}
+		maxCameraX, maxCameraY := config.MaxCamera()
+		newCameraX = max(0, min(newCameraX, maxCameraX))
+		newCameraY = max(0, min(newCameraY, maxCameraY))
 
 		config.CameraX = newCameraX
 		config.CameraY = newCameraY
@@ -1,6 +1,7 @@
 package system
 
 import (
+	"slices"
 	"sync/atomic"
 
 	"github.com/lixenwraith/vi-fighter/component"
@@ -9,7 +10,6 @@ import (
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
 	"github.com/lixenwraith/vi-fighter/parameter/visual"
-	"github.com/lixenwraith/vi-fighter/physics"
 	"github.com/lixenwraith/vi-fighter/vmath"
 )
 
@@ -40,7 +40,7 @@ func NewCleanerSystem(world *engine.World) engine.System {
 
 // Init resets session state for new game
 func (s *CleanerSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 	s.statActive.Store(0)
 	s.statSpawned.Store(0)
 	s.enabled = true
@@ -148,7 +148,7 @@ func (s *CleanerSystem) Update() {
 		// Physics integration
 		prevPreciseX := kineticComp.PreciseX
 		prevPreciseY := kineticComp.PreciseY
-		physics.Integrate(&kineticComp.Kinetic, dtFixed)
+		kineticComp.Step(dtFixed)
 
 		// Swept collision with wall/enemy blocking
 		blocked := false
@@ -615,5 +615,7 @@ func (s *CleanerSystem) scanTargetRows() []int {
 	for row := range targetRows {
 		rows = append(rows, row)
 	}
+	// Map order would vary cleaner entity order between identical runs
+	slices.Sort(rows)
 	return rows
 }
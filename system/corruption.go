@@ -0,0 +1,163 @@
+package system
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// corruptionDirs are the neighbor offsets red glyphs spread into
+var corruptionDirs = [4][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+
+// CorruptionSystem spreads red glyphs into adjacent green and blue glyphs over time
+// Red left on the field grows until removed with delete operators (see TypingSystem cleanup bonus)
+type CorruptionSystem struct {
+	world *engine.World
+
+	rng       *vmath.FastRand
+	spreadAcc time.Duration
+	reds      []core.Entity
+	cellBuf   []core.Entity
+
+	statRed    *atomic.Int64
+	statSpread *atomic.Int64
+
+	enabled bool
+}
+
+// NewCorruptionSystem creates a new red corruption system
+func NewCorruptionSystem(world *engine.World) engine.System {
+	s := &CorruptionSystem{
+		world:   world,
+		cellBuf: make([]core.Entity, parameter.MaxEntitiesPerCell),
+	}
+
+	s.statRed = world.Resources.Status.Ints.Get("corruption.red")
+	s.statSpread = world.Resources.Status.Ints.Get("corruption.spread")
+
+	s.Init()
+	return s
+}
+
+// Init resets session state for new game
+func (s *CorruptionSystem) Init() {
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
+	s.spreadAcc = 0
+	s.reds = s.reds[:0]
+	s.statRed.Store(0)
+	s.statSpread.Store(0)
+	s.enabled = true
+}
+
+// Name returns system's name
+func (s *CorruptionSystem) Name() string {
+	return "corruption"
+}
+
+// Priority returns the system's priority
+func (s *CorruptionSystem) Priority() int {
+	return parameter.PriorityCorruption
+}
+
+// EventTypes returns the event types CorruptionSystem handles
+func (s *CorruptionSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+// HandleEvent processes system control events
+func (s *CorruptionSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+	}
+}
+
+// Update advances the spread timer and infects neighbors once per interval
+func (s *CorruptionSystem) Update() {
+	if !s.enabled {
+		return
+	}
+
+	s.spreadAcc += s.world.Resources.Time.DeltaTime
+	if s.spreadAcc < parameter.CorruptionSpreadInterval {
+		return
+	}
+	s.spreadAcc -= parameter.CorruptionSpreadInterval
+
+	// Census of red glyphs
+	s.reds = s.reds[:0]
+	glyphEntities := s.world.Components.Glyph.GetAllEntities()
+	for _, entity := range glyphEntities {
+		glyphComp, ok := s.world.Components.Glyph.GetComponent(entity)
+		if ok && glyphComp.Type == component.GlyphRed {
+			s.reds = append(s.reds, entity)
+		}
+	}
+	s.statRed.Store(int64(len(s.reds)))
+
+	if len(s.reds) == 0 || float64(len(s.reds)) >= float64(len(glyphEntities))*parameter.CorruptionMaxRatio {
+		return
+	}
+
+	for range min(parameter.CorruptionSpreadPerStep, len(s.reds)) {
+		source := s.reds[s.rng.Intn(len(s.reds))]
+		if s.spreadFrom(source) {
+			s.statSpread.Add(1)
+		}
+	}
+}
+
+// spreadFrom converts one green or blue neighbor of source to red, trying directions in random order
+func (s *CorruptionSystem) spreadFrom(source core.Entity) bool {
+	pos, ok := s.world.Positions.GetPosition(source)
+	if !ok {
+		return false
+	}
+
+	start := s.rng.Intn(len(corruptionDirs))
+	for i := range corruptionDirs {
+		d := corruptionDirs[(start+i)%len(corruptionDirs)]
+		n := s.world.Positions.GetAllEntitiesAtInto(pos.X+d[0], pos.Y+d[1], s.cellBuf)
+		for _, target := range s.cellBuf[:n] {
+			if s.infect(target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// infect turns a green or blue glyph red unless it is protected from decay
+func (s *CorruptionSystem) infect(entity core.Entity) bool {
+	glyphComp, ok := s.world.Components.Glyph.GetComponent(entity)
+	if !ok || (glyphComp.Type != component.GlyphGreen && glyphComp.Type != component.GlyphBlue) {
+		return false
+	}
+	if protComp, ok := s.world.Components.Protection.GetComponent(entity); ok {
+		if protComp.Mask&component.ProtectFromDecay != 0 {
+			return false
+		}
+	}
+
+	glyphComp.Type = component.GlyphRed
+	glyphComp.Level = component.GlyphBright
+	s.world.Components.Glyph.SetComponent(entity, glyphComp)
+	return true
+}
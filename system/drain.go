@@ -2,7 +2,6 @@ package system
 
 import (
 	"sync/atomic"
-	"time"
 
 	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/core"
@@ -78,7 +77,7 @@ func NewDrainSystem(world *engine.World) engine.System {
 
 // Init resets session state for new game
 func (s *DrainSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(time.Now().UnixNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.pendingSpawns = s.pendingSpawns[:0]
 	s.drainCache = s.drainCache[:0]
 	s.nextSpawnOrder = 0
@@ -942,7 +941,7 @@ func (s *DrainSystem) updateDrainMovement() {
 
 		// 3. Integration & Collision
 		oldPreciseX, oldPreciseY := kineticComp.PreciseX, kineticComp.PreciseY
-		newX, newY := physics.Integrate(&kineticComp.Kinetic, dtFixed)
+		newX, newY := kineticComp.Step(dtFixed)
 
 		// Boundary Reflection
 		if newX < 0 || newX >= gameWidth {
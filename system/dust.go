@@ -87,7 +87,7 @@ func NewDustSystem(world *engine.World) engine.System {
 func (s *DustSystem) Init() {
 	s.lastCursorX = 0
 	s.lastCursorY = 0
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamEffects)
 	s.staggerTick = 0
 	s.statCreated.Store(0)
 	s.statActive.Store(0)
@@ -302,8 +302,7 @@ func (s *DustSystem) Update() {
 			dyCirc := vmath.ScaleToCircular(dy)
 			ax, ayCirc := physics.OrbitalEquilibrium(dx, dyCirc, dustComp.OrbitRadius, stiffness)
 
-			kineticComp.VelX += vmath.Mul(ax, dtFixed)
-			kineticComp.VelY += vmath.Mul(vmath.ScaleFromCircular(ayCirc), dtFixed)
+			kineticComp.ApplyForce(ax, vmath.ScaleFromCircular(ayCirc), dtFixed)
 
 			// Orbital damping (converts radial velocity to tangential)
 			velYCirc := vmath.ScaleToCircular(kineticComp.VelY)
@@ -331,11 +330,7 @@ func (s *DustSystem) Update() {
 		// --- Positions Integration ---
 		prevX, prevY := kineticComp.PreciseX, kineticComp.PreciseY
 
-		kineticComp.PreciseX += vmath.Mul(kineticComp.VelX, dtFixed)
-		kineticComp.PreciseY += vmath.Mul(kineticComp.VelY, dtFixed)
-
-		newX := vmath.ToInt(kineticComp.PreciseX)
-		newY := vmath.ToInt(kineticComp.PreciseY)
+		newX, newY := kineticComp.Move(dtFixed)
 
 		gameWidth := s.world.Resources.Config.MapWidth
 		gameHeight := s.world.Resources.Config.MapHeight
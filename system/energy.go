@@ -115,7 +115,7 @@ func (s *EnergySystem) HandleEvent(ev event.GameEvent) {
 
 	case event.EventEnergyGlyphConsumed:
 		if payload, ok := ev.Payload.(*event.EnergyGlyphConsumedPayload); ok {
-			s.handleGlyphConsumed(payload.Type, payload.Level)
+			s.handleGlyphConsumed(payload)
 		}
 
 	case event.EventEnergyBlinkStart:
@@ -325,7 +325,7 @@ func (s *EnergySystem) setEnergy(value int64) {
 }
 
 // handleGlyphConsumed calculates and applies energy from glyph destruction
-func (s *EnergySystem) handleGlyphConsumed(glyphType component.GlyphType, _ component.GlyphLevel) {
+func (s *EnergySystem) handleGlyphConsumed(payload *event.EnergyGlyphConsumedPayload) {
 	cursorEntity := s.world.Resources.Player.Entity
 
 	heatComp, ok := s.world.Components.Heat.GetComponent(cursorEntity)
@@ -338,18 +338,11 @@ func (s *EnergySystem) handleGlyphConsumed(glyphType component.GlyphType, _ comp
 		return
 	}
 
-	heat := heatComp.Current
-	var delta int
-	switch glyphType {
-	case component.GlyphBlue:
-		delta = parameter.EnergyBaseBlue * heat
-	case component.GlyphGreen:
-		delta = parameter.EnergyBaseGreen * heat
-	case component.GlyphRed:
-		delta = parameter.EnergyBaseRed * heat
-	default:
+	base := payload.Type.EnergyBase()
+	if base == 0 {
 		return
 	}
+	delta := base * heatComp.Current
 
 	currentEnergy := energyComp.Current
 	newEnergy := currentEnergy + int64(delta)
@@ -357,6 +350,10 @@ func (s *EnergySystem) handleGlyphConsumed(glyphType component.GlyphType, _ comp
 	energyComp.Current = newEnergy
 	s.world.Components.Energy.SetComponent(cursorEntity, energyComp)
 
+	if delta != 0 {
+		s.world.PushEvent(event.EventPopupScore, &event.PopupScorePayload{X: payload.X, Y: payload.Y, Delta: delta})
+	}
+
 	if newEnergy == 0 {
 		s.world.PushEvent(event.EventShieldDeactivate, nil)
 		s.world.PushEvent(event.EventEnergyCrossedZero, nil)
@@ -40,7 +40,7 @@ func NewEnvironmentSystem(world *engine.World) engine.System {
 }
 
 func (s *EnvironmentSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 	s.statWindActive.Store(false)
 	s.enabled = true
 }
@@ -39,7 +39,7 @@ func NewEyeSystem(world *engine.World) engine.System {
 }
 
 func (s *EyeSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.statActive.Store(false)
 	s.statCount.Store(0)
 	s.enabled = true
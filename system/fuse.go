@@ -49,7 +49,7 @@ func NewFuseSystem(world *engine.World) engine.System {
 
 func (s *FuseSystem) Init() {
 	s.fusions = make([]pendingFusion, 0, 16)
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.enabled = true
 }
 
@@ -136,7 +136,7 @@ func (s *FuseSystem) hasQuasarFusion() bool {
 }
 
 // applyEffect dispatches to effect-specific implementation
-func (s *FuseSystem) applyEffect(effect event.FuseEffect, sources []core.Point, area core.Area, spiritColor component.SpiritColor) {
+func (s *FuseSystem) applyEffect(effect event.FuseEffect, sources []core.Point, area core.Rect, spiritColor component.SpiritColor) {
 	switch effect {
 	case event.FuseEffectSpirit:
 		s.effectSpiritArea(sources, area, spiritColor)
@@ -147,9 +147,9 @@ func (s *FuseSystem) applyEffect(effect event.FuseEffect, sources []core.Point,
 	}
 }
 
-func (s *FuseSystem) effectSpiritArea(sources []core.Point, area core.Area, c component.SpiritColor) {
+func (s *FuseSystem) effectSpiritArea(sources []core.Point, area core.Rect, c component.SpiritColor) {
 	for i, src := range sources {
-		dest := vmath.AreaDistributePoint(area, i, s.rng)
+		dest := area.DistributePoint(i, s.rng)
 
 		s.world.PushEvent(event.EventSpiritSpawn, &event.SpiritSpawnRequestPayload{
 			StartX:    src.X,
@@ -162,7 +162,7 @@ func (s *FuseSystem) effectSpiritArea(sources []core.Point, area core.Area, c co
 	}
 }
 
-func (s *FuseSystem) effectMaterialize(area core.Area) {
+func (s *FuseSystem) effectMaterialize(area core.Rect) {
 	s.world.PushEvent(event.EventMaterializeAreaRequest, &event.MaterializeAreaRequestPayload{
 		X:          area.X,
 		Y:          area.Y,
@@ -213,7 +213,7 @@ func (s *FuseSystem) handleSwarmFuse(drainA, drainB core.Entity, effect event.Fu
 	event.EmitDeathBatch(s.world.Resources.Event.Queue, 0, []core.Entity{drainA, drainB})
 
 	sources := []core.Point{{X: posA.X, Y: posA.Y}, {X: posB.X, Y: posB.Y}}
-	area := core.Area{X: topLeftX, Y: topLeftY, Width: parameter.SwarmWidth, Height: parameter.SwarmHeight}
+	area := core.Rect{X: topLeftX, Y: topLeftY, Width: parameter.SwarmWidth, Height: parameter.SwarmHeight}
 	s.applyEffect(effect, sources, area, component.SpiritCyan)
 
 	s.fusions = append(s.fusions, pendingFusion{
@@ -267,7 +267,7 @@ func (s *FuseSystem) handleQuasarFuse() {
 	cX = topLeftX + parameter.QuasarHeaderOffsetX
 	cY = topLeftY + parameter.QuasarHeaderOffsetY
 
-	area := core.Area{X: topLeftX, Y: topLeftY, Width: parameter.QuasarWidth, Height: parameter.QuasarHeight}
+	area := core.Rect{X: topLeftX, Y: topLeftY, Width: parameter.QuasarWidth, Height: parameter.QuasarHeight}
 	s.applyEffect(event.FuseEffectMaterialize, sources, area, component.SpiritCyan)
 
 	// Emit EventEnemyKilled for each drain (enables loot drops)
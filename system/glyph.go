@@ -27,6 +27,12 @@ type GlyphCensus map[GlyphKey]int
 var glyphSpawnTypes = []component.GlyphType{component.GlyphBlue, component.GlyphGreen}
 var glyphSpawnLevels = []component.GlyphLevel{component.GlyphDark, component.GlyphNormal, component.GlyphBright}
 
+// glyphSnippet tracks a block spawned as one multi-row snippet for the completion bonus
+type glyphSnippet struct {
+	members []core.Entity
+	pending int // Members not yet typed
+}
+
 // GlyphSystem handles glyph sequence generation and spawning
 type GlyphSystem struct {
 	world *engine.World
@@ -45,6 +51,16 @@ type GlyphSystem struct {
 	localIndex      int
 	frameContent    *content.PreparedContent // Snapshot for current frame
 
+	// Block spawn mode: pending member -> its snippet
+	snippetOf map[core.Entity]*glyphSnippet
+
+	// Partner of the last typed bracket, the pair bonus is due if it is typed next
+	pairAwait core.Entity
+
+	// Spawn pattern anchors: seeded per session, drifting per wave
+	noise vmath.ValueNoise
+	wave  int
+
 	// Cached metric pointers
 	statEnabled     *atomic.Bool
 	statDensity     *status.AtomicFloat
@@ -74,7 +90,7 @@ func NewGlyphSystem(world *engine.World) engine.System {
 
 // Init resets session state for new game
 func (s *GlyphSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 	s.census = make(map[GlyphKey]int)
 	s.initCensus()
 
@@ -83,6 +99,10 @@ func (s *GlyphSystem) Init() {
 	s.localGeneration = 0
 	s.localIndex = 0
 	s.frameContent = nil
+	s.snippetOf = make(map[core.Entity]*glyphSnippet)
+	s.pairAwait = 0
+	s.noise = vmath.NewValueNoise(s.rng.Next())
+	s.wave = 0
 	s.statEnabled.Store(true)
 	s.statDensity.Set(0)
 	s.statRateMult.Set(0)
@@ -113,6 +133,7 @@ func (s *GlyphSystem) Priority() int {
 // EventTypes returns the event types SpawnSystem handles
 func (s *GlyphSystem) EventTypes() []event.EventType {
 	return []event.EventType{
+		event.EventEnergyGlyphConsumed,
 		event.EventMetaSystemCommandRequest,
 		event.EventGameReset,
 	}
@@ -140,9 +161,102 @@ func (s *GlyphSystem) HandleEvent(ev event.GameEvent) {
 		return
 	}
 
-	// switch ev.Type {
-	//
-	// }
+	switch ev.Type {
+	case event.EventEnergyGlyphConsumed:
+		if payload, ok := ev.Payload.(*event.EnergyGlyphConsumedPayload); ok {
+			s.handleGlyphConsumed(payload.Entity)
+		}
+	}
+}
+
+// handleGlyphConsumed counts a typed snippet member, awarding the bonus when the whole snippet is typed
+func (s *GlyphSystem) handleGlyphConsumed(entity core.Entity) {
+	s.checkBracketPair(entity)
+
+	snippet, ok := s.snippetOf[entity]
+	if !ok {
+		return
+	}
+	delete(s.snippetOf, entity)
+	snippet.pending--
+	if snippet.pending > 0 {
+		return
+	}
+
+	s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+		Delta: len(snippet.members) * parameter.SnippetBonusPerChar,
+		Type:  component.EnergyDeltaReward,
+	})
+	s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+		ID: parameter.Sfx.Coin,
+	})
+}
+
+// checkBracketPair awards the pair bonus when entity completes a bracket pair typed back to back
+// Runs before the typed glyph's death is processed, so its link is still readable
+func (s *GlyphSystem) checkBracketPair(entity core.Entity) {
+	awaited := s.pairAwait
+	s.pairAwait = 0
+	if entity == 0 {
+		return
+	}
+
+	if awaited != 0 && entity == awaited {
+		s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+			Delta: parameter.BracketPairBonus,
+			Type:  component.EnergyDeltaReward,
+		})
+		s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+			ID: parameter.Sfx.Coin,
+		})
+		return
+	}
+
+	if bracket, ok := s.world.Components.Bracket.GetComponent(entity); ok &&
+		s.world.Components.Glyph.HasEntity(bracket.Partner) {
+		s.pairAwait = bracket.Partner
+	}
+}
+
+// linkBrackets pairs matching (), [], {} among freshly placed glyphs in reading order
+// Unbalanced brackets stay unlinked and fall back to the scanning '%' match
+func (s *GlyphSystem) linkBrackets(entities []core.Entity, chars []rune) {
+	var stack []int
+	for i, ch := range chars {
+		switch ch {
+		case '(', '[', '{':
+			stack = append(stack, i)
+		case ')', ']', '}':
+			if len(stack) == 0 {
+				continue
+			}
+			open := stack[len(stack)-1]
+			if !bracketsMatch(chars[open], ch) {
+				// Mismatch invalidates the nesting, start over
+				stack = stack[:0]
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			s.world.Components.Bracket.SetComponent(entities[open], component.BracketComponent{Partner: entities[i]})
+			s.world.Components.Bracket.SetComponent(entities[i], component.BracketComponent{Partner: entities[open]})
+		}
+	}
+}
+
+func bracketsMatch(open, close rune) bool {
+	return (open == '(' && close == ')') || (open == '[' && close == ']') || (open == '{' && close == '}')
+}
+
+// pruneSnippets drops snippets that lost a member to anything but typing, they can no longer score
+func (s *GlyphSystem) pruneSnippets() {
+	for entity, snippet := range s.snippetOf {
+		if s.world.Components.Glyph.HasEntity(entity) {
+			continue
+		}
+		for _, m := range snippet.members {
+			delete(s.snippetOf, m)
+		}
+	}
 }
 
 // Update runs the spawn system logic
@@ -153,6 +267,11 @@ func (s *GlyphSystem) Update() {
 
 	config := s.world.Resources.Config
 
+	// Practice mode owns the field, see PracticeSystem
+	if config.Practice {
+		return
+	}
+
 	// Calculate current density and update rate multiplier
 	glyphCount := s.world.Components.Glyph.CountEntities()
 	screenCapacity := config.MapWidth * config.MapHeight
@@ -197,7 +316,7 @@ func (s *GlyphSystem) calculateDensity(entityCount, screenCapacity int) float64
 }
 
 // updateRateMultiplier adjusts spawn rate based on screen density
-// <30% filled: 2x faster, 30-70%: normal, >70%: 0.5x slower
+// <30% filled: 2x faster, 30-70%: normal, >70%: 0.5x slower; scaled up by heat tier
 func (s *GlyphSystem) updateRateMultiplier(density float64) {
 	if density < parameter.SpawnDensityLowThreshold {
 		s.rateMultiplier = parameter.SpawnRateFast
@@ -206,11 +325,21 @@ func (s *GlyphSystem) updateRateMultiplier(density float64) {
 	} else {
 		s.rateMultiplier = parameter.SpawnRateNormal
 	}
+
+	// Heat tier stacks on density so momentum brings more targets
+	if heatComp, ok := s.world.Components.Heat.GetComponent(s.world.Resources.Player.Entity); ok {
+		switch heatComp.Tier() {
+		case component.HeatTierWarm:
+			s.rateMultiplier *= parameter.SpawnRateHeatWarm
+		case component.HeatTierHot:
+			s.rateMultiplier *= parameter.SpawnRateHeatHot
+		}
+	}
 }
 
 // calculateNextSpawn calculates and sets the next spawn time
 func (s *GlyphSystem) calculateNextSpawn() time.Duration {
-	baseDelay := time.Duration(parameter.SpawnIntervalMs) * time.Millisecond
+	baseDelay := s.world.Resources.Config.Spawn.Interval()
 	adjustedDelay := time.Duration(float64(baseDelay) / s.rateMultiplier)
 
 	return adjustedDelay
@@ -267,16 +396,26 @@ func (s *GlyphSystem) updateCensus() {
 
 }
 
-// nextGlyphToSpawn returns color/level combinations not present on screen
+// nextGlyphToSpawn returns the least present color/level combination on screen
+// Keys are scanned in a fixed order and ties drawn from the spawn stream, so seeded games repeat
 func (s *GlyphSystem) nextGlyphToSpawn() GlyphKey {
 	minGlyphCount := -1
 	var minGlyphKey GlyphKey
-	for key, count := range s.census {
-		if minGlyphCount == -1 {
-			minGlyphCount = count
-			minGlyphKey = key
-		} else if count < minGlyphCount {
-			minGlyphCount = count
+	ties := 0
+	for _, spawnType := range glyphSpawnTypes {
+		for _, spawnLevel := range glyphSpawnLevels {
+			key := GlyphKey{Type: spawnType, Level: spawnLevel}
+			count := s.census[key]
+			switch {
+			case minGlyphCount == -1 || count < minGlyphCount:
+				minGlyphCount, minGlyphKey, ties = count, key, 1
+			case count == minGlyphCount:
+				// Reservoir pick keeps every tied key equally likely
+				ties++
+				if s.rng.Intn(ties) == 0 {
+					minGlyphKey = key
+				}
+			}
 		}
 	}
 	return minGlyphKey
@@ -299,135 +438,278 @@ func (s *GlyphSystem) spawnGlyphs() {
 		return
 	}
 
-	// Try to place each line from the block on the screen
-	for _, line := range block.Lines {
-		s.placeLine(line, glyphKey.Type, glyphKey.Level)
+	lines := block.Lines
+	tuning := s.world.Resources.Config.Spawn
+	if tuning.RedRatio > 0 && s.rng.Float64() < tuning.RedRatio {
+		glyphKey.Type = component.GlyphRed
+	}
+	if tuning.MaxLine > 0 {
+		lines = make([]string, len(block.Lines))
+		for i, line := range block.Lines {
+			lines[i] = string(cropLine(line, tuning.MaxLine))
+		}
 	}
+
+	s.pruneSnippets()
+	if s.world.Resources.Config.SpawnBlocks {
+		s.placeBlock(lines, glyphKey.Type, glyphKey.Level)
+		return
+	}
+
+	// Lay out the block's lines with the wave's spawn pattern
+	s.placePattern(lines, glyphKey.Type, glyphKey.Level)
 }
 
-// placeLine attempts to place a single line on the screen
-// Lines exceeding MapWidth are cropped to fit available space
-func (s *GlyphSystem) placeLine(line string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) bool {
+// placeBlock places all lines as one left-aligned multi-row snippet, or nothing
+// Rows are cropped to MapWidth and MapHeight
+func (s *GlyphSystem) placeBlock(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) bool {
 	config := s.world.Resources.Config
-	cursorEntity := s.world.Resources.Player.Entity
 
-	lineRunes := []rune(line)
-	lineLength := len(lineRunes)
-
-	if lineLength == 0 {
+	cursorPos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity)
+	if !ok {
 		return false
 	}
 
-	// Crop line if it exceeds game width
-	if lineLength > config.MapWidth {
-		lineRunes = lineRunes[:config.MapWidth]
-		lineLength = config.MapWidth
-	}
-
-	// Try up to MaxPlacementTries times to find a valid position
-	for range parameter.MaxPlacementTries {
-		// Random row selection
-		// TODO: convert to fast rand
-		row := s.rng.Intn(config.MapHeight)
-
-		// Check if line fits and find available columns
-		if lineLength > config.MapWidth {
-			// Line too long for screen, skip
-			continue
+	rows := make([][]rune, 0, len(lines))
+	width := 0
+	for _, line := range lines {
+		if len(rows) == config.MapHeight {
+			break
 		}
-
-		// Random column selection (must have room for full line)
-		maxStartCol := config.MapWidth - lineLength
-		if maxStartCol < 0 {
-			// Line still too long after crop, skip
-			return false
+		r := []rune(line)
+		if len(r) > config.MapWidth {
+			r = r[:config.MapWidth]
 		}
+		rows = append(rows, r)
+		width = max(width, len(r))
+	}
+	if width == 0 {
+		return false
+	}
+	height := len(rows)
 
-		startCol := s.rng.Intn(maxStartCol + 1)
+	type entityData struct {
+		entity core.Entity
+		pos    component.PositionComponent
+		char   rune
+	}
 
-		// Check for overlaps
-		hasOverlap := false
-		for i := range lineLength {
-			if s.world.Positions.IsBlocked(startCol+i, row, component.WallBlockSpawn) {
-				hasOverlap = true
-				break
+	for range parameter.BlockPlacementTries {
+		left, top := s.spawnOrigin(width, height)
+
+		// Whole block must clear walls, occupied cells (glyphs or any other entity), and the cursor zone
+		// Checked before any entity is created so a rejected spot costs no entity churn
+		blocked := false
+		for dy, row := range rows {
+			y := top + dy
+			for dx, ch := range row {
+				if ch == ' ' {
+					continue
+				}
+				x := left + dx
+				if s.world.Positions.IsBlocked(x, y, component.WallBlockSpawn) ||
+					s.world.Positions.HasAnyEntityAt(x, y) ||
+					(vmath.IntAbs(x-cursorPos.X) <= parameter.CursorExclusionX &&
+						vmath.IntAbs(y-cursorPos.Y) <= parameter.CursorExclusionY) {
+					blocked = true
+					break
+				}
 			}
-		}
-
-		// Check if too close to cursor
-		cursorPos, ok := s.world.Positions.GetPosition(cursorEntity)
-		if !ok {
-			return false
-		}
-		for i := range lineLength {
-			col := startCol + i
-			// if math.Abs(float64(col-cursorPos.X)) <= parameter.CursorExclusionX &&
-			// 	math.Abs(float64(row-cursorPos.Y)) <= parameter.CursorExclusionY {
-			if vmath.IntAbs(col-cursorPos.X) <= parameter.CursorExclusionX &&
-				vmath.IntAbs(row-cursorPos.Y) <= parameter.CursorExclusionY {
-				hasOverlap = true
+			if blocked {
 				break
 			}
 		}
-
-		if hasOverlap {
+		if blocked {
 			continue
 		}
 
-		// Valid position found, create entities
-
-		// 1. Create entities and prepare components
-		type entityData struct {
-			entity core.Entity
-			pos    component.PositionComponent
-			char   rune
-		}
-
-		entities := make([]entityData, 0, lineLength)
-
-		for i := range lineLength {
-			// Skip space characters - don't create entities for them
-			if lineRunes[i] == ' ' {
-				continue
+		entities := make([]entityData, 0, width*height)
+		for dy, row := range rows {
+			for dx, ch := range row {
+				if ch == ' ' {
+					continue
+				}
+				entities = append(entities, entityData{
+					entity: s.world.CreateEntity(),
+					pos:    component.PositionComponent{X: left + dx, Y: top + dy},
+					char:   ch,
+				})
 			}
-
-			entity := s.world.CreateEntity()
-			entities = append(entities, entityData{
-				entity: entity,
-				pos: component.PositionComponent{
-					X: startCol + i,
-					Y: row,
-				},
-				char: lineRunes[i],
-			})
 		}
 
-		// 2. Batch position validation and commit
 		batch := s.world.Positions.BeginBatch()
 		for _, ed := range entities {
 			batch.Add(ed.entity, ed.pos)
 		}
-
 		if err := batch.Commit(); err != nil {
-			// Collision detected - cleanup entities and try next attempt
 			for _, ed := range entities {
 				s.world.DestroyEntity(ed.entity)
 			}
 			continue
 		}
 
-		// 3. Set glyph components
+		snippet := &glyphSnippet{
+			members: make([]core.Entity, 0, len(entities)),
+			pending: len(entities),
+		}
+		chars := make([]rune, 0, len(entities))
 		for _, ed := range entities {
 			s.world.Components.Glyph.SetComponent(ed.entity, component.GlyphComponent{
 				Rune:  ed.char,
 				Type:  glyphType,
 				Level: glyphLevel,
 			})
+			snippet.members = append(snippet.members, ed.entity)
+			s.snippetOf[ed.entity] = snippet
+			chars = append(chars, ed.char)
 		}
-
+		s.linkBrackets(snippet.members, chars)
 		return true
 	}
 
+	return false
+}
+
+// placeLine attempts to place a single line at a random position
+// Lines exceeding MapWidth are cropped to fit available space
+func (s *GlyphSystem) placeLine(line string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) bool {
+	config := s.world.Resources.Config
+
+	lineRunes := cropLine(line, config.MapWidth)
+	if len(lineRunes) == 0 {
+		return false
+	}
+
+	// Try up to MaxPlacementTries times to find a valid position
+	for range parameter.MaxPlacementTries {
+		startCol, row := s.spawnOrigin(len(lineRunes), 1)
+		if s.placeLineAt(lineRunes, startCol, row, glyphType, glyphLevel) {
+			return true
+		}
+	}
+
 	// Failed to place after MaxPlacementTries attempts
 	return false
 }
+
+// spawnOrigin picks a random top-left for a width x height span that fits the map
+// When the field scrolls, most picks fall in or near the viewport so new glyphs are seen;
+// the rest use the whole map so off-screen areas still fill
+func (s *GlyphSystem) spawnOrigin(width, height int) (x, y int) {
+	config := s.world.Resources.Config
+	minX, minY := 0, 0
+	maxX, maxY := config.MapWidth-width, config.MapHeight-height
+
+	scrolls := config.MapWidth > config.ViewportWidth || config.MapHeight > config.ViewportHeight
+	if scrolls && s.rng.Intn(100) < parameter.SpawnNearViewPercent {
+		nearMinX := max(minX, config.CameraX-parameter.SpawnViewMarginX)
+		nearMinY := max(minY, config.CameraY-parameter.SpawnViewMarginY)
+		nearMaxX := min(maxX, config.CameraX+config.ViewportWidth+parameter.SpawnViewMarginX-width)
+		nearMaxY := min(maxY, config.CameraY+config.ViewportHeight+parameter.SpawnViewMarginY-height)
+		// A span wider than the near region keeps the whole-map range
+		if nearMaxX >= nearMinX && nearMaxY >= nearMinY {
+			minX, minY, maxX, maxY = nearMinX, nearMinY, nearMaxX, nearMaxY
+		}
+	}
+	return minX + s.rng.Intn(maxX-minX+1), minY + s.rng.Intn(maxY-minY+1)
+}
+
+// cropLine returns the runes of line cut to width
+func cropLine(line string, width int) []rune {
+	lineRunes := []rune(line)
+	if len(lineRunes) > width {
+		lineRunes = lineRunes[:max(0, width)]
+	}
+	return lineRunes
+}
+
+// placeLineAt places a line with its first rune at startCol, row
+// Fails when the span leaves the map, hits a wall or glyph, or enters the cursor exclusion zone
+func (s *GlyphSystem) placeLineAt(lineRunes []rune, startCol, row int, glyphType component.GlyphType, glyphLevel component.GlyphLevel) bool {
+	config := s.world.Resources.Config
+	lineLength := len(lineRunes)
+
+	if lineLength == 0 || row < 0 || row >= config.MapHeight || startCol < 0 || startCol+lineLength > config.MapWidth {
+		return false
+	}
+
+	// Check for overlaps
+	for i := range lineLength {
+		if s.world.Positions.IsBlocked(startCol+i, row, component.WallBlockSpawn) {
+			return false
+		}
+	}
+
+	// Check if too close to cursor
+	cursorPos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity)
+	if !ok {
+		return false
+	}
+	for i := range lineLength {
+		col := startCol + i
+		if vmath.IntAbs(col-cursorPos.X) <= parameter.CursorExclusionX &&
+			vmath.IntAbs(row-cursorPos.Y) <= parameter.CursorExclusionY {
+			return false
+		}
+	}
+
+	// Valid position found, create entities
+
+	// 1. Create entities and prepare components
+	type entityData struct {
+		entity core.Entity
+		pos    component.PositionComponent
+		char   rune
+	}
+
+	entities := make([]entityData, 0, lineLength)
+
+	for i := range lineLength {
+		// Skip space characters - don't create entities for them
+		if lineRunes[i] == ' ' {
+			continue
+		}
+
+		entity := s.world.CreateEntity()
+		entities = append(entities, entityData{
+			entity: entity,
+			pos: component.PositionComponent{
+				X: startCol + i,
+				Y: row,
+			},
+			char: lineRunes[i],
+		})
+	}
+
+	// 2. Batch position validation and commit
+	batch := s.world.Positions.BeginBatch()
+	for _, ed := range entities {
+		batch.Add(ed.entity, ed.pos)
+	}
+
+	if err := batch.Commit(); err != nil {
+		// Collision detected - cleanup entities
+		for _, ed := range entities {
+			s.world.DestroyEntity(ed.entity)
+		}
+		return false
+	}
+
+	// 3. Set glyph components
+	members := make([]core.Entity, 0, len(entities))
+	chars := make([]rune, 0, len(entities))
+	for _, ed := range entities {
+		s.world.Components.Glyph.SetComponent(ed.entity, component.GlyphComponent{
+			Rune:  ed.char,
+			Type:  glyphType,
+			Level: glyphLevel,
+		})
+		members = append(members, ed.entity)
+		chars = append(chars, ed.char)
+	}
+
+	// 4. Link bracket pairs for '%'
+	s.linkBrackets(members, chars)
+
+	return true
+}
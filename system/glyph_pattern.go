@@ -0,0 +1,188 @@
+package system
+
+import (
+	"math"
+	"strings"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// placePattern lays out one wave of lines with the configured spawn pattern
+// Mixed picks a pattern per wave; lines a pattern cannot fit fall back to random placement
+func (s *GlyphSystem) placePattern(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) {
+	s.wave++
+
+	pattern := s.world.Resources.Config.SpawnPattern
+	if pattern == parameter.SpawnPatternMixed {
+		pattern = parameter.SpawnPattern(s.rng.Intn(int(parameter.SpawnPatternMixed)))
+	}
+
+	var rest []string
+	switch pattern {
+	case parameter.SpawnPatternRain:
+		rest = s.placeRain(lines, glyphType, glyphLevel)
+	case parameter.SpawnPatternSentence:
+		rest = s.placeSentence(lines, glyphType, glyphLevel)
+	case parameter.SpawnPatternSpiral:
+		rest = s.placeSpiral(lines, glyphType, glyphLevel)
+	case parameter.SpawnPatternCluster:
+		rest = s.placeCluster(lines, glyphType, glyphLevel)
+	default:
+		rest = lines
+	}
+
+	for _, line := range rest {
+		s.placeLine(line, glyphType, glyphLevel)
+	}
+}
+
+// patternAnchor picks the top-left of a width x height span among random candidates,
+// preferring the highest noise value so anchors gather in drifting organic patches
+func (s *GlyphSystem) patternAnchor(width, height int) (x, y int, ok bool) {
+	config := s.world.Resources.Config
+	if width <= 0 || height <= 0 || width > config.MapWidth || height > config.MapHeight {
+		return 0, 0, false
+	}
+
+	drift := float64(s.wave) * parameter.SpawnNoiseWaveDrift
+	best := -1.0
+	for range parameter.SpawnAnchorCandidates {
+		cx, cy := s.spawnOrigin(width, height)
+		v := s.noise.Fractal(float64(cx)*parameter.SpawnNoiseScale+drift, float64(cy)*parameter.SpawnNoiseScale*2, parameter.SpawnNoiseOctaves)
+		if v > best {
+			best, x, y = v, cx, cy
+		}
+	}
+	return x, y, true
+}
+
+// placeRain trickles the words of the lines down one column, returning words that did not fit
+func (s *GlyphSystem) placeRain(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) []string {
+	config := s.world.Resources.Config
+
+	var words []string
+	width := 0
+	for _, line := range lines {
+		for _, w := range strings.Fields(line) {
+			r := []rune(w)
+			for len(r) > 0 {
+				n := min(len(r), parameter.SpawnRainMaxWord)
+				words = append(words, string(r[:n]))
+				width = max(width, n)
+				r = r[n:]
+			}
+		}
+	}
+
+	col, top, ok := s.patternAnchor(width, min(len(words), config.MapHeight))
+	if !ok {
+		return lines
+	}
+
+	// Blocked rows are skipped, the trickle continues below them
+	row := top
+	for i, w := range words {
+		for row < config.MapHeight && !s.placeLineAt([]rune(w), col, row, glyphType, glyphLevel) {
+			row++
+		}
+		if row >= config.MapHeight {
+			return words[i:]
+		}
+		row++
+	}
+	return nil
+}
+
+// placeSentence joins the lines into one sentence wrapped at word boundaries, returning rows that did not fit
+func (s *GlyphSystem) placeSentence(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) []string {
+	config := s.world.Resources.Config
+	width := min(parameter.SpawnSentenceWidth, config.MapWidth)
+
+	var rows []string
+	var cur []rune
+	for _, line := range lines {
+		for _, w := range strings.Fields(line) {
+			r := cropLine(w, width)
+			if len(cur) > 0 && len(cur)+1+len(r) > width {
+				rows = append(rows, string(cur))
+				cur = nil
+			}
+			if len(cur) > 0 {
+				cur = append(cur, ' ')
+			}
+			cur = append(cur, r...)
+		}
+	}
+	if len(cur) > 0 {
+		rows = append(rows, string(cur))
+	}
+
+	rowWidth := 0
+	for _, row := range rows {
+		rowWidth = max(rowWidth, len([]rune(row)))
+	}
+	left, top, ok := s.patternAnchor(rowWidth, min(len(rows), config.MapHeight))
+	if !ok {
+		return lines
+	}
+
+	var rest []string
+	for i, row := range rows {
+		if !s.placeLineAt([]rune(row), left, top+i, glyphType, glyphLevel) {
+			rest = append(rest, row)
+		}
+	}
+	return rest
+}
+
+// placeSpiral winds the lines outward from a center by the golden angle, returning lines that did not fit
+func (s *GlyphSystem) placeSpiral(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) []string {
+	config := s.world.Resources.Config
+
+	cx, cy, ok := s.patternAnchor(1, 1)
+	if !ok {
+		return lines
+	}
+
+	var rest []string
+	for i, line := range lines {
+		r := cropLine(line, config.MapWidth)
+		angle := float64(i) * parameter.SpawnSpiralAngleStep
+		radius := float64(i+1) * parameter.SpawnSpiralRadiusStep
+		x := cx + int(math.Round(radius*math.Cos(angle)*parameter.SpawnSpiralAspect)) - len(r)/2
+		y := cy + int(math.Round(radius*math.Sin(angle)))
+		if !s.placeLineAt(r, x, y, glyphType, glyphLevel) {
+			rest = append(rest, line)
+		}
+	}
+	return rest
+}
+
+// placeCluster bursts the lines around one center, returning lines that did not fit
+func (s *GlyphSystem) placeCluster(lines []string, glyphType component.GlyphType, glyphLevel component.GlyphLevel) []string {
+	config := s.world.Resources.Config
+
+	cx, cy, ok := s.patternAnchor(1, 1)
+	if !ok {
+		return lines
+	}
+
+	var rest []string
+	for _, line := range lines {
+		r := cropLine(line, config.MapWidth)
+		placed := false
+		for range parameter.SpawnClusterTries {
+			dx := s.rng.Intn(2*parameter.SpawnClusterRadiusX+1) - parameter.SpawnClusterRadiusX
+			dy := s.rng.Intn(2*parameter.SpawnClusterRadiusY+1) - parameter.SpawnClusterRadiusY
+			if s.placeLineAt(r, cx+dx-len(r)/2, cy+dy, glyphType, glyphLevel) {
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			rest = append(rest, line)
+		}
+	}
+	return rest
+}
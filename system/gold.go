@@ -52,7 +52,7 @@ func NewGoldSystem(world *engine.World) engine.System {
 // Init resets session state for new game
 func (s *GoldSystem) Init() {
 	s.active = false
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 	s.headerEntity = 0
 	s.startTime = time.Time{}
 	s.timeoutTime = time.Time{}
@@ -368,10 +368,7 @@ func (s *GoldSystem) handleGoldComplete() {
 		HeaderEntity: headerEntity,
 	})
 
-	// // Play sound
-	// if s.world.Resources.Audio != nil && s.world.Resources.Audio.Player != nil {
-	// 	s.world.Resources.Audio.Player.Play(audio.SoundCoin)
-	// }
+	s.awardTimeBonus()
 
 	// Silent destruction - members already dead from typing
 	s.world.PushEvent(event.EventCompositeDestroyRequest, &event.CompositeDestroyRequestPayload{
@@ -382,6 +379,25 @@ func (s *GoldSystem) handleGoldComplete() {
 	s.clearState()
 }
 
+// awardTimeBonus grants energy scaled by the time left on the countdown, faster completion pays more
+func (s *GoldSystem) awardTimeBonus() {
+	remaining := s.timeoutTime.Sub(s.world.Resources.Time.GameTime)
+	if remaining <= 0 {
+		return
+	}
+
+	ratio := float64(remaining) / float64(parameter.GoldDuration)
+	multiplier := 1 + (parameter.GoldBonusMaxMultiplier-1)*min(ratio, 1)
+
+	s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+		Delta: int(float64(parameter.GoldBonusEnergy) * multiplier),
+		Type:  component.EnergyDeltaReward,
+	})
+	s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+		ID: parameter.Sfx.Coin,
+	})
+}
+
 // handleGoldTimeout processes gold sequence expiration
 func (s *GoldSystem) handleGoldTimeout() {
 	if !s.active {
@@ -3,6 +3,7 @@ package system
 import (
 	"sync/atomic"
 
+	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
@@ -16,6 +17,8 @@ type HeatSystem struct {
 	statOverheat *atomic.Int64
 	statAtMax    *atomic.Bool
 	statEmber    *atomic.Bool
+	statTier     *atomic.Int64
+	statLockout  *atomic.Bool
 
 	enabled bool
 }
@@ -29,6 +32,8 @@ func NewHeatSystem(world *engine.World) engine.System {
 	s.statOverheat = s.world.Resources.Status.Ints.Get("heat.overheat")
 	s.statAtMax = s.world.Resources.Status.Bools.Get("heat.at_max")
 	s.statEmber = s.world.Resources.Status.Bools.Get("heat.ember")
+	s.statTier = s.world.Resources.Status.Ints.Get("heat.tier")
+	s.statLockout = s.world.Resources.Status.Bools.Get("heat.lockout")
 
 	s.Init()
 	return s
@@ -40,6 +45,8 @@ func (s *HeatSystem) Init() {
 	s.statOverheat.Store(0)
 	s.statAtMax.Store(false)
 	s.statEmber.Store(false)
+	s.statTier.Store(0)
+	s.statLockout.Store(false)
 	s.enabled = true
 }
 
@@ -75,6 +82,35 @@ func (s *HeatSystem) Update() {
 		modified = true
 	}
 
+	// Handle post-burst lockout timeout
+	if heatComp.LockoutRemaining > 0 {
+		heatComp.LockoutRemaining -= s.world.Resources.Time.DeltaTime
+		if heatComp.LockoutRemaining <= 0 {
+			heatComp.LockoutRemaining = 0
+			s.statLockout.Store(false)
+		}
+		modified = true
+	}
+
+	// Handle idle decay, ember has its own faster decay
+	if !heatComp.EmberActive && heatComp.Current > 0 {
+		now := s.world.Resources.Time.GameTime
+		if now.Sub(heatComp.LastGainTime) >= parameter.HeatDecayGrace &&
+			now.Sub(heatComp.DecayTime) >= parameter.HeatDecayInterval {
+			heatComp.Current = max(0, heatComp.Current-parameter.HeatDecayAmount)
+			heatComp.DecayTime = now
+
+			// Enforce invariant: heat < max → no overheat
+			if heatComp.Current < parameter.HeatMax {
+				heatComp.Overheat = 0
+				heatComp.OverheatWarned = false
+			}
+
+			s.storeStats(&heatComp)
+			modified = true
+		}
+	}
+
 	// Handle ember decay
 	if heatComp.EmberActive {
 		now := s.world.Resources.Time.GameTime
@@ -91,11 +127,10 @@ func (s *HeatSystem) Update() {
 			// Enforce invariant: heat < max → no overheat
 			if heatComp.Current < parameter.HeatMax {
 				heatComp.Overheat = 0
+				heatComp.OverheatWarned = false
 			}
 
-			s.statCurrent.Store(int64(heatComp.Current))
-			s.statOverheat.Store(int64(heatComp.Overheat))
-			s.statAtMax.Store(heatComp.Current >= parameter.HeatMax)
+			s.storeStats(&heatComp)
 			modified = true
 		}
 	}
@@ -153,9 +188,18 @@ func (s *HeatSystem) addHeat(delta int) {
 		return
 	}
 
+	// Gains are ignored during the post-burst lockout
+	if delta > 0 {
+		if heatComp.LockoutRemaining > 0 {
+			return
+		}
+		heatComp.LastGainTime = s.world.Resources.Time.GameTime
+	}
+
 	// Reset overheat if heat penalty
 	if delta < 0 {
 		heatComp.Overheat = 0
+		heatComp.OverheatWarned = false
 
 		s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
 			ID: parameter.Sfx.MetalHit,
@@ -173,21 +217,37 @@ func (s *HeatSystem) addHeat(delta int) {
 	}
 	heatComp.Current = newVal
 
-	// Trigger and reset overheat if at or above max
+	// Warn once as overheat nears the burst threshold
+	if !heatComp.OverheatWarned && float64(heatComp.Overheat) >= parameter.HeatOverheatWarnRatio*parameter.HeatMaxOverheat {
+		heatComp.OverheatWarned = true
+		s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+			ID: parameter.Sfx.Ring,
+		})
+	}
+
+	// Trigger and reset overheat if at or above max, then lock out further gains briefly
 	if heatComp.Overheat >= parameter.HeatMaxOverheat {
 		heatComp.Overheat = 0
+		heatComp.OverheatWarned = false
 		heatComp.BurstFlashRemaining = parameter.HeatBurstFlashDuration
+		heatComp.LockoutRemaining = parameter.HeatBurstLockout
 		heatComp.EmberActive = true
 		heatComp.EmberDecayTime = s.world.Resources.Time.GameTime
 		s.world.PushEvent(event.EventHeatBurst, nil)
 		s.statEmber.Store(true)
+		s.statLockout.Store(true)
 	}
 
 	s.world.Components.Heat.SetComponent(cursorEntity, heatComp)
+	s.storeStats(&heatComp)
+}
 
+// storeStats publishes heat state to the status registry
+func (s *HeatSystem) storeStats(heatComp *component.HeatComponent) {
 	s.statCurrent.Store(int64(heatComp.Current))
 	s.statOverheat.Store(int64(heatComp.Overheat))
-	s.statAtMax.Store(newVal >= parameter.HeatMax)
+	s.statAtMax.Store(heatComp.Current >= parameter.HeatMax)
+	s.statTier.Store(int64(heatComp.Tier()))
 }
 
 // setHeat stores absolute value with clamping and writes back to store
@@ -208,13 +268,16 @@ func (s *HeatSystem) setHeat(value int) {
 		value = parameter.HeatMax
 	} else {
 		heatComp.Overheat = 0
+		heatComp.OverheatWarned = false
 	}
 
+	// Setting heat counts as a gain so decay restarts its grace period
+	if value > heatComp.Current {
+		heatComp.LastGainTime = s.world.Resources.Time.GameTime
+	}
 	heatComp.Current = value
 
-	s.statCurrent.Store(int64(value))
-	s.statOverheat.Store(int64(heatComp.Overheat))
-	s.statAtMax.Store(value >= parameter.HeatMax)
+	s.storeStats(&heatComp)
 
 	s.world.Components.Heat.SetComponent(cursorEntity, heatComp)
 }
@@ -2,6 +2,7 @@ package system
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -11,6 +12,7 @@ import (
 	"github.com/lixenwraith/vi-fighter/engine"
 	"github.com/lixenwraith/vi-fighter/event"
 	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/parameter/visual"
 	"github.com/lixenwraith/vi-fighter/status"
 )
 
@@ -19,6 +21,17 @@ type MetaSystem struct {
 	ctx *engine.GameContext
 
 	world *engine.World
+
+	// Size guard: pause is held while the terminal is too small, and the last
+	// request made meanwhile decides whether play resumes once it grows back
+	sizeGuard    bool
+	resumeOnGrow bool
+
+	// Game over: the ended run's score and its leaderboard submission, shown until the next game
+	gameOver    bool
+	finalScore  int64
+	boardStatus string
+	boardTop    []event.LeaderboardRow
 }
 
 // NewMetaSystem creates a new meta system
@@ -56,8 +69,13 @@ func (s *MetaSystem) EventTypes() []event.EventType {
 		event.EventMetaDebugRequest,
 		event.EventMetaHelpRequest,
 		event.EventMetaAboutRequest,
+		event.EventMetaSettingsRequest,
+		event.EventMetaAnalysisRequest,
 		event.EventGamePauseRequest,
+		event.EventMetaSizeGuard,
 		event.EventGameReset,
+		event.EventGameOver,
+		event.EventMetaLeaderboardResult,
 	}
 }
 
@@ -97,15 +115,38 @@ func (s *MetaSystem) HandleEvent(ev event.GameEvent) {
 		s.handleDebugRequest()
 
 	case event.EventMetaHelpRequest:
-		s.handleHelpRequest()
+		payload, _ := ev.Payload.(*event.MetaHelpRequestPayload)
+		s.handleHelpRequest(payload)
 
 	case event.EventMetaAboutRequest:
 		s.handleAboutRequest()
 
+	case event.EventMetaSettingsRequest:
+		s.handleSettingsRequest()
+
+	case event.EventMetaAnalysisRequest:
+		payload, _ := ev.Payload.(*event.MetaAnalysisRequestPayload)
+		s.handleAnalysisRequest(payload)
+
 	case event.EventGamePauseRequest:
 		if p, ok := ev.Payload.(*event.GamePausePayload); ok {
 			s.handlePauseRequest(p.Paused)
 		}
+
+	case event.EventMetaSizeGuard:
+		if p, ok := ev.Payload.(*event.MetaSizeGuardPayload); ok {
+			s.handleSizeGuard(p.TooSmall)
+		}
+
+	case event.EventGameOver:
+		if p, ok := ev.Payload.(*event.GameOverPayload); ok {
+			s.handleGameOver(p.Score)
+		}
+
+	case event.EventMetaLeaderboardResult:
+		if p, ok := ev.Payload.(*event.MetaLeaderboardResultPayload); ok {
+			s.handleLeaderboardResult(p)
+		}
 	}
 }
 
@@ -125,21 +166,26 @@ func (s *MetaSystem) Update() {
 func (s *MetaSystem) handleGameReset() {
 	// 1. Pause and stop audio
 	s.ctx.SetPaused(true)
+	s.gameOver = false
+	s.boardStatus, s.boardTop = "", nil
 
 	// 2. Synchronous World Cleanup
 	// Already inside world.RunSafe from main -> DispatchEventsImmediately
 	s.ctx.World.Clear()
 
-	// 3. GameState reset (counters, NextID → 1)
+	// 3. GameState reset (counters, NextID → 1); scheduled events restart with the tick count
 	s.ctx.State.Reset()
+	s.ctx.World.Resources.Timer.Reset()
+
+	// Next game draws from a seed derived from this one, keeping seeded sessions reproducible
+	s.ctx.World.Resources.Rand.Advance()
 
-	// 4. Config reset (map dimensions to viewport)
+	// 4. Config reset (map dimensions to fixed field or viewport)
 	config := s.ctx.World.Resources.Config
-	config.MapWidth = config.ViewportWidth
-	config.MapHeight = config.ViewportHeight
+	config.MapWidth, config.MapHeight, config.CropOnResize = config.BaseMapSize()
 	config.CameraX = 0
 	config.CameraY = 0
-	config.CropOnResize = true
+	s.ctx.World.Positions.ResizeGrid(config.MapWidth, config.MapHeight)
 
 	// 5. Cursor recreation
 	s.ctx.World.CreateCursorEntity()
@@ -174,11 +220,9 @@ func (s *MetaSystem) handleLevelSetup(payload *event.LevelSetupPayload) {
 	height := payload.Height
 	cropOnResize := payload.CropOnResize
 
-	// Zero dimensions = reset to viewport with crop enabled
+	// Zero dimensions = reset to the fixed field, or viewport with crop enabled
 	if width <= 0 || height <= 0 {
-		width = s.world.Resources.Config.ViewportWidth
-		height = s.world.Resources.Config.ViewportHeight
-		cropOnResize = true
+		width, height, cropOnResize = s.world.Resources.Config.BaseMapSize()
 	}
 
 	s.world.SetupLevel(width, height, payload.ClearEntities, cropOnResize)
@@ -290,84 +334,403 @@ func splitStatKey(key string) (prefix, name string) {
 }
 
 // handleHelpRequest shows help information overlay
-func (s *MetaSystem) handleHelpRequest() {
+// Payload scopes cards to the mode help was opened from, nil shows the full reference
+func (s *MetaSystem) handleHelpRequest(payload *event.MetaHelpRequestPayload) {
 	content := &core.OverlayContent{
 		Title: "HELP",
 	}
 
-	// Card: Modes
-	content.Items = append(content.Items, core.OverlayCard{
+	if payload == nil {
+		content.Items = append(content.Items,
+			helpModesCard(), helpMotionCard(), helpOperatorCard(), helpGameCard(),
+			helpInsertCard(), helpSearchCard(), helpCommandCard(),
+		)
+		s.ctx.SetOverlayContent(content)
+		return
+	}
+
+	switch payload.Mode {
+	case core.ModeInsert:
+		content.Title = "HELP: INSERT"
+		content.Items = append(content.Items, helpInsertCard(), helpGameCard(), helpModesCard())
+	case core.ModeSearch:
+		content.Title = "HELP: SEARCH"
+		content.Items = append(content.Items, helpSearchCard(), helpModesCard())
+	case core.ModeCommand:
+		content.Title = "HELP: COMMAND"
+		content.Items = append(content.Items, helpCommandCard(), helpModesCard())
+	default:
+		content.Title = "HELP: NORMAL"
+		content.Items = append(content.Items, helpMotionCard(), helpOperatorCard(), helpGameCard(), helpModesCard())
+	}
+
+	s.ctx.SetOverlayContent(content)
+}
+
+func helpModesCard() core.OverlayCard {
+	return core.OverlayCard{
 		Title: "MODES",
 		Entries: []core.CardEntry{
 			{Key: "i", Value: "Enter INSERT mode"},
 			{Key: "ESC", Value: "Return to NORMAL / Show grid"},
 			{Key: "/", Value: "Enter SEARCH mode"},
 			{Key: ":", Value: "Enter COMMAND mode"},
+			{Key: "?/F1", Value: "Help for current mode"},
 		},
-	})
+	}
+}
 
-	// Card: Movement
-	content.Items = append(content.Items, core.OverlayCard{
-		Title: "MOVEMENT",
+func helpMotionCard() core.OverlayCard {
+	return core.OverlayCard{
+		Title: "MOTIONS",
 		Entries: []core.CardEntry{
-			{Key: "h/j/k/l", Value: "MoveEntity left/down/up/right"},
-			{Key: "w/b", Value: "Word forward/backward"},
-			{Key: "0/$", Value: "Line start/end"},
+			{Key: "h/j/k/l", Value: "Move left/down/up/right"},
+			{Key: "K/zK", Value: "Half page up"},
+			{Key: "zJ", Value: "Half page down (J joins)"},
+			{Key: "zH/zL", Value: "Half page left/right"},
+			{Key: "H/M/L", Value: "Top/middle/bottom of view"},
+			{Key: "w/b/e", Value: "Word forward/backward/end"},
+			{Key: "0/^/$", Value: "Line start/first char/end"},
 			{Key: "gg/G", Value: "Top/bottom of screen"},
+			{Key: "m", Value: "Screen horizontal middle"},
+			{Key: "go/g$/gm", Value: "Map origin/end/center"},
+			{Key: "{/}", Value: "Paragraph backward/forward"},
+			{Key: "%", Value: "Matching bracket"},
+			{Key: "[/]", Value: "Column glyph up/down"},
 			{Key: "f/F{c}", Value: "Find char forward/backward"},
 			{Key: "t/T{c}", Value: "Till char forward/backward"},
 			{Key: ";/,", Value: "Repeat find / reverse"},
+			{Key: "gh/gj/gk/gl", Value: "Jump to colored glyph"},
+			{Key: "u", Value: "Undo cursor jump"},
+			{Key: "C-e/C-y", Value: "Scroll view line down/up"},
+			{Key: "C-d/C-u", Value: "Scroll half page down/up"},
+			{Key: "C-f/C-b", Value: "Scroll page down/up"},
+			{Key: "zz/zt/zb", Value: "Cursor row to center/top/bottom"},
 		},
-	})
+	}
+}
 
-	// Card: Delete
-	content.Items = append(content.Items, core.OverlayCard{
-		Title: "DELETE",
+func helpOperatorCard() core.OverlayCard {
+	return core.OverlayCard{
+		Title: "OPERATORS",
 		Entries: []core.CardEntry{
 			{Key: "d{motion}", Value: "Delete with motion"},
 			{Key: "dd", Value: "Delete current line"},
 			{Key: "D", Value: "Delete to end of line"},
 			{Key: "x", Value: "Delete char at cursor"},
+			{Key: "r{c}", Value: "Replace red char (heat)"},
+			{Key: "J", Value: "Join next word on line"},
+			{Key: "q{a-z}", Value: "Record macro / stop"},
+			{Key: "[N]@{a-z}", Value: "Play macro"},
 		},
-	})
+	}
+}
 
-	// Card: Game
-	content.Items = append(content.Items, core.OverlayCard{
+func helpGameCard() core.OverlayCard {
+	return core.OverlayCard{
 		Title: "GAME",
 		Entries: []core.CardEntry{
 			{Key: "TAB", Value: "Jump to nugget (10 energy)"},
+			{Key: "Shift+TAB", Value: "Jump to gold"},
 			{Key: "ENTER", Value: "Fire directional cleaners"},
+			{Key: "\\ f/t or /", Value: "Seeker clears target sequence"},
 			{Key: "Ctrl+S", Value: "Toggle audio mute"},
+			{Key: "Ctrl+G", Value: "Toggle music mute"},
 		},
-	})
+	}
+}
 
-	// Card: Search
-	content.Items = append(content.Items, core.OverlayCard{
+func helpInsertCard() core.OverlayCard {
+	return core.OverlayCard{
+		Title: "INSERT",
+		Entries: []core.CardEntry{
+			{Key: "{c}", Value: "Type glyph under cursor"},
+			{Key: "SPACE", Value: "Delete char, move right"},
+			{Key: "BACKSPACE", Value: "Delete previous char"},
+			{Key: "DEL", Value: "Delete char at cursor"},
+			{Key: "Arrows", Value: "Move cursor"},
+			{Key: "ESC", Value: "Return to NORMAL"},
+		},
+	}
+}
+
+func helpSearchCard() core.OverlayCard {
+	return core.OverlayCard{
 		Title: "SEARCH",
 		Entries: []core.CardEntry{
 			{Key: "/text", Value: "Search for text"},
+			{Key: "ENTER", Value: "Jump to first match"},
 			{Key: "n/N", Value: "Next/previous match"},
+			{Key: "ESC", Value: "Cancel search"},
 		},
-	})
+	}
+}
 
-	// Card: Commands
-	content.Items = append(content.Items, core.OverlayCard{
+func helpCommandCard() core.OverlayCard {
+	return core.OverlayCard{
 		Title: "COMMANDS",
 		Entries: []core.CardEntry{
 			{Key: ":q", Value: "Quit game"},
 			{Key: ":n", Value: "New game"},
+			{Key: ":end", Value: "End run, show score"},
 			{Key: ":energy N", Value: "Set energy"},
 			{Key: ":heat N", Value: "Set heat"},
 			{Key: ":boost", Value: "Enable boost"},
+			{Key: ":turret", Value: "Buy typed turret at cursor"},
 			{Key: ":spawn on/off", Value: "Toggle spawning"},
+			{Key: ":cvd [mode]", Value: "Color-blind palette"},
+			{Key: ":set", Value: "Assist settings"},
 			{Key: ":d", Value: "Debug overlay"},
-			{Key: ":h", Value: "This help"},
+			{Key: ":an [file]", Value: "Session analysis, CSV export"},
+			{Key: ":h", Value: "Full help"},
+			{Key: "Up/Down", Value: "Command history"},
+		},
+	}
+}
+
+// === Settings ===
+
+// handleSettingsRequest shows current assist, accessibility, display, and popup options
+// Entries with an Action are editable: j/k select, Enter applies the action and rebuilds the card
+func (s *MetaSystem) handleSettingsRequest() {
+	config := s.world.Resources.Config
+	assist := config.Assist
+
+	onOff := func(v bool) string {
+		if v {
+			return "on"
+		}
+		return "off"
+	}
+
+	content := &core.OverlayContent{
+		Title:      "SETTINGS",
+		Selectable: true,
+	}
+
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "ASSIST",
+		Entries: []core.CardEntry{
+			{Key: "speed", Value: fmt.Sprintf("%d%%", assist.SpeedPercent),
+				Action: fmt.Sprintf("speed=%d", nextPreset(parameter.AssistSpeedPresets, assist.SpeedPercent))},
+			{Key: "penalty", Value: onOff(!assist.NoErrorPenalty), Action: "penalty!"},
+			{Key: "errorheat", Value: fmt.Sprintf("%d", assist.ErrorHeat),
+				Action: fmt.Sprintf("errorheat=%d", nextPreset(parameter.AssistErrorHeatPresets, assist.ErrorHeat))},
+			{Key: "forgive", Value: fmt.Sprintf("%dms", assist.ForgiveMs),
+				Action: fmt.Sprintf("forgive=%d", nextPreset(parameter.AssistForgivePresets, assist.ForgiveMs))},
+			{Key: "longflash", Value: onOff(assist.LongErrorFlash), Action: "longflash!"},
+			{Key: "bigcursor", Value: onOff(assist.LargeCursor), Action: "bigcursor!"},
+			{Key: "blocks", Value: onOff(config.SpawnBlocks), Action: "blocks!"},
+			{Key: "spawn", Value: config.SpawnPattern.String(),
+				Action: "spawn=" + nextName(parameter.SpawnPatternNames[:], config.SpawnPattern.String())},
+		},
+	})
+
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "ACCESSIBILITY",
+		Entries: []core.CardEntry{
+			{Key: "cvd", Value: config.Accessibility.String(),
+				Action: "cvd=" + nextName(visual.CVDModeNames[:], config.Accessibility.String())},
 		},
 	})
 
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "DISPLAY",
+		Entries: []core.CardEntry{
+			{Key: "crt", Value: onOff(config.CRT), Action: "crt!"},
+			{Key: "dither", Value: config.Dither.String(),
+				Action: "dither=" + nextName(visual.DitherModeNames[:], config.Dither.String())},
+			{Key: "ambience", Value: onOff(!config.NoAmbience), Action: "ambience!"},
+			{Key: "trail", Value: config.Trail.String(),
+				Action: "trail=" + nextName(visual.TrailStyleNames[:], config.Trail.String())},
+			{Key: "minimap", Value: onOff(config.Minimap), Action: "minimap!"},
+			{Key: "hud", Value: onOff(config.InputHUD), Action: "hud!"},
+			{Key: "preview", Value: onOff(config.Preview), Action: "preview!"},
+			{Key: "field", Value: engine.FormatFieldSize(config.FieldWidth, config.FieldHeight)}, // Free-form, :set only
+		},
+	})
+
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "POPUPS",
+		Entries: []core.CardEntry{
+			{Key: "popups", Value: onOff(!config.Popups.Off), Action: "popups!"},
+			{Key: "combo", Value: fmt.Sprintf("x%d", config.Popups.ComboMin),
+				Action: fmt.Sprintf("combo=%d", nextPreset(parameter.PopupComboPresets, config.Popups.ComboMin))},
+			{Key: "milestone", Value: fmt.Sprintf("%d", config.Popups.Milestone),
+				Action: fmt.Sprintf("milestone=%d", nextPreset(parameter.PopupMilestonePresets, config.Popups.Milestone))},
+		},
+	})
+
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "USAGE",
+		Entries: []core.CardEntry{
+			{Key: "j/k Enter", Value: "Select and change an option"},
+			{Key: ":set opt", Value: "Enable option"},
+			{Key: ":set noopt", Value: "Disable option"},
+			{Key: ":set opt!", Value: "Toggle option"},
+			{Key: ":set speed=N", Value: fmt.Sprintf("Game speed %d-%d%%", parameter.AssistSpeedMin, parameter.AssistSpeedMax)},
+			{Key: ":set errorheat=N", Value: fmt.Sprintf("Heat lost per typing error 0-%d", parameter.HeatMax)},
+			{Key: ":set forgive=N", Value: "Backspace window over a typo in ms, 0 off"},
+			{Key: ":set cvd=mode", Value: "Color-blind palette"},
+			{Key: ":set trail=style", Value: "Cursor trail"},
+			{Key: ":set dither=mode", Value: "256-color gradient dither"},
+			{Key: ":set spawn=pattern", Value: "Spawn layout"},
+			{Key: ":set field=WxH", Value: "Fixed field size, auto follows terminal"},
+			{Key: ":set combo=N", Value: "Streak shown on score popups"},
+			{Key: ":set milestone=N", Value: "Streak banner interval, 0 off"},
+		},
+	})
+
+	// Rebuilding after an edit keeps the selection and scroll in place
+	refresh := s.ctx.GetOverlayTitle() == content.Title
+	scroll, selected := s.ctx.GetOverlayScroll(), s.ctx.GetOverlaySelect()
+	s.ctx.SetOverlayContent(content)
+	if refresh {
+		s.ctx.SetOverlayScroll(scroll)
+		s.ctx.SetOverlaySelect(selected)
+	}
+}
+
+// nextPreset returns the first preset above v, wrapping to the first
+func nextPreset(presets []int, v int) int {
+	for _, p := range presets {
+		if p > v {
+			return p
+		}
+	}
+	return presets[0]
+}
+
+// nextName returns the name after cur, wrapping, or the first when cur is unknown
+func nextName(names []string, cur string) string {
+	for i, n := range names {
+		if n == cur {
+			return names[(i+1)%len(names)]
+		}
+	}
+	return names[0]
+}
+
+// === Analysis ===
+
+// handleAnalysisRequest shows the session timeline as charts with streak markers, or exports it as CSV
+// The current session is used, or the previous one right after a reset
+func (s *MetaSystem) handleAnalysisRequest(payload *event.MetaAnalysisRequestPayload) {
+	samples := s.world.Resources.Timeline.Session()
+
+	if payload != nil && payload.ExportPath != "" {
+		s.exportTimeline(payload.ExportPath, samples)
+		return
+	}
+
+	content := &core.OverlayContent{
+		Title:  "ANALYSIS",
+		Custom: true,
+	}
+
+	if len(samples) == 0 {
+		content.Items = append(content.Items, core.OverlayCard{
+			Title:   "SESSION",
+			Entries: []core.CardEntry{{Key: "timeline", Value: "no samples recorded yet"}},
+		})
+		s.ctx.SetOverlayContent(content)
+		return
+	}
+
+	last := samples[len(samples)-1]
+	best := engine.BestStreak(samples)
+	worstEnd, worstLen := engine.WorstStreak(samples)
+
+	var peakHeat int
+	var apmSum uint64
+	for _, sample := range samples {
+		peakHeat = max(peakHeat, sample.Heat)
+		apmSum += sample.APM
+	}
+	accuracy := 0.0
+	if typed := last.Correct + last.Errors; typed > 0 {
+		accuracy = float64(last.Correct) / float64(typed) * 100
+	}
+
+	summary := []core.CardEntry{
+		{Key: "time", Value: formatSessionSecond(last.Second)},
+		{Key: "energy", Value: fmt.Sprintf("%d", last.Energy)},
+		{Key: "peak heat", Value: fmt.Sprintf("%d", peakHeat)},
+		{Key: "accuracy", Value: fmt.Sprintf("%.1f%%", accuracy)},
+		{Key: "avg apm", Value: fmt.Sprintf("%d", apmSum/uint64(len(samples)))},
+	}
+	if best >= 0 {
+		summary = append(summary, core.CardEntry{
+			Key:   string(visual.AnalysisBestRune) + " best",
+			Value: fmt.Sprintf("%d at %s", samples[best].Streak, formatSessionSecond(samples[best].Second)),
+		})
+	}
+	if worstEnd >= 0 {
+		summary = append(summary, core.CardEntry{
+			Key:   string(visual.AnalysisWorstRune) + " worst",
+			Value: fmt.Sprintf("%ds of errors at %s", worstLen, formatSessionSecond(samples[worstEnd].Second)),
+		})
+	}
+	content.Items = append(content.Items, core.OverlayCard{Title: "SESSION", Entries: summary})
+
+	var markers []core.ChartMarker
+	if best >= 0 {
+		markers = append(markers, core.ChartMarker{Index: best, Rune: visual.AnalysisBestRune, Best: true})
+	}
+	if worstEnd >= 0 {
+		markers = append(markers, core.ChartMarker{Index: worstEnd, Rune: visual.AnalysisWorstRune})
+	}
+
+	series := func(title string, value func(i int) float64) core.OverlayChart {
+		values := make([]float64, len(samples))
+		for i := range samples {
+			values[i] = value(i)
+		}
+		return core.OverlayChart{Title: title, Values: values, Markers: markers}
+	}
+
+	content.Items = append(content.Items,
+		series("ENERGY", func(i int) float64 { return float64(samples[i].Energy) }),
+		series("HEAT", func(i int) float64 { return float64(samples[i].Heat) }),
+		series("APM", func(i int) float64 { return float64(samples[i].APM) }),
+		series("STREAK", func(i int) float64 { return float64(samples[i].Streak) }),
+		series("ERRORS/S", func(i int) float64 {
+			if i == 0 {
+				return float64(samples[0].Errors)
+			}
+			return float64(samples[i].Errors - samples[i-1].Errors)
+		}),
+	)
+
 	s.ctx.SetOverlayContent(content)
 }
 
+// exportTimeline writes the session timeline to path as CSV and reports the outcome in the status bar
+func (s *MetaSystem) exportTimeline(path string, samples []engine.TimelineSample) {
+	if len(samples) == 0 {
+		s.ctx.SetStatusMessage("No timeline recorded yet", 0, false)
+		return
+	}
+
+	f, err := os.Create(path)
+	if err == nil {
+		err = engine.WriteTimelineCSV(f, samples)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		s.ctx.SetStatusMessage(fmt.Sprintf("Timeline export failed: %v", err), 0, false)
+		return
+	}
+	s.ctx.SetStatusMessage(fmt.Sprintf("Timeline exported: %d samples to %s", len(samples), path), 0, false)
+}
+
+// formatSessionSecond formats a session offset as m:ss
+func formatSessionSecond(second int) string {
+	return fmt.Sprintf("%d:%02d", second/60, second%60)
+}
+
 // === About (placeholder) ===
 
 // handleAboutRequest shows about information overlay
@@ -395,11 +758,94 @@ func (s *MetaSystem) handleAboutRequest() {
 	s.ctx.SetOverlayContent(content)
 }
 
+// === Game Over ===
+
+// handleGameOver pauses the ended run and shows its score; closing the overlay starts a new game
+// The leaderboard, when enabled, answers with EventMetaLeaderboardResult
+func (s *MetaSystem) handleGameOver(score int64) {
+	s.ctx.SetPaused(true)
+	s.gameOver = true
+	s.finalScore = score
+	s.boardStatus, s.boardTop = "", nil
+	s.showGameOver()
+}
+
+// handleLeaderboardResult refreshes the game-over overlay if it is still open
+// Results arriving after a new game started are dropped
+func (s *MetaSystem) handleLeaderboardResult(payload *event.MetaLeaderboardResultPayload) {
+	if !s.gameOver {
+		return
+	}
+	s.boardStatus, s.boardTop = payload.Status, payload.Top
+	if content := s.ctx.GetOverlayContent(); content != nil && content.ResetOnClose {
+		s.showGameOver()
+	}
+}
+
+func (s *MetaSystem) showGameOver() {
+	content := &core.OverlayContent{
+		Title:        "GAME OVER",
+		ResetOnClose: true,
+	}
+	content.Items = append(content.Items, core.OverlayCard{
+		Title: "RUN",
+		Entries: []core.CardEntry{
+			{Key: "Score", Value: fmt.Sprintf("%d", s.finalScore)},
+			{Key: "ESC", Value: "New game"},
+		},
+	})
+
+	if s.boardStatus != "" || len(s.boardTop) > 0 {
+		board := core.OverlayCard{Title: "LEADERBOARD"}
+		if s.boardStatus != "" {
+			board.Entries = append(board.Entries, core.CardEntry{Key: "Status", Value: s.boardStatus})
+		}
+		for i, row := range s.boardTop {
+			key := fmt.Sprintf("%2d.", i+1)
+			if row.Own {
+				key = fmt.Sprintf("*%d.", i+1)
+			}
+			board.Entries = append(board.Entries, core.CardEntry{Key: key, Value: fmt.Sprintf("%-*s %d", parameter.LeaderboardNameMax, row.Player, row.Score)})
+		}
+		content.Items = append(content.Items, board)
+	}
+
+	s.ctx.SetOverlayContent(content)
+}
+
 // === Pause ===
 
 // handlePauseRequest applies pause to game state and clock, then announces
 // the change; each system applies it to its own domain (audio → AudioSystem)
+// Under the size guard the request is only remembered for when the terminal grows
 func (s *MetaSystem) handlePauseRequest(paused bool) {
+	if s.sizeGuard {
+		s.resumeOnGrow = !paused
+		return
+	}
+	s.applyPause(paused)
+}
+
+// handleSizeGuard pauses while the terminal is below the minimum size and restores
+// the requested pause state when it grows back
+func (s *MetaSystem) handleSizeGuard(tooSmall bool) {
+	if s.sizeGuard == tooSmall {
+		return
+	}
+	if tooSmall {
+		s.resumeOnGrow = !s.ctx.IsPaused.Load()
+		s.applyPause(true)
+		s.sizeGuard = true
+		return
+	}
+	s.sizeGuard = false
+	if s.resumeOnGrow {
+		s.applyPause(false)
+	}
+}
+
+// applyPause sets pause state on game and clock and announces the change
+func (s *MetaSystem) applyPause(paused bool) {
 	if s.ctx.IsPaused.Load() == paused {
 		return
 	}
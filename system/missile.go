@@ -36,6 +36,7 @@ func (s *MissileSystem) Priority() int { return parameter.PriorityMissile }
 func (s *MissileSystem) EventTypes() []event.EventType {
 	return []event.EventType{
 		event.EventMissileSpawnRequest,
+		event.EventSeekerSpawnRequest,
 		event.EventMetaSystemCommandRequest,
 		event.EventGameReset,
 	}
@@ -57,10 +58,15 @@ func (s *MissileSystem) HandleEvent(ev event.GameEvent) {
 	if !s.enabled {
 		return
 	}
-	if ev.Type == event.EventMissileSpawnRequest {
+	switch ev.Type {
+	case event.EventMissileSpawnRequest:
 		if p, ok := ev.Payload.(*event.MissileSpawnRequestPayload); ok {
 			s.handleSpawnRequest(p)
 		}
+	case event.EventSeekerSpawnRequest:
+		if p, ok := ev.Payload.(*event.SeekerSpawnRequestPayload); ok {
+			s.handleSeekerSpawnRequest(p)
+		}
 	}
 }
 
@@ -100,19 +106,33 @@ func (s *MissileSystem) Update() {
 		missileComp.Lifetime += dt
 
 		// if s.updateMissile(&missileComp, &kineticComp, dtFixed) {
-		if s.updateMissile(missileComp, kineticComp, dtFixed) {
+		impacted, fizzled := false, false
+		if missileComp.Seeker {
+			impacted, fizzled = s.updateSeeker(missileComp, kineticComp, dtFixed)
+		} else {
+			impacted = s.updateMissile(missileComp, kineticComp, dtFixed)
+		}
+		if fizzled {
+			toDestroy = append(toDestroy, missileEntity)
+			continue
+		}
+		if impacted {
+			explodeX, explodeY := kineticComp.GridPos()
+			radius := parameter.MissileExplosionRadius
+			if missileComp.Seeker {
+				radius = parameter.SeekerExplosionRadius
+			}
 			s.world.PushEvent(event.EventExplosionRequest, &event.ExplosionRequestPayload{
-				X:      vmath.ToInt(kineticComp.PreciseX),
-				Y:      vmath.ToInt(kineticComp.PreciseY),
-				Radius: parameter.MissileExplosionRadius,
+				X:      explodeX,
+				Y:      explodeY,
+				Radius: radius,
 				Type:   event.ExplosionTypeMissile,
 			})
 			toDestroy = append(toDestroy, missileEntity)
 			continue
 		}
 
-		gridX := vmath.ToInt(kineticComp.PreciseX)
-		gridY := vmath.ToInt(kineticComp.PreciseY)
+		gridX, gridY := kineticComp.GridPos()
 
 		// OOB check only (wall collision handled in traversal)
 		if s.world.Positions.IsOutOfBounds(gridX, gridY) {
@@ -164,8 +184,7 @@ func (s *MissileSystem) updateMissile(m *component.MissileComponent, k *componen
 
 	if !hasTarget {
 		// Ballistic drift if target is lost
-		k.PreciseX += vmath.Mul(k.VelX, dt)
-		k.PreciseY += vmath.Mul(k.VelY, dt)
+		k.Move(dt)
 	} else {
 		// Impact check before homing (specific target proximity)
 		dx := targetX - k.PreciseX
@@ -181,34 +200,109 @@ func (s *MissileSystem) updateMissile(m *component.MissileComponent, k *componen
 		k.VelX, k.VelY = physics.CapSpeed(k.VelX, k.VelY, parameter.MissileMaxSpeed)
 
 		// Integrate position
-		k.PreciseX += vmath.Mul(k.VelX, dt)
-		k.PreciseY += vmath.Mul(k.VelY, dt)
+		k.Move(dt)
 	}
 
 	// General Enemy Collision: missile detonates on any combatant contact
-	impactX, impactY, hitType := s.traverseForImpact(prevX, prevY, k.PreciseX, k.PreciseY)
+	impactX, impactY, hitType := s.traverseForImpact(prevX, prevY, k.PreciseX, k.PreciseY, false)
 	if hitType == impactWall {
-		k.PreciseX, k.PreciseY = vmath.CenteredFromGrid(impactX, impactY)
+		k.SetGridPos(impactX, impactY)
 		return true
 	}
 	if hitType == impactEnemy {
-		k.PreciseX, k.PreciseY = vmath.CenteredFromGrid(impactX, impactY)
+		k.SetGridPos(impactX, impactY)
 		return true
 	}
 
 	return false
 }
 
+// updateSeeker homes on the target cell and clears the first sequence met on the way
+// A wall or timeout fizzles the seeker without clearing anything
+func (s *MissileSystem) updateSeeker(m *component.MissileComponent, k *component.KineticComponent, dt int64) (impacted, fizzled bool) {
+	if m.Lifetime > parameter.SeekerMaxLifetime {
+		return false, true
+	}
+
+	prevX, prevY := k.PreciseX, k.PreciseY
+	targetX, targetY := vmath.CenteredFromGrid(m.SeekX, m.SeekY)
+
+	dx := targetX - k.PreciseX
+	dy := targetY - k.PreciseY
+	if vmath.MagnitudeSq(dx, dy) < parameter.MissileImpactRadiusSq {
+		k.PreciseX = targetX
+		k.PreciseY = targetY
+		s.clearSequence(m.SeekX, m.SeekY)
+		return true, false
+	}
+
+	physics.ApplyHoming(&k.Kinetic, targetX, targetY, &physics.MissileHoming, dt)
+	k.VelX, k.VelY = physics.CapSpeed(k.VelX, k.VelY, parameter.MissileMaxSpeed)
+	k.Move(dt)
+
+	impactX, impactY, hitType := s.traverseForImpact(prevX, prevY, k.PreciseX, k.PreciseY, true)
+	switch hitType {
+	case impactWall:
+		return false, true
+	case impactEnemy:
+		k.SetGridPos(impactX, impactY)
+		return true, false
+	case impactGlyph:
+		k.SetGridPos(impactX, impactY)
+		s.clearSequence(impactX, impactY)
+		return true, false
+	}
+
+	return false, false
+}
+
+// clearSequence deletes the contiguous glyph run on the row through x, y
+// Deletion goes through the typing system so protection and scoring apply
+func (s *MissileSystem) clearSequence(x, y int) {
+	if !s.hasGlyphAt(x, y) {
+		return
+	}
+
+	startX, endX := x, x
+	for startX > 0 && s.hasGlyphAt(startX-1, y) {
+		startX--
+	}
+	for endX < s.world.Resources.Config.MapWidth-1 && s.hasGlyphAt(endX+1, y) {
+		endX++
+	}
+
+	s.world.PushEvent(event.EventDeleteRequest, &event.DeleteRequestPayload{
+		RangeType: event.DeleteRangeChar,
+		StartX:    startX,
+		EndX:      endX,
+		StartY:    y,
+		EndY:      y,
+	})
+}
+
+// hasGlyphAt reports whether any entity at the cell is a glyph
+func (s *MissileSystem) hasGlyphAt(x, y int) bool {
+	var buf [parameter.MaxEntitiesPerCell]core.Entity
+	n := s.world.Positions.GetAllEntitiesAtInto(x, y, buf[:])
+	for _, e := range buf[:n] {
+		if s.world.Components.Glyph.HasEntity(e) {
+			return true
+		}
+	}
+	return false
+}
+
 type impactType uint8
 
 const (
 	impactNone impactType = iota
 	impactWall
 	impactEnemy
+	impactGlyph
 )
 
-// traverseForImpact walks path checking for wall/enemy collisions
-func (s *MissileSystem) traverseForImpact(fromX, fromY, toX, toY int64) (x, y int, hit impactType) {
+// traverseForImpact walks path checking for wall/enemy collisions, and glyphs when seeking
+func (s *MissileSystem) traverseForImpact(fromX, fromY, toX, toY int64, glyphs bool) (x, y int, hit impactType) {
 	fromGridX, fromGridY := vmath.ToInt(fromX), vmath.ToInt(fromY)
 	toGridX, toGridY := vmath.ToInt(toX), vmath.ToInt(toY)
 
@@ -239,6 +333,11 @@ func (s *MissileSystem) traverseForImpact(fromX, fromY, toX, toY int64) (x, y in
 			return currX, currY, impactEnemy
 		}
 
+		// Character field collision
+		if glyphs && s.hasGlyphAt(currX, currY) {
+			return currX, currY, impactGlyph
+		}
+
 		lastSafeX, lastSafeY = currX, currY
 	}
 
@@ -352,7 +451,32 @@ func (s *MissileSystem) handleSpawnRequest(p *event.MissileSpawnRequestPayload)
 	}
 }
 
-func (s *MissileSystem) spawnMissile(owner, origin core.Entity, x, y, vx, vy int64, target, hit core.Entity) {
+// handleSeekerSpawnRequest launches a seeker sideways off the aim line, curving in toward the target cell
+func (s *MissileSystem) handleSeekerSpawnRequest(p *event.SeekerSpawnRequestPayload) {
+	originX, originY := vmath.CenteredFromGrid(p.OriginX, p.OriginY)
+	targetX, targetY := vmath.CenteredFromGrid(p.TargetX, p.TargetY)
+
+	dirX, dirY := vmath.Normalize2D(targetX-originX, targetY-originY)
+	if dirX == 0 && dirY == 0 {
+		dirX, dirY = 0, -vmath.Scale
+	}
+
+	// Perpendicular launch, turned to the side facing up so the arc clears the cursor row
+	perpX, perpY := -dirY, dirX
+	if perpY > 0 {
+		perpX, perpY = -perpX, -perpY
+	}
+	vx := vmath.Mul(perpX, parameter.SeekerLaunchSpeed)
+	vy := vmath.Mul(perpY, parameter.SeekerLaunchSpeed)
+
+	e := s.spawnMissile(p.OwnerEntity, p.OriginEntity, originX, originY, vx, vy, 0, 0)
+	if m, ok := s.world.Components.Missile.GetPtr(e); ok {
+		m.Seeker = true
+		m.SeekX, m.SeekY = p.TargetX, p.TargetY
+	}
+}
+
+func (s *MissileSystem) spawnMissile(owner, origin core.Entity, x, y, vx, vy int64, target, hit core.Entity) core.Entity {
 	e := s.world.CreateEntity()
 
 	s.world.Components.Missile.SetComponent(e, component.MissileComponent{
@@ -372,6 +496,7 @@ func (s *MissileSystem) spawnMissile(owner, origin core.Entity, x, y, vx, vy int
 	})
 
 	s.world.Positions.SetPosition(e, component.PositionComponent{X: vmath.ToInt(x), Y: vmath.ToInt(y)})
+	return e
 }
 
 // --- Helpers ---
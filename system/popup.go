@@ -0,0 +1,212 @@
+package system
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// PopupSystem spawns and ages floating score, combo, and milestone text
+// Consecutive hits on a row merge into one running total that follows the cursor
+type PopupSystem struct {
+	world *engine.World
+
+	lastStreak int64 // Streak at the previous tick, for milestone crossings
+
+	statStreak *atomic.Int64 // Owned by TypingSystem
+
+	enabled bool
+}
+
+// NewPopupSystem creates a new popup system
+func NewPopupSystem(world *engine.World) engine.System {
+	s := &PopupSystem{
+		world: world,
+	}
+
+	s.statStreak = world.Resources.Status.Ints.Get("typing.streak")
+
+	s.Init()
+	return s
+}
+
+// Init clears live popups
+func (s *PopupSystem) Init() {
+	s.world.Resources.Transient.ClearPopups()
+	s.lastStreak = 0
+	s.enabled = true
+}
+
+// Name returns system's name
+func (s *PopupSystem) Name() string {
+	return "popup"
+}
+
+// Priority returns the system's priority
+func (s *PopupSystem) Priority() int {
+	return parameter.PriorityPopup
+}
+
+// EventTypes returns the event types PopupSystem handles
+func (s *PopupSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventPopupScore,
+		event.EventPopupText,
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+// HandleEvent spawns popups unless turned off in settings
+func (s *PopupSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+		return
+	}
+
+	if !s.enabled || s.world.Resources.Config.Popups.Off {
+		return
+	}
+
+	switch ev.Type {
+	case event.EventPopupScore:
+		if payload, ok := ev.Payload.(*event.PopupScorePayload); ok {
+			s.addScore(payload.X, payload.Y, payload.Delta)
+		}
+
+	case event.EventPopupText:
+		if payload, ok := ev.Payload.(*event.PopupTextPayload); ok {
+			s.add(engine.Popup{
+				X:    payload.X,
+				Y:    payload.Y,
+				Text: payload.Text,
+				Kind: engine.PopupBanner,
+				Life: parameter.PopupBannerDuration.Nanoseconds(),
+			})
+		}
+	}
+}
+
+// Update announces streak milestones and ages popups, dropping expired ones
+func (s *PopupSystem) Update() {
+	if !s.enabled {
+		return
+	}
+
+	settings := s.world.Resources.Config.Popups
+	if settings.Off {
+		s.world.Resources.Transient.ClearPopups()
+	}
+
+	// Polled rather than evented so glyphs without energy, such as gold, still count
+	streak := s.statStreak.Load()
+	if m := int64(settings.Milestone); !settings.Off && m > 0 && streak/m > s.lastStreak/m {
+		if pos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity); ok {
+			s.add(engine.Popup{
+				X:    pos.X,
+				Y:    pos.Y - 1, // Above the running score
+				Text: "STREAK " + strconv.FormatInt(streak/m*m, 10) + "!",
+				Kind: engine.PopupBanner,
+				Life: parameter.PopupBannerDuration.Nanoseconds(),
+			})
+		}
+	}
+	s.lastStreak = streak
+
+	transRes := s.world.Resources.Transient
+	if transRes.PopupCount == 0 {
+		return
+	}
+
+	dtNano := s.world.Resources.Time.DeltaTimeNano()
+
+	write := 0
+	for i := range transRes.PopupCount {
+		transRes.PopupBacking[i].Age += dtNano
+		if transRes.PopupBacking[i].Age < transRes.PopupBacking[i].Life {
+			if write != i {
+				transRes.PopupBacking[write] = transRes.PopupBacking[i]
+			}
+			write++
+		}
+	}
+	clear(transRes.PopupBacking[write:transRes.PopupCount])
+	transRes.PopupCount = write
+}
+
+// addScore merges delta into a live popup of the same sign near the hit, or spawns one
+func (s *PopupSystem) addScore(x, y, delta int) {
+	transRes := s.world.Resources.Transient
+	kind := engine.PopupScore
+	if delta < 0 {
+		kind = engine.PopupPenalty
+	}
+
+	for i := range transRes.PopupCount {
+		p := &transRes.PopupBacking[i]
+		if p.Kind != kind || p.Y != y || vmath.IntAbs(p.X-x) > parameter.PopupMergeDistance {
+			continue
+		}
+		p.X = x
+		p.Delta += delta
+		p.Age = 0
+		p.Text = s.scoreText(p.Delta)
+		return
+	}
+
+	s.add(engine.Popup{
+		X:     x,
+		Y:     y,
+		Text:  s.scoreText(delta),
+		Kind:  kind,
+		Delta: delta,
+		Life:  parameter.PopupScoreDuration.Nanoseconds(),
+	})
+}
+
+// scoreText formats a running total, "+120 x8!" once the streak reaches the combo threshold
+func (s *PopupSystem) scoreText(total int) string {
+	text := strconv.Itoa(total)
+	if total <= 0 {
+		return text
+	}
+	text = "+" + text
+	if streak := s.statStreak.Load(); streak >= int64(s.world.Resources.Config.Popups.ComboMin) {
+		text += " x" + strconv.FormatInt(streak, 10) + "!"
+	}
+	return text
+}
+
+// add stores a popup, replacing the oldest when full
+func (s *PopupSystem) add(p engine.Popup) {
+	transRes := s.world.Resources.Transient
+
+	var idx int
+	if transRes.PopupCount < parameter.PopupCap {
+		idx = transRes.PopupCount
+		transRes.PopupCount++
+	} else {
+		maxAge := transRes.PopupBacking[0].Age
+		for i := 1; i < parameter.PopupCap; i++ {
+			if transRes.PopupBacking[i].Age > maxAge {
+				maxAge = transRes.PopupBacking[i].Age
+				idx = i
+			}
+		}
+	}
+
+	transRes.PopupBacking[idx] = p
+}
@@ -0,0 +1,323 @@
+package system
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/status"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// practiceWord is a whitespace-separated run of glyphs on a practice line
+type practiceWord struct {
+	pending int  // Members not yet typed
+	missed  bool // A typing error landed on the word
+}
+
+// practiceLine is a revealed practice file line still on the field
+type practiceLine struct {
+	members []core.Entity
+	words   []practiceWord
+	x, y    int // Middle of the line, where its bonus popup shows
+}
+
+// practiceRef locates a pending glyph within its line and word
+type practiceRef struct {
+	line *practiceLine
+	word int
+}
+
+// PracticeSystem reveals a user file line by line in place of random spawning
+// A word is clean when all its glyphs are typed without a typing error on any of them
+type PracticeSystem struct {
+	world *engine.World
+
+	lines  []string
+	next   int // Index of the next line to reveal
+	row    int // Row of the next revealed line
+	active []*practiceLine
+	refOf  map[core.Entity]practiceRef
+
+	lastErrors int64
+	done       bool
+
+	statErrors   *atomic.Int64 // Owned by TypingSystem
+	statLines    *atomic.Int64
+	statWords    *atomic.Int64
+	statClean    *atomic.Int64
+	statAccuracy *status.AtomicFloat
+
+	enabled bool
+}
+
+// NewPracticeSystem creates a new practice file system
+func NewPracticeSystem(world *engine.World) engine.System {
+	s := &PracticeSystem{
+		world: world,
+	}
+
+	s.statErrors = world.Resources.Status.Ints.Get("typing.errors")
+	s.statLines = world.Resources.Status.Ints.Get("practice.lines")
+	s.statWords = world.Resources.Status.Ints.Get("practice.words")
+	s.statClean = world.Resources.Status.Ints.Get("practice.words_clean")
+	s.statAccuracy = world.Resources.Status.Floats.Get("practice.accuracy")
+
+	s.Init()
+	return s
+}
+
+// Init resets session state for new game, the file restarts from its first line
+func (s *PracticeSystem) Init() {
+	s.next = 0
+	s.row = 0
+	s.active = s.active[:0]
+	s.refOf = make(map[core.Entity]practiceRef)
+	s.lastErrors = 0
+	s.done = false
+	s.statLines.Store(0)
+	s.statWords.Store(0)
+	s.statClean.Store(0)
+	s.statAccuracy.Set(0)
+	s.enabled = true
+}
+
+// Name returns system's name
+func (s *PracticeSystem) Name() string {
+	return "practice"
+}
+
+// Priority returns the system's priority
+func (s *PracticeSystem) Priority() int {
+	return parameter.PriorityPractice
+}
+
+// EventTypes returns the event types PracticeSystem handles
+func (s *PracticeSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventEnergyGlyphConsumed,
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+// HandleEvent processes typed glyphs and system control events
+func (s *PracticeSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+	}
+
+	if !s.enabled {
+		return
+	}
+
+	switch ev.Type {
+	case event.EventEnergyGlyphConsumed:
+		if payload, ok := ev.Payload.(*event.EnergyGlyphConsumedPayload); ok {
+			if ref, ok := s.refOf[payload.Entity]; ok {
+				delete(s.refOf, payload.Entity)
+				ref.line.words[ref.word].pending--
+			}
+		}
+	}
+}
+
+// Update attributes typing errors, settles cleared lines, and reveals the next ones
+func (s *PracticeSystem) Update() {
+	if !s.enabled || !s.world.Resources.Config.Practice {
+		return
+	}
+
+	if s.lines == nil {
+		s.lines = s.world.Resources.Content.Provider.PracticeLines()
+		if len(s.lines) == 0 {
+			return
+		}
+	}
+
+	errCount := s.statErrors.Load()
+	if errCount > s.lastErrors {
+		s.attributeError()
+	}
+	s.lastErrors = errCount
+
+	s.settleLines()
+
+	for len(s.active) < parameter.PracticeVisibleLines && s.next < len(s.lines) {
+		if !s.placeLine(s.lines[s.next]) {
+			break // Field is crowded, retry next tick
+		}
+		s.next++
+	}
+
+	if !s.done && s.next == len(s.lines) && len(s.active) == 0 {
+		s.done = true
+		s.world.PushEvent(event.EventMetaStatusMessageRequest, &event.MetaStatusMessagePayload{
+			Message: fmt.Sprintf("Practice complete: %d lines, %d/%d words clean (%.0f%%)",
+				s.statLines.Load(), s.statClean.Load(), s.statWords.Load(), s.statAccuracy.Get()*100),
+			Duration:         parameter.PracticeSummaryDuration,
+			DurationOverride: true,
+		})
+	}
+}
+
+// attributeError marks the practice word under the cursor, errors on empty cells are not counted
+func (s *PracticeSystem) attributeError() {
+	cursorPos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity)
+	if !ok {
+		return
+	}
+
+	var buf [parameter.MaxEntitiesPerCell]core.Entity
+	count := s.world.Positions.GetAllEntitiesAtInto(cursorPos.X, cursorPos.Y, buf[:])
+	for i := range count {
+		if ref, ok := s.refOf[buf[i]]; ok {
+			ref.line.words[ref.word].missed = true
+			return
+		}
+	}
+}
+
+// settleLines scores and drops lines with no glyphs left, whether typed or destroyed
+func (s *PracticeSystem) settleLines() {
+	live := s.active[:0]
+	for _, line := range s.active {
+		cleared := true
+		for _, m := range line.members {
+			if s.world.Components.Glyph.HasEntity(m) {
+				cleared = false
+				break
+			}
+		}
+		if !cleared {
+			live = append(live, line)
+			continue
+		}
+
+		// Members lost to anything but typing leave their word pending
+		clean := 0
+		for _, w := range line.words {
+			if w.pending == 0 && !w.missed {
+				clean++
+			}
+		}
+		for _, m := range line.members {
+			delete(s.refOf, m)
+		}
+		if clean == len(line.words) {
+			s.world.PushEvent(event.EventPopupText, &event.PopupTextPayload{
+				X:    line.x,
+				Y:    line.y,
+				Text: parameter.PopupPerfectLineText,
+			})
+		}
+
+		s.statLines.Add(1)
+		words := s.statWords.Add(int64(len(line.words)))
+		cleanTotal := s.statClean.Add(int64(clean))
+		s.statAccuracy.Set(float64(cleanTotal) / float64(words))
+	}
+	s.active = live
+}
+
+// placeLine reveals a line left-aligned on the next free row below the previous one
+// Rows are cropped to MapWidth, the row cursor wraps to the top of the map
+func (s *PracticeSystem) placeLine(line string) bool {
+	config := s.world.Resources.Config
+	cursorPos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity)
+	if !ok {
+		return false
+	}
+
+	lineRunes := []rune(line)
+	width := config.MapWidth - parameter.PracticeColumn
+	if width <= 0 || config.MapHeight <= 0 {
+		return false
+	}
+	if len(lineRunes) > width {
+		lineRunes = lineRunes[:width]
+	}
+
+	for range config.MapHeight {
+		row := s.row % config.MapHeight
+		s.row = row + 1
+
+		if vmath.IntAbs(row-cursorPos.Y) <= parameter.CursorExclusionY {
+			continue
+		}
+		blocked := false
+		for i := range lineRunes {
+			if s.world.Positions.IsBlocked(parameter.PracticeColumn+i, row, component.WallBlockSpawn) {
+				blocked = true
+				break
+			}
+		}
+		if blocked {
+			continue
+		}
+
+		pl := &practiceLine{}
+		entities := make([]core.Entity, 0, len(lineRunes))
+		refs := make([]practiceRef, 0, len(lineRunes))
+		glyphRunes := make([]rune, 0, len(lineRunes))
+		batch := s.world.Positions.BeginBatch()
+		inWord := false
+		for i, r := range lineRunes {
+			if r == ' ' || r == '\t' {
+				inWord = false
+				continue
+			}
+			if !inWord {
+				pl.words = append(pl.words, practiceWord{})
+				inWord = true
+			}
+			pl.words[len(pl.words)-1].pending++
+
+			entity := s.world.CreateEntity()
+			entities = append(entities, entity)
+			refs = append(refs, practiceRef{line: pl, word: len(pl.words) - 1})
+			glyphRunes = append(glyphRunes, r)
+			batch.Add(entity, component.PositionComponent{X: parameter.PracticeColumn + i, Y: row})
+		}
+		if len(entities) == 0 {
+			return true // Nothing to type, counts as revealed
+		}
+
+		if err := batch.Commit(); err != nil {
+			for _, e := range entities {
+				s.world.DestroyEntity(e)
+			}
+			continue
+		}
+
+		for k, entity := range entities {
+			s.world.Components.Glyph.SetComponent(entity, component.GlyphComponent{
+				Rune:  glyphRunes[k],
+				Type:  component.GlyphGreen,
+				Level: component.GlyphNormal,
+			})
+			s.refOf[entity] = refs[k]
+		}
+
+		pl.members = entities
+		pl.x, pl.y = parameter.PracticeColumn+len(lineRunes)/2, row
+		s.active = append(s.active, pl)
+		s.row = row + parameter.PracticeRowStep
+		return true
+	}
+
+	return false
+}
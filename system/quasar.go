@@ -43,7 +43,7 @@ func NewQuasarSystem(world *engine.World) engine.System {
 }
 
 func (s *QuasarSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.statActive.Store(false)
 	s.statCount.Store(0)
 	s.enabled = true
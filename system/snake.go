@@ -37,7 +37,7 @@ func NewSnakeSystem(world *engine.World) engine.System {
 }
 
 func (s *SnakeSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.statActive.Store(false)
 	s.statCount.Store(0)
 	s.enabled = true
@@ -730,16 +730,14 @@ func (s *SnakeSystem) applyBodySpringPhysics(bodyComp *component.SnakeBodyCompon
 				}
 
 				// Apply as acceleration
-				kineticComp.VelX += vmath.Mul(forceX, dtFixed)
-				kineticComp.VelY += vmath.Mul(forceY, dtFixed)
+				kineticComp.ApplyForce(forceX, forceY, dtFixed)
 
 				// Damping
 				kineticComp.VelX = vmath.Mul(kineticComp.VelX, parameter.SnakeSpringDamping)
 				kineticComp.VelY = vmath.Mul(kineticComp.VelY, parameter.SnakeSpringDamping)
 
 				// Integrate position
-				kineticComp.PreciseX += vmath.Mul(kineticComp.VelX, dtFixed)
-				kineticComp.PreciseY += vmath.Mul(kineticComp.VelY, dtFixed)
+				kineticComp.Move(dtFixed)
 			} else {
 				// Direct follow: snap to rest position
 				kineticComp.PreciseX = memberRestX
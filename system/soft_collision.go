@@ -183,7 +183,7 @@ func (s *SoftCollisionSystem) initFlockingMatrix() {
 }
 
 func (s *SoftCollisionSystem) Init() {
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.clearCaches()
 	s.enabled = true
 }
@@ -409,7 +409,7 @@ func (s *SoftCollisionSystem) tryApplyCollision(
 		s.rng,
 	)
 
-	physics.ApplyImpulse(&kineticComp.Kinetic, impulseX, impulseY)
+	kineticComp.ApplyImpulse(impulseX, impulseY)
 
 	// Set immunity
 	combatComp.RemainingKineticImmunity = parameter.SoftCollisionImmunityDuration
@@ -470,8 +470,7 @@ func (s *SoftCollisionSystem) processAllFlocking(dtFixed int64) {
 
 			// Integrate and apply accumulated acceleration
 			if hasFlocking {
-				kineticComp.VelX += vmath.Mul(totalAccelX, dtFixed)
-				kineticComp.VelY += vmath.Mul(totalAccelY, dtFixed)
+				kineticComp.ApplyForce(totalAccelX, totalAccelY, dtFixed)
 				s.world.Components.Kinetic.SetComponent(tgt.entity, kineticComp)
 			}
 		}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"strconv"
 
+	"github.com/lixenwraith/vi-fighter/asset"
 	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/core"
 	"github.com/lixenwraith/vi-fighter/engine"
@@ -170,7 +171,7 @@ func (s *SplashSystem) Update() {
 			}
 
 			// Construct current BBox for magnifier
-			magW := splashComp.Length * parameter.SplashCharWidth
+			magW := asset.SplashTextWidth(splashComp.Content[:splashComp.Length])
 			magH := parameter.SplashCharHeight
 			magBBox := BBox{X: splashComp.AnchorX, Y: splashComp.AnchorY, W: magW, H: magH}
 
@@ -186,7 +187,7 @@ func (s *SplashSystem) Update() {
 			// If collision detected, attempt to find a new valid position
 			if collision {
 				if pos, ok := s.world.Positions.GetPosition(s.world.Resources.Player.Entity); ok {
-					newX, newY := s.calculateProximityAnchor(pos.X, pos.Y, splashComp.Length)
+					newX, newY := s.calculateProximityAnchor(pos.X, pos.Y, asset.SplashTextWidth(splashComp.Content[:splashComp.Length]))
 					if newX != splashComp.AnchorX || newY != splashComp.AnchorY {
 						splashComp.AnchorX = newX
 						splashComp.AnchorY = newY
@@ -234,7 +235,7 @@ func (s *SplashSystem) validateMagnifier(splashEntity core.Entity, splash *compo
 	}
 
 	// Update if character or type changed (handles entity swap, moving entities)
-	newColor := visual.GlyphColorLUT[glyph.Type][component.GlyphNormal]
+	newColor := visual.CVDGlyphColorLUT[s.world.Resources.Config.Accessibility][glyph.Type][component.GlyphNormal]
 	if splash.Content[0] != glyph.Rune || splash.Color != newColor {
 		splash.Content[0] = glyph.Rune
 		splash.Color = newColor
@@ -356,10 +357,10 @@ func (s *SplashSystem) handleCursorMoved(payload *event.CursorMovedPayload) {
 	}
 
 	// Resolve color from glyph type
-	color := visual.GlyphColorLUT[glyphComp.Type][component.GlyphNormal]
+	color := visual.CVDGlyphColorLUT[s.world.Resources.Config.Accessibility][glyphComp.Type][component.GlyphNormal]
 
 	// Calculate proximity anchor (between cursor and center, min 15 chars away)
-	anchorX, anchorY := s.calculateProximityAnchor(cursorX, cursorY, 1)
+	anchorX, anchorY := s.calculateProximityAnchor(cursorX, cursorY, asset.SplashTextWidth([]rune{glyphComp.Rune}))
 
 	// Check for existing magnifier to update in place
 	existingSplashEntity := s.findSplashEntityBySlot(component.SlotMagnifier)
@@ -398,10 +399,9 @@ func (s *SplashSystem) handleCursorMoved(payload *event.CursorMovedPayload) {
 // calculateProximityAnchor uses spiral search to find valid magnifier position
 // Works in virtual circular space, converts back to game elliptical space
 // Searches diagonals first to avoid overlapping with ping crosshair lines
-func (s *SplashSystem) calculateProximityAnchor(cursorX, cursorY, charCount int) (int, int) {
+func (s *SplashSystem) calculateProximityAnchor(cursorX, cursorY, splashW int) (int, int) {
 	config := s.world.Resources.Config
 
-	splashW := charCount * parameter.SplashCharWidth
 	splashH := parameter.SplashCharHeight
 
 	if splashW > config.MapWidth || splashH > config.MapHeight {
@@ -448,7 +448,7 @@ func (s *SplashSystem) checkBBoxCollisionAny(candidate BBox, boxes []BBox) bool
 // calculateTimerOffset finds valid offset for timer relative to anchor entity
 // Uses 8-direction search: cardinals first, then diagonals
 func (s *SplashSystem) calculateTimerOffset(splashComp *component.SplashComponent, timerBBoxes []BBox) {
-	timerWidth := splashComp.Length * parameter.SplashCharWidth
+	timerWidth := asset.SplashTextWidth(splashComp.Content[:splashComp.Length])
 	timerHeight := parameter.SplashCharHeight
 
 	var anchorX, anchorY int
@@ -514,7 +514,7 @@ func (s *SplashSystem) getTimerBBoxes(excludeEntity core.Entity) []BBox {
 		}
 
 		// Dynamic width for timer
-		w := splashComp.Length * parameter.SplashCharWidth
+		w := asset.SplashTextWidth(splashComp.Content[:splashComp.Length])
 		h := parameter.SplashCharHeight
 
 		// Apply padding for inter-splash collision (Inflated Bounding Box)
@@ -50,7 +50,7 @@ func NewSwarmSystem(world *engine.World) engine.System {
 
 func (s *SwarmSystem) Init() {
 	s.active = false
-	s.rng = vmath.NewFastRand(uint64(s.world.Resources.Time.RealTimeNano()))
+	s.rng = s.world.Resources.Rand.Stream(vmath.StreamAI)
 	s.statActive.Store(false)
 	s.statCount.Store(0)
 	s.statPlayerKills.Store(0)
@@ -0,0 +1,129 @@
+package system
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+)
+
+// TimelineSystem records a per-second session timeline for post-game analysis
+type TimelineSystem struct {
+	world *engine.World
+
+	start      time.Time // Game time of the first sample, zero until the first tick
+	nextSample time.Time
+
+	statCorrect *atomic.Int64 // Owned by TypingSystem
+	statErrors  *atomic.Int64 // Owned by TypingSystem
+	statStreak  *atomic.Int64 // Owned by TypingSystem
+
+	enabled bool
+}
+
+// NewTimelineSystem creates a new session timeline system
+func NewTimelineSystem(world *engine.World) engine.System {
+	s := &TimelineSystem{
+		world: world,
+	}
+
+	s.statCorrect = world.Resources.Status.Ints.Get("typing.correct")
+	s.statErrors = world.Resources.Status.Ints.Get("typing.errors")
+	s.statStreak = world.Resources.Status.Ints.Get("typing.streak")
+
+	s.Init()
+	return s
+}
+
+// Init archives the finished session and starts a new timeline
+func (s *TimelineSystem) Init() {
+	s.world.Resources.Timeline.Reset()
+	s.start = time.Time{}
+	s.nextSample = time.Time{}
+	s.enabled = true
+}
+
+// Name returns system's name
+func (s *TimelineSystem) Name() string {
+	return "timeline"
+}
+
+// Priority returns the system's priority
+func (s *TimelineSystem) Priority() int {
+	return parameter.PriorityTimeline
+}
+
+// EventTypes returns the event types TimelineSystem handles
+func (s *TimelineSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+// HandleEvent processes system control events
+func (s *TimelineSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+	}
+}
+
+// Update records a sample each TimelineSampleInterval of game time
+func (s *TimelineSystem) Update() {
+	if !s.enabled {
+		return
+	}
+
+	now := s.world.Resources.Time.GameTime
+	if s.start.IsZero() {
+		s.start = now
+		s.nextSample = now
+	}
+	if now.Before(s.nextSample) {
+		return
+	}
+	s.nextSample = s.nextSample.Add(parameter.TimelineSampleInterval)
+	// A long stall records one sample rather than a burst of identical ones
+	if !now.Before(s.nextSample) {
+		s.nextSample = now.Add(parameter.TimelineSampleInterval)
+	}
+
+	s.world.Resources.Timeline.Record(s.sample(now))
+}
+
+// sample snapshots the cursor entity and typing stats
+func (s *TimelineSystem) sample(now time.Time) engine.TimelineSample {
+	cursorEntity := s.world.Resources.Player.Entity
+
+	sample := engine.TimelineSample{
+		Second:  int(now.Sub(s.start) / time.Second),
+		Correct: s.statCorrect.Load(),
+		Errors:  s.statErrors.Load(),
+		APM:     s.world.Resources.Game.State.GetAPM(),
+		Streak:  s.statStreak.Load(),
+	}
+	if ec, ok := s.world.Components.Energy.GetComponent(cursorEntity); ok {
+		sample.Energy = ec.Current
+	}
+	if hc, ok := s.world.Components.Heat.GetComponent(cursorEntity); ok {
+		sample.Heat = hc.Current
+	}
+	if bc, ok := s.world.Components.Boost.GetComponent(cursorEntity); ok {
+		sample.Boost = bc.Active
+	}
+	if sc, ok := s.world.Components.Shield.GetComponent(cursorEntity); ok {
+		sample.Shield = sc.Active
+	}
+	return sample
+}
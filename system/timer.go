@@ -7,7 +7,7 @@ import (
 	"github.com/lixenwraith/vi-fighter/parameter"
 )
 
-// TimerSystem manages lifecycle timers for entities
+// TimerSystem manages lifecycle timers for entities and fires scheduled events
 // It runs before cleanup to tag expired entities for destruction
 type TimerSystem struct {
 	world *engine.World
@@ -82,6 +82,9 @@ func (s *TimerSystem) Update() {
 		return
 	}
 
+	// Ticks are counted after systems run, so this is the tick in progress
+	s.world.Resources.Timer.Advance(s.world.Resources.Game.State.GetGameTicks()+1, s.world.PushEvent)
+
 	entities := s.world.Components.Timer.GetAllEntities()
 	dt := s.world.Resources.Time.DeltaTime
 
@@ -236,7 +236,7 @@ func (s *TowerSystem) findTowerPosition(radiusX, radiusY int) (int, int, bool) {
 	width := 2*radiusX + 1
 	height := 2*radiusY + 1
 
-	rng := vmath.NewFastRand(uint64(s.world.Resources.Time.GameTimeNano()))
+	rng := s.world.Resources.Rand.Stream(vmath.StreamSpawn)
 
 	minCX := radiusX
 	maxCX := config.MapWidth - radiusX - 1
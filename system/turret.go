@@ -0,0 +1,334 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/lixenwraith/vi-fighter/component"
+	"github.com/lixenwraith/vi-fighter/core"
+	"github.com/lixenwraith/vi-fighter/engine"
+	"github.com/lixenwraith/vi-fighter/event"
+	"github.com/lixenwraith/vi-fighter/parameter"
+	"github.com/lixenwraith/vi-fighter/vmath"
+)
+
+// TurretSystem manages player-bought turrets bound to typed words
+// Typing every glyph of a turret's word primes it; a primed turret fires a missile volley
+// at the nearest enemies, then binds a new word after TurretRearmDelay
+// A word lost to anything but typing is replaced without firing
+type TurretSystem struct {
+	world *engine.World
+
+	// ownerOf maps bound word glyphs to their turret
+	ownerOf map[core.Entity]core.Entity
+
+	// Telemetry
+	statCount *atomic.Int64
+	statFired *atomic.Int64
+
+	enabled bool
+}
+
+func NewTurretSystem(world *engine.World) engine.System {
+	s := &TurretSystem{
+		world: world,
+	}
+
+	s.statCount = world.Resources.Status.Ints.Get("turret.count")
+	s.statFired = world.Resources.Status.Ints.Get("turret.fired")
+
+	s.Init()
+	return s
+}
+
+func (s *TurretSystem) Init() {
+	s.destroyAll()
+	s.ownerOf = make(map[core.Entity]core.Entity)
+	s.statCount.Store(0)
+	s.statFired.Store(0)
+	s.enabled = true
+}
+
+func (s *TurretSystem) Name() string {
+	return "turret"
+}
+
+func (s *TurretSystem) Priority() int {
+	return parameter.PriorityTurret
+}
+
+func (s *TurretSystem) EventTypes() []event.EventType {
+	return []event.EventType{
+		event.EventTurretPlaceRequest,
+		event.EventTurretCancelRequest,
+		event.EventEnergyGlyphConsumed,
+		event.EventMetaSystemCommandRequest,
+		event.EventGameReset,
+	}
+}
+
+func (s *TurretSystem) HandleEvent(ev event.GameEvent) {
+	if ev.Type == event.EventGameReset {
+		s.Init()
+		return
+	}
+
+	if ev.Type == event.EventMetaSystemCommandRequest {
+		if payload, ok := ev.Payload.(*event.MetaSystemCommandPayload); ok {
+			if payload.SystemName == s.Name() {
+				s.enabled = payload.Enabled
+			}
+		}
+	}
+
+	if !s.enabled {
+		return
+	}
+
+	switch ev.Type {
+	case event.EventTurretPlaceRequest:
+		if payload, ok := ev.Payload.(*event.TurretPlacePayload); ok {
+			s.placeTurret(payload.X, payload.Y)
+		}
+
+	case event.EventTurretCancelRequest:
+		s.destroyAll()
+		clear(s.ownerOf)
+
+	case event.EventEnergyGlyphConsumed:
+		if payload, ok := ev.Payload.(*event.EnergyGlyphConsumedPayload); ok {
+			if turretEntity, ok := s.ownerOf[payload.Entity]; ok {
+				delete(s.ownerOf, payload.Entity)
+				if turretComp, ok := s.world.Components.Turret.GetComponent(turretEntity); ok {
+					turretComp.Typed++
+					s.world.Components.Turret.SetComponent(turretEntity, turretComp)
+				}
+			}
+		}
+	}
+}
+
+func (s *TurretSystem) Update() {
+	if !s.enabled {
+		return
+	}
+
+	turretEntities := s.world.Components.Turret.GetAllEntities()
+	s.statCount.Store(int64(len(turretEntities)))
+	if len(turretEntities) == 0 {
+		return
+	}
+
+	dt := s.world.Resources.Time.DeltaTime
+
+	for _, turretEntity := range turretEntities {
+		turretComp, ok := s.world.Components.Turret.GetComponent(turretEntity)
+		if !ok {
+			continue
+		}
+		turretPos, ok := s.world.Positions.GetPosition(turretEntity)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case turretComp.Primed:
+			if s.fire(turretEntity, turretPos) {
+				turretComp.Primed = false
+				turretComp.Rearm = parameter.TurretRearmDelay
+			}
+
+		case turretComp.Word == nil:
+			turretComp.Rearm -= dt
+			if turretComp.Rearm <= 0 {
+				turretComp.Rearm = 0
+				turretComp.Word = s.bindWord(turretEntity, turretPos)
+				turretComp.Typed = 0
+			}
+
+		default:
+			if s.wordAlive(turretComp.Word) {
+				break
+			}
+			// Word gone: primed only when every glyph was typed
+			turretComp.Primed = turretComp.Typed == len(turretComp.Word)
+			if !turretComp.Primed {
+				turretComp.Rearm = parameter.TurretRearmDelay
+			}
+			for _, e := range turretComp.Word {
+				delete(s.ownerOf, e)
+			}
+			turretComp.Word = nil
+			turretComp.Typed = 0
+		}
+
+		s.world.Components.Turret.SetComponent(turretEntity, turretComp)
+	}
+}
+
+// === Placement ===
+
+// placeTurret buys a turret at x, y when the player has the energy and the word row has room
+func (s *TurretSystem) placeTurret(x, y int) {
+	cursorEntity := s.world.Resources.Player.Entity
+	energyComp, ok := s.world.Components.Energy.GetComponent(cursorEntity)
+	if !ok || vmath.Abs(energyComp.Current) < parameter.TurretCost {
+		s.pushStatus(fmt.Sprintf("Turret needs %d energy", parameter.TurretCost))
+		return
+	}
+
+	wordX := x + parameter.TurretWordOffset
+	if s.world.Positions.IsBlocked(x, y, component.WallBlockSpawn) ||
+		!s.world.Positions.IsAreaFree(wordX, y, parameter.TurretWordMaxLen, 1, component.WallBlockSpawn) ||
+		s.turretAt(x, y) {
+		s.pushStatus("No room for a turret here")
+		return
+	}
+
+	turretEntity := s.world.CreateEntity()
+	s.world.Positions.SetPosition(turretEntity, component.PositionComponent{X: x, Y: y})
+	s.world.Components.Turret.SetComponent(turretEntity, component.TurretComponent{})
+
+	s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+		Delta: parameter.TurretCost,
+		Type:  component.EnergyDeltaSpend,
+	})
+
+	s.world.PushEvent(event.EventTurretPlaced, &event.TurretPlacedPayload{
+		Entity: turretEntity,
+		X:      x,
+		Y:      y,
+	})
+}
+
+// turretAt reports whether a turret already occupies the cell
+func (s *TurretSystem) turretAt(x, y int) bool {
+	var buf [parameter.MaxEntitiesPerCell]core.Entity
+	n := s.world.Positions.GetAllEntitiesAtInto(x, y, buf[:])
+	for _, e := range buf[:n] {
+		if s.world.Components.Turret.HasEntity(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// === Word ===
+
+// bindWord spawns a new word to the right of the turret, nil if the row is occupied
+func (s *TurretSystem) bindWord(turretEntity core.Entity, turretPos component.PositionComponent) []core.Entity {
+	word := []rune(s.pickWord())
+	startX := turretPos.X + parameter.TurretWordOffset
+
+	entities := make([]core.Entity, len(word))
+	batch := s.world.Positions.BeginBatch()
+	for i := range word {
+		entities[i] = s.world.CreateEntity()
+		batch.Add(entities[i], component.PositionComponent{X: startX + i, Y: turretPos.Y})
+	}
+	if err := batch.Commit(); err != nil {
+		for _, e := range entities {
+			s.world.DestroyEntity(e)
+		}
+		return nil
+	}
+
+	for i, e := range entities {
+		s.world.Components.Glyph.SetComponent(e, component.GlyphComponent{
+			Rune:  word[i],
+			Type:  component.GlyphBlue,
+			Level: component.GlyphBright,
+		})
+		s.ownerOf[e] = turretEntity
+	}
+	return entities
+}
+
+// pickWord draws a word of bounded length from the current content, falling back to a fixed list
+func (s *TurretSystem) pickWord() string {
+	rng := s.world.Resources.Rand.Stream(vmath.StreamSpawn)
+
+	if content := s.world.Resources.Content.Provider.CurrentContent(); content != nil && len(content.Blocks) > 0 {
+		for range parameter.TurretWordAttempts {
+			block := content.Blocks[rng.Intn(len(content.Blocks))]
+			if len(block.Lines) == 0 {
+				continue
+			}
+			fields := strings.Fields(block.Lines[rng.Intn(len(block.Lines))])
+			if len(fields) == 0 {
+				continue
+			}
+			word := fields[rng.Intn(len(fields))]
+			if n := len([]rune(word)); n >= parameter.TurretWordMinLen && n <= parameter.TurretWordMaxLen {
+				return word
+			}
+		}
+	}
+
+	return parameter.TurretFallbackWords[rng.Intn(len(parameter.TurretFallbackWords))]
+}
+
+// wordAlive reports whether any glyph of the word remains
+func (s *TurretSystem) wordAlive(word []core.Entity) bool {
+	for _, e := range word {
+		if s.world.Components.Glyph.HasEntity(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// === Fire ===
+
+// fire launches a volley at the nearest enemies, false when none are present
+func (s *TurretSystem) fire(turretEntity core.Entity, turretPos component.PositionComponent) bool {
+	cursorEntity := s.world.Resources.Player.Entity
+	fromX, fromY := vmath.CenteredFromGrid(turretPos.X, turretPos.Y)
+
+	assignments := FindNearestTargets(s.world, fromX, fromY, parameter.TurretMissileCount, cursorEntity)
+	if len(assignments) == 0 {
+		return false
+	}
+
+	targets := make([]core.Entity, len(assignments))
+	hits := make([]core.Entity, len(assignments))
+	for i, a := range assignments {
+		targets[i] = a.Target
+		hits[i] = a.Hit
+	}
+
+	s.world.PushEvent(event.EventMissileSpawnRequest, &event.MissileSpawnRequestPayload{
+		OwnerEntity:  cursorEntity,
+		OriginEntity: turretEntity,
+		OriginX:      turretPos.X,
+		OriginY:      turretPos.Y,
+		Count:        parameter.TurretMissileCount,
+		Targets:      targets,
+		HitEntities:  hits,
+	})
+	s.statFired.Add(1)
+	return true
+}
+
+// === Lifecycle ===
+
+func (s *TurretSystem) pushStatus(msg string) {
+	s.world.PushEvent(event.EventMetaStatusMessageRequest, &event.MetaStatusMessagePayload{
+		Message: msg,
+	})
+}
+
+// destroyAll removes every turret with its bound word
+func (s *TurretSystem) destroyAll() {
+	for _, turretEntity := range s.world.Components.Turret.GetAllEntities() {
+		if turretComp, ok := s.world.Components.Turret.GetComponent(turretEntity); ok {
+			for _, e := range turretComp.Word {
+				if s.world.Components.Glyph.HasEntity(e) {
+					s.world.DestroyEntity(e)
+				}
+			}
+		}
+		s.world.DestroyEntity(turretEntity)
+	}
+}
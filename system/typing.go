@@ -3,6 +3,7 @@ package system
 import (
 	"math"
 	"sync/atomic"
+	"time"
 
 	"github.com/lixenwraith/vi-fighter/component"
 	"github.com/lixenwraith/vi-fighter/core"
@@ -18,7 +19,9 @@ type TypingSystem struct {
 
 	statCorrect   *atomic.Int64
 	statErrors    *atomic.Int64
+	statForgiven  *atomic.Int64
 	statMaxStreak *atomic.Int64
+	statStreak    *atomic.Int64
 
 	currentStreak int64
 
@@ -33,7 +36,9 @@ func NewTypingSystem(world *engine.World) engine.System {
 
 	s.statCorrect = world.Resources.Status.Ints.Get("typing.correct")
 	s.statErrors = world.Resources.Status.Ints.Get("typing.errors")
+	s.statForgiven = world.Resources.Status.Ints.Get("typing.forgiven")
 	s.statMaxStreak = world.Resources.Status.Ints.Get("typing.max_streak")
+	s.statStreak = world.Resources.Status.Ints.Get("typing.streak")
 
 	s.Init()
 	return s
@@ -43,7 +48,9 @@ func (s *TypingSystem) Init() {
 	s.currentStreak = 0
 	s.statCorrect.Store(0)
 	s.statErrors.Store(0)
+	s.statForgiven.Store(0)
 	s.statMaxStreak.Store(0)
+	s.statStreak.Store(0)
 	s.enabled = true
 }
 
@@ -56,16 +63,34 @@ func (s *TypingSystem) Priority() int {
 	return parameter.PriorityTyping
 }
 
+// Update applies the penalty of a typing error whose forgiveness window ran out
 func (s *TypingSystem) Update() {
 	if !s.enabled {
 		return
 	}
+
+	cursorEntity := s.world.Resources.Player.Entity
+	cursor, ok := s.world.Components.Cursor.GetComponent(cursorEntity)
+	if !ok || cursor.ForgiveRemaining <= 0 {
+		return
+	}
+	cursor.ForgiveRemaining -= s.world.Resources.Time.DeltaTime
+	if cursor.ForgiveRemaining > 0 {
+		s.world.Components.Cursor.SetComponent(cursorEntity, cursor)
+		return
+	}
+	cursor.ForgiveRemaining = 0
+	s.world.Components.Cursor.SetComponent(cursorEntity, cursor)
+	s.applyErrorPenalty()
 }
 
 func (s *TypingSystem) EventTypes() []event.EventType {
 	return []event.EventType{
 		event.EventCharacterTyped,
 		event.EventDeleteRequest,
+		event.EventReplaceRequest,
+		event.EventJoinRequest,
+		event.EventTypingErrorForgive,
 		event.EventMetaSystemCommandRequest,
 		event.EventGameReset,
 	}
@@ -102,6 +127,19 @@ func (s *TypingSystem) HandleEvent(ev event.GameEvent) {
 		if payload, ok := ev.Payload.(*event.DeleteRequestPayload); ok {
 			s.handleDeleteRequest(payload)
 		}
+
+	case event.EventReplaceRequest:
+		if payload, ok := ev.Payload.(*event.ReplaceRequestPayload); ok {
+			s.handleReplaceRequest(payload)
+		}
+
+	case event.EventJoinRequest:
+		if payload, ok := ev.Payload.(*event.JoinRequestPayload); ok {
+			s.handleJoinRequest(payload)
+		}
+
+	case event.EventTypingErrorForgive:
+		s.handleErrorForgive()
 	}
 }
 
@@ -148,6 +186,9 @@ func (s *TypingSystem) handleTyping(cursorX, cursorY int, typedRune rune) {
 func (s *TypingSystem) applyUniversalRewards() {
 	cursorEntity := s.world.Resources.Player.Entity
 
+	// Typing on instead of correcting keeps the pending error
+	s.commitPendingError()
+
 	// Check current boost state BEFORE pushing events
 	boost, ok := s.world.Components.Boost.GetComponent(cursorEntity)
 	isBoostActive := ok && boost.Active
@@ -173,6 +214,7 @@ func (s *TypingSystem) applyUniversalRewards() {
 
 	s.statCorrect.Add(1)
 	s.currentStreak++
+	s.statStreak.Store(s.currentStreak)
 	maxStreak := s.statMaxStreak.Load()
 	if maxStreak < s.currentStreak {
 		s.statMaxStreak.Store(s.currentStreak)
@@ -202,26 +244,81 @@ func (s *TypingSystem) emitTypingFeedback(glyphType component.GlyphType) {
 }
 
 // emitTypingError emits events corresponding to typing error
+// With a forgiveness window the penalty waits for it to run out, and Backspace within it
+// cancels the penalty; a second error meanwhile applies both at once
 func (s *TypingSystem) emitTypingError() {
 	cursorEntity := s.world.Resources.Player.Entity
+	assist := &s.world.Resources.Config.Assist
 
-	// Set cursor error flash
+	pending := s.commitPendingError()
+	forgive := time.Duration(assist.ForgiveMs) * time.Millisecond
+
+	// Set cursor error flash, held while the error can still be forgiven
 	if cursor, ok := s.world.Components.Cursor.GetComponent(cursorEntity); ok {
 		cursor.ErrorFlashRemaining = parameter.ErrorBlinkTimeout
+		if assist.LongErrorFlash {
+			cursor.ErrorFlashRemaining = parameter.AssistErrorFlashTimeout
+		}
+		if !pending && forgive > 0 {
+			cursor.ForgiveRemaining = forgive
+			cursor.ErrorFlashRemaining = max(cursor.ErrorFlashRemaining, forgive)
+		}
 		s.world.Components.Cursor.SetComponent(cursorEntity, cursor)
 	}
 
-	// Reset boost and apply heat penalty
-	s.world.PushEvent(event.EventHeatAddRequest, &event.HeatAddRequestPayload{Delta: -parameter.HeatTypingErrorPenalty})
-	s.world.PushEvent(event.EventBoostDeactivate, nil)
 	s.world.PushEvent(event.EventEnergyBlinkStart, &event.EnergyBlinkPayload{Type: 0, Level: 0})
 
 	s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
 		ID: parameter.Sfx.Error,
 	})
 
+	if pending || forgive <= 0 {
+		s.applyErrorPenalty()
+	}
+}
+
+// applyErrorPenalty resets boost, heat, and streak for a typing error
+func (s *TypingSystem) applyErrorPenalty() {
+	assist := &s.world.Resources.Config.Assist
+
+	// Reset boost and apply heat penalty, unless the assist waives it
+	if !assist.NoErrorPenalty {
+		if assist.ErrorHeat > 0 {
+			s.world.PushEvent(event.EventHeatAddRequest, &event.HeatAddRequestPayload{Delta: -assist.ErrorHeat})
+		}
+		s.world.PushEvent(event.EventBoostDeactivate, nil)
+	}
+
 	s.statErrors.Add(1)
 	s.currentStreak = 0
+	s.statStreak.Store(0)
+}
+
+// commitPendingError applies the penalty of an error still in its forgiveness window
+// Returns true if one was pending
+func (s *TypingSystem) commitPendingError() bool {
+	cursorEntity := s.world.Resources.Player.Entity
+	cursor, ok := s.world.Components.Cursor.GetComponent(cursorEntity)
+	if !ok || cursor.ForgiveRemaining <= 0 {
+		return false
+	}
+	cursor.ForgiveRemaining = 0
+	s.world.Components.Cursor.SetComponent(cursorEntity, cursor)
+	s.applyErrorPenalty()
+	return true
+}
+
+// handleErrorForgive drops a pending typing error and its flash without penalty
+func (s *TypingSystem) handleErrorForgive() {
+	cursorEntity := s.world.Resources.Player.Entity
+	cursor, ok := s.world.Components.Cursor.GetComponent(cursorEntity)
+	if !ok || cursor.ForgiveRemaining <= 0 {
+		return
+	}
+	cursor.ForgiveRemaining = 0
+	cursor.ErrorFlashRemaining = 0
+	s.world.Components.Cursor.SetComponent(cursorEntity, cursor)
+	s.statForgiven.Add(1)
 }
 
 func (s *TypingSystem) moveCursorRight() {
@@ -269,9 +366,12 @@ func (s *TypingSystem) handleCompositeMember(entity core.Entity, anchorID core.E
 
 	// Color-based energy (only Blue/Green/Red for now)
 	if header.Behavior != component.BehaviorGold {
+		pos, _ := s.world.Positions.GetPosition(entity)
 		s.world.PushEvent(event.EventEnergyGlyphConsumed, &event.EnergyGlyphConsumedPayload{
 			Type:  glyph.Type,
 			Level: glyph.Level,
+			X:     pos.X,
+			Y:     pos.Y,
 		})
 	}
 
@@ -308,9 +408,13 @@ func (s *TypingSystem) handleGlyph(entity core.Entity, glyph component.GlyphComp
 	// Type-specific handling, placeholder for other type additions
 	switch glyph.Type {
 	case component.GlyphBlue, component.GlyphGreen, component.GlyphRed:
+		pos, _ := s.world.Positions.GetPosition(entity)
 		s.world.PushEvent(event.EventEnergyGlyphConsumed, &event.EnergyGlyphConsumedPayload{
-			Type:  glyph.Type,
-			Level: glyph.Level,
+			Entity: entity,
+			Type:   glyph.Type,
+			Level:  glyph.Level,
+			X:      pos.X,
+			Y:      pos.Y,
 		})
 	}
 
@@ -426,16 +530,173 @@ func (s *TypingSystem) handleDeleteRequest(payload *event.DeleteRequestPayload)
 
 			// Optimization: Get entities by cell for the range on this row
 			for x := minX; x <= maxX; x++ {
-				s.world.Positions.GetAllEntitiesAtInto(x, y, cellEntitiesBuf)
-				for _, entity := range cellEntitiesBuf {
+				n := s.world.Positions.GetAllEntitiesAtInto(x, y, cellEntitiesBuf)
+				for _, entity := range cellEntitiesBuf[:n] {
 					checkEntity(entity)
 				}
 			}
 		}
 	}
 
+	s.awardCleanup(entitiesToDelete)
+
 	// Batch deletion via DeathSystem (silent)
 	if len(entitiesToDelete) > 0 {
 		event.EmitDeathBatch(s.world.Resources.Event.Queue, 0, entitiesToDelete)
 	}
 }
+
+// awardCleanup rewards removing red corruption with delete operators
+// A delete that removes only red glyphs earns the precision multiplier
+func (s *TypingSystem) awardCleanup(entities []core.Entity) {
+	reds := 0
+	for _, entity := range entities {
+		if glyphComp, ok := s.world.Components.Glyph.GetComponent(entity); ok && glyphComp.Type == component.GlyphRed {
+			reds++
+		}
+	}
+	if reds == 0 {
+		return
+	}
+
+	bonus := reds * parameter.CorruptionCleanupBonus
+	if reds == len(entities) {
+		bonus *= parameter.CorruptionPrecisionMultiplier
+	}
+
+	s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+		Delta: bonus,
+		Type:  component.EnergyDeltaReward,
+	})
+	s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+		ID: parameter.Sfx.Coin,
+	})
+}
+
+// glyphAt returns the glyph entity at a cell, 0 if none
+func (s *TypingSystem) glyphAt(x, y int) core.Entity {
+	var buf [parameter.MaxEntitiesPerCell]core.Entity
+	count := s.world.Positions.GetAllEntitiesAtInto(x, y, buf[:])
+	for i := range count {
+		if s.world.Components.Glyph.HasEntity(buf[i]) {
+			return buf[i]
+		}
+	}
+	return 0
+}
+
+// handleReplaceRequest turns Count red glyphs from the cursor rightward into green glyphs of the
+// typed character, paid with heat per glyph
+// Like vim, all or nothing: any other target in the span, or too little heat to pay, counts as a typing error
+func (s *TypingSystem) handleReplaceRequest(payload *event.ReplaceRequestPayload) {
+	count := max(1, payload.Count)
+
+	entities := make([]core.Entity, 0, count)
+	for i := range count {
+		entity := s.glyphAt(payload.X+i, payload.Y)
+		if entity == 0 || s.world.Components.Member.HasEntity(entity) {
+			s.emitTypingError()
+			return
+		}
+		glyph, ok := s.world.Components.Glyph.GetComponent(entity)
+		if !ok || glyph.Type != component.GlyphRed {
+			s.emitTypingError()
+			return
+		}
+		entities = append(entities, entity)
+	}
+
+	cost := parameter.ReplaceHeatCost * count
+	heat, ok := s.world.Components.Heat.GetComponent(s.world.Resources.Player.Entity)
+	if !ok || heat.Current < cost {
+		s.emitTypingError()
+		return
+	}
+	s.world.PushEvent(event.EventHeatAddRequest, &event.HeatAddRequestPayload{Delta: -cost})
+
+	for _, entity := range entities {
+		glyph, _ := s.world.Components.Glyph.GetComponent(entity)
+		glyph.Rune = payload.Char
+		glyph.Type = component.GlyphGreen
+		s.world.Components.Glyph.SetComponent(entity, glyph)
+	}
+
+	s.emitTypingFeedback(component.GlyphGreen)
+}
+
+// handleJoinRequest pulls the nearest word to the right of the cursor word against it, leaving one gap cell
+// Words are runs of adjacent glyphs on the row; the pulled word scores by whether it matches the cursor glyph's color
+func (s *TypingSystem) handleJoinRequest(payload *event.JoinRequestPayload) {
+	config := s.world.Resources.Config
+	y := payload.Y
+
+	anchor := s.glyphAt(payload.X, y)
+	if anchor == 0 {
+		s.emitTypingError()
+		return
+	}
+	anchorGlyph, _ := s.world.Components.Glyph.GetComponent(anchor)
+
+	// End of the cursor word
+	end := payload.X
+	for end+1 < config.MapWidth && s.glyphAt(end+1, y) != 0 {
+		end++
+	}
+
+	// Nearest word start, the gap between must be free of walls
+	start := end + 1
+	for start < config.MapWidth && s.glyphAt(start, y) == 0 {
+		if s.world.Positions.IsBlocked(start, y, component.WallBlockSpawn) {
+			s.emitTypingError()
+			return
+		}
+		start++
+	}
+	shift := start - (end + 2)
+	if start >= config.MapWidth || shift <= 0 {
+		s.emitTypingError()
+		return
+	}
+
+	var pulled []core.Entity
+	matched := true
+	for x := start; x < config.MapWidth; x++ {
+		entity := s.glyphAt(x, y)
+		if entity == 0 {
+			break
+		}
+		// Composite members keep their offsets from the header
+		if s.world.Components.Member.HasEntity(entity) {
+			s.emitTypingError()
+			return
+		}
+		if glyph, ok := s.world.Components.Glyph.GetComponent(entity); ok && glyph.Type != anchorGlyph.Type {
+			matched = false
+		}
+		pulled = append(pulled, entity)
+	}
+
+	// Left to right, every target cell is either gap or already vacated
+	for _, entity := range pulled {
+		pos, _ := s.world.Positions.GetPosition(entity)
+		pos.X -= shift
+		s.world.Positions.SetPosition(entity, pos)
+	}
+
+	if !matched {
+		s.world.PushEvent(event.EventHeatAddRequest, &event.HeatAddRequestPayload{Delta: -parameter.JoinMismatchHeatPenalty})
+		s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+			ID: parameter.Sfx.Error,
+		})
+		return
+	}
+
+	s.world.PushEvent(event.EventEnergyAddRequest, &event.EnergyAddPayload{
+		Delta: len(pulled) * parameter.JoinBonusPerChar,
+		Type:  component.EnergyDeltaReward,
+	})
+	s.world.PushEvent(event.EventSoundRequest, &event.SoundRequestPayload{
+		ID: parameter.Sfx.Coin,
+	})
+	s.emitTypingFeedback(anchorGlyph.Type)
+}
@@ -65,6 +65,7 @@ func (s *WeaponSystem) EventTypes() []event.EventType {
 		event.EventEnergyCrossedZero,
 		event.EventWeaponFireRequest,
 		event.EventWeaponFireRequest,
+		event.EventSeekerFireRequest,
 		event.EventMetaSystemCommandRequest,
 		event.EventGameReset,
 	}
@@ -99,6 +100,11 @@ func (s *WeaponSystem) HandleEvent(ev event.GameEvent) {
 
 	case event.EventWeaponFireRequest:
 		s.handleFireMain()
+
+	case event.EventSeekerFireRequest:
+		if payload, ok := ev.Payload.(*event.SeekerFireRequestPayload); ok {
+			s.fireSeeker(payload.X, payload.Y)
+		}
 	}
 }
 
@@ -653,6 +659,53 @@ func (s *WeaponSystem) fireAllWeapons() {
 	s.world.Components.Weapon.SetComponent(cursorEntity, weaponComp)
 }
 
+// fireSeeker launches a seeker from the launcher orb at the sequence under x, y, sharing the launcher cooldown
+func (s *WeaponSystem) fireSeeker(x, y int) {
+	cursorEntity := s.world.Resources.Player.Entity
+	cursorPos, ok := s.world.Positions.GetPosition(cursorEntity)
+	if !ok {
+		return
+	}
+
+	weaponComp, ok := s.world.Components.Weapon.GetComponent(cursorEntity)
+	if !ok || weaponComp.Charges[component.WeaponLauncher] <= 0 {
+		s.pushStatus("Seeker needs the launcher")
+		return
+	}
+	if weaponComp.Cooldown[component.WeaponLauncher] > 0 {
+		s.pushStatus("Launcher recharging")
+		return
+	}
+
+	weaponComp.Cooldown[component.WeaponLauncher] = parameter.WeaponCooldownSeeker
+	launcherOrbEntity := weaponComp.Orbs[component.WeaponLauncher]
+
+	originX, originY := cursorPos.X, cursorPos.Y
+	if launcherOrbEntity != 0 {
+		s.triggerOrbFlash(launcherOrbEntity)
+		if orbPos, ok := s.world.Positions.GetPosition(launcherOrbEntity); ok {
+			originX, originY = orbPos.X, orbPos.Y
+		}
+	}
+
+	s.world.PushEvent(event.EventSeekerSpawnRequest, &event.SeekerSpawnRequestPayload{
+		OwnerEntity:  cursorEntity,
+		OriginEntity: launcherOrbEntity,
+		OriginX:      originX,
+		OriginY:      originY,
+		TargetX:      x,
+		TargetY:      y,
+	})
+
+	s.world.Components.Weapon.SetComponent(cursorEntity, weaponComp)
+}
+
+func (s *WeaponSystem) pushStatus(msg string) {
+	s.world.PushEvent(event.EventMetaStatusMessageRequest, &event.MetaStatusMessagePayload{
+		Message: msg,
+	})
+}
+
 func (s *WeaponSystem) fireDisruptorWeapon(cursorEntity core.Entity, cursorPos component.PositionComponent, weaponComp *component.WeaponComponent) {
 	targets := FindTargetsInEllipse(s.world, cursorPos.X, cursorPos.Y, parameter.PulseRadiusInvRxSq, parameter.PulseRadiusInvRySq, cursorEntity)
 	if len(targets) == 0 {
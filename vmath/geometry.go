@@ -0,0 +1,113 @@
+package vmath
+
+// AABB is an axis-aligned bounding box in Q32.32, Min inclusive and Max exclusive
+type AABB struct {
+	Min, Max Vec2
+}
+
+// AABBFromCenter builds a box around center with the given half extents
+func AABBFromCenter(center Vec2, halfW, halfH int64) AABB {
+	return AABB{
+		Min: Vec2{center.X - halfW, center.Y - halfH},
+		Max: Vec2{center.X + halfW, center.Y + halfH},
+	}
+}
+
+// AABBFromCell returns the box covering one grid cell
+func AABBFromCell(x, y int) AABB {
+	return AABB{
+		Min: Vec2{FromInt(x), FromInt(y)},
+		Max: Vec2{FromInt(x + 1), FromInt(y + 1)},
+	}
+}
+
+// Contains reports whether p lies inside the box
+func (b AABB) Contains(p Vec2) bool {
+	return p.X >= b.Min.X && p.X < b.Max.X && p.Y >= b.Min.Y && p.Y < b.Max.Y
+}
+
+// Overlaps reports whether two boxes share any area
+func (b AABB) Overlaps(o AABB) bool {
+	return b.Min.X < o.Max.X && o.Min.X < b.Max.X && b.Min.Y < o.Max.Y && o.Min.Y < b.Max.Y
+}
+
+// Expand grows the box by margin on every side
+func (b AABB) Expand(margin int64) AABB {
+	return AABB{
+		Min: Vec2{b.Min.X - margin, b.Min.Y - margin},
+		Max: Vec2{b.Max.X + margin, b.Max.Y + margin},
+	}
+}
+
+// ClosestPoint returns the point of the box nearest p
+func (b AABB) ClosestPoint(p Vec2) Vec2 {
+	return Vec2{clamp64(p.X, b.Min.X, b.Max.X), clamp64(p.Y, b.Min.Y, b.Max.Y)}
+}
+
+// CirclesOverlap reports whether two circles intersect or touch
+func CirclesOverlap(c1 Vec2, r1 int64, c2 Vec2, r2 int64) bool {
+	r := r1 + r2
+	return c1.DistSq(c2) <= Mul(r, r)
+}
+
+// CircleOverlapsAABB reports whether a circle intersects a box
+func CircleOverlapsAABB(center Vec2, radius int64, b AABB) bool {
+	return center.DistSq(b.ClosestPoint(center)) <= Mul(radius, radius)
+}
+
+// SegmentIntersect returns the intersection point of segments p1-p2 and q1-q2
+// Collinear overlapping segments report the first endpoint found on the other segment
+func SegmentIntersect(p1, p2, q1, q2 Vec2) (Vec2, bool) {
+	r := p2.Sub(p1)
+	s := q2.Sub(q1)
+	qp := q1.Sub(p1)
+	denom := r.Cross(s)
+
+	if denom == 0 {
+		// Parallel; only collinear overlap intersects
+		if qp.Cross(r) != 0 {
+			return Vec2{}, false
+		}
+		for _, c := range [4]Vec2{q1, q2, p1, p2} {
+			if onSegment(p1, p2, c) && onSegment(q1, q2, c) {
+				return c, true
+			}
+		}
+		return Vec2{}, false
+	}
+
+	// p1 + t*r == q1 + u*s, t and u in [0, 1]
+	t := Div(qp.Cross(s), denom)
+	u := Div(qp.Cross(r), denom)
+	if t < 0 || t > Scale || u < 0 || u > Scale {
+		return Vec2{}, false
+	}
+	return p1.Add(r.Scale(t)), true
+}
+
+// SegmentCircleIntersect reports whether segment a-b passes within radius of center
+// Use for swept collision of fast movers that skip cells between frames
+func SegmentCircleIntersect(a, b, center Vec2, radius int64) bool {
+	return center.DistSq(ClosestPointOnSegment(a, b, center)) <= Mul(radius, radius)
+}
+
+// ClosestPointOnSegment projects p onto segment a-b, clamped to the endpoints
+func ClosestPointOnSegment(a, b, p Vec2) Vec2 {
+	ab := b.Sub(a)
+	lenSq := ab.MagSq()
+	if lenSq == 0 {
+		return a
+	}
+	t := clamp64(Div(p.Sub(a).Dot(ab), lenSq), 0, Scale)
+	return a.Add(ab.Scale(t))
+}
+
+// onSegment reports whether collinear point c lies within the bounds of segment a-b
+func onSegment(a, b, c Vec2) bool {
+	return c.X >= min(a.X, b.X) && c.X <= max(a.X, b.X) &&
+		c.Y >= min(a.Y, b.Y) && c.Y <= max(a.Y, b.Y)
+}
+
+func clamp64(v, lo, hi int64) int64 {
+	return max(lo, min(v, hi))
+}
@@ -0,0 +1,63 @@
+package vmath
+
+import "math"
+
+// --- Noise ---
+
+// ValueNoise is seeded 2D value noise: hashed lattice values with smoothstep interpolation
+// Output is in [0, 1); equal seeds give equal fields
+type ValueNoise struct {
+	seed uint64
+}
+
+// NewValueNoise creates a noise field for seed
+func NewValueNoise(seed uint64) ValueNoise {
+	return ValueNoise{seed: seed}
+}
+
+// lattice hashes a lattice point to [0, 1) with a splitmix64 finalizer
+func (n ValueNoise) lattice(x, y int) float64 {
+	h := n.seed ^ uint64(int64(x))*0x9E3779B97F4A7C15 ^ uint64(int64(y))*0xC2B2AE3D27D4EB4F
+	h ^= h >> 30
+	h *= 0xBF58476D1CE4E5B9
+	h ^= h >> 27
+	h *= 0x94D049BB133111EB
+	h ^= h >> 31
+	return float64(h>>11) / (1 << 53)
+}
+
+// At samples the field at x, y
+func (n ValueNoise) At(x, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	ix, iy := int(x0), int(y0)
+	fx, fy := x-x0, y-y0
+	sx := fx * fx * (3 - 2*fx)
+	sy := fy * fy * (3 - 2*fy)
+
+	a := n.lattice(ix, iy)
+	b := n.lattice(ix+1, iy)
+	c := n.lattice(ix, iy+1)
+	d := n.lattice(ix+1, iy+1)
+
+	top := a + (b-a)*sx
+	bottom := c + (d-c)*sx
+	return top + (bottom-top)*sy
+}
+
+// Fractal sums octaves at doubling frequency and halving amplitude, normalized to [0, 1)
+func (n ValueNoise) Fractal(x, y float64, octaves int) float64 {
+	if octaves < 1 {
+		octaves = 1
+	}
+	sum, amp, norm := 0.0, 1.0, 0.0
+	for i := range octaves {
+		// Offset each octave so lattice points do not line up
+		off := float64(i) * 17.31
+		sum += amp * n.At(x+off, y+off)
+		norm += amp
+		x *= 2
+		y *= 2
+		amp *= 0.5
+	}
+	return sum / norm
+}
@@ -13,6 +13,19 @@ func NewFastRand(seed uint64) *FastRand {
 	return &FastRand{state: seed}
 }
 
+// State returns the generator state for serialization
+func (r *FastRand) State() uint64 {
+	return r.state
+}
+
+// SetState restores a state from State, zero is remapped as in NewFastRand
+func (r *FastRand) SetState(state uint64) {
+	if state == 0 {
+		state = 1
+	}
+	r.state = state
+}
+
 func (r *FastRand) Next() uint64 {
 	r.state ^= r.state << 13
 	r.state ^= r.state >> 17
@@ -0,0 +1,130 @@
+package vmath
+
+import "fmt"
+
+// --- Random Streams ---
+
+// RandStream names an independent random channel
+type RandStream uint8
+
+const (
+	StreamSpawn   RandStream = iota // Content, collectibles, enemy placement
+	StreamEffects                   // Particles and cosmetic rolls
+	StreamAI                        // Enemy decisions and movement jitter
+	RandStreamCount
+)
+
+// RandStreamNames are the serialized channel names, indexed by RandStream
+var RandStreamNames = [RandStreamCount]string{"spawn", "effects", "ai"}
+
+// String returns the channel name
+func (s RandStream) String() string {
+	if s < RandStreamCount {
+		return RandStreamNames[s]
+	}
+	return "unknown"
+}
+
+// RandStreams is a seedable set of independent FastRand channels derived from one seed
+// Draws on one channel never shift another, so effect changes leave spawns reproducible
+// Streams are stable pointers: reseeding and restoring update them in place
+type RandStreams struct {
+	seed    uint64
+	streams [RandStreamCount]FastRand
+}
+
+// RandState is a serializable snapshot of RandStreams, for save and replay files
+type RandState struct {
+	Seed    uint64            `json:"seed" toml:"seed"`
+	Streams map[string]uint64 `json:"streams,omitempty" toml:"streams"`
+}
+
+// NewRandStreams creates channels derived from seed
+func NewRandStreams(seed uint64) *RandStreams {
+	r := &RandStreams{}
+	r.Reseed(seed)
+	return r
+}
+
+// splitMix64 is the splitmix64 step, used to derive well-mixed seeds
+func splitMix64(x uint64) uint64 {
+	x += 0x9E3779B97F4A7C15
+	x = (x ^ (x >> 30)) * 0xBF58476D1CE4E5B9
+	x = (x ^ (x >> 27)) * 0x94D049BB133111EB
+	return x ^ (x >> 31)
+}
+
+// streamSeed derives a channel's initial state from the master seed and the channel name
+// Keyed by name so adding a channel never changes the others
+func streamSeed(seed uint64, name string) uint64 {
+	h := uint64(14695981039346656037) // FNV-1a offset basis
+	for i := 0; i < len(name); i++ {
+		h ^= uint64(name[i])
+		h *= 1099511628211
+	}
+	return splitMix64(seed ^ h)
+}
+
+// Reseed resets every channel from a new master seed
+func (r *RandStreams) Reseed(seed uint64) {
+	r.seed = seed
+	for i := range r.streams {
+		r.streams[i].SetState(streamSeed(seed, RandStreamNames[i]))
+	}
+}
+
+// Advance reseeds from a seed derived from the current one
+// A new game after reset stays reproducible from the session's original seed
+func (r *RandStreams) Advance() {
+	r.Reseed(splitMix64(r.seed))
+}
+
+// Seed returns the current master seed
+func (r *RandStreams) Seed() uint64 {
+	return r.seed
+}
+
+// DeriveSeed returns a seed keyed by name for a generator kept outside the streams,
+// such as one owned by a service goroutine
+func (r *RandStreams) DeriveSeed(name string) uint64 {
+	return streamSeed(r.seed, name)
+}
+
+// Stream returns the generator for a channel
+func (r *RandStreams) Stream(s RandStream) *FastRand {
+	return &r.streams[s]
+}
+
+// Snapshot captures the seed and every channel's current state
+func (r *RandStreams) Snapshot() RandState {
+	st := RandState{Seed: r.seed, Streams: make(map[string]uint64, RandStreamCount)}
+	for i := range r.streams {
+		st.Streams[RandStreamNames[i]] = r.streams[i].State()
+	}
+	return st
+}
+
+// Restore applies a snapshot; channels missing from it start fresh from its seed
+func (r *RandStreams) Restore(st RandState) error {
+	for name := range st.Streams {
+		if !isRandStreamName(name) {
+			return fmt.Errorf("unknown random stream %q", name)
+		}
+	}
+	r.Reseed(st.Seed)
+	for i := range r.streams {
+		if state, ok := st.Streams[RandStreamNames[i]]; ok {
+			r.streams[i].SetState(state)
+		}
+	}
+	return nil
+}
+
+func isRandStreamName(name string) bool {
+	for _, n := range RandStreamNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -44,3 +44,14 @@ func SignF(x float64) float64 {
 	}
 	return 0.0
 }
+
+// EaseOutCubicF decelerates toward 1, t in [0.0, 1.0]
+func EaseOutCubicF(t float64) float64 {
+	u := 1 - t
+	return 1 - u*u*u
+}
+
+// EaseInQuadF accelerates away from 0, t in [0.0, 1.0]
+func EaseInQuadF(t float64) float64 {
+	return t * t
+}
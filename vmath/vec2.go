@@ -117,4 +117,110 @@ func ReflectAxisX(velX, velY int64) (int64, int64) {
 // Use for top/bottom screen edge collision
 func ReflectAxisY(velX, velY int64) (int64, int64) {
 	return velX, -velY
-}
\ No newline at end of file
+}
+
+// Vec2 is a 2D vector in Q32.32 fixed-point
+// Value type; methods wrap the free functions above so both forms stay interchangeable
+type Vec2 struct {
+	X, Y int64
+}
+
+// V2 creates a Vec2 from Q32.32 components
+func V2(x, y int64) Vec2 {
+	return Vec2{X: x, Y: y}
+}
+
+// V2FromGrid returns the centered Q32.32 position of a grid cell
+func V2FromGrid(x, y int) Vec2 {
+	cx, cy := CenteredFromGrid(x, y)
+	return Vec2{X: cx, Y: cy}
+}
+
+// Grid returns the grid cell containing the vector
+func (v Vec2) Grid() (int, int) {
+	return GridFromCentered(v.X, v.Y)
+}
+
+// XY returns the components for callers still using int64 pairs
+func (v Vec2) XY() (int64, int64) {
+	return v.X, v.Y
+}
+
+func (v Vec2) Add(o Vec2) Vec2 {
+	return Vec2{v.X + o.X, v.Y + o.Y}
+}
+
+func (v Vec2) Sub(o Vec2) Vec2 {
+	return Vec2{v.X - o.X, v.Y - o.Y}
+}
+
+func (v Vec2) Neg() Vec2 {
+	return Vec2{-v.X, -v.Y}
+}
+
+// Scale multiplies by a Q32.32 factor
+func (v Vec2) Scale(factor int64) Vec2 {
+	x, y := ScaleVector(v.X, v.Y, factor)
+	return Vec2{x, y}
+}
+
+func (v Vec2) Dot(o Vec2) int64 {
+	return DotProduct(v.X, v.Y, o.X, o.Y)
+}
+
+// Cross returns the z component of the 3D cross product, positive when o is counter-clockwise of v
+func (v Vec2) Cross(o Vec2) int64 {
+	return Mul(v.X, o.Y) - Mul(v.Y, o.X)
+}
+
+func (v Vec2) Mag() int64 {
+	return Magnitude(v.X, v.Y)
+}
+
+func (v Vec2) MagSq() int64 {
+	return MagnitudeSq(v.X, v.Y)
+}
+
+// MagApprox returns approximate length (~4% error)
+func (v Vec2) MagApprox() int64 {
+	return MagnitudeApprox(v.X, v.Y)
+}
+
+// Normalize returns the unit vector, zero-safe
+func (v Vec2) Normalize() Vec2 {
+	x, y := Normalize2D(v.X, v.Y)
+	return Vec2{x, y}
+}
+
+func (v Vec2) ClampMagnitude(maxMag int64) Vec2 {
+	x, y := ClampMagnitude(v.X, v.Y, maxMag)
+	return Vec2{x, y}
+}
+
+// Perpendicular returns the vector rotated 90° counter-clockwise
+func (v Vec2) Perpendicular() Vec2 {
+	x, y := Perpendicular(v.X, v.Y)
+	return Vec2{x, y}
+}
+
+// Rotate rotates by a Q32.32 angle where Scale = 2π
+func (v Vec2) Rotate(angle int64) Vec2 {
+	x, y := RotateVector(v.X, v.Y, angle)
+	return Vec2{x, y}
+}
+
+// Reflect reflects off a surface with unit normal n
+func (v Vec2) Reflect(n Vec2) Vec2 {
+	x, y := Reflect(v.X, v.Y, n.X, n.Y)
+	return Vec2{x, y}
+}
+
+// Lerp interpolates toward o, t in [0, Scale]
+func (v Vec2) Lerp(o Vec2, t int64) Vec2 {
+	return Vec2{Lerp(v.X, o.X, t), Lerp(v.Y, o.Y, t)}
+}
+
+// DistSq returns squared distance to o
+func (v Vec2) DistSq(o Vec2) int64 {
+	return o.Sub(v).MagSq()
+}